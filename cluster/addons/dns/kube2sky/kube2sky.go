@@ -57,9 +57,10 @@ func addDNS(record string, service *kapi.Service, etcdClient *etcd.Client) error
 		return nil
 	}
 
+	// TODO: Support multi-port services with SRV records per port.
 	svc := skymsg.Service{
 		Host:     service.Spec.PortalIP,
-		Port:     service.Spec.Port,
+		Port:     service.Spec.Ports[0].Port,
 		Priority: 10,
 		Weight:   10,
 		Ttl:      30,
@@ -70,7 +71,7 @@ func addDNS(record string, service *kapi.Service, etcdClient *etcd.Client) error
 	}
 	// Set with no TTL, and hope that kubernetes events are accurate.
 
-	log.Printf("Setting dns record: %v -> %s:%d\n", record, service.Spec.PortalIP, service.Spec.Port)
+	log.Printf("Setting dns record: %v -> %s:%d\n", record, service.Spec.PortalIP, service.Spec.Ports[0].Port)
 	_, err = etcdClient.Set(skymsg.Path(record), string(b), uint64(0))
 	return err
 }
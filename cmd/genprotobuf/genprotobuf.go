@@ -0,0 +1,101 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// genprotobuf writes the protobuf marshalers of the v1beta3 API types.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	_ "github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/golang/glog"
+	flag "github.com/spf13/pflag"
+)
+
+const header = `/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// generated by hack/update-generated-protobuf.sh; DO NOT EDIT
+
+`
+
+var output = flag.StringP("output", "o", "-", "Output file, or - for stdout")
+
+func main() {
+	flag.Parse()
+
+	generator := conversion.NewProtobufGenerator("github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3")
+	generator.AddHelper(reflect.TypeOf(util.Time{}), "appendProtobufTime", "unmarshalProtobufTime")
+	generator.AddHelper(reflect.TypeOf(resource.Quantity{}), "appendProtobufQuantity", "unmarshalProtobufQuantity")
+	generator.AddHelper(reflect.TypeOf(util.IntOrString{}), "appendProtobufIntOrString", "unmarshalProtobufIntOrString")
+	generator.AddHelper(reflect.TypeOf(runtime.RawExtension{}), "appendProtobufRawExtension", "unmarshalProtobufRawExtension")
+
+	knownTypes := api.Scheme.KnownTypes("v1beta3")
+	kinds := []string{}
+	for kind := range knownTypes {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		if err := generator.Add(knownTypes[kind]); err != nil {
+			glog.Fatalf("Unable to generate the protobuf marshaler of %s: %v", kind, err)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	if err := generator.Write(buf, "v1beta3"); err != nil {
+		glog.Fatalf("Unable to write the protobuf marshalers: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			glog.Fatalf("Unable to create %s: %v", *output, err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if _, err := buf.WriteTo(w); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to write the protobuf marshalers: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -280,10 +280,16 @@ func endpointsSet(c *client.Client, serviceNamespace, serviceID string, endpoint
 			glog.Infof("Error on creating endpoints: %v", err)
 			return false, nil
 		}
-		for _, e := range endpoints.Endpoints {
-			glog.Infof("%s/%s endpoint: %s:%d %#v", serviceNamespace, serviceID, e.IP, e.Port, e.TargetRef)
+		count := 0
+		for _, ss := range endpoints.Subsets {
+			for _, addr := range ss.Addresses {
+				for _, port := range ss.Ports {
+					count++
+					glog.Infof("%s/%s endpoint: %s:%d %#v", serviceNamespace, serviceID, addr.IP, port.Port, addr.TargetRef)
+				}
+			}
 		}
-		return len(endpoints.Endpoints) == endpointCount, nil
+		return count == endpointCount, nil
 	}
 }
 
@@ -368,7 +374,7 @@ containers:
 				glog.Fatalf("%s FAILED: mirror pod has not been created or is not running: %v", desc, err)
 			}
 			// Delete the mirror pod, and wait for it to be recreated.
-			c.Pods(namespace).Delete(podName)
+			c.Pods(namespace).Delete(podName, nil)
 			if err = wait.Poll(time.Second, time.Second*30,
 				podRunning(c, namespace, podName)); err != nil {
 				glog.Fatalf("%s FAILED: mirror pod has not been re-created or is not running: %v", desc, err)
@@ -444,13 +450,16 @@ func runSelfLinkTestOnNamespace(c *client.Client, namespace string) {
 			},
 		},
 		Spec: api.ServiceSpec{
-			Port: 12345,
 			// This is here because validation requires it.
 			Selector: map[string]string{
 				"foo": "bar",
 			},
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     12345,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	services := c.Services(namespace)
@@ -506,13 +515,16 @@ func runAtomicPutTest(c *client.Client) {
 			},
 		},
 		Spec: api.ServiceSpec{
-			Port: 12345,
 			// This is here because validation requires it.
 			Selector: map[string]string{
 				"foo": "bar",
 			},
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     12345,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	services := c.Services(api.NamespaceDefault)
@@ -585,13 +597,16 @@ func runPatchTest(c *client.Client) {
 			},
 		},
 		Spec: api.ServiceSpec{
-			Port: 12345,
 			// This is here because validation requires it.
 			Selector: map[string]string{
 				"foo": "bar",
 			},
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     12345,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	services := c.Services(api.NamespaceDefault)
@@ -664,7 +679,7 @@ func runMasterServiceTest(client *client.Client) {
 		if err != nil {
 			glog.Fatalf("unexpected error listing endpoints for kubernetes service: %v", err)
 		}
-		if len(ep.Endpoints) == 0 {
+		if len(ep.Subsets) == 0 {
 			glog.Fatalf("no endpoints for kubernetes service: %v", ep)
 		}
 	} else {
@@ -675,7 +690,7 @@ func runMasterServiceTest(client *client.Client) {
 		if err != nil {
 			glog.Fatalf("unexpected error listing endpoints for kubernetes service: %v", err)
 		}
-		if len(ep.Endpoints) == 0 {
+		if len(ep.Subsets) == 0 {
 			glog.Fatalf("no endpoints for kubernetes service: %v", ep)
 		}
 	} else {
@@ -726,9 +741,12 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     8080,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	svc1, err = client.Services(api.NamespaceDefault).Create(svc1)
@@ -743,9 +761,12 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     8080,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	svc3, err = client.Services("other").Create(svc3)
@@ -763,9 +784,12 @@ func runServiceTest(client *client.Client) {
 			Selector: map[string]string{
 				"name": "thisisalonglabel",
 			},
-			Port:            8080,
-			Protocol:        "TCP",
+			Ports: []api.ServicePort{{
+				Port:     8080,
+				Protocol: "TCP",
+			}},
 			SessionAffinity: "None",
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	svc2, err = client.Services(api.NamespaceDefault).Create(svc2)
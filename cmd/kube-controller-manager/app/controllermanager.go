@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
 	nodeControllerPkg "github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider/controller"
 	replicationControllerPkg "github.com/GoogleCloudPlatform/kubernetes/pkg/controller"
@@ -36,7 +37,9 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/namespace"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/resourcequota"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/service"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volumeclaimbinder"
 
 	"github.com/golang/glog"
 	"github.com/spf13/pflag"
@@ -44,20 +47,32 @@ import (
 
 // CMServer is the main context object for the controller manager.
 type CMServer struct {
-	Port                    int
-	Address                 util.IP
-	ClientConfig            client.Config
-	CloudProvider           string
-	CloudConfigFile         string
-	MinionRegexp            string
-	NodeSyncPeriod          time.Duration
-	ResourceQuotaSyncPeriod time.Duration
-	NamespaceSyncPeriod     time.Duration
-	RegisterRetryCount      int
-	MachineList             util.StringList
-	SyncNodeList            bool
-	SyncNodeStatus          bool
-	PodEvictionTimeout      time.Duration
+	Port                       int
+	Address                    util.IP
+	ClientConfig               client.Config
+	CloudProvider              string
+	CloudConfigFile            string
+	MinionRegexp               string
+	NodeSyncPeriod             time.Duration
+	ResourceQuotaSyncPeriod    time.Duration
+	NamespaceSyncPeriod        time.Duration
+	PVClaimBinderSyncPeriod    time.Duration
+	JobSyncPeriod              time.Duration
+	DaemonSyncPeriod           time.Duration
+	DisruptionSyncPeriod       time.Duration
+	DeploymentSyncPeriod       time.Duration
+	GarbageCollectorSyncPeriod time.Duration
+	AutoscalerSyncPeriod       time.Duration
+	ServiceAccountSyncPeriod   time.Duration
+	RegisterRetryCount         int
+	MachineList                util.StringList
+	SyncNodeList               bool
+	SyncNodeStatus             bool
+	PodEvictionTimeout         time.Duration
+
+	// ServiceAccountKeyFile is the PEM-encoded RSA private key used to sign
+	// service account tokens. The tokens controller only runs when it is set.
+	ServiceAccountKeyFile string
 
 	// TODO: Discover these by pinging the host machines, and rip out these params.
 	NodeMilliCPU int64
@@ -70,17 +85,25 @@ type CMServer struct {
 // NewCMServer creates a new CMServer with a default config.
 func NewCMServer() *CMServer {
 	s := CMServer{
-		Port:                    ports.ControllerManagerPort,
-		Address:                 util.IP(net.ParseIP("127.0.0.1")),
-		NodeSyncPeriod:          10 * time.Second,
-		ResourceQuotaSyncPeriod: 10 * time.Second,
-		NamespaceSyncPeriod:     1 * time.Minute,
-		RegisterRetryCount:      10,
-		PodEvictionTimeout:      5 * time.Minute,
-		NodeMilliCPU:            1000,
-		NodeMemory:              resource.MustParse("3Gi"),
-		SyncNodeList:            true,
-		SyncNodeStatus:          false,
+		Port:                       ports.ControllerManagerPort,
+		Address:                    util.IP(net.ParseIP("127.0.0.1")),
+		NodeSyncPeriod:             10 * time.Second,
+		ResourceQuotaSyncPeriod:    10 * time.Second,
+		NamespaceSyncPeriod:        1 * time.Minute,
+		PVClaimBinderSyncPeriod:    10 * time.Second,
+		JobSyncPeriod:              10 * time.Second,
+		DaemonSyncPeriod:           10 * time.Second,
+		DisruptionSyncPeriod:       10 * time.Second,
+		GarbageCollectorSyncPeriod: 30 * time.Second,
+		DeploymentSyncPeriod:       10 * time.Second,
+		AutoscalerSyncPeriod:       30 * time.Second,
+		ServiceAccountSyncPeriod:   10 * time.Second,
+		RegisterRetryCount:         10,
+		PodEvictionTimeout:         5 * time.Minute,
+		NodeMilliCPU:               1000,
+		NodeMemory:                 resource.MustParse("3Gi"),
+		SyncNodeList:               true,
+		SyncNodeStatus:             false,
 		KubeletConfig: client.KubeletConfig{
 			Port:        ports.KubeletPort,
 			EnableHttps: false,
@@ -102,6 +125,15 @@ func (s *CMServer) AddFlags(fs *pflag.FlagSet) {
 		"fewer calls to cloud provider, but may delay addition of new nodes to cluster.")
 	fs.DurationVar(&s.ResourceQuotaSyncPeriod, "resource_quota_sync_period", s.ResourceQuotaSyncPeriod, "The period for syncing quota usage status in the system")
 	fs.DurationVar(&s.NamespaceSyncPeriod, "namespace_sync_period", s.NamespaceSyncPeriod, "The period for syncing namespace life-cycle updates")
+	fs.DurationVar(&s.PVClaimBinderSyncPeriod, "pvclaimbinder_sync_period", s.PVClaimBinderSyncPeriod, "The period for syncing persistent volumes and persistent volume claims")
+	fs.DurationVar(&s.JobSyncPeriod, "job_sync_period", s.JobSyncPeriod, "The period for syncing jobs with the pods that execute them")
+	fs.DurationVar(&s.DaemonSyncPeriod, "daemon_sync_period", s.DaemonSyncPeriod, "The period for syncing daemon sets with the nodes they run on")
+	fs.DurationVar(&s.DisruptionSyncPeriod, "disruption_sync_period", s.DisruptionSyncPeriod, "The period for syncing the healthy pods of pod disruption budgets")
+	fs.DurationVar(&s.GarbageCollectorSyncPeriod, "garbage_collector_sync_period", s.GarbageCollectorSyncPeriod, "The period for deleting the objects whose owners are gone, and orphaning the dependents of the objects deleted with the orphan finalizer")
+	fs.DurationVar(&s.DeploymentSyncPeriod, "deployment_sync_period", s.DeploymentSyncPeriod, "The period for syncing deployments with the replication controllers that roll them out")
+	fs.DurationVar(&s.AutoscalerSyncPeriod, "autoscaler_sync_period", s.AutoscalerSyncPeriod, "The period for syncing the number of pods of horizontal pod autoscalers with their CPU usage")
+	fs.DurationVar(&s.ServiceAccountSyncPeriod, "service_account_sync_period", s.ServiceAccountSyncPeriod, "The period for syncing service accounts and their API tokens")
+	fs.StringVar(&s.ServiceAccountKeyFile, "service_account_private_key_file", s.ServiceAccountKeyFile, "Filename containing a PEM-encoded private RSA key used to sign service account tokens.")
 	fs.DurationVar(&s.PodEvictionTimeout, "pod_eviction_timeout", s.PodEvictionTimeout, "The grace peroid for deleting pods on failed nodes.")
 	fs.IntVar(&s.RegisterRetryCount, "register_retry_count", s.RegisterRetryCount, ""+
 		"The number of retries for initial node registration.  Retry interval equals node_sync_period.")
@@ -156,12 +188,36 @@ func (s *CMServer) Run(_ []string) error {
 		http.ListenAndServe(net.JoinHostPort(s.Address.String(), strconv.Itoa(s.Port)), nil)
 	}()
 
+	record.StartRecording(kubeClient.Events(""))
+
 	endpoints := service.NewEndpointController(kubeClient)
 	go util.Forever(func() { endpoints.SyncServiceEndpoints() }, time.Second*10)
 
 	controllerManager := replicationControllerPkg.NewReplicationManager(kubeClient)
 	controllerManager.Run(replicationControllerPkg.DefaultSyncPeriod)
 
+	jobManager := replicationControllerPkg.NewJobManager(kubeClient)
+	jobManager.Run(s.JobSyncPeriod)
+
+	daemonManager := replicationControllerPkg.NewDaemonManager(kubeClient)
+	daemonManager.Run(s.DaemonSyncPeriod)
+
+	disruptionManager := replicationControllerPkg.NewDisruptionManager(kubeClient)
+	disruptionManager.Run(s.DisruptionSyncPeriod)
+
+	deploymentManager := replicationControllerPkg.NewDeploymentManager(kubeClient)
+	deploymentManager.Run(s.DeploymentSyncPeriod)
+
+	garbageCollector := replicationControllerPkg.NewGarbageCollector(kubeClient)
+	garbageCollector.Run(s.GarbageCollectorSyncPeriod)
+
+	containerInfoGetter := &client.HTTPContainerInfoGetter{
+		Client: http.DefaultClient,
+		Port:   int(s.KubeletConfig.Port),
+	}
+	autoscalerManager := replicationControllerPkg.NewAutoscalerManager(kubeClient, containerInfoGetter)
+	autoscalerManager.Run(s.AutoscalerSyncPeriod)
+
 	kubeletClient, err := client.NewKubeletClient(&s.KubeletConfig)
 	if err != nil {
 		glog.Fatalf("Failure to start kubelet client: %v", err)
@@ -185,6 +241,22 @@ func (s *CMServer) Run(_ []string) error {
 	namespaceManager := namespace.NewNamespaceManager(kubeClient)
 	namespaceManager.Run(s.NamespaceSyncPeriod)
 
+	pvclaimBinder := volumeclaimbinder.NewPersistentVolumeClaimBinder(kubeClient)
+	pvclaimBinder.Run(s.PVClaimBinderSyncPeriod)
+
+	serviceAccountsController := serviceaccount.NewServiceAccountsController(kubeClient)
+	serviceAccountsController.Run(s.ServiceAccountSyncPeriod)
+
+	if len(s.ServiceAccountKeyFile) > 0 {
+		privateKey, err := serviceaccount.ReadPrivateKey(s.ServiceAccountKeyFile)
+		if err != nil {
+			glog.Errorf("Error reading key for service account token controller: %v", err)
+		} else {
+			tokensController := serviceaccount.NewTokensController(kubeClient, serviceaccount.JWTTokenGenerator(privateKey))
+			tokensController.Run(s.ServiceAccountSyncPeriod)
+		}
+	}
+
 	select {}
 	return nil
 }
@@ -0,0 +1,113 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package app implements a server that routes HTTP requests to services
+// according to the rules of Ingress objects.
+package app
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/ingress"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/golang/glog"
+	"github.com/spf13/pflag"
+)
+
+// RouterServer configures and runs an ingress router.
+type RouterServer struct {
+	BindAddress   util.IP
+	Port          int
+	SecurePort    int
+	PublicAddress string
+	SyncPeriod    time.Duration
+	ClientConfig  client.Config
+	HealthzPort   int
+}
+
+// NewRouterServer creates a new RouterServer object with default parameters.
+func NewRouterServer() *RouterServer {
+	return &RouterServer{
+		BindAddress: util.IP(net.ParseIP("0.0.0.0")),
+		Port:        80,
+		SecurePort:  443,
+		SyncPeriod:  10 * time.Second,
+		HealthzPort: ports.IngressRouterPort,
+	}
+}
+
+// AddFlags adds flags for a specific RouterServer to the specified FlagSet.
+func (s *RouterServer) AddFlags(fs *pflag.FlagSet) {
+	fs.Var(&s.BindAddress, "bind_address", "The IP address for the router to serve on (set to 0.0.0.0 for all interfaces)")
+	fs.IntVar(&s.Port, "port", s.Port, "The port to serve HTTP requests on.")
+	fs.IntVar(&s.SecurePort, "secure_port", s.SecurePort, "The port to serve HTTPS requests on, with the certificates of the ingresses. Use 0 to disable.")
+	fs.StringVar(&s.PublicAddress, "public_address", s.PublicAddress, "The address clients reach the router at, written into the status of the ingresses. If empty, the status of the ingresses is left alone.")
+	fs.DurationVar(&s.SyncPeriod, "sync_period", s.SyncPeriod, "The period for resyncing the routes with the ingresses, services, endpoints and secrets. The routes are also rebuilt whenever one of them changes.")
+	client.BindClientConfigFlags(fs, &s.ClientConfig)
+	fs.IntVar(&s.HealthzPort, "healthz_port", s.HealthzPort, "The port to bind the health check server. Use 0 to disable.")
+}
+
+// Run runs the specified RouterServer. This should never exit.
+func (s *RouterServer) Run(_ []string) error {
+	if len(s.ClientConfig.Host) == 0 {
+		return fmt.Errorf("--master must be set")
+	}
+	kubeClient, err := client.New(&s.ClientConfig)
+	if err != nil {
+		glog.Fatalf("Invalid API configuration: %v", err)
+	}
+
+	router := ingress.NewRouter()
+	ingress.NewIngressController(kubeClient, router, s.PublicAddress).Run(s.SyncPeriod)
+
+	if s.HealthzPort > 0 {
+		go util.Forever(func() {
+			err := http.ListenAndServe(net.JoinHostPort(s.BindAddress.String(), strconv.Itoa(s.HealthzPort)), nil)
+			if err != nil {
+				glog.Errorf("Starting health server failed: %v", err)
+			}
+		}, 5*time.Second)
+	}
+
+	if s.SecurePort > 0 {
+		secureAddr := net.JoinHostPort(s.BindAddress.String(), strconv.Itoa(s.SecurePort))
+		tlsConfig := &tls.Config{GetCertificate: router.GetCertificate}
+		go util.Forever(func() {
+			l, err := net.Listen("tcp", secureAddr)
+			if err != nil {
+				glog.Errorf("Unable to listen on %s: %v", secureAddr, err)
+				return
+			}
+			if err := http.Serve(tls.NewListener(l, tlsConfig), router); err != nil {
+				glog.Errorf("Unable to serve HTTPS: %v", err)
+			}
+		}, 5*time.Second)
+	}
+
+	server := &http.Server{
+		Addr:    net.JoinHostPort(s.BindAddress.String(), strconv.Itoa(s.Port)),
+		Handler: router,
+	}
+	return server.ListenAndServe()
+}
@@ -21,6 +21,7 @@ readonly KUBE_GOPATH="${KUBE_OUTPUT}/go"
 # The set of server targets that we are only building for Linux
 readonly KUBE_SERVER_TARGETS=(
   cmd/kube-proxy
+  cmd/kube-ingress-router
   cmd/kube-apiserver
   cmd/kube-controller-manager
   cmd/kubelet
@@ -0,0 +1,38 @@
+#!/bin/bash
+
+# Copyright 2015 Google Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Verifies that the protobuf marshalers of the v1beta3 API types are up to date.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+KUBE_ROOT=$(dirname "${BASH_SOURCE}")/..
+source "${KUBE_ROOT}/hack/lib/init.sh"
+
+kube::golang::setup_env
+
+cd "${KUBE_ROOT}"
+GENERATED=pkg/api/v1beta3/protobuf_generated.go
+TMP_GENERATED=$(mktemp -t protobuf_generated.XXXXXX)
+trap "rm -f '${TMP_GENERATED}'" EXIT
+
+go run cmd/genprotobuf/genprotobuf.go -o "${TMP_GENERATED}"
+if ! diff -u "${GENERATED}" "${TMP_GENERATED}"; then
+  echo "${GENERATED} is out of date. Please run hack/update-generated-protobuf.sh"
+  exit 1
+fi
+echo "${GENERATED} up to date."
@@ -37,6 +37,15 @@ const namespaceKey key = 0
 // userKey is the context key for the request user.
 const userKey key = 1
 
+// resourceVersionKey is the context key for the resource version requested by a list.
+const resourceVersionKey key = 2
+
+// limitKey is the context key for the maximum number of items requested by a list.
+const limitKey key = 3
+
+// continueKey is the context key for the continue token of a list.
+const continueKey key = 4
+
 // NewContext instantiates a base context object for request flows.
 func NewContext() Context {
 	return context.TODO()
@@ -101,3 +110,40 @@ func UserFrom(ctx Context) (user.Info, bool) {
 	user, ok := ctx.Value(userKey).(user.Info)
 	return user, ok
 }
+
+// WithResourceVersion returns a copy of parent in which the resource version value is set.
+// A list with the resource version "0" accepts any version of the objects, and may be
+// served from a cache.
+func WithResourceVersion(parent Context, resourceVersion string) Context {
+	return WithValue(parent, resourceVersionKey, resourceVersion)
+}
+
+// ResourceVersionFrom returns the value of the resource version key on the ctx
+func ResourceVersionFrom(ctx Context) (string, bool) {
+	resourceVersion, ok := ctx.Value(resourceVersionKey).(string)
+	return resourceVersion, ok
+}
+
+// WithLimit returns a copy of parent in which the limit value is set. A list with a limit
+// returns at most limit items, and a continue token if more items remain.
+func WithLimit(parent Context, limit int) Context {
+	return WithValue(parent, limitKey, limit)
+}
+
+// LimitFrom returns the value of the limit key on the ctx
+func LimitFrom(ctx Context) (int, bool) {
+	limit, ok := ctx.Value(limitKey).(int)
+	return limit, ok
+}
+
+// WithContinue returns a copy of parent in which the continue token value is set. A list
+// with a continue token returns the items following the page which returned the token.
+func WithContinue(parent Context, continueToken string) Context {
+	return WithValue(parent, continueKey, continueToken)
+}
+
+// ContinueFrom returns the value of the continue key on the ctx
+func ContinueFrom(ctx Context) (string, bool) {
+	continueToken, ok := ctx.Value(continueKey).(string)
+	return continueToken, ok
+}
@@ -40,6 +40,37 @@ func TestNamespaceContext(t *testing.T) {
 	}
 }
 
+// TestResourceVersionContext validates that a resource version can be get/set on a context object
+func TestResourceVersionContext(t *testing.T) {
+	if _, ok := api.ResourceVersionFrom(api.NewContext()); ok {
+		t.Errorf("Should not be ok because there is no resource version on the context")
+	}
+	ctx := api.WithResourceVersion(api.NewDefaultContext(), "0")
+	if result, ok := api.ResourceVersionFrom(ctx); !ok || result != "0" {
+		t.Errorf("Expected: 0, Actual: %v", result)
+	}
+	if api.NamespaceValue(ctx) != api.NamespaceDefault {
+		t.Errorf("Expected the namespace to be kept")
+	}
+}
+
+// TestListPageContext validates that a limit and a continue token can be get/set on a context object
+func TestListPageContext(t *testing.T) {
+	if _, ok := api.LimitFrom(api.NewContext()); ok {
+		t.Errorf("Should not be ok because there is no limit on the context")
+	}
+	if _, ok := api.ContinueFrom(api.NewContext()); ok {
+		t.Errorf("Should not be ok because there is no continue token on the context")
+	}
+	ctx := api.WithContinue(api.WithLimit(api.NewContext(), 10), "token")
+	if result, ok := api.LimitFrom(ctx); !ok || result != 10 {
+		t.Errorf("Expected: 10, Actual: %v", result)
+	}
+	if result, ok := api.ContinueFrom(ctx); !ok || result != "token" {
+		t.Errorf("Expected: token, Actual: %v", result)
+	}
+}
+
 // TestValidNamespace validates that namespace rules are enforced on a resource prior to create or update
 func TestValidNamespace(t *testing.T) {
 	ctx := api.NewDefaultContext()
@@ -0,0 +1,113 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package endpoints contains helpers for working with the subsets of
+// api.Endpoints.
+package endpoints
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+// RepackSubsets expands subsets into the individual endpoints they describe
+// and packs those again into the canonical layout: every address appears in
+// exactly one subset, together with all the ports it offers, and addresses,
+// ports and subsets are sorted. Code comparing endpoints can rely on this
+// form. Addresses are de-duplicated by IP, and subsets without any address
+// or port are dropped. The result is a newly allocated slice.
+func RepackSubsets(subsets []api.EndpointSubset) []api.EndpointSubset {
+	addresses := map[string]api.EndpointAddress{}
+	ports := map[string]map[api.EndpointPort]bool{}
+	for i := range subsets {
+		for _, address := range subsets[i].Addresses {
+			for _, port := range subsets[i].Ports {
+				if _, found := addresses[address.IP]; !found {
+					addresses[address.IP] = address
+					ports[address.IP] = map[api.EndpointPort]bool{}
+				}
+				ports[address.IP][port] = true
+			}
+		}
+	}
+
+	// Group the addresses offering identical sets of ports.
+	groups := map[string]*api.EndpointSubset{}
+	keys := []string{}
+	for ip, portSet := range ports {
+		sortedPorts := make([]api.EndpointPort, 0, len(portSet))
+		for port := range portSet {
+			sortedPorts = append(sortedPorts, port)
+		}
+		sort.Sort(portsByName(sortedPorts))
+		key := portsKey(sortedPorts)
+		group, found := groups[key]
+		if !found {
+			group = &api.EndpointSubset{Ports: sortedPorts}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.Addresses = append(group.Addresses, addresses[ip])
+	}
+
+	result := make([]api.EndpointSubset, 0, len(keys))
+	for _, key := range keys {
+		group := groups[key]
+		sort.Sort(addressesByIP(group.Addresses))
+		result = append(result, *group)
+	}
+	sort.Sort(subsetsByFirstIP(result))
+	return result
+}
+
+func portsKey(ports []api.EndpointPort) string {
+	parts := make([]string, 0, len(ports))
+	for _, port := range ports {
+		parts = append(parts, fmt.Sprintf("%s/%d/%s", port.Name, port.Port, port.Protocol))
+	}
+	return strings.Join(parts, ",")
+}
+
+type portsByName []api.EndpointPort
+
+func (p portsByName) Len() int      { return len(p) }
+func (p portsByName) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p portsByName) Less(i, j int) bool {
+	if p[i].Name != p[j].Name {
+		return p[i].Name < p[j].Name
+	}
+	if p[i].Port != p[j].Port {
+		return p[i].Port < p[j].Port
+	}
+	return p[i].Protocol < p[j].Protocol
+}
+
+type addressesByIP []api.EndpointAddress
+
+func (a addressesByIP) Len() int           { return len(a) }
+func (a addressesByIP) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a addressesByIP) Less(i, j int) bool { return a[i].IP < a[j].IP }
+
+// subsetsByFirstIP orders repacked subsets, in which every address appears
+// in only one subset.
+type subsetsByFirstIP []api.EndpointSubset
+
+func (s subsetsByFirstIP) Len() int           { return len(s) }
+func (s subsetsByFirstIP) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s subsetsByFirstIP) Less(i, j int) bool { return s[i].Addresses[0].IP < s[j].Addresses[0].IP }
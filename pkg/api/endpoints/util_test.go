@@ -0,0 +1,101 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoints
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestRepackSubsets(t *testing.T) {
+	testCases := []struct {
+		name   string
+		given  []api.EndpointSubset
+		expect []api.EndpointSubset
+	}{
+		{
+			name:   "empty everything",
+			given:  []api.EndpointSubset{{Addresses: []api.EndpointAddress{}, Ports: []api.EndpointPort{}}},
+			expect: []api.EndpointSubset{},
+		},
+		{
+			name:   "no ports",
+			given:  []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}}}},
+			expect: []api.EndpointSubset{},
+		},
+		{
+			name: "one address one port",
+			given: []api.EndpointSubset{{
+				Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+				Ports:     []api.EndpointPort{{Port: 111}},
+			}},
+			expect: []api.EndpointSubset{{
+				Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+				Ports:     []api.EndpointPort{{Port: 111}},
+			}},
+		},
+		{
+			name: "addresses with the same ports are merged",
+			given: []api.EndpointSubset{
+				{
+					Addresses: []api.EndpointAddress{{IP: "5.6.7.8"}},
+					Ports:     []api.EndpointPort{{Name: "p", Port: 111}},
+				},
+				{
+					Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+					Ports:     []api.EndpointPort{{Name: "p", Port: 111}},
+				},
+			},
+			expect: []api.EndpointSubset{{
+				Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}, {IP: "5.6.7.8"}},
+				Ports:     []api.EndpointPort{{Name: "p", Port: 111}},
+			}},
+		},
+		{
+			name: "ports of the same address are merged",
+			given: []api.EndpointSubset{
+				{
+					Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+					Ports:     []api.EndpointPort{{Name: "q", Port: 222}},
+				},
+				{
+					Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}, {IP: "5.6.7.8"}},
+					Ports:     []api.EndpointPort{{Name: "p", Port: 111}},
+				},
+			},
+			expect: []api.EndpointSubset{
+				{
+					Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+					Ports:     []api.EndpointPort{{Name: "p", Port: 111}, {Name: "q", Port: 222}},
+				},
+				{
+					Addresses: []api.EndpointAddress{{IP: "5.6.7.8"}},
+					Ports:     []api.EndpointPort{{Name: "p", Port: 111}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := RepackSubsets(tc.given)
+		if !reflect.DeepEqual(result, tc.expect) {
+			t.Errorf("%s: expected %#v, got %#v", tc.name, tc.expect, result)
+		}
+	}
+}
@@ -220,6 +220,28 @@ func NewTimeoutError(message string) error {
 	}}
 }
 
+// NewTooManyRequests returns an error indicating that the request was refused for now,
+// and may succeed if retried later.
+func NewTooManyRequests(message string) error {
+	return &StatusError{api.Status{
+		Status:  api.StatusFailure,
+		Code:    StatusTooManyRequests,
+		Reason:  api.StatusReasonTooManyRequests,
+		Message: message,
+	}}
+}
+
+// NewResourceExpired returns an error indicating that the request refers to a version of
+// the resources which is no longer available.
+func NewResourceExpired(message string) error {
+	return &StatusError{api.Status{
+		Status:  api.StatusFailure,
+		Code:    http.StatusGone,
+		Reason:  api.StatusReasonExpired,
+		Message: message,
+	}}
+}
+
 // IsNotFound returns true if the specified error was created by NewNotFoundErr.
 func IsNotFound(err error) bool {
 	return reasonForError(err) == api.StatusReasonNotFound
@@ -263,6 +285,18 @@ func IsServerTimeout(err error) bool {
 	return reasonForError(err) == api.StatusReasonServerTimeout
 }
 
+// IsTooManyRequests determines if err is an error which indicates that the request was
+// refused for now, and may succeed if retried later.
+func IsTooManyRequests(err error) bool {
+	return reasonForError(err) == api.StatusReasonTooManyRequests
+}
+
+// IsResourceExpired determines if err is an error which indicates that the request refers
+// to a version of the resources which is no longer available.
+func IsResourceExpired(err error) bool {
+	return reasonForError(err) == api.StatusReasonExpired
+}
+
 // IsStatusError determines if err is an API Status error received from the master.
 func IsStatusError(err error) bool {
 	_, ok := err.(*StatusError)
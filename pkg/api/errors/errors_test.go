@@ -53,6 +53,12 @@ func TestErrorNew(t *testing.T) {
 	if IsMethodNotSupported(err) {
 		t.Errorf("expected to not be %s", api.StatusReasonMethodNotAllowed)
 	}
+	if IsTooManyRequests(err) {
+		t.Errorf("expected to not be %s", api.StatusReasonTooManyRequests)
+	}
+	if IsResourceExpired(err) {
+		t.Errorf("expected to not be %s", api.StatusReasonExpired)
+	}
 
 	if !IsConflict(NewConflict("test", "2", errors.New("message"))) {
 		t.Errorf("expected to be conflict")
@@ -75,6 +81,12 @@ func TestErrorNew(t *testing.T) {
 	if !IsMethodNotSupported(NewMethodNotSupported("foo", "delete")) {
 		t.Errorf("expected to be %s", api.StatusReasonMethodNotAllowed)
 	}
+	if !IsTooManyRequests(NewTooManyRequests("reason")) {
+		t.Errorf("expected to be %s", api.StatusReasonTooManyRequests)
+	}
+	if !IsResourceExpired(NewResourceExpired("reason")) {
+		t.Errorf("expected to be %s", api.StatusReasonExpired)
+	}
 }
 
 func TestNewInvalid(t *testing.T) {
@@ -17,10 +17,12 @@ limitations under the License.
 package api
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 
 	"github.com/davecgh/go-spew/spew"
@@ -68,10 +70,13 @@ var Semantic = conversion.EqualitiesOrDie(
 var standardResources = util.NewStringSet(
 	string(ResourceMemory),
 	string(ResourceCPU),
+	string(ResourceStorage),
 	string(ResourcePods),
 	string(ResourceQuotas),
 	string(ResourceServices),
-	string(ResourceReplicationControllers))
+	string(ResourceReplicationControllers),
+	string(ResourceLimitsCPU),
+	string(ResourceLimitsMemory))
 
 func IsStandardResourceName(str string) bool {
 	return standardResources.Has(str)
@@ -96,6 +101,60 @@ func IsServiceIPRequested(service *Service) bool {
 	return service.Spec.PortalIP == ""
 }
 
+// ToleratesTaint returns true if the toleration matches the key, value and effect of the taint.
+func (t *Toleration) ToleratesTaint(taint *Taint) bool {
+	if t.Key != taint.Key {
+		return false
+	}
+	if len(t.Effect) > 0 && t.Effect != taint.Effect {
+		return false
+	}
+	return t.Operator == TolerationOpExists || t.Value == taint.Value
+}
+
+// TolerationsTolerateTaint returns true if one of the tolerations matches the taint.
+func TolerationsTolerateTaint(tolerations []Toleration, taint *Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// NodeSelectorRequirementsAsSelector converts the requirements of a node selector term
+// into a labels.Selector matching the labels of the nodes which satisfy all of them.
+func NodeSelectorRequirementsAsSelector(nsm []NodeSelectorRequirement) (labels.Selector, error) {
+	selector := labels.LabelSelector{}
+	for _, expr := range nsm {
+		var op labels.Operator
+		switch expr.Operator {
+		case NodeSelectorOpIn:
+			op = labels.InOperator
+		case NodeSelectorOpNotIn:
+			op = labels.NotInOperator
+		case NodeSelectorOpExists:
+			op = labels.ExistsOperator
+		default:
+			return nil, fmt.Errorf("%q is not a valid node selector operator", expr.Operator)
+		}
+		r, err := labels.NewRequirement(expr.Key, op, util.NewStringSet(expr.Values...))
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, *r)
+	}
+	return selector, nil
+}
+
+// PodPriority returns the priority of a pod, or zero if it has none.
+func PodPriority(pod *Pod) int {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	return 0
+}
+
 var standardFinalizers = util.NewStringSet(
 	string(FinalizerKubernetes))
 
@@ -85,3 +85,26 @@ func TestIsStandardResource(t *testing.T) {
 		}
 	}
 }
+
+func TestTolerationsTolerateTaint(t *testing.T) {
+	taint := Taint{Key: "dedicated", Value: "gpu", Effect: TaintEffectNoSchedule}
+	testCases := []struct {
+		tolerations []Toleration
+		expected    bool
+	}{
+		{nil, false},
+		{[]Toleration{{Key: "dedicated", Value: "gpu", Effect: TaintEffectNoSchedule}}, true},
+		{[]Toleration{{Key: "dedicated", Operator: TolerationOpEqual, Value: "gpu"}}, true},
+		{[]Toleration{{Key: "dedicated", Operator: TolerationOpExists}}, true},
+		{[]Toleration{{Key: "dedicated", Value: "ingress"}}, false},
+		{[]Toleration{{Key: "dedicated", Value: "gpu", Effect: TaintEffectNoExecute}}, false},
+		{[]Toleration{{Key: "gpu", Operator: TolerationOpExists}}, false},
+		{[]Toleration{{Key: "gpu", Operator: TolerationOpExists}, {Key: "dedicated", Value: "gpu"}}, true},
+	}
+
+	for i, tc := range testCases {
+		if actual := TolerationsTolerateTaint(tc.tolerations, &taint); actual != tc.expected {
+			t.Errorf("%d: expected %v, got %v", i, tc.expected, actual)
+		}
+	}
+}
@@ -81,6 +81,7 @@ func InterfacesFor(version string) (*meta.VersionInterfaces, error) {
 			Codec:            v1beta3.Codec,
 			ObjectConvertor:  api.Scheme,
 			MetadataAccessor: accessor,
+			ProtobufCodec:    v1beta3.ProtobufCodec,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported storage version: %s (valid: %s)", version, strings.Join(Versions, ", "))
@@ -117,9 +118,12 @@ func init() {
 	// the list of kinds that are scoped at the root of the api hierarchy
 	// if a kind is not enumerated here, it is assumed to have a namespace scope
 	kindToRootScope := map[string]bool{
-		"Node":      true,
-		"Minion":    true,
-		"Namespace": true,
+		"Node":               true,
+		"Minion":             true,
+		"Namespace":          true,
+		"PersistentVolume":   true,
+		"PriorityClass":      true,
+		"ThirdPartyResource": true,
 	}
 
 	// enumerate all supported versions, get the kinds, and register with the mapper how to address our resources
@@ -26,6 +26,10 @@ type VersionInterfaces struct {
 	runtime.Codec
 	runtime.ObjectConvertor
 	MetadataAccessor
+
+	// ProtobufCodec encodes the objects of the version in protobuf, or is nil if the
+	// version has no protobuf encoding.
+	ProtobufCodec runtime.Codec
 }
 
 // Interface lets you work with object and list metadata from any of the versioned or
@@ -46,6 +50,8 @@ type Interface interface {
 	SetResourceVersion(version string)
 	SelfLink() string
 	SetSelfLink(selfLink string)
+	Continue() string
+	SetContinue(continueToken string)
 	Labels() map[string]string
 	SetLabels(labels map[string]string)
 	Annotations() map[string]string
@@ -85,6 +91,9 @@ type MetadataAccessor interface {
 	SelfLink(obj runtime.Object) (string, error)
 	SetSelfLink(obj runtime.Object, selfLink string) error
 
+	Continue(obj runtime.Object) (string, error)
+	SetContinue(obj runtime.Object, continueToken string) error
+
 	Labels(obj runtime.Object) (map[string]string, error)
 	SetLabels(obj runtime.Object, labels map[string]string) error
 
@@ -212,6 +212,23 @@ func (resourceAccessor) SetSelfLink(obj runtime.Object, selfLink string) error {
 	return nil
 }
 
+func (resourceAccessor) Continue(obj runtime.Object) (string, error) {
+	accessor, err := Accessor(obj)
+	if err != nil {
+		return "", err
+	}
+	return accessor.Continue(), nil
+}
+
+func (resourceAccessor) SetContinue(obj runtime.Object, continueToken string) error {
+	accessor, err := Accessor(obj)
+	if err != nil {
+		return err
+	}
+	accessor.SetContinue(continueToken)
+	return nil
+}
+
 func (resourceAccessor) Labels(obj runtime.Object) (map[string]string, error) {
 	accessor, err := Accessor(obj)
 	if err != nil {
@@ -273,6 +290,7 @@ type genericAccessor struct {
 	kind            *string
 	resourceVersion *string
 	selfLink        *string
+	continueToken   *string
 	labels          *map[string]string
 	annotations     *map[string]string
 }
@@ -351,6 +369,20 @@ func (a genericAccessor) SetSelfLink(selfLink string) {
 	*a.selfLink = selfLink
 }
 
+func (a genericAccessor) Continue() string {
+	if a.continueToken == nil {
+		return ""
+	}
+	return *a.continueToken
+}
+
+func (a genericAccessor) SetContinue(continueToken string) {
+	if a.continueToken == nil {
+		return
+	}
+	*a.continueToken = continueToken
+}
+
 func (a genericAccessor) Labels() map[string]string {
 	if a.labels == nil {
 		return nil
@@ -418,5 +450,8 @@ func extractFromListMeta(v reflect.Value, a *genericAccessor) error {
 	if err := runtime.FieldPtr(v, "SelfLink", &a.selfLink); err != nil {
 		return err
 	}
+	if err := runtime.FieldPtr(v, "Continue", &a.continueToken); err != nil {
+		return err
+	}
 	return nil
 }
@@ -401,6 +401,7 @@ func TestGenericListMeta(t *testing.T) {
 	type ListMeta struct {
 		SelfLink        string `json:"selfLink,omitempty"`
 		ResourceVersion string `json:"resourceVersion,omitempty"`
+		Continue        string `json:"continue,omitempty"`
 	}
 	type Object struct {
 		TypeMeta `json:",inline"`
@@ -414,6 +415,7 @@ func TestGenericListMeta(t *testing.T) {
 		ListMeta{
 			ResourceVersion: "1",
 			SelfLink:        "some/place/only/we/know",
+			Continue:        "next",
 		},
 	}
 	accessor, err := Accessor(&j)
@@ -438,6 +440,9 @@ func TestGenericListMeta(t *testing.T) {
 	if e, a := "some/place/only/we/know", accessor.SelfLink(); e != a {
 		t.Errorf("expected %v, got %v", e, a)
 	}
+	if e, a := "next", accessor.Continue(); e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
 
 	accessor.SetName("bar")
 	accessor.SetUID("other")
@@ -445,6 +450,7 @@ func TestGenericListMeta(t *testing.T) {
 	accessor.SetKind("d")
 	accessor.SetResourceVersion("2")
 	accessor.SetSelfLink("google.com")
+	accessor.SetContinue("")
 
 	// Prove that accessor changes the original object.
 	if e, a := "c", j.APIVersion; e != a {
@@ -459,6 +465,9 @@ func TestGenericListMeta(t *testing.T) {
 	if e, a := "google.com", j.SelfLink; e != a {
 		t.Errorf("expected %v, got %v", e, a)
 	}
+	if e, a := "", j.Continue; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
 }
 
 type MyAPIObject struct {
@@ -17,8 +17,12 @@ limitations under the License.
 package rest
 
 import (
+	"time"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 // RESTDeleteStrategy defines deletion behavior on an object that follows Kubernetes
@@ -35,17 +39,45 @@ type RESTDeleteStrategy interface {
 // should be gracefully deleted, if gracefulPending is set the object has already been gracefully deleted
 // (and the provided grace period is longer than the time to deletion), and an error is returned if the
 // condition cannot be checked or the gracePeriodSeconds is invalid. The options argument may be updated with
-// default values if graceful is true.
+// default values if graceful is true. If graceful is true, the DeletionTimestamp and
+// DeletionGracePeriodSeconds of the object are set to reflect the grace period.
 func BeforeDelete(strategy RESTDeleteStrategy, ctx api.Context, obj runtime.Object, options *api.DeleteOptions) (graceful, gracefulPending bool, err error) {
 	if strategy == nil {
 		return false, false, nil
 	}
-	_, _, kerr := objectMetaAndKind(strategy, obj)
+	objectMeta, _, kerr := objectMetaAndKind(strategy, obj)
 	if kerr != nil {
 		return false, false, kerr
 	}
+	if options.GracePeriodSeconds != nil && *options.GracePeriodSeconds < 0 {
+		return false, false, errors.NewBadRequest("gracePeriodSeconds must be non-negative")
+	}
+
+	// the object is already being deleted
+	if objectMeta.DeletionTimestamp != nil {
+		// the object was deleted without recording its grace period, delete it now
+		if objectMeta.DeletionGracePeriodSeconds == nil {
+			return false, false, nil
+		}
+		// a pending deletion may only be shortened
+		if options.GracePeriodSeconds == nil || *options.GracePeriodSeconds >= *objectMeta.DeletionGracePeriodSeconds {
+			options.GracePeriodSeconds = objectMeta.DeletionGracePeriodSeconds
+			return false, true, nil
+		}
+		setDeletionGracePeriod(objectMeta, *options.GracePeriodSeconds)
+		return true, false, nil
+	}
+
 	if !strategy.CheckGracefulDelete(obj, options) {
 		return false, false, nil
 	}
+	setDeletionGracePeriod(objectMeta, *options.GracePeriodSeconds)
 	return true, false, nil
 }
+
+// setDeletionGracePeriod records that the object is deleted in the given number of seconds.
+func setDeletionGracePeriod(objectMeta *api.ObjectMeta, seconds int64) {
+	deletionTimestamp := util.NewTime(util.Now().Add(time.Duration(seconds) * time.Second))
+	objectMeta.DeletionTimestamp = &deletionTimestamp
+	objectMeta.DeletionGracePeriodSeconds = &seconds
+}
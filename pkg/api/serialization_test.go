@@ -92,6 +92,7 @@ func roundTripSame(t *testing.T, item runtime.Object) {
 	roundTrip(t, v1beta2.Codec, item)
 	fuzzInternalObject(t, "v1beta3", item, seed)
 	roundTrip(t, v1beta3.Codec, item)
+	roundTrip(t, v1beta3.ProtobufCodec, item)
 }
 
 func roundTripAll(t *testing.T, item runtime.Object) {
@@ -216,6 +217,57 @@ func BenchmarkEncodeJSON(b *testing.B) {
 	}
 }
 
+// benchmarkEncodeWith measures the encoding of a pod with codec.
+func benchmarkEncodeWith(b *testing.B, codec runtime.Codec) {
+	pod := api.Pod{}
+	apiObjectFuzzer := apitesting.FuzzerFor(nil, "", rand.NewSource(benchmarkSeed))
+	apiObjectFuzzer.Fuzz(&pod)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := codec.Encode(&pod); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkEncodeV1beta3 provides the baseline of BenchmarkEncodeV1beta3Protobuf
+func BenchmarkEncodeV1beta3(b *testing.B) {
+	benchmarkEncodeWith(b, v1beta3.Codec)
+}
+
+// BenchmarkEncodeV1beta3Protobuf measures the encoding of objects in protobuf, for comparison with BenchmarkEncodeV1beta3
+func BenchmarkEncodeV1beta3Protobuf(b *testing.B) {
+	benchmarkEncodeWith(b, v1beta3.ProtobufCodec)
+}
+
+// benchmarkEncodeVersionedWith measures the encoding of a pod of the version of codec,
+// which is not converted, to compare the serialization alone.
+func benchmarkEncodeVersionedWith(b *testing.B, codec runtime.Codec) {
+	pod := api.Pod{}
+	apiObjectFuzzer := apitesting.FuzzerFor(nil, "", rand.NewSource(benchmarkSeed))
+	apiObjectFuzzer.Fuzz(&pod)
+	versioned, err := api.Scheme.ConvertToVersion(&pod, "v1beta3")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := codec.Encode(versioned); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkEncodeVersionedV1beta3 provides the baseline of BenchmarkEncodeVersionedV1beta3Protobuf
+func BenchmarkEncodeVersionedV1beta3(b *testing.B) {
+	benchmarkEncodeVersionedWith(b, v1beta3.Codec)
+}
+
+// BenchmarkEncodeVersionedV1beta3Protobuf measures the encoding of v1beta3 objects in protobuf, for comparison with BenchmarkEncodeVersionedV1beta3
+func BenchmarkEncodeVersionedV1beta3Protobuf(b *testing.B) {
+	benchmarkEncodeVersionedWith(b, v1beta3.ProtobufCodec)
+}
+
 func BenchmarkDecode(b *testing.B) {
 	pod := api.Pod{}
 	apiObjectFuzzer := apitesting.FuzzerFor(nil, "", rand.NewSource(benchmarkSeed))
@@ -237,6 +289,33 @@ func BenchmarkDecodeInto(b *testing.B) {
 	}
 }
 
+// benchmarkDecodeWith measures the decoding of a pod encoded with codec.
+func benchmarkDecodeWith(b *testing.B, codec runtime.Codec) {
+	pod := api.Pod{}
+	apiObjectFuzzer := apitesting.FuzzerFor(nil, "", rand.NewSource(benchmarkSeed))
+	apiObjectFuzzer.Fuzz(&pod)
+	data, err := codec.Encode(&pod)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := codec.Decode(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecodeV1beta3 provides the baseline of BenchmarkDecodeV1beta3Protobuf
+func BenchmarkDecodeV1beta3(b *testing.B) {
+	benchmarkDecodeWith(b, v1beta3.Codec)
+}
+
+// BenchmarkDecodeV1beta3Protobuf measures the decoding of objects from protobuf, for comparison with BenchmarkDecodeV1beta3
+func BenchmarkDecodeV1beta3Protobuf(b *testing.B) {
+	benchmarkDecodeWith(b, v1beta3.ProtobufCodec)
+}
+
 // BenchmarkDecodeJSON provides a baseline for regular JSON decode performance
 func BenchmarkDecodeJSON(b *testing.B) {
 	pod := api.Pod{}
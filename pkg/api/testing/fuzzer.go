@@ -87,6 +87,7 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(j *api.ListMeta, c fuzz.Continue) {
 			j.ResourceVersion = strconv.FormatUint(c.RandUint64(), 10)
 			j.SelfLink = c.RandString()
+			j.Continue = c.RandString()
 		},
 		func(j *api.PodPhase, c fuzz.Continue) {
 			statuses := []api.PodPhase{api.PodPending, api.PodRunning, api.PodFailed, api.PodUnknown}
@@ -113,6 +114,31 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 			// only replicas round trips
 			j.Replicas = int(c.RandUint64())
 		},
+		func(j *api.JobSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// completions and parallelism are defaulted when zero
+			j.Completions = 1 + c.Rand.Intn(100)
+			j.Parallelism = 1 + c.Rand.Intn(100)
+		},
+		func(j *api.DeploymentStrategy, c fuzz.Continue) {
+			// the strategy type and rolling update parameters are defaulted when unset
+			if c.RandBool() {
+				j.Type = api.RecreateDeploymentStrategyType
+				j.RollingUpdate = nil
+				return
+			}
+			j.Type = api.RollingUpdateDeploymentStrategyType
+			j.RollingUpdate = &api.RollingUpdateDeployment{
+				MaxUnavailable: util.NewIntOrStringFromInt(c.Rand.Intn(10)),
+				MaxSurge:       util.NewIntOrStringFromString(fmt.Sprintf("%d%%", c.Rand.Intn(100))),
+			}
+		},
+		func(j *api.HorizontalPodAutoscalerSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j) // fuzz self without calling this function again
+			// min replicas and target utilization are defaulted when zero
+			j.MinReplicas = 1 + c.Rand.Intn(10)
+			j.TargetCPUUtilization = 1 + c.Rand.Intn(100)
+		},
 		func(j *api.List, c fuzz.Continue) {
 			c.FuzzNoCustom(j) // fuzz self without calling this function again
 			if j.Items == nil {
@@ -167,7 +193,7 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(vs *api.VolumeSource, c fuzz.Continue) {
 			// Exactly one of the fields should be set.
 			//FIXME: the fuzz can still end up nil.  What if fuzz allowed me to say that?
-			fuzzOneOf(c, &vs.HostPath, &vs.EmptyDir, &vs.GCEPersistentDisk, &vs.GitRepo, &vs.Secret, &vs.NFS)
+			fuzzOneOf(c, &vs.HostPath, &vs.EmptyDir, &vs.GCEPersistentDisk, &vs.GitRepo, &vs.Secret, &vs.NFS, &vs.PersistentVolumeClaim, &vs.ConfigMap, &vs.DownwardAPI)
 		},
 		func(d *api.DNSPolicy, c fuzz.Continue) {
 			policies := []api.DNSPolicy{api.DNSClusterFirst, api.DNSDefault}
@@ -184,6 +210,15 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(ct *api.Container, c fuzz.Continue) {
 			c.FuzzNoCustom(ct)                                          // fuzz self without calling this function again
 			ct.TerminationMessagePath = "/" + ct.TerminationMessagePath // Must be non-empty
+			// requests are defaulted to limits
+			for name, limit := range ct.Resources.Limits {
+				if _, found := ct.Resources.Requests[name]; !found {
+					if ct.Resources.Requests == nil {
+						ct.Resources.Requests = api.ResourceList{}
+					}
+					ct.Resources.Requests[name] = limit
+				}
+			}
 		},
 		func(e *api.Event, c fuzz.Continue) {
 			c.FuzzNoCustom(e) // fuzz self without calling this function again
@@ -204,10 +239,9 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		func(s *api.NamespaceStatus, c fuzz.Continue) {
 			s.Phase = api.NamespaceActive
 		},
-		func(ep *api.Endpoint, c fuzz.Continue) {
+		func(ep *api.EndpointAddress, c fuzz.Continue) {
 			// TODO: If our API used a particular type for IP fields we could just catch that here.
 			ep.IP = fmt.Sprintf("%d.%d.%d.%d", c.Rand.Intn(256), c.Rand.Intn(256), c.Rand.Intn(256), c.Rand.Intn(256))
-			ep.Port = c.Rand.Intn(65536)
 		},
 		func(http *api.HTTPGetAction, c fuzz.Continue) {
 			c.FuzzNoCustom(http)        // fuzz self without calling this function again
@@ -215,11 +249,17 @@ func FuzzerFor(t *testing.T, version string, src rand.Source) *fuzz.Fuzzer {
 		},
 		func(ss *api.ServiceSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(ss) // fuzz self without calling this function again
-			switch ss.TargetPort.Kind {
+			types := []api.ServiceType{api.ServiceTypeClusterIP, api.ServiceTypeNodePort, api.ServiceTypeLoadBalancer}
+			ss.Type = types[c.Rand.Intn(len(types))]
+		},
+		func(sp *api.ServicePort, c fuzz.Continue) {
+			c.FuzzNoCustom(sp)               // fuzz self without calling this function again
+			sp.Port = 1 + c.Rand.Intn(65535) // non-zero
+			switch sp.TargetPort.Kind {
 			case util.IntstrInt:
-				ss.TargetPort.IntVal = 1 + ss.TargetPort.IntVal%65535 // non-zero
+				sp.TargetPort.IntVal = 1 + sp.TargetPort.IntVal%65535 // non-zero
 			case util.IntstrString:
-				ss.TargetPort.StrVal = "x" + ss.TargetPort.StrVal // non-empty
+				sp.TargetPort.StrVal = "x" + sp.TargetPort.StrVal // non-empty
 			}
 		},
 	)
@@ -71,6 +71,10 @@ type ListMeta struct {
 	// and values may only be valid for a particular resource or set of resources. Only servers
 	// will generate resource versions.
 	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Continue is set when the list was limited by the client and more items remain. The
+	// client lists the next items by passing this opaque value in the continue parameter.
+	Continue string `json:"continue,omitempty"`
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects
@@ -131,6 +135,10 @@ type ObjectMeta struct {
 	// will send a hard termination signal to the container.
 	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty"`
 
+	// DeletionGracePeriodSeconds is the number of seconds the object is given to terminate
+	// gracefully, set along with DeletionTimestamp. It may only be shortened.
+	DeletionGracePeriodSeconds *int64 `json:"deletionGracePeriodSeconds,omitempty"`
+
 	// Labels are key value pairs that may be used to scope and select individual resources.
 	// Label keys are of the form:
 	//     label-key ::= prefixed-name | name
@@ -148,6 +156,26 @@ type ObjectMeta struct {
 	// objects.  Annotation keys have the same formatting restrictions as Label keys. See the
 	// comments on Labels for details.
 	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// OwnerReferences lists the objects this object depends on. Once all of them are deleted,
+	// the object is deleted by the garbage collector, unless the deletion of its owners asked
+	// for their dependents to be orphaned. The owners are in the namespace of the object.
+	OwnerReferences []OwnerReference `json:"ownerReferences,omitempty"`
+
+	// Finalizers must be empty before the object is removed from the registry. An object
+	// deleted while it has finalizers is only marked with a DeletionTimestamp, and removed
+	// once the parties responsible for the finalizers have cleared them.
+	Finalizers []string `json:"finalizers,omitempty"`
+}
+
+// OwnerReference identifies an object owning the object it is set on.
+type OwnerReference struct {
+	// Kind of the owner.
+	Kind string `json:"kind"`
+	// Name of the owner.
+	Name string `json:"name"`
+	// UID of the owner, which tells it apart from a later object of the same name.
+	UID types.UID `json:"uid"`
 }
 
 const (
@@ -193,8 +221,141 @@ type VolumeSource struct {
 	Secret *SecretVolumeSource `json:"secret"`
 	// NFS represents an NFS mount on the host that shares a pod's lifetime
 	NFS *NFSVolumeSource `json:"nfs"`
+	// PersistentVolumeClaim represents a reference to a PersistentVolumeClaim in the same namespace.
+	// The kubelet resolves the claim to the PersistentVolume it is bound to.
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim"`
+	// ConfigMap represents a config map that should populate this volume.
+	ConfigMap *ConfigMapVolumeSource `json:"configMap"`
+	// DownwardAPI represents metadata of the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI"`
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the administrator who creates
+// PersistentVolumes. Only one of its members may be specified.
+type PersistentVolumeSource struct {
+	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"gcePersistentDisk"`
+	// HostPath represents a directory on the host.
+	// This is useful for development and testing only.
+	// on-host storage is not supported in any way
+	HostPath *HostPathVolumeSource `json:"hostPath"`
+	// NFS represents an NFS mount on the host
+	NFS *NFSVolumeSource `json:"nfs"`
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PersistentVolumeClaim in the same namespace.
+type PersistentVolumeClaimVolumeSource struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume
+	ClaimName string `json:"claimName,omitempty"`
+	// Optional: Defaults to false (read/write).  ReadOnly here
+	// will force the ReadOnly setting in VolumeMounts
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// PersistentVolume is a storage resource provisioned by an administrator.  It is
+// cluster-scoped and may be bound to a single PersistentVolumeClaim.
+type PersistentVolume struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines a persistent volume owned by the cluster
+	Spec PersistentVolumeSpec `json:"spec,omitempty"`
+
+	// Status represents the current information about persistent volume.
+	Status PersistentVolumeStatus `json:"status,omitempty"`
+}
+
+// PersistentVolumeSpec has most of the details required to define a persistent volume.
+type PersistentVolumeSpec struct {
+	// Resources represents the actual resources of the volume
+	Capacity ResourceList `json:"capacity"`
+	// Source represents the location and type of a volume to mount.
+	PersistentVolumeSource `json:",inline"`
+	// AccessModes contains all ways the volume can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// ClaimRef is part of a bi-directional binding between PersistentVolume and PersistentVolumeClaim.
+	// ClaimRef is expected to be non-nil when bound.
+	// claim.VolumeRef is the authoritative bind between PV and PVC.
+	ClaimRef *ObjectReference `json:"claimRef,omitempty"`
 }
 
+// PersistentVolumeStatus represents information about the status of a PersistentVolume.
+type PersistentVolumeStatus struct {
+	// Phase indicates if a volume is available, bound to a claim, or released by a claim
+	Phase PersistentVolumePhase `json:"phase,omitempty"`
+}
+
+// PersistentVolumeList is a list of PersistentVolumes.
+type PersistentVolumeList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PersistentVolume `json:"items,omitempty"`
+}
+
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume
+type PersistentVolumeClaim struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the volume requested by a pod author
+	Spec PersistentVolumeClaimSpec `json:"spec,omitempty"`
+
+	// Status represents the current information about a claim
+	Status PersistentVolumeClaimStatus `json:"status,omitempty"`
+}
+
+// PersistentVolumeClaimList is a list of PersistentVolumeClaims.
+type PersistentVolumeClaimList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PersistentVolumeClaim `json:"items,omitempty"`
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes
+type PersistentVolumeClaimSpec struct {
+	// Contains the types of access modes required
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// Resources represents the minimum resources required
+	Resources ResourceRequirements `json:"resources,omitempty"`
+}
+
+// PersistentVolumeClaimStatus is the current status of a persistent volume claim.
+type PersistentVolumeClaimStatus struct {
+	// Phase represents the current phase of PersistentVolumeClaim
+	Phase PersistentVolumeClaimPhase `json:"phase,omitempty"`
+	// AccessModes contains all ways the volume backing the PVC can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty"`
+	// Represents the actual resources of the underlying volume
+	Capacity ResourceList `json:"capacity,omitempty"`
+	// VolumeRef is a reference to the PersistentVolume bound to the PersistentVolumeClaim
+	VolumeRef *ObjectReference `json:"volumeRef,omitempty"`
+}
+
+type PersistentVolumePhase string
+
+const (
+	// used for PersistentVolumes that are not yet bound
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// used for PersistentVolumes that are bound
+	VolumeBound PersistentVolumePhase = "Bound"
+	// used for PersistentVolumes where the bound PersistentVolumeClaim was deleted
+	// released volumes must be recycled before becoming available again
+	VolumeReleased PersistentVolumePhase = "Released"
+)
+
+type PersistentVolumeClaimPhase string
+
+const (
+	// used for PersistentVolumeClaims that are not yet bound
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// used for PersistentVolumeClaims that are bound
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+)
+
 // used by VolumeSources to describe their mounting/access modes
 type AccessModeType string
 
@@ -281,6 +442,38 @@ type SecretVolumeSource struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource. The keys of
+// the config map are projected as files in the volume.
+type ConfigMapVolumeSource struct {
+	// Name of the config map in the pod's namespace to use.
+	Name string `json:"name"`
+	// Items selects the keys to project and the paths to project them to.
+	// If unspecified, every key is projected into a file named after the key.
+	Items []KeyToPath `json:"items,omitempty"`
+}
+
+// KeyToPath maps a key of a config map to a path in a volume.
+type KeyToPath struct {
+	// The key to project.
+	Key string `json:"key"`
+	// The path, relative to the volume, of the file to project the key to.
+	Path string `json:"path"`
+}
+
+// DownwardAPIVolumeSource projects fields of the pod into files of a volume.
+type DownwardAPIVolumeSource struct {
+	// Items is the list of files to write, one per pod field.
+	Items []DownwardAPIVolumeFile `json:"items,omitempty"`
+}
+
+// DownwardAPIVolumeFile maps a field of the pod to a file in a volume.
+type DownwardAPIVolumeFile struct {
+	// The path, relative to the volume, of the file to write.
+	Path string `json:"path"`
+	// Selects the pod field to write; only name, namespace, labels and annotations are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef"`
+}
+
 // NFSVolumeSource represents an NFS Mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server
@@ -326,6 +519,30 @@ type EnvVar struct {
 	Name string `json:"name"`
 	// Optional: defaults to "".
 	Value string `json:"value,omitempty"`
+	// Optional: specifies a source the value of this var should come from.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+type EnvVarSource struct {
+	// Selects a key of a config map in the pod's namespace.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// Selects a field of the pod; only name, namespace and podIP are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// The name of the config map in the pod's namespace.
+	Name string `json:"name"`
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// ObjectFieldSelector selects a field of the pod a container runs in.
+type ObjectFieldSelector struct {
+	// The path of the field to select, such as "metadata.name".
+	FieldPath string `json:"fieldPath"`
 }
 
 // HTTPGetAction describes an action based on HTTP Get requests.
@@ -390,6 +607,9 @@ type Capabilities struct {
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources required.
 	Limits ResourceList `json:"limits,omitempty"`
+	// Requests describes the minimum amount of compute resources required, which is
+	// reserved for the container when it is scheduled. Requests default to Limits.
+	Requests ResourceList `json:"requests,omitempty"`
 }
 
 // Container represents a single container that is expected to be run on the host.
@@ -403,11 +623,11 @@ type Container struct {
 	Command []string `json:"command,omitempty"`
 	// Optional: Defaults to Docker's default.
 	WorkingDir string          `json:"workingDir,omitempty"`
-	Ports      []ContainerPort `json:"ports,omitempty"`
-	Env        []EnvVar        `json:"env,omitempty"`
+	Ports      []ContainerPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort"`
+	Env        []EnvVar        `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 	// Compute resource requirements.
 	Resources      ResourceRequirements `json:"resources,omitempty"`
-	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty"`
+	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath"`
 	LivenessProbe  *Probe               `json:"livenessProbe,omitempty"`
 	ReadinessProbe *Probe               `json:"readinessProbe,omitempty"`
 	Lifecycle      *Lifecycle           `json:"lifecycle,omitempty"`
@@ -587,16 +807,31 @@ const (
 	DNSDefault DNSPolicy = "Default"
 )
 
+// DefaultTerminationGracePeriodSeconds is the number of seconds pods which do not set
+// TerminationGracePeriodSeconds are given to terminate gracefully.
+const DefaultTerminationGracePeriodSeconds = 30
+
 // PodSpec is a description of a pod
 type PodSpec struct {
-	Volumes []Volume `json:"volumes"`
+	Volumes []Volume `json:"volumes" patchStrategy:"merge" patchMergeKey:"name"`
 	// Required: there must be at least one container in a pod.
-	Containers    []Container   `json:"containers"`
+	Containers    []Container   `json:"containers" patchStrategy:"merge" patchMergeKey:"name"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
+	// List of initialization containers, which are run one at a time, in order, and must
+	// each terminate successfully before the containers of the pod are started. If one of
+	// them fails, it is restarted according to the RestartPolicy of the pod.
+	InitContainers []Container `json:"initContainers,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 	// Required: Set DNS policy.
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// NodeAffinity is a set of constraints and preferences on the labels of the node
+	// the pod is scheduled onto, beyond the exact matches of NodeSelector.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty"`
+	// PodAffinity is a set of pods which the pod should be scheduled near to.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
+	// PodAntiAffinity is a set of pods which the pod should be scheduled away from.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty"`
 
 	// Host is a request to schedule this pod onto a specific host.  If it is non-empty,
 	// the the scheduler simply schedules this pod onto that host, assuming that it fits
@@ -606,6 +841,136 @@ type PodSpec struct {
 	// used must be specified.
 	// Optional: Default to false.
 	HostNetwork bool `json:"hostNetwork,omitempty"`
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// Optional duration in seconds the pod needs to terminate gracefully. When the pod is
+	// deleted, its containers are sent a termination signal, and forcibly killed once this
+	// period has elapsed. Zero means the containers are killed immediately. If not set,
+	// DefaultTerminationGracePeriodSeconds is used.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// Tolerations let the pod be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty"`
+	// PriorityClassName is the name of the PriorityClass of the pod. If empty, the
+	// global default class is used, if any.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Priority is resolved from the PriorityClass of the pod on creation, and cannot be
+	// set directly. Pods without a priority have a priority of zero.
+	Priority *int `json:"priority,omitempty"`
+}
+
+// NodeAffinity describes the constraints and preferences of a pod on the labels of
+// the nodes it is scheduled onto.
+type NodeAffinity struct {
+	// The pod only fits on the nodes matching at least one of these terms.
+	RequiredDuringScheduling []NodeSelectorTerm `json:"requiredDuringScheduling,omitempty"`
+	// The scheduler favors the nodes matching the preferred terms with the greatest
+	// total weight, but may choose a node matching none of them.
+	PreferredDuringScheduling []PreferredSchedulingTerm `json:"preferredDuringScheduling,omitempty"`
+}
+
+// NodeSelectorTerm is matched by the nodes whose labels satisfy all of its requirements.
+type NodeSelectorTerm struct {
+	// Required. The requirements on the labels of the node.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions"`
+}
+
+// NodeSelectorOperator is the operator relating the key and values of a NodeSelectorRequirement.
+type NodeSelectorOperator string
+
+const (
+	// The node has the key, with one of the values.
+	NodeSelectorOpIn NodeSelectorOperator = "In"
+	// The node does not have the key, or has it with none of the values.
+	NodeSelectorOpNotIn NodeSelectorOperator = "NotIn"
+	// The node has the key, with any value.
+	NodeSelectorOpExists NodeSelectorOperator = "Exists"
+)
+
+// NodeSelectorRequirement is a requirement on the value of a node label.
+type NodeSelectorRequirement struct {
+	// Required. The label key the requirement applies to.
+	Key string `json:"key"`
+	// Required. How the value of the label is matched.
+	Operator NodeSelectorOperator `json:"operator"`
+	// The values of the label, which must be set for the In and NotIn operators,
+	// and empty for Exists.
+	Values []string `json:"values,omitempty"`
+}
+
+// PreferredSchedulingTerm is a node selector term with the weight it adds to the
+// nodes which match it.
+type PreferredSchedulingTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight"`
+	// Required. The term the node should match.
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// PodAffinity describes the pods which a pod should be scheduled in the same topology
+// domain as.
+type PodAffinity struct {
+	// The pod only fits on the nodes which are in the same topology domain as a pod
+	// matching each of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty"`
+	// The scheduler favors the nodes in the same topology domain as the pods matching
+	// the preferred terms with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty"`
+}
+
+// PodAntiAffinity describes the pods which a pod should not be scheduled in the same
+// topology domain as.
+type PodAntiAffinity struct {
+	// The pod does not fit on the nodes which are in the same topology domain as a pod
+	// matching any of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty"`
+	// The scheduler favors the nodes away from the pods matching the preferred terms
+	// with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty"`
+}
+
+// PodAffinityTerm selects a set of pods, and the nodes which are in the same topology
+// domain as them.
+type PodAffinityTerm struct {
+	// Required. The labels of the pods the term applies to. Only the pods in the
+	// namespace of the pod being scheduled are considered.
+	LabelSelector map[string]string `json:"labelSelector"`
+	// The key of the node label defining the topology domains: the nodes which have the
+	// same value for this label are in the same domain. If empty, each node is its own
+	// domain.
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// WeightedPodAffinityTerm is a pod affinity term with the weight it adds to the nodes
+// which satisfy it.
+type WeightedPodAffinityTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight"`
+	// Required. The term the node should satisfy.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm"`
+}
+
+// TolerationOperator is the operator a toleration uses to match the value of a taint.
+type TolerationOperator string
+
+const (
+	// The toleration matches a taint with an equal value.
+	TolerationOpEqual TolerationOperator = "Equal"
+	// The toleration matches a taint with any value.
+	TolerationOpExists TolerationOperator = "Exists"
+)
+
+// Toleration lets a pod be scheduled onto, and keep running on, a node whose taints
+// it matches.
+type Toleration struct {
+	// Required. The key of the taints the toleration matches.
+	Key string `json:"key"`
+	// Optional. How the value of a taint is matched; empty means Equal.
+	Operator TolerationOperator `json:"operator,omitempty"`
+	// The value of the taints the toleration matches, if the operator is Equal.
+	Value string `json:"value,omitempty"`
+	// Optional. The effect of the taints the toleration matches; all effects are
+	// matched when empty.
+	Effect TaintEffect `json:"effect,omitempty"`
 }
 
 // PodStatus represents information about the status of a pod. Status may trail the actual
@@ -621,6 +986,9 @@ type PodStatus struct {
 	Host   string `json:"host,omitempty"`
 	HostIP string `json:"hostIP,omitempty"`
 	PodIP  string `json:"podIP,omitempty"`
+	// NominatedHost is the node the scheduler made room on for the pod, by preempting pods
+	// with a lower priority. The pod may still be scheduled onto another node.
+	NominatedHost string `json:"nominatedHost,omitempty"`
 
 	// The key of this map is the *name* of the container within the manifest; it has one
 	// entry per container in the manifest. The value of this map is currently the output
@@ -629,6 +997,8 @@ type PodStatus struct {
 	// TODO: Make real decisions about what our info should look like. Re-enable fuzz test
 	// when we have done this.
 	Info PodInfo `json:"info,omitempty"`
+	// InitContainerInfo holds the status of the init containers of the pod, keyed by name.
+	InitContainerInfo PodInfo `json:"initContainerInfo,omitempty"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
@@ -729,6 +1099,367 @@ type ReplicationControllerList struct {
 	Items []ReplicationController `json:"items"`
 }
 
+// JobSpec describes how a job execution will look.
+type JobSpec struct {
+	// Parallelism is the maximum number of pods the job should run at any given time.
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// Completions is the number of pods that must terminate successfully before the
+	// job is considered complete.
+	Completions int `json:"completions,omitempty"`
+
+	// RetryLimit is the number of failures that are tolerated before the job is
+	// marked as failed. A failed pod and a container restart each count as a failure.
+	RetryLimit int `json:"retryLimit,omitempty"`
+
+	// Selector is a label query over pods that are owned by this job.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created when
+	// executing the job.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// JobPhase is a label for the condition of a job at the current time.
+type JobPhase string
+
+// These are the valid phases of a job.
+const (
+	// JobRunning means the job still has completions outstanding.
+	JobRunning JobPhase = "Running"
+	// JobComplete means the job has reached its desired number of completions.
+	JobComplete JobPhase = "Complete"
+	// JobFailed means the job failed more often than its retry limit allows.
+	JobFailed JobPhase = "Failed"
+)
+
+// JobStatus represents the current state of a job.
+type JobStatus struct {
+	// Phase is the current lifecycle phase of the job.
+	Phase JobPhase `json:"phase,omitempty"`
+
+	// Active is the number of actively running pods.
+	Active int `json:"active,omitempty"`
+
+	// Succeeded is the number of pods which terminated successfully.
+	Succeeded int `json:"succeeded,omitempty"`
+
+	// Failed is the number of pods which terminated with a failure plus the number of
+	// container restarts of the job's pods.
+	Failed int `json:"failed,omitempty"`
+}
+
+// Job represents the configuration of a run-to-completion workload.
+type Job struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of this job.
+	Spec JobSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this job. This data may be out of date by
+	// some window of time.
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Job `json:"items"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over pods that are managed by the daemon set.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pod that will be created on every
+	// node that matches the template's node selector.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	// CurrentNumberScheduled is the number of nodes that are running a daemon pod
+	// and are supposed to run it.
+	CurrentNumberScheduled int `json:"currentNumberScheduled"`
+
+	// NumberMisscheduled is the number of nodes that are running a daemon pod but
+	// are not supposed to run it.
+	NumberMisscheduled int `json:"numberMisscheduled"`
+
+	// DesiredNumberScheduled is the number of nodes that should be running a daemon pod.
+	DesiredNumberScheduled int `json:"desiredNumberScheduled"`
+}
+
+// DaemonSet represents the configuration of a daemon set, which runs a copy of a
+// pod on every matching node.
+type DaemonSet struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of this daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this daemon set. This data may be out of date
+	// by some window of time.
+	Status DaemonSetStatus `json:"status,omitempty"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []DaemonSet `json:"items"`
+}
+
+// DeploymentSpec is the specification of a deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas"`
+
+	// Selector is a label query over pods that are managed by the deployment.
+	Selector map[string]string `json:"selector"`
+
+	// Template is the object that describes the pods that will be created. Every
+	// distinct template is rolled out through its own replication controller.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+
+	// Strategy is how existing pods are replaced by new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty"`
+
+	// RevisionHistoryLimit is the number of old replication controllers to retain
+	// so that the deployment can be rolled back. Zero retains all of them.
+	RevisionHistoryLimit int `json:"revisionHistoryLimit,omitempty"`
+
+	// RollbackTo, if set, asks the deployment to return to the template of an
+	// earlier revision. It is cleared once the rollback has been applied.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+}
+
+// RollbackConfig names the revision a deployment should be rolled back to.
+type RollbackConfig struct {
+	// Revision to roll back to. Zero means the revision preceding the current one.
+	Revision int64 `json:"revision,omitempty"`
+}
+
+// DeploymentStrategyType is the kind of strategy used to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of the strategy, either Recreate or RollingUpdate.
+	Type DeploymentStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate holds the parameters of a RollingUpdate strategy.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateDeployment controls the pace of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the number of pods, or the percentage of desired pods, that
+	// may be unavailable during the update.
+	MaxUnavailable util.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the number of pods, or the percentage of desired pods, that may
+	// be created above the desired number of pods during the update.
+	MaxSurge util.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// DeploymentStatus represents the current status of a deployment.
+type DeploymentStatus struct {
+	// Replicas is the total number of pods targeted by the deployment.
+	Replicas int `json:"replicas"`
+
+	// UpdatedReplicas is the number of pods running the current template.
+	UpdatedReplicas int `json:"updatedReplicas"`
+
+	// AvailableReplicas is the number of pods that are running and ready.
+	AvailableReplicas int `json:"availableReplicas"`
+
+	// UnavailableReplicas is the number of pods that exist but are not yet available.
+	UnavailableReplicas int `json:"unavailableReplicas"`
+}
+
+// Deployment represents the desired state of a set of pods that is rolled out
+// by the server through replication controllers.
+type Deployment struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of this deployment.
+	Spec DeploymentSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this deployment. This data may be out of date
+	// by some window of time.
+	Status DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Deployment `json:"items"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ReplicationController is the name of the replication controller, in the same
+	// namespace, whose replica count is managed by the autoscaler.
+	ReplicationController string `json:"replicationController"`
+
+	// MinReplicas is the lower limit for the number of replicas.
+	MinReplicas int `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int `json:"maxReplicas"`
+
+	// TargetCPUUtilization is the target average CPU usage of the pods, as a
+	// percentage of the CPU request of their containers.
+	TargetCPUUtilization int `json:"targetCPUUtilization,omitempty"`
+}
+
+// HorizontalPodAutoscalerStatus represents the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	// CurrentReplicas is the number of replicas last observed by the autoscaler.
+	CurrentReplicas int `json:"currentReplicas"`
+
+	// DesiredReplicas is the number of replicas last computed by the autoscaler.
+	DesiredReplicas int `json:"desiredReplicas"`
+
+	// CurrentCPUUtilization is the last observed average CPU usage of the pods, as a
+	// percentage of the CPU request of their containers. It is unset if no usage could
+	// be collected.
+	CurrentCPUUtilization *int `json:"currentCPUUtilization,omitempty"`
+
+	// LastScaleTime is the last time the autoscaler changed the number of replicas.
+	LastScaleTime *util.Time `json:"lastScaleTime,omitempty"`
+}
+
+// HorizontalPodAutoscaler scales the number of replicas of a replication
+// controller to keep the CPU usage of its pods close to a target.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired behavior of this autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this autoscaler. This data may be out of date
+	// by some window of time.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []HorizontalPodAutoscaler `json:"items"`
+}
+
+// IngressSpec describes how requests received by the router are routed to services.
+type IngressSpec struct {
+	// Backend is the service port receiving the requests which match no rule.
+	// Such requests are refused if it is not set.
+	Backend *IngressBackend `json:"backend,omitempty"`
+
+	// TLS lists the certificates served for the hosts of the rules. Requests for
+	// a host not listed here are only served over plain HTTP.
+	TLS []IngressTLS `json:"tls,omitempty"`
+
+	// Rules route requests to services by host name and URL path.
+	Rules []IngressRule `json:"rules,omitempty"`
+}
+
+// IngressTLS names the secret holding the certificate served for a set of hosts.
+type IngressTLS struct {
+	// Hosts are the host names the certificate is served for.
+	Hosts []string `json:"hosts,omitempty"`
+
+	// SecretName is the name of the secret, in the same namespace, holding the
+	// certificate and the private key under the keys TLSCertKey and TLSPrivateKeyKey.
+	SecretName string `json:"secretName"`
+}
+
+// IngressRule routes the requests for a host name by URL path.
+type IngressRule struct {
+	// Host is the fully qualified domain name matched against the host of
+	// requests. A rule without a host matches the requests for every host which
+	// has no rule of its own.
+	Host string `json:"host,omitempty"`
+
+	// Paths map URL paths to backends. The longest path matching a request wins.
+	Paths []IngressPath `json:"paths"`
+}
+
+// IngressPath routes the requests under a URL path to a backend.
+type IngressPath struct {
+	// Path is matched against the leading segments of the URL path of requests.
+	// An empty path matches every request.
+	Path string `json:"path,omitempty"`
+
+	// Backend is the service port receiving the matching requests.
+	Backend IngressBackend `json:"backend"`
+}
+
+// IngressBackend names a port of a service.
+type IngressBackend struct {
+	// ServiceName is the name of a service in the same namespace as the ingress.
+	ServiceName string `json:"serviceName"`
+
+	// ServicePort is the port number or the name of a port of the service.
+	ServicePort util.IntOrString `json:"servicePort"`
+}
+
+// IngressStatus represents the current status of an ingress.
+type IngressStatus struct {
+	// Address is the address at which the router serves the ingress.
+	Address string `json:"address,omitempty"`
+}
+
+// Ingress is a collection of rules routing HTTP requests to services by host
+// name and URL path.
+type Ingress struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the rules of this ingress.
+	Spec IngressSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this ingress. This data may be out of date
+	// by some window of time.
+	Status IngressStatus `json:"status,omitempty"`
+}
+
+// IngressList is a collection of ingresses.
+type IngressList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []Ingress `json:"items"`
+}
+
+const (
+	// TLSCertKey is the key of the PEM encoded certificate in the data of a
+	// secret referenced by an ingress.
+	TLSCertKey = "tls.crt"
+	// TLSPrivateKeyKey is the key of the PEM encoded private key in the data of
+	// a secret referenced by an ingress.
+	TLSPrivateKeyKey = "tls.key"
+)
+
 const (
 	// PortalIPNone - do not assign a portal IP
 	// no proxying required and no environment variables should be created for pods
@@ -754,17 +1485,31 @@ const (
 	AffinityTypeNone AffinityType = "None"
 )
 
+// ServiceType describes how a service is exposed.
+type ServiceType string
+
+const (
+	// ServiceTypeClusterIP means a service will only be accessible inside the
+	// cluster, via the portal IP.
+	ServiceTypeClusterIP ServiceType = "ClusterIP"
+
+	// ServiceTypeNodePort means a service will be exposed on one port of
+	// every node, in addition to 'ClusterIP' type.
+	ServiceTypeNodePort ServiceType = "NodePort"
+
+	// ServiceTypeLoadBalancer means a service will be exposed via an
+	// external load balancer (if the cloud provider supports it), in addition
+	// to 'NodePort' type.
+	ServiceTypeLoadBalancer ServiceType = "LoadBalancer"
+)
+
 // ServiceStatus represents the current status of a service
 type ServiceStatus struct{}
 
 // ServiceSpec describes the attributes that a user creates on a service
 type ServiceSpec struct {
-	// Port is the TCP or UDP port that will be made available to each pod for connecting to the pods
-	// proxied by this service.
-	Port int `json:"port"`
-
-	// Required: Supports "TCP" and "UDP".
-	Protocol Protocol `json:"protocol,omitempty"`
+	// Required: The list of ports that are exposed by this service.
+	Ports []ServicePort `json:"ports"`
 
 	// This service will route traffic to pods having labels matching this selector. If empty or not present,
 	// the service is assumed to have endpoints set by an external process and Kubernetes will not modify
@@ -778,8 +1523,10 @@ type ServiceSpec struct {
 	// None can be specified for headless services when proxying is not required
 	PortalIP string `json:"portalIP,omitempty"`
 
-	// CreateExternalLoadBalancer indicates whether a load balancer should be created for this service.
-	CreateExternalLoadBalancer bool `json:"createExternalLoadBalancer,omitempty"`
+	// Required: Type determines how the service will be exposed.  Valid
+	// options: ClusterIP, NodePort, LoadBalancer
+	Type ServiceType `json:"type,omitempty"`
+
 	// PublicIPs are used by external load balancers, or can be set by
 	// users to handle external traffic that arrives at a node.
 	// For load balancers, the publicIP will usually be the IP address of the load balancer,
@@ -787,17 +1534,36 @@ type ServiceSpec struct {
 	// For hostnames, the user will use a CNAME record (instead of using an A record with the IP)
 	PublicIPs []string `json:"publicIPs,omitempty"`
 
-	// TargetPort is the name or number of the port on the container to direct traffic to.
-	// This is useful if the containers the service points to have multiple open ports.
-	// Optional: If unspecified, the first port on the container will be used.
-	// As of v1beta3 this field will become required in the internal API,
-	// and the versioned APIs must provide a default value.
-	TargetPort util.IntOrString `json:"targetPort,omitempty"`
-
 	// Required: Supports "ClientIP" and "None".  Used to maintain session affinity.
 	SessionAffinity AffinityType `json:"sessionAffinity,omitempty"`
 }
 
+// ServicePort is a single port exposed by a service.
+type ServicePort struct {
+	// Optional if only one ServicePort is defined on this service: The
+	// name of this port within the service.  This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names.  This maps to
+	// the 'Name' field in EndpointPort objects.
+	Name string `json:"name"`
+
+	// Required: Supports "TCP" and "UDP".
+	Protocol Protocol `json:"protocol"`
+
+	// Required: The port that will be exposed on the service.
+	Port int `json:"port"`
+
+	// Required: The name or number of the port on the container to direct
+	// traffic to.  This is useful if the containers the service points to
+	// have multiple open ports.  The versioned APIs provide a default value.
+	TargetPort util.IntOrString `json:"targetPort"`
+
+	// The port on each node on which this service is exposed.  Only used
+	// for services of type NodePort or LoadBalancer.  Usually assigned by
+	// the system; if specified, it will be allocated to the service if
+	// unused, and creation of the service will fail otherwise.
+	NodePort int `json:"nodePort"`
+}
+
 // Service is a named abstraction of software service (for example, mysql) consisting of local port
 // (for example 3306) that the proxy listens on, and the selector that determines which pods
 // will answer requests sent through the proxy.
@@ -818,25 +1584,51 @@ type Endpoints struct {
 	TypeMeta   `json:",inline"`
 	ObjectMeta `json:"metadata,omitempty"`
 
-	// Optional: The IP protocol for these endpoints. Supports "TCP" and
-	// "UDP".  Defaults to "TCP".
-	Protocol  Protocol   `json:"protocol,omitempty"`
-	Endpoints []Endpoint `json:"endpoints,omitempty"`
+	// The set of all endpoints is the union of all subsets.
+	Subsets []EndpointSubset `json:"subsets"`
 }
 
-// Endpoint is a single IP endpoint of a service.
-type Endpoint struct {
-	// Required: The IP of this endpoint.
+// EndpointSubset is a group of addresses with a common set of ports.  The
+// expanded set of endpoints is the Cartesian product of Addresses x Ports.
+// For example, given:
+//
+//	{
+//	  Addresses: [{"ip": "10.10.1.1"}, {"ip": "10.10.2.2"}],
+//	  Ports:     [{"name": "a", "port": 8675}, {"name": "b", "port": 309}]
+//	}
+//
+// The resulting set of endpoints can be viewed as:
+//
+//	a: [ 10.10.1.1:8675, 10.10.2.2:8675 ],
+//	b: [ 10.10.1.1:309, 10.10.2.2:309 ]
+type EndpointSubset struct {
+	Addresses []EndpointAddress `json:"addresses,omitempty"`
+	Ports     []EndpointPort    `json:"ports,omitempty"`
+}
+
+// EndpointAddress is a tuple that describes single IP address.
+type EndpointAddress struct {
+	// The IP of this endpoint.
 	// TODO: This should allow hostname or IP, see #4447.
 	IP string `json:"ip"`
 
-	// Required: The destination port to access.
-	Port int `json:"port"`
-
 	// Optional: The kubernetes object related to the entry point.
 	TargetRef *ObjectReference `json:"targetRef,omitempty"`
 }
 
+// EndpointPort is a tuple that describes a single port.
+type EndpointPort struct {
+	// The name of this port (corresponds to ServicePort.Name).  Optional
+	// if only one port is defined.  Must be a DNS_LABEL.
+	Name string `json:"name,omitempty"`
+
+	// The port number.
+	Port int `json:"port"`
+
+	// The IP protocol for this port.
+	Protocol Protocol `json:"protocol,omitempty"`
+}
+
 // EndpointsList is a list of endpoints.
 type EndpointsList struct {
 	TypeMeta `json:",inline"`
@@ -859,6 +1651,33 @@ type NodeSpec struct {
 
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
 	Unschedulable bool `json:"unschedulable,omitempty"`
+
+	// Taints repel the pods which do not tolerate them.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// TaintEffect is the effect of a node taint on the pods which do not tolerate it.
+type TaintEffect string
+
+const (
+	// Do not schedule new pods onto the node unless they tolerate the taint.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// Like TaintEffectNoSchedule, but the scheduler only tries to avoid the node
+	// instead of prohibiting it.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	// Do not schedule new pods onto the node, and evict the pods already running
+	// on it, unless they tolerate the taint.
+	TaintEffectNoExecute TaintEffect = "NoExecute"
+)
+
+// Taint is attached to a node and repels the pods which do not tolerate it.
+type Taint struct {
+	// Required. The key of the taint.
+	Key string `json:"key"`
+	// The value of the taint.
+	Value string `json:"value,omitempty"`
+	// Required. The effect of the taint on the pods which do not tolerate it.
+	Effect TaintEffect `json:"effect"`
 }
 
 // NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
@@ -957,6 +1776,8 @@ const (
 	ResourceCPU ResourceName = "cpu"
 	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
 	ResourceMemory ResourceName = "memory"
+	// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+	ResourceStorage ResourceName = "storage"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -1043,6 +1864,17 @@ type Binding struct {
 	Target ObjectReference `json:"target"`
 }
 
+// Eviction is posted to the eviction subresource of a pod to delete it, unless that
+// would leave fewer healthy pods than one of its disruption budgets allows.
+type Eviction struct {
+	TypeMeta `json:",inline"`
+	// ObjectMeta describes the pod being evicted.
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// DeleteOptions may be provided to delete the pod with a specific grace period.
+	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
+}
+
 // DeleteOptions may be provided when deleting an API object
 type DeleteOptions struct {
 	TypeMeta `json:",inline"`
@@ -1051,8 +1883,17 @@ type DeleteOptions struct {
 	// The value zero indicates delete immediately. If this value is nil, the default grace period for the
 	// specified type will be used.
 	GracePeriodSeconds *int64 `json:"gracePeriodSeconds"`
+
+	// Should the dependents of the object be orphaned. If true, the object is kept with the
+	// "orphan" finalizer until the garbage collector has removed it from the owner references
+	// of its dependents; otherwise the garbage collector deletes its dependents.
+	OrphanDependents *bool `json:"orphanDependents,omitempty"`
 }
 
+// FinalizerOrphan is set on an object deleted with OrphanDependents, until the garbage
+// collector has removed the object from the owner references of its dependents.
+const FinalizerOrphan = "orphan"
+
 // Status is a return value for calls that don't return other objects.
 // TODO: this could go in apiserver, but I'm including it here so clients needn't
 // import both.
@@ -1191,6 +2032,18 @@ const (
 	// can only be created. API calls that return MethodNotAllowed can never succeed.
 	StatusReasonMethodNotAllowed StatusReason = "MethodNotAllowed"
 
+	// StatusReasonTooManyRequests means the server refused the request for now, as too
+	// many requests were made within a given window. The client may retry the request
+	// later, for instance once fewer pods of a disruption budget are being evicted.
+	// Status code 429
+	StatusReasonTooManyRequests StatusReason = "TooManyRequests"
+
+	// StatusReasonExpired means the request refers to a version of the resources which is
+	// no longer available, for instance the continue token of a list which was started too
+	// long ago. The client must restart from the current version.
+	// Status code 410
+	StatusReasonExpired StatusReason = "Expired"
+
 	// StatusReasonInternalError indicates that an internal error occurred, it is unexpected
 	// and the outcome of the call is unknown.
 	// Details (optional):
@@ -1324,8 +2177,8 @@ type ContainerManifest struct {
 	// with the API refactoring. It is required for now to determine the instance
 	// of a Pod.
 	UUID          types.UID     `json:"uuid,omitempty"`
-	Volumes       []Volume      `json:"volumes"`
-	Containers    []Container   `json:"containers"`
+	Volumes       []Volume      `json:"volumes" patchStrategy:"merge" patchMergeKey:"name"`
+	Containers    []Container   `json:"containers" patchStrategy:"merge" patchMergeKey:"name"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
 	// Required: Set DNS policy.
 	DNSPolicy DNSPolicy `json:"dnsPolicy"`
@@ -1402,6 +2255,12 @@ const (
 	ResourceReplicationControllers ResourceName = "replicationcontrollers"
 	// ResourceQuotas, number
 	ResourceQuotas ResourceName = "resourcequotas"
+	// ResourceLimitsCPU, the CPU limits of the containers of pods, in cores.
+	// Quota on ResourceCPU applies to their CPU requests.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory, the memory limits of the containers of pods, in bytes.
+	// Quota on ResourceMemory applies to their memory requests.
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -1460,6 +2319,21 @@ type SecretType string
 
 const (
 	SecretTypeOpaque SecretType = "Opaque" // Default; arbitrary user-defined data
+
+	// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+	//
+	// Required fields:
+	// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+	// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+	// - Secret.Data["token"] - a token that identifies the service account to the API
+	SecretTypeServiceAccountToken SecretType = "kubernetes.io/service-account-token"
+
+	// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountNameKey = "kubernetes.io/service-account.name"
+	// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountUIDKey = "kubernetes.io/service-account.uid"
+	// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+	ServiceAccountTokenKey = "token"
 )
 
 type SecretList struct {
@@ -1469,6 +2343,171 @@ type SecretList struct {
 	Items []Secret `json:"items"`
 }
 
+// ServiceAccount binds together a name, understood by users and perhaps by peripheral
+// systems, with a principal that can be authenticated and a set of secrets.
+type ServiceAccount struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount
+	Secrets []ObjectReference `json:"secrets"`
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+type ServiceAccountList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceAccount `json:"items"`
+}
+
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Data contains the configuration data. Each key must be a valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// ConfigMapList is a list of ConfigMaps.
+type ConfigMapList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ConfigMap `json:"items"`
+}
+
+// PriorityClass maps a name to the priority of the pods referring to it. The pods
+// with a higher priority are scheduled first, and may preempt the pods with a lower
+// priority when no node has room for them. PriorityClasses are not namespaced.
+type PriorityClass struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Value is the priority of the pods of the class. The higher, the more important.
+	Value int `json:"value"`
+	// GlobalDefault makes the class the default of the pods which do not name one. At
+	// most one class should be the global default.
+	GlobalDefault bool `json:"globalDefault,omitempty"`
+	// Description is a human readable explanation of when to use the class.
+	Description string `json:"description,omitempty"`
+}
+
+// PriorityClassList is a list of PriorityClasses.
+type PriorityClassList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PriorityClass `json:"items"`
+}
+
+// PodDisruptionBudgetSpec is the specification of a pod disruption budget.
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number of pods selected by the budget which must still be
+	// healthy after an eviction.
+	MinAvailable int `json:"minAvailable"`
+
+	// Selector is a label query over the pods whose evictions are limited by the budget.
+	Selector map[string]string `json:"selector"`
+}
+
+// PodDisruptionBudgetStatus represents the current status of a pod disruption budget.
+type PodDisruptionBudgetStatus struct {
+	// PodDisruptionsAllowed is the number of pods which may currently be evicted.
+	PodDisruptionsAllowed int `json:"podDisruptionsAllowed"`
+
+	// CurrentHealthy is the number of healthy pods selected by the budget.
+	CurrentHealthy int `json:"currentHealthy"`
+
+	// DesiredHealthy is the minimum number of healthy pods desired.
+	DesiredHealthy int `json:"desiredHealthy"`
+
+	// ExpectedPods is the number of pods selected by the budget which are neither
+	// terminated nor being deleted.
+	ExpectedPods int `json:"expectedPods"`
+
+	// DisruptedPods maps the names of the pods whose eviction was admitted to the time
+	// of the eviction, until the controller observes that they are being deleted. These
+	// pods are not counted as healthy in the meantime.
+	DisruptedPods map[string]util.Time `json:"disruptedPods,omitempty"`
+}
+
+// PodDisruptionBudget limits the number of pods of a group which may be evicted at
+// the same time, such as the members of a quorum.
+type PodDisruptionBudget struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the pods selected by this budget, and how many of them must stay healthy.
+	Spec PodDisruptionBudgetSpec `json:"spec,omitempty"`
+
+	// Status is the current status of this budget. This data may be out of date by some
+	// window of time.
+	Status PodDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// PodDisruptionBudgetList is a collection of pod disruption budgets.
+type PodDisruptionBudgetList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []PodDisruptionBudget `json:"items"`
+}
+
+// ThirdPartyResource registers a kind of object which the API server stores and serves
+// without knowing its schema. Its name is made of the kind, in lower case with dashes
+// between the words, followed by the API group of the kind: "database-cluster.example.com"
+// registers the kind DatabaseCluster, served under /thirdparty/example.com/{version}.
+type ThirdPartyResource struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Description is a free-form description of the kind, for humans.
+	Description string `json:"description,omitempty"`
+
+	// Versions are the API versions the kind is served in.
+	Versions []string `json:"versions,omitempty"`
+}
+
+// ThirdPartyResourceList is a list of ThirdPartyResources.
+type ThirdPartyResourceList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ThirdPartyResource `json:"items"`
+}
+
+// ThirdPartyResourceData is an object of a kind registered by a ThirdPartyResource, as
+// it is stored. The content of the object other than its metadata is kept as opaque JSON.
+type ThirdPartyResourceData struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Data is the JSON content of the object, without its kind, API version and metadata.
+	Data []byte `json:"data,omitempty"`
+}
+
+// ThirdPartyResourceDataList is a list of ThirdPartyResourceData.
+type ThirdPartyResourceDataList struct {
+	TypeMeta `json:",inline"`
+	ListMeta `json:"metadata,omitempty"`
+
+	Items []ThirdPartyResourceData `json:"items"`
+}
+
+// RangeAllocation is the persisted state of an allocator of a range of values, such
+// as the node ports of services.
+type RangeAllocation struct {
+	TypeMeta   `json:",inline"`
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// Range is the range the allocator hands out values from, such as "30000-32767".
+	Range string `json:"range"`
+	// Data is a bit array with a bit set for every allocated value of the range.
+	Data []byte `json:"data"`
+}
+
 // These constants are for remote command execution and port forwarding and are
 // used by both the client side and server side components.
 //
@@ -35,6 +35,20 @@ type RootPaths struct {
 	Paths []string `json:"paths"`
 }
 
+// PatchType is the content type of a patch, which selects how it is applied.
+type PatchType string
+
+const (
+	// JSONPatchType is a list of operations applied to the object, as defined by RFC 6902.
+	JSONPatchType PatchType = "application/json-patch+json"
+	// MergePatchType is a partial object merged into the object, as defined by RFC 7386.
+	// Lists are replaced as a whole.
+	MergePatchType PatchType = "application/merge-patch+json"
+	// StrategicMergePatchType is a merge patch which merges the lists of the object by
+	// the keys declared with the patchStrategy and patchMergeKey tags of the API types.
+	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+)
+
 // preV1Beta3 returns true if the provided API version is an API introduced before v1beta3.
 func PreV1Beta3(version string) bool {
 	return version == "v1beta1" || version == "v1beta2"
@@ -22,6 +22,7 @@ import (
 	"strconv"
 
 	newer "github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/endpoints"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -36,7 +37,6 @@ func init() {
 	newer.Scheme.AddStructFieldConversion(newer.TypeMeta{}, "TypeMeta", TypeMeta{}, "TypeMeta")
 	newer.Scheme.AddStructFieldConversion(newer.ObjectMeta{}, "ObjectMeta", TypeMeta{}, "TypeMeta")
 	newer.Scheme.AddStructFieldConversion(newer.ListMeta{}, "ListMeta", TypeMeta{}, "TypeMeta")
-	newer.Scheme.AddStructFieldConversion(newer.Endpoints{}, "Endpoints", Endpoints{}, "Endpoints")
 
 	// TODO: scope this to a specific type once that becomes available and remove the Event conversion functions below
 	// newer.Scheme.AddStructFieldConversion(string(""), "Status", string(""), "Condition")
@@ -58,6 +58,7 @@ func init() {
 		// ListMeta must be converted to TypeMeta
 		func(in *newer.ListMeta, out *TypeMeta, s conversion.Scope) error {
 			out.SelfLink = in.SelfLink
+			out.Continue = in.Continue
 			if len(in.ResourceVersion) > 0 {
 				v, err := strconv.ParseUint(in.ResourceVersion, 10, 64)
 				if err != nil {
@@ -69,6 +70,7 @@ func init() {
 		},
 		func(in *TypeMeta, out *newer.ListMeta, s conversion.Scope) error {
 			out.SelfLink = in.SelfLink
+			out.Continue = in.Continue
 			if in.ResourceVersion != 0 {
 				out.ResourceVersion = strconv.FormatUint(in.ResourceVersion, 10)
 			} else {
@@ -85,7 +87,12 @@ func init() {
 			out.UID = in.UID
 			out.CreationTimestamp = in.CreationTimestamp
 			out.DeletionTimestamp = in.DeletionTimestamp
+			out.DeletionGracePeriodSeconds = in.DeletionGracePeriodSeconds
 			out.SelfLink = in.SelfLink
+			if err := s.Convert(&in.OwnerReferences, &out.OwnerReferences, 0); err != nil {
+				return err
+			}
+			out.Finalizers = in.Finalizers
 			if len(in.ResourceVersion) > 0 {
 				v, err := strconv.ParseUint(in.ResourceVersion, 10, 64)
 				if err != nil {
@@ -102,7 +109,12 @@ func init() {
 			out.UID = in.UID
 			out.CreationTimestamp = in.CreationTimestamp
 			out.DeletionTimestamp = in.DeletionTimestamp
+			out.DeletionGracePeriodSeconds = in.DeletionGracePeriodSeconds
 			out.SelfLink = in.SelfLink
+			if err := s.Convert(&in.OwnerReferences, &out.OwnerReferences, 0); err != nil {
+				return err
+			}
+			out.Finalizers = in.Finalizers
 			if in.ResourceVersion != 0 {
 				out.ResourceVersion = strconv.FormatUint(in.ResourceVersion, 10)
 			} else {
@@ -116,7 +128,7 @@ func init() {
 			out.Value = in.Value
 			out.Key = in.Name
 			out.Name = in.Name
-			return nil
+			return s.Convert(&in.ValueFrom, &out.ValueFrom, 0)
 		},
 		func(in *EnvVar, out *newer.EnvVar, s conversion.Scope) error {
 			out.Value = in.Value
@@ -125,7 +137,7 @@ func init() {
 			} else {
 				out.Name = in.Key
 			}
-			return nil
+			return s.Convert(&in.ValueFrom, &out.ValueFrom, 0)
 		},
 
 		// Path & MountType are deprecated.
@@ -191,10 +203,14 @@ func init() {
 			if err := s.Convert(&in.Info, &out.Info, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.InitContainerInfo, &out.InitContainerInfo, 0); err != nil {
+				return err
+			}
 			out.Message = in.Message
 			out.Host = in.Host
 			out.HostIP = in.HostIP
 			out.PodIP = in.PodIP
+			out.NominatedHost = in.NominatedHost
 			return nil
 		},
 		func(in *PodState, out *newer.PodStatus, s conversion.Scope) error {
@@ -207,11 +223,15 @@ func init() {
 			if err := s.Convert(&in.Info, &out.Info, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.InitContainerInfo, &out.InitContainerInfo, 0); err != nil {
+				return err
+			}
 
 			out.Message = in.Message
 			out.Host = in.Host
 			out.HostIP = in.HostIP
 			out.PodIP = in.PodIP
+			out.NominatedHost = in.NominatedHost
 			return nil
 		},
 		func(in *newer.PodSpec, out *PodState, s conversion.Scope) error {
@@ -597,12 +617,33 @@ func init() {
 			if err := s.Convert(&in.Containers, &out.Containers, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.InitContainers, &out.InitContainers, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Tolerations, &out.Tolerations, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.NodeAffinity, &out.NodeAffinity, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PodAffinity, &out.PodAffinity, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PodAntiAffinity, &out.PodAntiAffinity, 0); err != nil {
+				return err
+			}
+			out.PriorityClassName = in.PriorityClassName
+			if err := s.Convert(&in.Priority, &out.Priority, 0); err != nil {
+				return err
+			}
 			if err := s.Convert(&in.RestartPolicy, &out.RestartPolicy, 0); err != nil {
 				return err
 			}
 			out.DNSPolicy = DNSPolicy(in.DNSPolicy)
 			out.Version = "v1beta2"
 			out.HostNetwork = in.HostNetwork
+			out.ServiceAccount = in.ServiceAccount
+			out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
 			return nil
 		},
 		func(in *ContainerManifest, out *newer.PodSpec, s conversion.Scope) error {
@@ -612,11 +653,32 @@ func init() {
 			if err := s.Convert(&in.Containers, &out.Containers, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.InitContainers, &out.InitContainers, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Tolerations, &out.Tolerations, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.NodeAffinity, &out.NodeAffinity, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PodAffinity, &out.PodAffinity, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PodAntiAffinity, &out.PodAntiAffinity, 0); err != nil {
+				return err
+			}
+			out.PriorityClassName = in.PriorityClassName
+			if err := s.Convert(&in.Priority, &out.Priority, 0); err != nil {
+				return err
+			}
 			if err := s.Convert(&in.RestartPolicy, &out.RestartPolicy, 0); err != nil {
 				return err
 			}
 			out.DNSPolicy = newer.DNSPolicy(in.DNSPolicy)
 			out.HostNetwork = in.HostNetwork
+			out.ServiceAccount = in.ServiceAccount
+			out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
 			return nil
 		},
 
@@ -631,14 +693,31 @@ func init() {
 				return err
 			}
 
-			out.Port = in.Spec.Port
-			out.Protocol = Protocol(in.Spec.Protocol)
+			// Produce legacy fields.
+			if len(in.Spec.Ports) > 0 {
+				out.Port = in.Spec.Ports[0].Port
+				out.Protocol = Protocol(in.Spec.Ports[0].Protocol)
+				out.ContainerPort = in.Spec.Ports[0].TargetPort
+			}
+			// Copy modern fields.
+			for i := range in.Spec.Ports {
+				out.Ports = append(out.Ports, ServicePort{
+					Name:          in.Spec.Ports[i].Name,
+					Port:          in.Spec.Ports[i].Port,
+					Protocol:      Protocol(in.Spec.Ports[i].Protocol),
+					ContainerPort: in.Spec.Ports[i].TargetPort,
+					NodePort:      in.Spec.Ports[i].NodePort,
+				})
+			}
+
 			if err := s.Convert(&in.Spec.Selector, &out.Selector, 0); err != nil {
 				return err
 			}
-			out.CreateExternalLoadBalancer = in.Spec.CreateExternalLoadBalancer
+			if err := s.Convert(&in.Spec.Type, &out.Type, 0); err != nil {
+				return err
+			}
+			out.CreateExternalLoadBalancer = in.Spec.Type == newer.ServiceTypeLoadBalancer
 			out.PublicIPs = in.Spec.PublicIPs
-			out.ContainerPort = in.Spec.TargetPort
 			out.PortalIP = in.Spec.PortalIP
 			if err := s.Convert(&in.Spec.SessionAffinity, &out.SessionAffinity, 0); err != nil {
 				return err
@@ -657,14 +736,42 @@ func init() {
 				return err
 			}
 
-			out.Spec.Port = in.Port
-			out.Spec.Protocol = newer.Protocol(in.Protocol)
+			if len(in.Ports) == 0 && in.Port != 0 {
+				// Use legacy fields to produce modern fields.
+				out.Spec.Ports = append(out.Spec.Ports, newer.ServicePort{
+					Name:       "",
+					Port:       in.Port,
+					Protocol:   newer.Protocol(in.Protocol),
+					TargetPort: in.ContainerPort,
+				})
+			} else {
+				// Use modern fields, ignore legacy.
+				for i := range in.Ports {
+					out.Spec.Ports = append(out.Spec.Ports, newer.ServicePort{
+						Name:       in.Ports[i].Name,
+						Port:       in.Ports[i].Port,
+						Protocol:   newer.Protocol(in.Ports[i].Protocol),
+						TargetPort: in.Ports[i].ContainerPort,
+						NodePort:   in.Ports[i].NodePort,
+					})
+				}
+			}
+
 			if err := s.Convert(&in.Selector, &out.Spec.Selector, 0); err != nil {
 				return err
 			}
-			out.Spec.CreateExternalLoadBalancer = in.CreateExternalLoadBalancer
+			typeIn := in.Type
+			if typeIn == "" {
+				if in.CreateExternalLoadBalancer {
+					typeIn = ServiceTypeLoadBalancer
+				} else {
+					typeIn = ServiceTypeClusterIP
+				}
+			}
+			if err := s.Convert(&typeIn, &out.Spec.Type, 0); err != nil {
+				return err
+			}
 			out.Spec.PublicIPs = in.PublicIPs
-			out.Spec.TargetPort = in.ContainerPort
 			out.Spec.PortalIP = in.PortalIP
 			if err := s.Convert(&in.SessionAffinity, &out.Spec.SessionAffinity, 0); err != nil {
 				return err
@@ -704,6 +811,9 @@ func init() {
 			out.PodCIDR = in.Spec.PodCIDR
 			out.ExternalID = in.Spec.ExternalID
 			out.Unschedulable = in.Spec.Unschedulable
+			if err := s.Convert(&in.Spec.Taints, &out.Taints, 0); err != nil {
+				return err
+			}
 			return s.Convert(&in.Spec.Capacity, &out.NodeResources.Capacity, 0)
 		},
 		func(in *Minion, out *newer.Node, s conversion.Scope) error {
@@ -736,6 +846,9 @@ func init() {
 			out.Spec.PodCIDR = in.PodCIDR
 			out.Spec.ExternalID = in.ExternalID
 			out.Spec.Unschedulable = in.Unschedulable
+			if err := s.Convert(&in.Taints, &out.Spec.Taints, 0); err != nil {
+				return err
+			}
 			return s.Convert(&in.NodeResources.Capacity, &out.Spec.Capacity, 0)
 		},
 
@@ -900,6 +1013,296 @@ func init() {
 			return nil
 		},
 
+		func(in *newer.PersistentVolume, out *PersistentVolume, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *PersistentVolume, out *newer.PersistentVolume, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.PersistentVolumeClaim, out *PersistentVolumeClaim, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *PersistentVolumeClaim, out *newer.PersistentVolumeClaim, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.Job, out *Job, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Job, out *newer.Job, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.DaemonSet, out *DaemonSet, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *DaemonSet, out *newer.DaemonSet, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.Deployment, out *Deployment, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Deployment, out *newer.Deployment, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.HorizontalPodAutoscaler, out *HorizontalPodAutoscaler, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *HorizontalPodAutoscaler, out *newer.HorizontalPodAutoscaler, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.Ingress, out *Ingress, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *Ingress, out *newer.Ingress, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
+		func(in *newer.ThirdPartyResourceData, out *ThirdPartyResourceData, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Data, &out.Data, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *ThirdPartyResourceData, out *newer.ThirdPartyResourceData, s conversion.Scope) error {
+			if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Data, &out.Data, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Labels, &out.ObjectMeta.Labels, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+
 		// Object ID <-> Name
 		// TODO: amend the conversion package to allow overriding specific fields.
 		func(in *ObjectReference, out *newer.ObjectReference, s conversion.Scope) error {
@@ -1061,6 +1464,15 @@ func init() {
 			if err := s.Convert(&in.NFS, &out.NFS, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ConfigMap, &out.ConfigMap, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.DownwardAPI, &out.DownwardAPI, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 		func(in *VolumeSource, out *newer.VolumeSource, s conversion.Scope) error {
@@ -1082,6 +1494,15 @@ func init() {
 			if err := s.Convert(&in.NFS, &out.NFS, 0); err != nil {
 				return err
 			}
+			if err := s.Convert(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.ConfigMap, &out.ConfigMap, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.DownwardAPI, &out.DownwardAPI, 0); err != nil {
+				return err
+			}
 			return nil
 		},
 
@@ -1181,21 +1602,39 @@ func init() {
 			if err := s.Convert(&in.ObjectMeta, &out.TypeMeta, 0); err != nil {
 				return err
 			}
-			if err := s.Convert(&in.Protocol, &out.Protocol, 0); err != nil {
+			if err := s.Convert(&in.Subsets, &out.Subsets, 0); err != nil {
 				return err
 			}
-			for i := range in.Endpoints {
-				ep := &in.Endpoints[i]
-				hostPort := net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port))
-				out.Endpoints = append(out.Endpoints, hostPort)
-				if ep.TargetRef != nil {
-					target := EndpointObjectReference{
-						Endpoint: hostPort,
+			// Produce back-compat fields from the first port.
+			firstPortName := ""
+			out.Protocol = ProtocolTCP
+			if len(in.Subsets) > 0 && len(in.Subsets[0].Ports) > 0 {
+				if err := s.Convert(&in.Subsets[0].Ports[0].Protocol, &out.Protocol, 0); err != nil {
+					return err
+				}
+				firstPortName = in.Subsets[0].Ports[0].Name
+			}
+			for i := range in.Subsets {
+				ss := &in.Subsets[i]
+				for j := range ss.Ports {
+					ssp := &ss.Ports[j]
+					if ssp.Name != firstPortName {
+						continue
 					}
-					if err := s.Convert(ep.TargetRef, &target.ObjectReference, 0); err != nil {
-						return err
+					for k := range ss.Addresses {
+						ssa := &ss.Addresses[k]
+						hostPort := net.JoinHostPort(ssa.IP, strconv.Itoa(ssp.Port))
+						out.Endpoints = append(out.Endpoints, hostPort)
+						if ssa.TargetRef != nil {
+							target := EndpointObjectReference{
+								Endpoint: hostPort,
+							}
+							if err := s.Convert(ssa.TargetRef, &target.ObjectReference, 0); err != nil {
+								return err
+							}
+							out.TargetRefs = append(out.TargetRefs, target)
+						}
 					}
-					out.TargetRefs = append(out.TargetRefs, target)
 				}
 			}
 			return nil
@@ -1207,31 +1646,37 @@ func init() {
 			if err := s.Convert(&in.TypeMeta, &out.ObjectMeta, 0); err != nil {
 				return err
 			}
-			if err := s.Convert(&in.Protocol, &out.Protocol, 0); err != nil {
-				return err
+			if len(in.Subsets) > 0 {
+				// Use modern fields, ignore legacy.
+				return s.Convert(&in.Subsets, &out.Subsets, 0)
 			}
+			// Simulate subsets from the legacy fields, one per endpoint.
 			for i := range in.Endpoints {
-				out.Endpoints = append(out.Endpoints, newer.Endpoint{})
-				ep := &out.Endpoints[i]
 				host, port, err := net.SplitHostPort(in.Endpoints[i])
 				if err != nil {
 					return err
 				}
-				ep.IP = host
 				pn, err := strconv.Atoi(port)
 				if err != nil {
 					return err
 				}
-				ep.Port = pn
+				address := newer.EndpointAddress{IP: host}
 				for j := range in.TargetRefs {
 					if in.TargetRefs[j].Endpoint != in.Endpoints[i] {
 						continue
 					}
-					ep.TargetRef = &newer.ObjectReference{}
-					if err := s.Convert(&in.TargetRefs[j].ObjectReference, ep.TargetRef, 0); err != nil {
+					address.TargetRef = &newer.ObjectReference{}
+					if err := s.Convert(&in.TargetRefs[j].ObjectReference, address.TargetRef, 0); err != nil {
 						return err
 					}
 				}
+				out.Subsets = append(out.Subsets, newer.EndpointSubset{
+					Addresses: []newer.EndpointAddress{address},
+					Ports:     []newer.EndpointPort{{Protocol: newer.Protocol(in.Protocol), Port: pn}},
+				})
+			}
+			if len(out.Subsets) > 0 {
+				out.Subsets = endpoints.RepackSubsets(out.Subsets)
 			}
 			return nil
 		},
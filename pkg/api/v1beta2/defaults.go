@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -53,6 +54,28 @@ func init() {
 			if obj.TerminationMessagePath == "" {
 				obj.TerminationMessagePath = TerminationMessagePathDefault
 			}
+			// Requests default to limits, where the CPU and Memory fields take
+			// precedence over the limits of Resources.
+			if obj.CPU > 0 || obj.Memory > 0 {
+				if obj.Resources.Requests == nil {
+					obj.Resources.Requests = ResourceList{}
+				}
+				if _, found := obj.Resources.Requests[ResourceCPU]; !found && obj.CPU > 0 {
+					obj.Resources.Requests[ResourceCPU] = util.NewIntOrStringFromString(fmt.Sprintf("%v", float64(obj.CPU)/1000))
+				}
+				if _, found := obj.Resources.Requests[ResourceMemory]; !found && obj.Memory > 0 {
+					obj.Resources.Requests[ResourceMemory] = util.NewIntOrStringFromInt(int(obj.Memory))
+				}
+			}
+			for name, limit := range obj.Resources.Limits {
+				if _, found := obj.Resources.Requests[name]; found {
+					continue
+				}
+				if obj.Resources.Requests == nil {
+					obj.Resources.Requests = ResourceList{}
+				}
+				obj.Resources.Requests[name] = limit
+			}
 		},
 		func(obj *RestartPolicy) {
 			if util.AllPtrFieldsNil(obj) {
@@ -63,9 +86,24 @@ func init() {
 			if obj.Protocol == "" {
 				obj.Protocol = ProtocolTCP
 			}
+			for i := range obj.Ports {
+				sp := &obj.Ports[i]
+				if sp.Protocol == "" {
+					sp.Protocol = ProtocolTCP
+				}
+			}
 			if obj.SessionAffinity == "" {
 				obj.SessionAffinity = AffinityTypeNone
 			}
+			if obj.Type == "" {
+				if obj.CreateExternalLoadBalancer {
+					obj.Type = ServiceTypeLoadBalancer
+				} else {
+					obj.Type = ServiceTypeClusterIP
+				}
+			} else if obj.Type == ServiceTypeLoadBalancer {
+				obj.CreateExternalLoadBalancer = true
+			}
 		},
 		func(obj *PodSpec) {
 			if obj.DNSPolicy == "" {
@@ -73,6 +111,7 @@ func init() {
 			}
 			if obj.HostNetwork {
 				defaultHostNetworkPorts(&obj.Containers)
+				defaultHostNetworkPorts(&obj.InitContainers)
 			}
 		},
 		func(obj *ContainerManifest) {
@@ -81,6 +120,7 @@ func init() {
 			}
 			if obj.HostNetwork {
 				defaultHostNetworkPorts(&obj.Containers)
+				defaultHostNetworkPorts(&obj.InitContainers)
 			}
 		},
 		func(obj *LivenessProbe) {
@@ -97,6 +137,15 @@ func init() {
 			if obj.Protocol == "" {
 				obj.Protocol = "TCP"
 			}
+			for i := range obj.Subsets {
+				ss := &obj.Subsets[i]
+				for i := range ss.Ports {
+					ep := &ss.Ports[i]
+					if ep.Protocol == "" {
+						ep.Protocol = ProtocolTCP
+					}
+				}
+			}
 		},
 		func(obj *HTTPGetAction) {
 			if obj.Path == "" {
@@ -108,6 +157,33 @@ func init() {
 				obj.Phase = NamespaceActive
 			}
 		},
+		func(obj *JobSpec) {
+			if obj.Completions == 0 {
+				obj.Completions = 1
+			}
+			if obj.Parallelism == 0 {
+				obj.Parallelism = 1
+			}
+		},
+		func(obj *DeploymentStrategy) {
+			if obj.Type == "" {
+				obj.Type = RollingUpdateDeploymentStrategyType
+			}
+			if obj.Type == RollingUpdateDeploymentStrategyType && obj.RollingUpdate == nil {
+				obj.RollingUpdate = &RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				}
+			}
+		},
+		func(obj *HorizontalPodAutoscalerSpec) {
+			if obj.MinReplicas == 0 {
+				obj.MinReplicas = 1
+			}
+			if obj.TargetCPUUtilization == 0 {
+				obj.TargetCPUUtilization = 80
+			}
+		},
 	)
 }
 
@@ -80,8 +80,128 @@ type VolumeSource struct {
 	Secret *SecretVolumeSource `json:"secret" description:"secret to populate volume"`
 	// NFS represents an NFS mount on the host that shares a pod's lifetime
 	NFS *NFSVolumeSource `json:"nfs" description:"NFS volume that will be mounted in the host machine"`
+	// PersistentVolumeClaim represents a reference to a PersistentVolumeClaim in the same namespace
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim" description:"a reference to a PersistentVolumeClaim in the same namespace"`
+	// ConfigMap represents a config map that should populate this volume.
+	ConfigMap *ConfigMapVolumeSource `json:"configMap" description:"config map to populate volume with"`
+	// DownwardAPI represents metadata of the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI" description:"downward API metadata to populate volume with"`
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the administrator who creates
+// PersistentVolumes. Only one of its members may be specified.
+type PersistentVolumeSource struct {
+	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"persistentDisk" description:"GCE disk resource provisioned by an admin"`
+	// HostPath represents a directory on the host.
+	// This is useful for development and testing only.
+	// on-host storage is not supported in any way.
+	HostPath *HostPathVolumeSource `json:"hostPath" description:"a HostPath provisioned by a developer or tester; for development use only"`
+	// NFS represents an NFS mount on the host
+	NFS *NFSVolumeSource `json:"nfs" description:"NFS volume resource provisioned by an admin"`
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PersistentVolumeClaim in the same namespace.
+type PersistentVolumeClaimVolumeSource struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume
+	ClaimName string `json:"claimName,omitempty" description:"the name of the claim in the same namespace to be mounted as a volume"`
+	// Optional: Defaults to false (read/write).  ReadOnly here
+	// will force the ReadOnly setting in VolumeMounts
+	ReadOnly bool `json:"readOnly,omitempty" description:"mount volume as read-only when true; default false"`
+}
+
+type PersistentVolume struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize objects; may match selectors of replication controllers and services"`
+
+	//Spec defines a persistent volume owned by the cluster
+	Spec PersistentVolumeSpec `json:"spec,omitempty" description:"specification of a persistent volume as provisioned by an administrator"`
+
+	// Status represents the current information about persistent volume.
+	Status PersistentVolumeStatus `json:"status,omitempty" description:"current status of a persistent volume; populated by the system, read-only"`
+}
+
+// PersistentVolumeSpec has most of the details required to define a persistent volume
+type PersistentVolumeSpec struct {
+	// Resources represents the actual resources of the volume
+	Capacity ResourceList `json:"capacity,omitempty" description:"a description of the persistent volume's resources and capacity"`
+	// Source represents the location and type of a volume to mount.
+	PersistentVolumeSource `json:",inline" description:"the actual volume backing the persistent volume"`
+	// AccessModes contains all ways the volume can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"all ways the volume can be mounted"`
+	// holds the binding reference to a PersistentVolumeClaim
+	ClaimRef *ObjectReference `json:"claimRef,omitempty" description:"when bound, a reference to the bound claim"`
+}
+
+type PersistentVolumeStatus struct {
+	// Phase indicates if a volume is available, bound to a claim, or released by a claim
+	Phase PersistentVolumePhase `json:"phase,omitempty" description:"the current phase of a persistent volume"`
+}
+
+type PersistentVolumeList struct {
+	TypeMeta `json:",inline"`
+	Items    []PersistentVolume `json:"items,omitempty" description:"list of persistent volumes"`
 }
 
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume
+type PersistentVolumeClaim struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize objects; may match selectors of replication controllers and services"`
+
+	// Spec defines the volume requested by a pod author
+	Spec PersistentVolumeClaimSpec `json:"spec,omitempty" description:"the desired characteristics of a volume"`
+
+	// Status represents the current information about a claim
+	Status PersistentVolumeClaimStatus `json:"status,omitempty" description:"the current status of a persistent volume claim; read-only"`
+}
+
+type PersistentVolumeClaimList struct {
+	TypeMeta `json:",inline"`
+	Items    []PersistentVolumeClaim `json:"items,omitempty" description:"a list of persistent volume claims"`
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes
+type PersistentVolumeClaimSpec struct {
+	// Contains the types of access modes required
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"the desired access modes the volume should have"`
+	// Resources represents the minimum resources required
+	Resources ResourceRequirements `json:"resources,omitempty" description:"the desired resources the volume should have"`
+}
+
+type PersistentVolumeClaimStatus struct {
+	// Phase represents the current phase of PersistentVolumeClaim
+	Phase PersistentVolumeClaimPhase `json:"phase,omitempty" description:"the current phase of the claim"`
+	// AccessModes contains all ways the volume backing the PVC can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty" description:"the actual access modes the volume has"`
+	// Represents the actual resources of the underlying volume
+	Capacity ResourceList `json:"capacity,omitempty" description:"the actual resources the volume has"`
+	// VolumeRef is a reference to the PersistentVolume bound to the PersistentVolumeClaim
+	VolumeRef *ObjectReference `json:"volumeRef,omitempty" description:"a reference to the backing persistent volume, when bound"`
+}
+
+type PersistentVolumePhase string
+
+const (
+	// used for PersistentVolumes that are not yet bound
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// used for PersistentVolumes that are bound
+	VolumeBound PersistentVolumePhase = "Bound"
+	// used for PersistentVolumes where the bound PersistentVolumeClaim was deleted
+	// released volumes must be recycled before becoming available again
+	VolumeReleased PersistentVolumePhase = "Released"
+)
+
+type PersistentVolumeClaimPhase string
+
+const (
+	// used for PersistentVolumeClaims that are not yet bound
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// used for PersistentVolumeClaims that are bound
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+)
+
 // used by VolumeSources to describe their mounting/access modes
 type AccessModeType string
 
@@ -127,6 +247,38 @@ type SecretVolumeSource struct {
 	Target ObjectReference `json:"target" description:"target is a reference to a secret"`
 }
 
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource. The keys of
+// the config map are projected as files in the volume.
+type ConfigMapVolumeSource struct {
+	// Name of the config map in the pod's namespace to use.
+	Name string `json:"name" description:"name of a config map in the pod's namespace"`
+	// Items selects the keys to project and the paths to project them to.
+	// If unspecified, every key is projected into a file named after the key.
+	Items []KeyToPath `json:"items,omitempty" description:"keys to project and the paths to project them to; defaults to every key, projected into a file named after the key"`
+}
+
+// KeyToPath maps a key of a config map to a path in a volume.
+type KeyToPath struct {
+	// The key to project.
+	Key string `json:"key" description:"the key to project"`
+	// The path, relative to the volume, of the file to project the key to.
+	Path string `json:"path" description:"the relative path of the file to project the key to; may not contain '..'"`
+}
+
+// DownwardAPIVolumeSource projects fields of the pod into files of a volume.
+type DownwardAPIVolumeSource struct {
+	// Items is the list of files to write, one per pod field.
+	Items []DownwardAPIVolumeFile `json:"items,omitempty" description:"files to write, one per pod field"`
+}
+
+// DownwardAPIVolumeFile maps a field of the pod to a file in a volume.
+type DownwardAPIVolumeFile struct {
+	// The path, relative to the volume, of the file to write.
+	Path string `json:"path" description:"the relative path of the file to write; may not contain '..'"`
+	// Selects the pod field to write; only name, namespace, labels and annotations are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef" description:"selects the pod field to write; only metadata.name, metadata.namespace, metadata.labels and metadata.annotations are supported"`
+}
+
 // Protocol defines network protocols supported for things like conatiner ports.
 type Protocol string
 
@@ -204,6 +356,30 @@ type EnvVar struct {
 	Name string `json:"name" description:"name of the environment variable; must be a C_IDENTIFIER"`
 	// Optional: defaults to "".
 	Value string `json:"value,omitempty" description:"value of the environment variable; defaults to empty string"`
+	// Optional: specifies a source the value of this var should come from.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty" description:"source for the environment variable's value; cannot be used if value is not empty"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+type EnvVarSource struct {
+	// Selects a key of a config map in the pod's namespace.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty" description:"selects a key of a config map in the pod's namespace"`
+	// Selects a field of the pod; only name, namespace and podIP are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty" description:"selects a field of the pod; only metadata.name, metadata.namespace and status.podIP are supported"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// The name of the config map in the pod's namespace.
+	Name string `json:"name" description:"name of the config map in the pod's namespace"`
+	// The key to select.
+	Key string `json:"key" description:"the key to select"`
+}
+
+// ObjectFieldSelector selects a field of the pod a container runs in.
+type ObjectFieldSelector struct {
+	// The path of the field to select, such as "metadata.name".
+	FieldPath string `json:"fieldPath" description:"path of the field to select, such as metadata.name"`
 }
 
 // HTTPGetAction describes an action based on HTTP Get requests.
@@ -282,6 +458,9 @@ type Capabilities struct {
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources required.
 	Limits ResourceList `json:"limits,omitempty" description:"Maximum amount of compute resources allowed"`
+	// Requests describes the minimum amount of compute resources required, which is
+	// reserved for the container when it is scheduled. Requests default to Limits.
+	Requests ResourceList `json:"requests,omitempty" description:"Minimum amount of resources requested; defaults to Limits"`
 }
 
 // Container represents a single container that is expected to be run on the host.
@@ -297,14 +476,14 @@ type Container struct {
 	Command []string `json:"command,omitempty" description:"command argv array; not executed within a shell; defaults to entrypoint or command in the image; cannot be updated"`
 	// Optional: Defaults to Docker's default.
 	WorkingDir string               `json:"workingDir,omitempty" description:"container's working directory; defaults to image's default; cannot be updated"`
-	Ports      []ContainerPort      `json:"ports,omitempty" description:"list of ports to expose from the container; cannot be updated"`
-	Env        []EnvVar             `json:"env,omitempty" description:"list of environment variables to set in the container; cannot be updated"`
+	Ports      []ContainerPort      `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort" description:"list of ports to expose from the container; cannot be updated"`
+	Env        []EnvVar             `json:"env,omitempty" patchStrategy:"merge" patchMergeKey:"name" description:"list of environment variables to set in the container; cannot be updated"`
 	Resources  ResourceRequirements `json:"resources,omitempty" description:"Compute Resources required by this container; cannot be updated"`
 	// Optional: Defaults to unlimited.
 	CPU int `json:"cpu,omitempty" description:"CPU share in thousandths of a core; cannot be updated"`
 	// Optional: Defaults to unlimited.
 	Memory         int64          `json:"memory,omitempty" description:"memory limit in bytes; defaults to unlimited; cannot be updated"`
-	VolumeMounts   []VolumeMount  `json:"volumeMounts,omitempty" description:"pod volumes to mount into the container's filesystem; cannot be updated"`
+	VolumeMounts   []VolumeMount  `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath" description:"pod volumes to mount into the container's filesystem; cannot be updated"`
 	LivenessProbe  *LivenessProbe `json:"livenessProbe,omitempty" description:"periodic probe of container liveness; container will be restarted if the probe fails; cannot be updated"`
 	ReadinessProbe *LivenessProbe `json:"readinessProbe,omitempty" description:"periodic probe of container service readiness; container will be removed from service endpoints if the probe fails; cannot be updated"`
 	Lifecycle      *Lifecycle     `json:"lifecycle,omitempty" description:"actions that the management system should take in response to container lifecycle events; cannot be updated"`
@@ -376,6 +555,10 @@ type TypeMeta struct {
 	// will send a hard termination signal to the container.
 	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty" description:"RFC 3339 date and time at which the object will be deleted; populated by the system when a graceful deletion is requested, read-only; if not set, graceful deletion of the object has not been requested"`
 
+	// DeletionGracePeriodSeconds is the number of seconds the object is given to terminate
+	// gracefully, set along with DeletionTimestamp. It may only be shortened.
+	DeletionGracePeriodSeconds *int64 `json:"deletionGracePeriodSeconds,omitempty" description:"number of seconds allowed for the object to terminate gracefully before it is removed; set when a graceful deletion is requested, and may only be shortened; read-only"`
+
 	// GenerateName indicates that the name should be made unique by the server prior to persisting
 	// it. A non-empty value for the field indicates the name will be made unique (and the name
 	// returned to the client will be different than the name passed). The value of this field will
@@ -393,6 +576,30 @@ type TypeMeta struct {
 	// external tooling. They are not queryable and should be preserved when modifying
 	// objects.
 	Annotations map[string]string `json:"annotations,omitempty" description:"map of string keys and values that can be used by external tooling to store and retrieve arbitrary metadata about the object"`
+
+	// OwnerReferences lists the objects this object depends on. Once all of them are deleted,
+	// the object is deleted by the garbage collector, unless the deletion of its owners asked
+	// for their dependents to be orphaned. The owners are in the namespace of the object.
+	OwnerReferences []OwnerReference `json:"ownerReferences,omitempty" description:"objects this object depends on; the object is deleted by the garbage collector once all of them are deleted, unless their deletion orphans their dependents; all the owners are in the namespace of the object"`
+
+	// Finalizers must be empty before the object is removed from the registry. An object
+	// deleted while it has finalizers is only marked with a DeletionTimestamp, and removed
+	// once the parties responsible for the finalizers have cleared them.
+	Finalizers []string `json:"finalizers,omitempty" description:"must be empty before the object is removed; an object deleted while it has finalizers is only marked with a deletion timestamp"`
+
+	// Continue is set on lists limited by the client when more items remain. The client
+	// lists the next items by passing this opaque value in the continue parameter.
+	Continue string `json:"continue,omitempty" description:"opaque value set on lists limited by the client when more items remain; passed by the client in the continue parameter to list the next items"`
+}
+
+// OwnerReference identifies an object owning the object it is set on.
+type OwnerReference struct {
+	// Kind of the owner.
+	Kind string `json:"kind" description:"kind of the owner"`
+	// Name of the owner.
+	Name string `json:"name" description:"name of the owner"`
+	// UID of the owner, which tells it apart from a later object of the same name.
+	UID types.UID `json:"uid" description:"UID of the owner"`
 }
 
 type ConditionStatus string
@@ -407,6 +614,121 @@ const (
 	ConditionUnknown ConditionStatus = "Unknown"
 )
 
+// NodeAffinity describes the constraints and preferences of a pod on the labels of
+// the nodes it is scheduled onto.
+type NodeAffinity struct {
+	// The pod only fits on the nodes matching at least one of these terms.
+	RequiredDuringScheduling []NodeSelectorTerm `json:"requiredDuringScheduling,omitempty" description:"terms of which a node must match at least one for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes matching the preferred terms with the greatest
+	// total weight, but may choose a node matching none of them.
+	PreferredDuringScheduling []PreferredSchedulingTerm `json:"preferredDuringScheduling,omitempty" description:"weighted terms which the scheduler prefers the nodes of the pod to match"`
+}
+
+// NodeSelectorTerm is matched by the nodes whose labels satisfy all of its requirements.
+type NodeSelectorTerm struct {
+	// Required. The requirements on the labels of the node.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions" description:"requirements on the labels of the node, which must all be satisfied"`
+}
+
+// NodeSelectorOperator is the operator relating the key and values of a NodeSelectorRequirement.
+type NodeSelectorOperator string
+
+const (
+	// The node has the key, with one of the values.
+	NodeSelectorOpIn NodeSelectorOperator = "In"
+	// The node does not have the key, or has it with none of the values.
+	NodeSelectorOpNotIn NodeSelectorOperator = "NotIn"
+	// The node has the key, with any value.
+	NodeSelectorOpExists NodeSelectorOperator = "Exists"
+)
+
+// NodeSelectorRequirement is a requirement on the value of a node label.
+type NodeSelectorRequirement struct {
+	// Required. The label key the requirement applies to.
+	Key string `json:"key" description:"label key the requirement applies to"`
+	// Required. How the value of the label is matched.
+	Operator NodeSelectorOperator `json:"operator" description:"how the value of the label is matched; one of In, NotIn or Exists"`
+	// The values of the label, which must be set for the In and NotIn operators,
+	// and empty for Exists.
+	Values []string `json:"values,omitempty" description:"values of the label; required for In and NotIn, empty for Exists"`
+}
+
+// PreferredSchedulingTerm is a node selector term with the weight it adds to the
+// nodes which match it.
+type PreferredSchedulingTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight" description:"weight added to the nodes matching the term, from 1 to 100"`
+	// Required. The term the node should match.
+	Preference NodeSelectorTerm `json:"preference" description:"term the node should match"`
+}
+
+// PodAffinity describes the pods which a pod should be scheduled in the same topology
+// domain as.
+type PodAffinity struct {
+	// The pod only fits on the nodes which are in the same topology domain as a pod
+	// matching each of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty" description:"terms which must each be matched by a pod in the same topology domain as the node for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes in the same topology domain as the pods matching
+	// the preferred terms with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty" description:"weighted terms which the scheduler prefers to be matched by pods in the same topology domain as the node of the pod"`
+}
+
+// PodAntiAffinity describes the pods which a pod should not be scheduled in the same
+// topology domain as.
+type PodAntiAffinity struct {
+	// The pod does not fit on the nodes which are in the same topology domain as a pod
+	// matching any of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty" description:"terms none of which may be matched by a pod in the same topology domain as the node for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes away from the pods matching the preferred terms
+	// with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty" description:"weighted terms which the scheduler prefers not to be matched by pods in the same topology domain as the node of the pod"`
+}
+
+// PodAffinityTerm selects a set of pods, and the nodes which are in the same topology
+// domain as them.
+type PodAffinityTerm struct {
+	// Required. The labels of the pods the term applies to. Only the pods in the
+	// namespace of the pod being scheduled are considered.
+	LabelSelector map[string]string `json:"labelSelector" description:"selector over the labels of the pods in the namespace of the pod"`
+	// The key of the node label defining the topology domains: the nodes which have the
+	// same value for this label are in the same domain. If empty, each node is its own
+	// domain.
+	TopologyKey string `json:"topologyKey,omitempty" description:"node label whose value defines the topology domain of a node; if empty, each node is its own domain"`
+}
+
+// WeightedPodAffinityTerm is a pod affinity term with the weight it adds to the nodes
+// which satisfy it.
+type WeightedPodAffinityTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight" description:"weight of the term, from 1 to 100"`
+	// Required. The term the node should satisfy.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm" description:"term the node should satisfy"`
+}
+
+// TolerationOperator is the operator a toleration uses to match the value of a taint.
+type TolerationOperator string
+
+const (
+	// The toleration matches a taint with an equal value.
+	TolerationOpEqual TolerationOperator = "Equal"
+	// The toleration matches a taint with any value.
+	TolerationOpExists TolerationOperator = "Exists"
+)
+
+// Toleration lets a pod be scheduled onto, and keep running on, a node whose taints
+// it matches.
+type Toleration struct {
+	// Required. The key of the taints the toleration matches.
+	Key string `json:"key" description:"taint key the toleration applies to"`
+	// Optional. How the value of a taint is matched; empty means Equal.
+	Operator TolerationOperator `json:"operator,omitempty" description:"how the taint value is matched; one of Equal or Exists; empty means Equal"`
+	// The value of the taints the toleration matches, if the operator is Equal.
+	Value string `json:"value,omitempty" description:"taint value the toleration matches when the operator is Equal"`
+	// Optional. The effect of the taints the toleration matches; all effects are
+	// matched when empty.
+	Effect TaintEffect `json:"effect,omitempty" description:"taint effect the toleration matches; empty matches all effects"`
+}
+
 // PodStatus represents a status of a pod.
 //
 // https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/pod-states.md
@@ -523,11 +845,16 @@ type PodState struct {
 	Host    string `json:"host,omitempty" description:"host to which the pod is assigned; empty if not yet scheduled; cannot be updated"`
 	HostIP  string `json:"hostIP,omitempty" description:"IP address of the host to which the pod is assigned; empty if not yet scheduled"`
 	PodIP   string `json:"podIP,omitempty" description:"IP address allocated to the pod; routable at least within the cluster; empty if not yet allocated"`
+	// NominatedHost is the node the scheduler made room on for the pod, by preempting pods
+	// with a lower priority. The pod may still be scheduled onto another node.
+	NominatedHost string `json:"nominatedHost,omitempty" description:"node the pod is expected to be scheduled onto once the pods it preempted there have terminated; empty if it preempted no pod"`
 
 	// The key of this map is the *name* of the container within the manifest; it has one
 	// entry per container in the manifest. The value of this map is ContainerStatus for
 	// the container.
 	Info PodInfo `json:"info,omitempty" description:"map of container name to container status"`
+	// InitContainerInfo holds the status of the init containers of the pod, keyed by name.
+	InitContainerInfo PodInfo `json:"initContainerInfo,omitempty" description:"map of init container name to container status"`
 }
 
 type PodStatusResult struct {
@@ -566,6 +893,240 @@ type ReplicationControllerList struct {
 	Items    []ReplicationController `json:"items" description:"list of replication controllers"`
 }
 
+// JobSpec describes how a job execution will look.
+type JobSpec struct {
+	Parallelism int               `json:"parallelism,omitempty" description:"maximum number of pods the job should run at any given time; defaults to 1"`
+	Completions int               `json:"completions,omitempty" description:"number of successfully terminated pods required to complete the job; defaults to 1"`
+	RetryLimit  int               `json:"retryLimit,omitempty" description:"number of failed pods and container restarts tolerated before the job is marked as failed"`
+	Selector    map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this job"`
+	Template    *PodTemplate      `json:"template,omitempty" description:"template for pods to be created when executing the job"`
+}
+
+// JobPhase is a label for the condition of a job at the current time.
+type JobPhase string
+
+// These are the valid phases of a job.
+const (
+	// JobRunning means the job still has completions outstanding.
+	JobRunning JobPhase = "Running"
+	// JobComplete means the job has reached its desired number of completions.
+	JobComplete JobPhase = "Complete"
+	// JobFailed means the job failed more often than its retry limit allows.
+	JobFailed JobPhase = "Failed"
+)
+
+// JobStatus represents the current state of a job.
+type JobStatus struct {
+	Phase     JobPhase `json:"phase,omitempty" description:"current lifecycle phase of the job"`
+	Active    int      `json:"active,omitempty" description:"number of actively running pods"`
+	Succeeded int      `json:"succeeded,omitempty" description:"number of pods which terminated successfully"`
+	Failed    int      `json:"failed,omitempty" description:"number of pods which terminated with a failure plus container restarts of the job's pods"`
+}
+
+// Job represents the configuration of a run-to-completion workload.
+type Job struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize jobs"`
+
+	// Spec defines the desired behavior of this job.
+	Spec JobSpec `json:"spec,omitempty" description:"specification of the desired behavior of the job"`
+
+	// Status is the current status of this job.
+	Status JobStatus `json:"status,omitempty" description:"most recently observed status of the job; populated by the system, read-only"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline"`
+	Items    []Job `json:"items" description:"list of jobs"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this daemon set"`
+	Template *PodTemplate      `json:"template,omitempty" description:"template for the pod that will be created on every node that matches the template's node selector"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	CurrentNumberScheduled int `json:"currentNumberScheduled" description:"number of nodes that are running a daemon pod and are supposed to run it"`
+	NumberMisscheduled     int `json:"numberMisscheduled" description:"number of nodes that are running a daemon pod but are not supposed to run it"`
+	DesiredNumberScheduled int `json:"desiredNumberScheduled" description:"number of nodes that should be running a daemon pod"`
+}
+
+// DaemonSet represents the configuration of a daemon set, which runs a copy of a
+// pod on every matching node.
+type DaemonSet struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize daemon sets"`
+
+	// Spec defines the desired behavior of this daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty" description:"specification of the desired behavior of the daemon set"`
+
+	// Status is the current status of this daemon set.
+	Status DaemonSetStatus `json:"status,omitempty" description:"most recently observed status of the daemon set; populated by the system, read-only"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline"`
+	Items    []DaemonSet `json:"items" description:"list of daemon sets"`
+}
+
+// DeploymentSpec is the specification of a deployment.
+type DeploymentSpec struct {
+	Replicas             int                `json:"replicas" description:"number of desired pods"`
+	Selector             map[string]string  `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this deployment"`
+	Template             *PodTemplate       `json:"template,omitempty" description:"template for the pods that will be created; every distinct template is rolled out through its own replication controller"`
+	Strategy             DeploymentStrategy `json:"strategy,omitempty" description:"how existing pods are replaced by new ones"`
+	RevisionHistoryLimit int                `json:"revisionHistoryLimit,omitempty" description:"number of old replication controllers to retain for rollback; zero retains all of them"`
+	RollbackTo           *RollbackConfig    `json:"rollbackTo,omitempty" description:"earlier revision whose template the deployment should return to; cleared once the rollback has been applied"`
+}
+
+// RollbackConfig names the revision a deployment should be rolled back to.
+type RollbackConfig struct {
+	Revision int64 `json:"revision,omitempty" description:"revision to roll back to; zero means the revision preceding the current one"`
+}
+
+// DeploymentStrategyType is the kind of strategy used to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	Type          DeploymentStrategyType   `json:"type,omitempty" description:"type of the strategy; Recreate or RollingUpdate"`
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty" description:"parameters of a RollingUpdate strategy"`
+}
+
+// RollingUpdateDeployment controls the pace of a rolling update.
+type RollingUpdateDeployment struct {
+	MaxUnavailable util.IntOrString `json:"maxUnavailable,omitempty" description:"number of pods, or percentage of desired pods, that may be unavailable during the update"`
+	MaxSurge       util.IntOrString `json:"maxSurge,omitempty" description:"number of pods, or percentage of desired pods, that may be created above the desired number of pods during the update"`
+}
+
+// DeploymentStatus represents the current status of a deployment.
+type DeploymentStatus struct {
+	Replicas            int `json:"replicas" description:"total number of pods targeted by the deployment"`
+	UpdatedReplicas     int `json:"updatedReplicas" description:"number of pods running the current template"`
+	AvailableReplicas   int `json:"availableReplicas" description:"number of pods that are running and ready"`
+	UnavailableReplicas int `json:"unavailableReplicas" description:"number of pods that exist but are not yet available"`
+}
+
+// Deployment represents the desired state of a set of pods that is rolled out
+// by the server through replication controllers.
+type Deployment struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize deployments"`
+
+	// Spec defines the desired behavior of this deployment.
+	Spec DeploymentSpec `json:"spec,omitempty" description:"specification of the desired behavior of the deployment"`
+
+	// Status is the current status of this deployment.
+	Status DeploymentStatus `json:"status,omitempty" description:"most recently observed status of the deployment; populated by the system, read-only"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline"`
+	Items    []Deployment `json:"items" description:"list of deployments"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	ReplicationController string `json:"replicationController" description:"name of the replication controller, in the same namespace, whose replica count is managed by the autoscaler"`
+	MinReplicas           int    `json:"minReplicas,omitempty" description:"lower limit for the number of replicas; defaults to 1"`
+	MaxReplicas           int    `json:"maxReplicas" description:"upper limit for the number of replicas"`
+	TargetCPUUtilization  int    `json:"targetCPUUtilization,omitempty" description:"target average CPU usage of the pods, as a percentage of the CPU request of their containers; defaults to 80"`
+}
+
+// HorizontalPodAutoscalerStatus represents the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	CurrentReplicas       int        `json:"currentReplicas" description:"number of replicas last observed by the autoscaler"`
+	DesiredReplicas       int        `json:"desiredReplicas" description:"number of replicas last computed by the autoscaler"`
+	CurrentCPUUtilization *int       `json:"currentCPUUtilization,omitempty" description:"last observed average CPU usage of the pods, as a percentage of the CPU request of their containers; unset if no usage could be collected"`
+	LastScaleTime         *util.Time `json:"lastScaleTime,omitempty" description:"last time the autoscaler changed the number of replicas"`
+}
+
+// HorizontalPodAutoscaler scales the number of replicas of a replication
+// controller to keep the CPU usage of its pods close to a target.
+type HorizontalPodAutoscaler struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize horizontal pod autoscalers"`
+
+	// Spec defines the desired behavior of this autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty" description:"specification of the desired behavior of the autoscaler"`
+
+	// Status is the current status of this autoscaler.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty" description:"most recently observed status of the autoscaler; populated by the system, read-only"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline"`
+	Items    []HorizontalPodAutoscaler `json:"items" description:"list of horizontal pod autoscalers"`
+}
+
+// IngressSpec describes how requests received by the router are routed to services.
+type IngressSpec struct {
+	Backend *IngressBackend `json:"backend,omitempty" description:"service port receiving the requests which match no rule; such requests are refused if not set"`
+	TLS     []IngressTLS    `json:"tls,omitempty" description:"certificates served for the hosts of the rules; requests for other hosts are only served over plain HTTP"`
+	Rules   []IngressRule   `json:"rules,omitempty" description:"rules routing requests to services by host name and URL path"`
+}
+
+// IngressTLS names the secret holding the certificate served for a set of hosts.
+type IngressTLS struct {
+	Hosts      []string `json:"hosts,omitempty" description:"host names the certificate is served for"`
+	SecretName string   `json:"secretName" description:"name of the secret, in the same namespace, holding the certificate and private key under the keys tls.crt and tls.key"`
+}
+
+// IngressRule routes the requests for a host name by URL path.
+type IngressRule struct {
+	Host  string        `json:"host,omitempty" description:"fully qualified domain name matched against the host of requests; a rule without a host matches every host which has no rule of its own"`
+	Paths []IngressPath `json:"paths" description:"URL paths mapped to backends; the longest path matching a request wins"`
+}
+
+// IngressPath routes the requests under a URL path to a backend.
+type IngressPath struct {
+	Path    string         `json:"path,omitempty" description:"path matched against the leading segments of the URL path of requests; an empty path matches every request"`
+	Backend IngressBackend `json:"backend" description:"service port receiving the matching requests"`
+}
+
+// IngressBackend names a port of a service.
+type IngressBackend struct {
+	ServiceName string           `json:"serviceName" description:"name of a service in the same namespace as the ingress"`
+	ServicePort util.IntOrString `json:"servicePort" description:"port number or name of a port of the service"`
+}
+
+// IngressStatus represents the current status of an ingress.
+type IngressStatus struct {
+	Address string `json:"address,omitempty" description:"address at which the router serves the ingress"`
+}
+
+// Ingress is a collection of rules routing HTTP requests to services by host
+// name and URL path.
+type Ingress struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize ingresses"`
+
+	// Spec defines the rules of this ingress.
+	Spec IngressSpec `json:"spec,omitempty" description:"rules of the ingress"`
+
+	// Status is the current status of this ingress.
+	Status IngressStatus `json:"status,omitempty" description:"most recently observed status of the ingress; populated by the system, read-only"`
+}
+
+// IngressList is a collection of ingresses.
+type IngressList struct {
+	TypeMeta `json:",inline"`
+	Items    []Ingress `json:"items" description:"list of ingresses"`
+}
+
 // ReplicationController represents the configuration of a replication controller.
 //
 // https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/replication-controller.md
@@ -586,6 +1147,24 @@ type PodTemplate struct {
 	Annotations  map[string]string `json:"annotations,omitempty" description:"map of string keys and values that can be used by external tooling to store and retrieve arbitrary metadata about pods created from the template"`
 }
 
+// ServiceType describes how a service is exposed.
+type ServiceType string
+
+const (
+	// ServiceTypeClusterIP means a service will only be accessible inside the
+	// cluster, via the portal IP.
+	ServiceTypeClusterIP ServiceType = "ClusterIP"
+
+	// ServiceTypeNodePort means a service will be exposed on one port of
+	// every node, in addition to 'ClusterIP' type.
+	ServiceTypeNodePort ServiceType = "NodePort"
+
+	// ServiceTypeLoadBalancer means a service will be exposed via an
+	// external load balancer (if the cloud provider supports it), in addition
+	// to 'NodePort' type.
+	ServiceTypeLoadBalancer ServiceType = "LoadBalancer"
+)
+
 // Session Affinity Type string
 type AffinityType string
 
@@ -628,7 +1207,12 @@ type Service struct {
 	// This service will route traffic to pods having labels matching this selector. If null, no endpoints will be automatically created. If empty, all pods will be selected.
 	Selector map[string]string `json:"selector" description:"label keys and values that must match in order to receive traffic for this service; if empty, all pods are selected, if not specified, endpoints must be manually specified"`
 	// An external load balancer should be set up via the cloud-provider
-	CreateExternalLoadBalancer bool `json:"createExternalLoadBalancer,omitempty" description:"set up a cloud-provider-specific load balancer on an external IP"`
+	// Deprecated: use Type instead.
+	CreateExternalLoadBalancer bool `json:"createExternalLoadBalancer,omitempty" description:"set up a cloud-provider-specific load balancer on an external IP; deprecated, use type LoadBalancer instead"`
+
+	// Optional: Type determines how the service will be exposed.  Valid
+	// options: ClusterIP, NodePort, LoadBalancer
+	Type ServiceType `json:"type,omitempty" description:"type of this service; must be ClusterIP, NodePort, or LoadBalancer; defaults to ClusterIP, or LoadBalancer if createExternalLoadBalancer is set"`
 
 	// PublicIPs are used by external load balancers, or can be set by
 	// users to handle external traffic that arrives at a node.
@@ -651,6 +1235,40 @@ type Service struct {
 
 	// Optional: Supports "ClientIP" and "None".  Used to maintain session affinity.
 	SessionAffinity AffinityType `json:"sessionAffinity,omitempty" description:"enable client IP based session affinity; must be ClientIP or None; defaults to None"`
+
+	// Optional: Ports to expose on the service.  If this field is
+	// specified, the legacy fields (Port, Protocol, ContainerPort) will
+	// be overwritten by the first member of this array.  If this field is
+	// not specified, it will be populated from the legacy fields.
+	Ports []ServicePort `json:"ports" description:"ports to be exposed on the service; if this field is specified, the legacy fields (Port, Protocol, ContainerPort) will be overwritten by the first member of this array; if this field is not specified, it will be populated from the legacy fields"`
+}
+
+// ServicePort is a single port exposed by a service.
+type ServicePort struct {
+	// Optional if only one ServicePort is defined on this service: The
+	// name of this port within the service.  This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names.  This maps to
+	// the 'Name' field in EndpointPort objects.
+	Name string `json:"name" description:"the name of this port; optional if only one port is defined"`
+
+	// Optional: The IP protocol for this port.  Supports "TCP" and "UDP",
+	// default is TCP.
+	Protocol Protocol `json:"protocol" description:"the protocol used by this port; must be UDP or TCP; TCP if unspecified"`
+
+	// Required: The port that will be exposed by this service.
+	Port int `json:"port" description:"the port number that is exposed"`
+
+	// Optional: The target port on pods selected by this service.  If this
+	// is a string, it will be looked up as a named port in the target
+	// Pod's container ports.  If this is not specified, the default value
+	// is sort of complicated:
+	//   * If the legacy ContainerPort field is set, it is used.
+	//   * If the pods declare exactly one container port, it is used.
+	//   * Otherwise, the value of Port is used.
+	ContainerPort util.IntOrString `json:"containerPort" description:"the port to access on the containers belonging to pods targeted by the service; defaults to the service port"`
+
+	// The port on each node on which this service is exposed.
+	NodePort int `json:"nodePort" description:"the port on each node on which this service is exposed when type is NodePort or LoadBalancer; usually assigned by the system; if specified, it will be allocated to the service if unused, and creation of the service will fail otherwise"`
 }
 
 // EndpointObjectReference is a reference to an object exposing the endpoint
@@ -669,6 +1287,39 @@ type Endpoints struct {
 	Endpoints []string `json:"endpoints" description:"list of endpoints corresponding to a service, of the form address:port, such as 10.10.1.1:1909"`
 	// Optional: The kubernetes object related to the entry point.
 	TargetRefs []EndpointObjectReference `json:"targetRefs,omitempty" description:"list of references to objects providing the endpoints"`
+	// The set of all endpoints is the union of all subsets.  If this field
+	// is not empty it must include all {Endpoints, TargetRefs} and the
+	// legacy fields are only populated from the first port of it.
+	Subsets []EndpointSubset `json:"subsets,omitempty" description:"sets of addresses and ports that comprise a service"`
+}
+
+// EndpointSubset is a group of addresses with a common set of ports.  The
+// expanded set of endpoints is the Cartesian product of Addresses x Ports.
+type EndpointSubset struct {
+	Addresses []EndpointAddress `json:"addresses,omitempty" description:"IP addresses which offer the related ports"`
+	Ports     []EndpointPort    `json:"ports,omitempty" description:"port numbers available on the related IP addresses"`
+}
+
+// EndpointAddress is a tuple that describes single IP address.
+type EndpointAddress struct {
+	// The IP of this endpoint.
+	IP string `json:"ip" description:"IP address of the endpoint"`
+
+	// Optional: The kubernetes object related to the entry point.
+	TargetRef *ObjectReference `json:"targetRef,omitempty" description:"reference to object providing the endpoint"`
+}
+
+// EndpointPort is a tuple that describes a single port.
+type EndpointPort struct {
+	// The name of this port (corresponds to ServicePort.Name).  Optional
+	// if only one port is defined.  Must be a DNS_LABEL.
+	Name string `json:"name,omitempty" description:"name of this port"`
+
+	// The port number.
+	Port int `json:"port" description:"port number of the endpoint"`
+
+	// The IP protocol for this port.
+	Protocol Protocol `json:"protocol,omitempty" description:"protocol for this port; must be UDP or TCP; TCP if unspecified"`
 }
 
 // EndpointsList is a list of endpoints.
@@ -677,6 +1328,30 @@ type EndpointsList struct {
 	Items    []Endpoints `json:"items" description:"list of service endpoint lists"`
 }
 
+// TaintEffect is the effect of a node taint on the pods which do not tolerate it.
+type TaintEffect string
+
+const (
+	// Do not schedule new pods onto the node unless they tolerate the taint.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// Like TaintEffectNoSchedule, but the scheduler only tries to avoid the node
+	// instead of prohibiting it.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	// Do not schedule new pods onto the node, and evict the pods already running
+	// on it, unless they tolerate the taint.
+	TaintEffectNoExecute TaintEffect = "NoExecute"
+)
+
+// Taint is attached to a node and repels the pods which do not tolerate it.
+type Taint struct {
+	// Required. The key of the taint.
+	Key string `json:"key" description:"taint key; must be a valid label key"`
+	// The value of the taint.
+	Value string `json:"value,omitempty" description:"taint value; must be a valid label value"`
+	// Required. The effect of the taint on the pods which do not tolerate it.
+	Effect TaintEffect `json:"effect" description:"effect of the taint on the pods which do not tolerate it; one of NoSchedule, PreferNoSchedule or NoExecute"`
+}
+
 // NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
 type NodeSystemInfo struct {
 	// MachineID is the machine-id reported by the node
@@ -781,6 +1456,8 @@ const (
 	ResourceCPU ResourceName = "cpu"
 	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
 	ResourceMemory ResourceName = "memory"
+	// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+	ResourceStorage ResourceName = "storage"
 )
 
 type ResourceList map[ResourceName]util.IntOrString
@@ -800,6 +1477,8 @@ type Minion struct {
 	PodCIDR string `json:"podCIDR,omitempty" description:"IP range assigned to the node"`
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
 	Unschedulable bool `json:"unschedulable,omitempty" description:"disable pod scheduling on the node"`
+	// Taints repel the pods which do not tolerate them.
+	Taints []Taint `json:"taints,omitempty" description:"list of taints of the node, which repel the pods that do not tolerate them"`
 	// Status describes the current status of a node
 	Status NodeStatus `json:"status,omitempty" description:"current status of node"`
 	// Labels for the node
@@ -875,6 +1554,15 @@ type Binding struct {
 	Host     string `json:"host" description:"host to which to bind the specified pod"`
 }
 
+// Eviction is posted to the eviction subresource of a pod to delete it, unless that
+// would leave fewer healthy pods than one of its disruption budgets allows.
+type Eviction struct {
+	TypeMeta `json:",inline"`
+
+	// DeleteOptions may be provided to delete the pod with a specific grace period.
+	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty" description:"options of the deletion of the pod"`
+}
+
 // DeleteOptions may be provided when deleting an API object
 type DeleteOptions struct {
 	TypeMeta `json:",inline"`
@@ -883,6 +1571,11 @@ type DeleteOptions struct {
 	// The value zero indicates delete immediately. If this value is nil, the default grace period for the
 	// specified type will be used.
 	GracePeriodSeconds *int64 `json:"gracePeriodSeconds" description:"the duration in seconds to wait before deleting this object; defaults to a per object value if not specified; zero means delete immediately"`
+
+	// Should the dependents of the object be orphaned. If true, the object is kept with the
+	// "orphan" finalizer until the garbage collector has removed it from the owner references
+	// of its dependents; otherwise the garbage collector deletes its dependents.
+	OrphanDependents *bool `json:"orphanDependents,omitempty" description:"whether the dependents of the object are orphaned instead of deleted by the garbage collector"`
 }
 
 // Status is a return value for calls that don't return other objects.
@@ -1129,15 +1822,40 @@ type ContainerManifest struct {
 	// with the API refactory. It is required for now to determine the instance
 	// of a Pod.
 	UUID          types.UID     `json:"uuid,omitempty" description:"manifest UUID; cannot be updated"`
-	Volumes       []Volume      `json:"volumes" description:"list of volumes that can be mounted by containers belonging to the pod"`
-	Containers    []Container   `json:"containers" description:"list of containers belonging to the pod; cannot be updated; containers cannot currently be added or removed"`
+	Volumes       []Volume      `json:"volumes" patchStrategy:"merge" patchMergeKey:"name" description:"list of volumes that can be mounted by containers belonging to the pod"`
+	Containers    []Container   `json:"containers" patchStrategy:"merge" patchMergeKey:"name" description:"list of containers belonging to the pod; cannot be updated; containers cannot currently be added or removed"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" description:"restart policy for all containers within the pod; one of RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever"`
+	// List of initialization containers, which are run one at a time, in order, and must
+	// each terminate successfully before the containers of the pod are started. If one of
+	// them fails, it is restarted according to the RestartPolicy of the pod.
+	InitContainers []Container `json:"initContainers,omitempty" patchStrategy:"merge" patchMergeKey:"name" description:"list of initialization containers belonging to the pod, run one at a time to successful completion before the containers are started; cannot be updated"`
+	// Tolerations let the pod be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty" description:"list of tolerations of the pod, which let it be scheduled onto and keep running on nodes with matching taints"`
+	// NodeAffinity is a set of constraints and preferences on the labels of the node
+	// the pod is scheduled onto, beyond the exact matches of NodeSelector.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty" description:"required and preferred terms on the labels of the node the pod is scheduled onto"`
+	// PodAffinity is a set of pods which the pod should be scheduled near to.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty" description:"pods which the pod should be scheduled in the same topology domain as"`
+	// PodAntiAffinity is a set of pods which the pod should be scheduled away from.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty" description:"pods which the pod should not be scheduled in the same topology domain as"`
+	// PriorityClassName is the name of the PriorityClass of the pod. If empty, the
+	// global default class is used, if any.
+	PriorityClassName string `json:"priorityClassName,omitempty" description:"name of the PriorityClass of the pod; if empty, the global default class is used, if any"`
+	// Priority is resolved from the PriorityClass of the pod on creation, and cannot be
+	// set directly. Pods without a priority have a priority of zero.
+	Priority *int `json:"priority,omitempty" description:"priority of the pod, resolved from its PriorityClass on creation; read-only"`
 	// Optional: Set DNS policy.  Defaults to "ClusterFirst"
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
 	// Uses the host's network namespace. If this option is set, the ports that will be
 	// used must be specified.
 	// Optional: Default to false.
 	HostNetwork bool `json:"hostNetwork,omitempty" description:"host networking requested for this pod"`
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod
+	ServiceAccount string `json:"serviceAccount,omitempty" description:"name of the ServiceAccount to use to run this pod"`
+	// Optional duration in seconds the pod needs to terminate gracefully. When the pod is
+	// deleted, its containers are sent a termination signal, and forcibly killed once this
+	// period has elapsed. Zero means the containers are killed immediately.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" description:"optional duration in seconds the pod needs to terminate gracefully; containers are sent a termination signal, then forcibly killed once the period has elapsed; zero means kill immediately; defaults to 30 seconds"`
 }
 
 // ContainerManifestList is used to communicate container manifests to kubelet.
@@ -1165,10 +1883,29 @@ const (
 
 // PodSpec is a description of a pod
 type PodSpec struct {
-	Volumes []Volume `json:"volumes" description:"list of volumes that can be mounted by containers belonging to the pod"`
+	Volumes []Volume `json:"volumes" patchStrategy:"merge" patchMergeKey:"name" description:"list of volumes that can be mounted by containers belonging to the pod"`
 	// Required: there must be at least one container in a pod.
-	Containers    []Container   `json:"containers" description:"list of containers belonging to the pod; containers cannot currently be added or removed; there must be at least one container in a Pod"`
+	Containers    []Container   `json:"containers" patchStrategy:"merge" patchMergeKey:"name" description:"list of containers belonging to the pod; containers cannot currently be added or removed; there must be at least one container in a Pod"`
 	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" description:"restart policy for all containers within the pod; one of RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever"`
+	// List of initialization containers, which are run one at a time, in order, and must
+	// each terminate successfully before the containers of the pod are started. If one of
+	// them fails, it is restarted according to the RestartPolicy of the pod.
+	InitContainers []Container `json:"initContainers,omitempty" patchStrategy:"merge" patchMergeKey:"name" description:"list of initialization containers belonging to the pod, run one at a time to successful completion before the containers are started; cannot be updated"`
+	// Tolerations let the pod be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty" description:"list of tolerations of the pod, which let it be scheduled onto and keep running on nodes with matching taints"`
+	// NodeAffinity is a set of constraints and preferences on the labels of the node
+	// the pod is scheduled onto, beyond the exact matches of NodeSelector.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty" description:"required and preferred terms on the labels of the node the pod is scheduled onto"`
+	// PodAffinity is a set of pods which the pod should be scheduled near to.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty" description:"pods which the pod should be scheduled in the same topology domain as"`
+	// PodAntiAffinity is a set of pods which the pod should be scheduled away from.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty" description:"pods which the pod should not be scheduled in the same topology domain as"`
+	// PriorityClassName is the name of the PriorityClass of the pod. If empty, the
+	// global default class is used, if any.
+	PriorityClassName string `json:"priorityClassName,omitempty" description:"name of the PriorityClass of the pod; if empty, the global default class is used, if any"`
+	// Priority is resolved from the PriorityClass of the pod on creation, and cannot be
+	// set directly. Pods without a priority have a priority of zero.
+	Priority *int `json:"priority,omitempty" description:"priority of the pod, resolved from its PriorityClass on creation; read-only"`
 	// Optional: Set DNS policy.  Defaults to "ClusterFirst"
 	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
@@ -1182,6 +1919,12 @@ type PodSpec struct {
 	// used must be specified.
 	// Optional: Default to false.
 	HostNetwork bool `json:"hostNetwork,omitempty" description:"host networking requested for this pod"`
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod
+	ServiceAccount string `json:"serviceAccount,omitempty" description:"name of the ServiceAccount to use to run this pod"`
+	// Optional duration in seconds the pod needs to terminate gracefully. When the pod is
+	// deleted, its containers are sent a termination signal, and forcibly killed once this
+	// period has elapsed. Zero means the containers are killed immediately.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" description:"optional duration in seconds the pod needs to terminate gracefully; containers are sent a termination signal, then forcibly killed once the period has elapsed; zero means kill immediately; defaults to 30 seconds"`
 }
 
 // List holds a list of objects, which may not be known by the server.
@@ -1242,6 +1985,12 @@ const (
 	ResourceReplicationControllers ResourceName = "replicationcontrollers"
 	// ResourceQuotas, number
 	ResourceQuotas ResourceName = "resourcequotas"
+	// ResourceLimitsCPU, the CPU limits of the containers of pods, in cores.
+	// Quota on ResourceCPU applies to their CPU requests.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory, the memory limits of the containers of pods, in bytes.
+	// Quota on ResourceMemory applies to their memory requests.
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
@@ -1312,6 +2061,21 @@ type SecretType string
 
 const (
 	SecretTypeOpaque SecretType = "Opaque" // Default; arbitrary user-defined data
+
+	// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+	//
+	// Required fields:
+	// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+	// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+	// - Secret.Data["token"] - a token that identifies the service account to the API
+	SecretTypeServiceAccountToken SecretType = "kubernetes.io/service-account-token"
+
+	// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountNameKey = "kubernetes.io/service-account.name"
+	// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountUIDKey = "kubernetes.io/service-account.uid"
+	// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+	ServiceAccountTokenKey = "token"
 )
 
 type SecretList struct {
@@ -1319,3 +2083,156 @@ type SecretList struct {
 
 	Items []Secret `json:"items" description:"items is a list of secret objects"`
 }
+
+// ServiceAccount binds together a name, understood by users and perhaps by peripheral
+// systems, with a principal that can be authenticated and a set of secrets.
+type ServiceAccount struct {
+	TypeMeta `json:",inline"`
+
+	// Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount
+	Secrets []ObjectReference `json:"secrets" description:"list of secrets that can be used by pods running as this service account"`
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+type ServiceAccountList struct {
+	TypeMeta `json:",inline"`
+
+	Items []ServiceAccount `json:"items" description:"list of ServiceAccounts"`
+}
+
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	TypeMeta `json:",inline"`
+
+	// Data contains the configuration data. Each key must be a valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty" description:"data contains the configuration data; each key must be a valid DNS_SUBDOMAIN"`
+}
+
+// ConfigMapList is a list of ConfigMaps.
+type ConfigMapList struct {
+	TypeMeta `json:",inline"`
+
+	Items []ConfigMap `json:"items" description:"list of config maps"`
+}
+
+// PriorityClass maps a name to the priority of the pods referring to it. The pods
+// with a higher priority are scheduled first, and may preempt the pods with a lower
+// priority when no node has room for them. PriorityClasses are not namespaced.
+type PriorityClass struct {
+	TypeMeta `json:",inline"`
+
+	// Value is the priority of the pods of the class. The higher, the more important.
+	Value int `json:"value" description:"priority of the pods of the class; the higher, the more important"`
+	// GlobalDefault makes the class the default of the pods which do not name one. At
+	// most one class should be the global default.
+	GlobalDefault bool `json:"globalDefault,omitempty" description:"make the class the default of the pods which do not name one"`
+	// Description is a human readable explanation of when to use the class.
+	Description string `json:"description,omitempty" description:"human readable explanation of when to use the class"`
+}
+
+// PriorityClassList is a list of PriorityClasses.
+type PriorityClassList struct {
+	TypeMeta `json:",inline"`
+
+	Items []PriorityClass `json:"items" description:"list of priority classes"`
+}
+
+// PodDisruptionBudgetSpec is the specification of a pod disruption budget.
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number of pods selected by the budget which must still be
+	// healthy after an eviction.
+	MinAvailable int `json:"minAvailable" description:"number of pods selected by the budget which must still be healthy after an eviction"`
+
+	// Selector is a label query over the pods whose evictions are limited by the budget.
+	Selector map[string]string `json:"selector" description:"label keys and values that must match in order for the evictions of a pod to be limited by this budget"`
+}
+
+// PodDisruptionBudgetStatus represents the current status of a pod disruption budget.
+type PodDisruptionBudgetStatus struct {
+	// PodDisruptionsAllowed is the number of pods which may currently be evicted.
+	PodDisruptionsAllowed int `json:"podDisruptionsAllowed" description:"number of pods which may currently be evicted"`
+
+	// CurrentHealthy is the number of healthy pods selected by the budget.
+	CurrentHealthy int `json:"currentHealthy" description:"number of healthy pods selected by the budget"`
+
+	// DesiredHealthy is the minimum number of healthy pods desired.
+	DesiredHealthy int `json:"desiredHealthy" description:"minimum number of healthy pods desired"`
+
+	// ExpectedPods is the number of pods selected by the budget which are neither
+	// terminated nor being deleted.
+	ExpectedPods int `json:"expectedPods" description:"number of pods selected by the budget which are neither terminated nor being deleted"`
+
+	// DisruptedPods maps the names of the pods whose eviction was admitted to the time
+	// of the eviction, until the controller observes that they are being deleted. These
+	// pods are not counted as healthy in the meantime.
+	DisruptedPods map[string]util.Time `json:"disruptedPods,omitempty" description:"map of the pods whose eviction was admitted but whose deletion was not observed yet to the time of the eviction"`
+}
+
+// PodDisruptionBudget limits the number of pods of a group which may be evicted at
+// the same time, such as the members of a quorum.
+type PodDisruptionBudget struct {
+	TypeMeta `json:",inline"`
+
+	// Spec defines the pods selected by this budget, and how many of them must stay healthy.
+	Spec PodDisruptionBudgetSpec `json:"spec,omitempty" description:"specification of the pods selected by the budget, and of how many of them must stay healthy"`
+
+	// Status is the current status of this budget. This data may be out of date by some
+	// window of time.
+	Status PodDisruptionBudgetStatus `json:"status,omitempty" description:"most recently observed status of the budget; populated by the system, read-only"`
+}
+
+// PodDisruptionBudgetList is a collection of pod disruption budgets.
+type PodDisruptionBudgetList struct {
+	TypeMeta `json:",inline"`
+
+	Items []PodDisruptionBudget `json:"items" description:"list of pod disruption budgets"`
+}
+
+// ThirdPartyResource registers a kind of object which the API server stores and serves
+// without knowing its schema. Its name is made of the kind, in lower case with dashes
+// between the words, followed by the API group of the kind: "database-cluster.example.com"
+// registers the kind DatabaseCluster, served under /thirdparty/example.com/{version}.
+type ThirdPartyResource struct {
+	TypeMeta `json:",inline"`
+
+	// Description is a free-form description of the kind, for humans.
+	Description string `json:"description,omitempty" description:"free-form description of the kind"`
+
+	// Versions are the API versions the kind is served in.
+	Versions []string `json:"versions,omitempty" description:"API versions the kind is served in"`
+}
+
+// ThirdPartyResourceList is a list of ThirdPartyResources.
+type ThirdPartyResourceList struct {
+	TypeMeta `json:",inline"`
+
+	Items []ThirdPartyResource `json:"items" description:"list of third party resources"`
+}
+
+// ThirdPartyResourceData is an object of a kind registered by a ThirdPartyResource, as
+// it is stored. The content of the object other than its metadata is kept as opaque JSON.
+type ThirdPartyResourceData struct {
+	TypeMeta `json:",inline"`
+	Labels   map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize the objects"`
+
+	// Data is the JSON content of the object, without its kind, API version and metadata.
+	Data []byte `json:"data,omitempty" description:"JSON content of the object, without its kind, API version and metadata"`
+}
+
+// ThirdPartyResourceDataList is a list of ThirdPartyResourceData.
+type ThirdPartyResourceDataList struct {
+	TypeMeta `json:",inline"`
+
+	Items []ThirdPartyResourceData `json:"items" description:"list of third party resource data"`
+}
+
+// RangeAllocation is the persisted state of an allocator of a range of values, such
+// as the node ports of services.
+type RangeAllocation struct {
+	TypeMeta `json:",inline"`
+
+	// Range is the range the allocator hands out values from, such as "30000-32767".
+	Range string `json:"range" description:"range the allocator hands out values from"`
+	// Data is a bit array with a bit set for every allocated value of the range.
+	Data []byte `json:"data" description:"bit array with a bit set for every allocated value of the range"`
+}
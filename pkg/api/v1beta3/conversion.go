@@ -20,11 +20,68 @@ import (
 	"fmt"
 
 	newer "github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 )
 
 func init() {
+	err := newer.Scheme.AddConversionFuncs(
+		// CreateExternalLoadBalancer is kept for compatibility and derived
+		// from Type, which is the only field stored internally.
+		func(in *newer.ServiceSpec, out *ServiceSpec, s conversion.Scope) error {
+			if err := s.Convert(&in.Ports, &out.Ports, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Selector, &out.Selector, 0); err != nil {
+				return err
+			}
+			out.PortalIP = in.PortalIP
+			if err := s.Convert(&in.Type, &out.Type, 0); err != nil {
+				return err
+			}
+			out.CreateExternalLoadBalancer = in.Type == newer.ServiceTypeLoadBalancer
+			if err := s.Convert(&in.PublicIPs, &out.PublicIPs, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.SessionAffinity, &out.SessionAffinity, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+		func(in *ServiceSpec, out *newer.ServiceSpec, s conversion.Scope) error {
+			if err := s.Convert(&in.Ports, &out.Ports, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.Selector, &out.Selector, 0); err != nil {
+				return err
+			}
+			out.PortalIP = in.PortalIP
+			typeIn := in.Type
+			if typeIn == "" {
+				if in.CreateExternalLoadBalancer {
+					typeIn = ServiceTypeLoadBalancer
+				} else {
+					typeIn = ServiceTypeClusterIP
+				}
+			}
+			if err := s.Convert(&typeIn, &out.Type, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.PublicIPs, &out.PublicIPs, 0); err != nil {
+				return err
+			}
+			if err := s.Convert(&in.SessionAffinity, &out.SessionAffinity, 0); err != nil {
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		// If one of the conversion functions is malformed, detect it immediately.
+		panic(err)
+	}
+
 	// Add field conversion funcs.
-	err := newer.Scheme.AddFieldLabelConversionFunc("v1beta3", "pods",
+	err = newer.Scheme.AddFieldLabelConversionFunc("v1beta3", "pods",
 		func(label, value string) (string, string, error) {
 			switch label {
 			case "name",
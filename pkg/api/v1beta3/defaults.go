@@ -51,14 +51,30 @@ func init() {
 			if obj.TerminationMessagePath == "" {
 				obj.TerminationMessagePath = TerminationMessagePathDefault
 			}
+			// Requests default to Limits.
+			for name, limit := range obj.Resources.Limits {
+				if _, found := obj.Resources.Requests[name]; found {
+					continue
+				}
+				if obj.Resources.Requests == nil {
+					obj.Resources.Requests = ResourceList{}
+				}
+				obj.Resources.Requests[name] = limit
+			}
 		},
 		func(obj *Service) {
-			if obj.Spec.Protocol == "" {
-				obj.Spec.Protocol = ProtocolTCP
-			}
 			if obj.Spec.SessionAffinity == "" {
 				obj.Spec.SessionAffinity = AffinityTypeNone
 			}
+			if obj.Spec.Type == "" {
+				if obj.Spec.CreateExternalLoadBalancer {
+					obj.Spec.Type = ServiceTypeLoadBalancer
+				} else {
+					obj.Spec.Type = ServiceTypeClusterIP
+				}
+			} else if obj.Spec.Type == ServiceTypeLoadBalancer {
+				obj.Spec.CreateExternalLoadBalancer = true
+			}
 		},
 		func(obj *PodSpec) {
 			if obj.DNSPolicy == "" {
@@ -69,6 +85,7 @@ func init() {
 			}
 			if obj.HostNetwork {
 				defaultHostNetworkPorts(&obj.Containers)
+				defaultHostNetworkPorts(&obj.InitContainers)
 			}
 		},
 		func(obj *Probe) {
@@ -82,8 +99,14 @@ func init() {
 			}
 		},
 		func(obj *Endpoints) {
-			if obj.Protocol == "" {
-				obj.Protocol = "TCP"
+			for i := range obj.Subsets {
+				ss := &obj.Subsets[i]
+				for i := range ss.Ports {
+					ep := &ss.Ports[i]
+					if ep.Protocol == "" {
+						ep.Protocol = ProtocolTCP
+					}
+				}
 			}
 		},
 		func(obj *HTTPGetAction) {
@@ -91,7 +114,10 @@ func init() {
 				obj.Path = "/"
 			}
 		},
-		func(obj *ServiceSpec) {
+		func(obj *ServicePort) {
+			if obj.Protocol == "" {
+				obj.Protocol = ProtocolTCP
+			}
 			if obj.TargetPort.Kind == util.IntstrInt && obj.TargetPort.IntVal == 0 ||
 				obj.TargetPort.Kind == util.IntstrString && obj.TargetPort.StrVal == "" {
 				obj.TargetPort = util.NewIntOrStringFromInt(obj.Port)
@@ -102,6 +128,33 @@ func init() {
 				obj.Phase = NamespaceActive
 			}
 		},
+		func(obj *JobSpec) {
+			if obj.Completions == 0 {
+				obj.Completions = 1
+			}
+			if obj.Parallelism == 0 {
+				obj.Parallelism = 1
+			}
+		},
+		func(obj *DeploymentStrategy) {
+			if obj.Type == "" {
+				obj.Type = RollingUpdateDeploymentStrategyType
+			}
+			if obj.Type == RollingUpdateDeploymentStrategyType && obj.RollingUpdate == nil {
+				obj.RollingUpdate = &RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				}
+			}
+		},
+		func(obj *HorizontalPodAutoscalerSpec) {
+			if obj.MinReplicas == 0 {
+				obj.MinReplicas = 1
+			}
+			if obj.TargetCPUUtilization == 0 {
+				obj.TargetCPUUtilization = 80
+			}
+		},
 	)
 }
 
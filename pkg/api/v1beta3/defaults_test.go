@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
 	current "github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -44,14 +45,30 @@ func TestSetDefaultService(t *testing.T) {
 	svc := &current.Service{}
 	obj2 := roundTrip(t, runtime.Object(svc))
 	svc2 := obj2.(*current.Service)
-	if svc2.Spec.Protocol != current.ProtocolTCP {
-		t.Errorf("Expected default protocol :%s, got: %s", current.ProtocolTCP, svc2.Spec.Protocol)
-	}
 	if svc2.Spec.SessionAffinity != current.AffinityTypeNone {
 		t.Errorf("Expected default sesseion affinity type:%s, got: %s", current.AffinityTypeNone, svc2.Spec.SessionAffinity)
 	}
 }
 
+func TestSetDefaultServiceType(t *testing.T) {
+	out := roundTrip(t, runtime.Object(&current.Service{})).(*current.Service)
+	if out.Spec.Type != current.ServiceTypeClusterIP {
+		t.Errorf("Expected default service type %s, got %s", current.ServiceTypeClusterIP, out.Spec.Type)
+	}
+
+	in := &current.Service{Spec: current.ServiceSpec{CreateExternalLoadBalancer: true}}
+	out = roundTrip(t, runtime.Object(in)).(*current.Service)
+	if out.Spec.Type != current.ServiceTypeLoadBalancer {
+		t.Errorf("Expected service type %s, got %s", current.ServiceTypeLoadBalancer, out.Spec.Type)
+	}
+
+	in = &current.Service{Spec: current.ServiceSpec{Type: current.ServiceTypeLoadBalancer}}
+	out = roundTrip(t, runtime.Object(in)).(*current.Service)
+	if !out.Spec.CreateExternalLoadBalancer {
+		t.Errorf("Expected CreateExternalLoadBalancer to be set for type %s", out.Spec.Type)
+	}
+}
+
 func TestSetDefaultSecret(t *testing.T) {
 	s := &current.Secret{}
 	obj2 := roundTrip(t, runtime.Object(s))
@@ -63,28 +80,70 @@ func TestSetDefaultSecret(t *testing.T) {
 }
 
 func TestSetDefaulEndpointsProtocol(t *testing.T) {
-	in := &current.Endpoints{}
+	in := &current.Endpoints{Subsets: []current.EndpointSubset{
+		{Ports: []current.EndpointPort{{}, {Protocol: "UDP"}, {}}},
+	}}
 	obj := roundTrip(t, runtime.Object(in))
 	out := obj.(*current.Endpoints)
 
-	if out.Protocol != current.ProtocolTCP {
-		t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Protocol)
+	for i := range out.Subsets {
+		for j := range out.Subsets[i].Ports {
+			if in.Subsets[i].Ports[j].Protocol == "" {
+				if out.Subsets[i].Ports[j].Protocol != current.ProtocolTCP {
+					t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Subsets[i].Ports[j].Protocol)
+				}
+			} else {
+				if out.Subsets[i].Ports[j].Protocol != in.Subsets[i].Ports[j].Protocol {
+					t.Errorf("Expected protocol %s, got %s", in.Subsets[i].Ports[j].Protocol, out.Subsets[i].Ports[j].Protocol)
+				}
+			}
+		}
 	}
 }
 
 func TestSetDefaulServiceTargetPort(t *testing.T) {
-	in := &current.Service{Spec: current.ServiceSpec{Port: 1234}}
+	in := &current.Service{Spec: current.ServiceSpec{Ports: []current.ServicePort{{Port: 1234}}}}
 	obj := roundTrip(t, runtime.Object(in))
 	out := obj.(*current.Service)
-	if out.Spec.TargetPort.Kind != util.IntstrInt || out.Spec.TargetPort.IntVal != 1234 {
-		t.Errorf("Expected TargetPort to be defaulted, got %s", out.Spec.TargetPort)
+	if out.Spec.Ports[0].TargetPort.Kind != util.IntstrInt || out.Spec.Ports[0].TargetPort.IntVal != 1234 {
+		t.Errorf("Expected TargetPort to be defaulted, got %s", out.Spec.Ports[0].TargetPort)
 	}
 
-	in = &current.Service{Spec: current.ServiceSpec{Port: 1234, TargetPort: util.NewIntOrStringFromInt(5678)}}
+	in = &current.Service{Spec: current.ServiceSpec{Ports: []current.ServicePort{{Port: 1234, TargetPort: util.NewIntOrStringFromInt(5678)}}}}
 	obj = roundTrip(t, runtime.Object(in))
 	out = obj.(*current.Service)
-	if out.Spec.TargetPort.Kind != util.IntstrInt || out.Spec.TargetPort.IntVal != 5678 {
-		t.Errorf("Expected TargetPort to be unchanged, got %s", out.Spec.TargetPort)
+	if out.Spec.Ports[0].TargetPort.Kind != util.IntstrInt || out.Spec.Ports[0].TargetPort.IntVal != 5678 {
+		t.Errorf("Expected TargetPort to be unchanged, got %s", out.Spec.Ports[0].TargetPort)
+	}
+}
+
+func TestSetDefaultServicePort(t *testing.T) {
+	// Unchanged if set.
+	in := &current.Service{Spec: current.ServiceSpec{
+		Ports: []current.ServicePort{
+			{Protocol: "UDP", Port: 9376, TargetPort: util.NewIntOrStringFromString("p")},
+			{Protocol: "UDP", Port: 8675, TargetPort: util.NewIntOrStringFromInt(309)},
+		},
+	}}
+	out := roundTrip(t, runtime.Object(in)).(*current.Service)
+	if out.Spec.Ports[0].Protocol != current.ProtocolUDP {
+		t.Errorf("Expected protocol %s, got %s", current.ProtocolUDP, out.Spec.Ports[0].Protocol)
+	}
+	if out.Spec.Ports[0].TargetPort != in.Spec.Ports[0].TargetPort {
+		t.Errorf("Expected port %v, got %v", in.Spec.Ports[0].TargetPort, out.Spec.Ports[0].TargetPort)
+	}
+	if out.Spec.Ports[1].TargetPort != in.Spec.Ports[1].TargetPort {
+		t.Errorf("Expected port %v, got %v", in.Spec.Ports[1].TargetPort, out.Spec.Ports[1].TargetPort)
+	}
+
+	// Defaulted.
+	in = &current.Service{Spec: current.ServiceSpec{Ports: []current.ServicePort{{Port: 8080}}}}
+	out = roundTrip(t, runtime.Object(in)).(*current.Service)
+	if out.Spec.Ports[0].Protocol != current.ProtocolTCP {
+		t.Errorf("Expected protocol %s, got %s", current.ProtocolTCP, out.Spec.Ports[0].Protocol)
+	}
+	if out.Spec.Ports[0].TargetPort != util.NewIntOrStringFromInt(8080) {
+		t.Errorf("Expected port %v, got %v", 8080, out.Spec.Ports[0].TargetPort)
 	}
 }
 
@@ -123,3 +182,32 @@ func TestSetDefaultPodSpecHostNetwork(t *testing.T) {
 		t.Errorf("Expected container port to be defaulted, was made %d instead of %d", hostPortNum, portNum)
 	}
 }
+
+func TestSetDefaultContainerResourceRequests(t *testing.T) {
+	pod := &current.Pod{
+		Spec: current.PodSpec{
+			Containers: []current.Container{
+				{
+					Resources: current.ResourceRequirements{
+						Limits: current.ResourceList{
+							current.ResourceCPU:    resource.MustParse("1"),
+							current.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Requests: current.ResourceList{
+							current.ResourceCPU: resource.MustParse("250m"),
+						},
+					},
+				},
+			},
+		},
+	}
+	obj2 := roundTrip(t, runtime.Object(pod))
+	requests := obj2.(*current.Pod).Spec.Containers[0].Resources.Requests
+
+	if cpu := requests[current.ResourceCPU]; cpu.String() != "250m" {
+		t.Errorf("Expected the cpu request to be kept, got %s", cpu.String())
+	}
+	if memory := requests[current.ResourceMemory]; memory.String() != "1Gi" {
+		t.Errorf("Expected the memory request to be defaulted to the limit, got %s", memory.String())
+	}
+}
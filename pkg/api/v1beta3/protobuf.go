@@ -0,0 +1,141 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta3
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// The types of other packages the v1beta3 types hold are encoded in protobuf by the
+// helpers below, which protobuf_generated.go calls. They keep the precision of the JSON
+// encoding: times are encoded in seconds and quantities in their canonical form.
+
+// appendProtobufTime appends the time as a message holding its Unix time in seconds in
+// field 1, which is empty for the zero time.
+func appendProtobufTime(buf []byte, number uint64, in *util.Time, always bool) []byte {
+	start := len(buf)
+	buf = protobuf.StartMessage(buf, number)
+	if !in.IsZero() {
+		buf = protobuf.AppendInt(buf, 1, in.Unix())
+	}
+	return protobuf.EndMessage(buf, start, !always)
+}
+
+func unmarshalProtobufTime(value protobuf.Value, out *util.Time) error {
+	data, err := value.Message()
+	if err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		if number == 1 {
+			var sec int64
+			if err := value.DecodeInt64(&sec); err != nil {
+				return err
+			}
+			*out = util.Unix(sec, 0)
+		}
+	}
+	return nil
+}
+
+// appendProtobufQuantity appends the canonical form of the quantity. A quantity without
+// an amount is omitted unless always is set.
+func appendProtobufQuantity(buf []byte, number uint64, in *resource.Quantity, always bool) []byte {
+	if in.Amount == nil && !always {
+		return buf
+	}
+	return protobuf.AppendString(buf, number, in.String())
+}
+
+func unmarshalProtobufQuantity(value protobuf.Value, out *resource.Quantity) error {
+	var s string
+	if err := value.DecodeString(&s); err != nil || len(s) == 0 {
+		// an absent quantity is left unset
+		return err
+	}
+	parsed, err := resource.ParseQuantity(s)
+	if err != nil {
+		return err
+	}
+	*out = *parsed
+	return nil
+}
+
+// appendProtobufIntOrString appends the IntOrString as a message holding its Kind in
+// field 1, IntVal in field 2 and StrVal in field 3.
+func appendProtobufIntOrString(buf []byte, number uint64, in *util.IntOrString, always bool) []byte {
+	start := len(buf)
+	buf = protobuf.StartMessage(buf, number)
+	if in.Kind != 0 {
+		buf = protobuf.AppendInt(buf, 1, int64(in.Kind))
+	}
+	if in.IntVal != 0 {
+		buf = protobuf.AppendInt(buf, 2, int64(in.IntVal))
+	}
+	if len(in.StrVal) != 0 {
+		buf = protobuf.AppendString(buf, 3, in.StrVal)
+	}
+	return protobuf.EndMessage(buf, start, !always)
+}
+
+func unmarshalProtobufIntOrString(value protobuf.Value, out *util.IntOrString) error {
+	data, err := value.Message()
+	if err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeInt((*int)(&out.Kind))
+		case 2:
+			err = value.DecodeInt(&out.IntVal)
+		case 3:
+			err = value.DecodeString(&out.StrVal)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to decode field %d of IntOrString: %v", number, err)
+		}
+	}
+	return nil
+}
+
+// appendProtobufRawExtension appends the JSON encoding of the object the extension holds.
+func appendProtobufRawExtension(buf []byte, number uint64, in *runtime.RawExtension, always bool) []byte {
+	if len(in.RawJSON) == 0 && !always {
+		return buf
+	}
+	return protobuf.AppendBytes(buf, number, in.RawJSON)
+}
+
+func unmarshalProtobufRawExtension(value protobuf.Value, out *runtime.RawExtension) error {
+	return value.DecodeBytes(&out.RawJSON)
+}
@@ -52,25 +52,29 @@ import (
 type TypeMeta struct {
 	// Kind is a string value representing the REST resource this object represents.
 	// Servers may infer this from the endpoint the client submits requests to.
-	Kind string `json:"kind,omitempty" description:"kind of object, in CamelCase; cannot be updated"`
+	Kind string `json:"kind,omitempty" protobuf:"1" description:"kind of object, in CamelCase; cannot be updated"`
 
 	// APIVersion defines the versioned schema of this representation of an object.
 	// Servers should convert recognized schemas to the latest internal value, and
 	// may reject unrecognized values.
-	APIVersion string `json:"apiVersion,omitempty" description:"version of the schema the object should have"`
+	APIVersion string `json:"apiVersion,omitempty" protobuf:"2" description:"version of the schema the object should have"`
 }
 
 // ListMeta describes metadata that synthetic resources must have, including lists and
 // various status objects.
 type ListMeta struct {
 	// SelfLink is a URL representing this object.
-	SelfLink string `json:"selfLink,omitempty" description:"URL for the object; populated by the system, read-only"`
+	SelfLink string `json:"selfLink,omitempty" protobuf:"1" description:"URL for the object; populated by the system, read-only"`
 
 	// An opaque value that represents the version of this response for use with optimistic
 	// concurrency and change monitoring endpoints.  Clients must treat these values as opaque
 	// and values may only be valid for a particular resource or set of resources. Only servers
 	// will generate resource versions.
-	ResourceVersion string `json:"resourceVersion,omitempty" description:"string that identifies the internal version of this object that can be used by clients to determine when objects have changed; populated by the system, read-only; value must be treated as opaque by clients and passed unmodified back to the server: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"2" description:"string that identifies the internal version of this object that can be used by clients to determine when objects have changed; populated by the system, read-only; value must be treated as opaque by clients and passed unmodified back to the server: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
+
+	// Continue is set when the list was limited by the client and more items remain. The
+	// client lists the next items by passing this opaque value in the continue parameter.
+	Continue string `json:"continue,omitempty" protobuf:"3" description:"opaque value set when the list was limited by the client and more items remain; passed by the client in the continue parameter to list the next items"`
 }
 
 // ObjectMeta is metadata that all persisted resources must have, which includes all objects
@@ -80,7 +84,7 @@ type ObjectMeta struct {
 	// some resources may allow a client to request the generation of an appropriate name
 	// automatically. Name is primarily intended for creation idempotence and configuration
 	// definition.
-	Name string `json:"name,omitempty" description:"string that identifies an object. Must be unique within a namespace; cannot be updated"`
+	Name string `json:"name,omitempty" protobuf:"1" description:"string that identifies an object. Must be unique within a namespace; cannot be updated"`
 
 	// GenerateName indicates that the name should be made unique by the server prior to persisting
 	// it. A non-empty value for the field indicates the name will be made unique (and the name
@@ -93,32 +97,32 @@ type ObjectMeta struct {
 	// generated name exists - instead, it will either return 201 Created or 500 with Reason
 	// ServerTimeout indicating a unique name could not be found in the time allotted, and the client
 	// should retry (optionally after the time indicated in the Retry-After header).
-	GenerateName string `json:"generateName,omitempty" description:"an optional prefix to use to generate a unique name; has the same validation rules as name; optional, and is applied only name if is not specified"`
+	GenerateName string `json:"generateName,omitempty" protobuf:"2" description:"an optional prefix to use to generate a unique name; has the same validation rules as name; optional, and is applied only name if is not specified"`
 
 	// Namespace defines the space within which name must be unique. An empty namespace is
 	// equivalent to the "default" namespace, but "default" is the canonical representation.
 	// Not all objects are required to be scoped to a namespace - the value of this field for
 	// those objects will be empty.
-	Namespace string `json:"namespace,omitempty" description:"namespace of the object; cannot be updated"`
+	Namespace string `json:"namespace,omitempty" protobuf:"3" description:"namespace of the object; cannot be updated"`
 
 	// SelfLink is a URL representing this object.
-	SelfLink string `json:"selfLink,omitempty" description:"URL for the object; populated by the system, read-only"`
+	SelfLink string `json:"selfLink,omitempty" protobuf:"4" description:"URL for the object; populated by the system, read-only"`
 
 	// UID is the unique in time and space value for this object. It is typically generated by
 	// the server on successful creation of a resource and is not allowed to change on PUT
 	// operations.
-	UID types.UID `json:"uid,omitempty" description:"unique UUID across space and time; populated by the system; read-only"`
+	UID types.UID `json:"uid,omitempty" protobuf:"5" description:"unique UUID across space and time; populated by the system; read-only"`
 
 	// An opaque value that represents the version of this resource. May be used for optimistic
 	// concurrency, change detection, and the watch operation on a resource or set of resources.
 	// Clients must treat these values as opaque and values may only be valid for a particular
 	// resource or set of resources. Only servers will generate resource versions.
-	ResourceVersion string `json:"resourceVersion,omitempty" description:"string that identifies the internal version of this object that can be used by clients to determine when objects have changed; populated by the system, read-only; value must be treated as opaque by clients and passed unmodified back to the server: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
+	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"6" description:"string that identifies the internal version of this object that can be used by clients to determine when objects have changed; populated by the system, read-only; value must be treated as opaque by clients and passed unmodified back to the server: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
 
 	// CreationTimestamp is a timestamp representing the server time when this object was
 	// created. It is not guaranteed to be set in happens-before order across separate operations.
 	// Clients may not set this value. It is represented in RFC3339 form and is in UTC.
-	CreationTimestamp util.Time `json:"creationTimestamp,omitempty" description:"RFC 3339 date and time at which the object was created; populated by the system, read-only; null for lists"`
+	CreationTimestamp util.Time `json:"creationTimestamp,omitempty" protobuf:"7" description:"RFC 3339 date and time at which the object was created; populated by the system, read-only; null for lists"`
 
 	// DeletionTimestamp is the time after which this resource will be deleted. This
 	// field is set by the server when a graceful deletion is requested by the user, and is not
@@ -129,16 +133,40 @@ type ObjectMeta struct {
 	// a pod is deleted in 30 seconds. The Kubelet will react by sending a graceful termination
 	// signal to the containers in the pod. Once the resource is deleted in the API, the Kubelet
 	// will send a hard termination signal to the container.
-	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty" description:"RFC 3339 date and time at which the object will be deleted; populated by the system when a graceful deletion is requested, read-only; if not set, graceful deletion of the object has not been requested"`
+	DeletionTimestamp *util.Time `json:"deletionTimestamp,omitempty" protobuf:"8" description:"RFC 3339 date and time at which the object will be deleted; populated by the system when a graceful deletion is requested, read-only; if not set, graceful deletion of the object has not been requested"`
+
+	// DeletionGracePeriodSeconds is the number of seconds the object is given to terminate
+	// gracefully, set along with DeletionTimestamp. It may only be shortened.
+	DeletionGracePeriodSeconds *int64 `json:"deletionGracePeriodSeconds,omitempty" protobuf:"9" description:"number of seconds allowed for the object to terminate gracefully before it is removed; set when a graceful deletion is requested, and may only be shortened; read-only"`
 
 	// Labels are key value pairs that may be used to scope and select individual resources.
 	// TODO: replace map[string]string with labels.LabelSet type
-	Labels map[string]string `json:"labels,omitempty" description:"map of string keys and values that can be used to organize and categorize objects; may match selectors of replication controllers and services"`
+	Labels map[string]string `json:"labels,omitempty" protobuf:"10" description:"map of string keys and values that can be used to organize and categorize objects; may match selectors of replication controllers and services"`
 
 	// Annotations are unstructured key value data stored with a resource that may be set by
 	// external tooling. They are not queryable and should be preserved when modifying
 	// objects.
-	Annotations map[string]string `json:"annotations,omitempty" description:"map of string keys and values that can be used by external tooling to store and retrieve arbitrary metadata about objects"`
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"11" description:"map of string keys and values that can be used by external tooling to store and retrieve arbitrary metadata about objects"`
+
+	// OwnerReferences lists the objects this object depends on. Once all of them are deleted,
+	// the object is deleted by the garbage collector, unless the deletion of its owners asked
+	// for their dependents to be orphaned. The owners are in the namespace of the object.
+	OwnerReferences []OwnerReference `json:"ownerReferences,omitempty" protobuf:"12" description:"objects this object depends on; the object is deleted by the garbage collector once all of them are deleted, unless their deletion orphans their dependents; all the owners are in the namespace of the object"`
+
+	// Finalizers must be empty before the object is removed from the registry. An object
+	// deleted while it has finalizers is only marked with a DeletionTimestamp, and removed
+	// once the parties responsible for the finalizers have cleared them.
+	Finalizers []string `json:"finalizers,omitempty" protobuf:"13" description:"must be empty before the object is removed; an object deleted while it has finalizers is only marked with a deletion timestamp"`
+}
+
+// OwnerReference identifies an object owning the object it is set on.
+type OwnerReference struct {
+	// Kind of the owner.
+	Kind string `json:"kind" protobuf:"1" description:"kind of the owner"`
+	// Name of the owner.
+	Name string `json:"name" protobuf:"2" description:"name of the owner"`
+	// UID of the owner, which tells it apart from a later object of the same name.
+	UID types.UID `json:"uid" protobuf:"3" description:"UID of the owner"`
 }
 
 const (
@@ -184,11 +212,11 @@ const (
 type Volume struct {
 	// Required: This must be a DNS_LABEL.  Each volume in a pod must have
 	// a unique name.
-	Name string `json:"name" description:"volume name; must be a DNS_LABEL and unique within the pod"`
+	Name string `json:"name" protobuf:"1" description:"volume name; must be a DNS_LABEL and unique within the pod"`
 	// Source represents the location and type of a volume to mount.
 	// This is optional for now. If not specified, the Volume is implied to be an EmptyDir.
 	// This implied behavior is deprecated and will be removed in a future version.
-	VolumeSource `json:",inline,omitempty"`
+	VolumeSource `json:",inline,omitempty" protobuf:"2"`
 }
 
 // VolumeSource represents the source location of a valume to mount.
@@ -200,20 +228,142 @@ type VolumeSource struct {
 	// to see the host machine. Most containers will NOT need this.
 	// TODO(jonesdl) We need to restrict who can use host directory mounts and who can/can not
 	// mount host directories as read/write.
-	HostPath *HostPathVolumeSource `json:"hostPath" description:"pre-existing host file or directory; generally for privileged system daemons or other agents tied to the host"`
+	HostPath *HostPathVolumeSource `json:"hostPath" protobuf:"1" description:"pre-existing host file or directory; generally for privileged system daemons or other agents tied to the host"`
 	// EmptyDir represents a temporary directory that shares a pod's lifetime.
-	EmptyDir *EmptyDirVolumeSource `json:"emptyDir" description:"temporary directory that shares a pod's lifetime"`
+	EmptyDir *EmptyDirVolumeSource `json:"emptyDir" protobuf:"2" description:"temporary directory that shares a pod's lifetime"`
 	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
 	// kubelet's host machine and then exposed to the pod.
-	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"gcePersistentDisk" description:"GCE disk resource attached to the host machine on demand"`
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"gcePersistentDisk" protobuf:"3" description:"GCE disk resource attached to the host machine on demand"`
 	// GitRepo represents a git repository at a particular revision.
-	GitRepo *GitRepoVolumeSource `json:"gitRepo" description:"git repository at a particular revision"`
+	GitRepo *GitRepoVolumeSource `json:"gitRepo" protobuf:"4" description:"git repository at a particular revision"`
 	// Secret represents a secret that should populate this volume.
-	Secret *SecretVolumeSource `json:"secret" description:"secret to populate volume"`
+	Secret *SecretVolumeSource `json:"secret" protobuf:"5" description:"secret to populate volume"`
 	// NFS represents an NFS mount on the host that shares a pod's lifetime
-	NFS *NFSVolumeSource `json:"nfs" description:"NFS volume that will be mounted in the host machine"`
+	NFS *NFSVolumeSource `json:"nfs" protobuf:"6" description:"NFS volume that will be mounted in the host machine"`
+	// PersistentVolumeClaim represents a reference to a PersistentVolumeClaim in the same namespace
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim" protobuf:"7" description:"a reference to a PersistentVolumeClaim in the same namespace"`
+	// ConfigMap represents a config map that should populate this volume.
+	ConfigMap *ConfigMapVolumeSource `json:"configMap" protobuf:"8" description:"config map to populate volume with"`
+	// DownwardAPI represents metadata of the pod that should populate this volume.
+	DownwardAPI *DownwardAPIVolumeSource `json:"downwardAPI" protobuf:"9" description:"downward API metadata to populate volume with"`
+}
+
+// PersistentVolumeSource is similar to VolumeSource but meant for the administrator who creates
+// PersistentVolumes. Only one of its members may be specified.
+type PersistentVolumeSource struct {
+	// GCEPersistentDisk represents a GCE Disk resource that is attached to a
+	// kubelet's host machine and then exposed to the pod.
+	GCEPersistentDisk *GCEPersistentDiskVolumeSource `json:"gcePersistentDisk" protobuf:"1" description:"GCE disk resource provisioned by an admin"`
+	// HostPath represents a directory on the host.
+	// This is useful for development and testing only.
+	// on-host storage is not supported in any way.
+	HostPath *HostPathVolumeSource `json:"hostPath" protobuf:"2" description:"a HostPath provisioned by a developer or tester; for development use only"`
+	// NFS represents an NFS mount on the host
+	NFS *NFSVolumeSource `json:"nfs" protobuf:"3" description:"NFS volume resource provisioned by an admin"`
+}
+
+// PersistentVolumeClaimVolumeSource references the user's PersistentVolumeClaim in the same namespace.
+type PersistentVolumeClaimVolumeSource struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume
+	ClaimName string `json:"claimName,omitempty" protobuf:"1" description:"the name of the claim in the same namespace to be mounted as a volume"`
+	// Optional: Defaults to false (read/write).  ReadOnly here
+	// will force the ReadOnly setting in VolumeMounts
+	ReadOnly bool `json:"readOnly,omitempty" protobuf:"2" description:"mount volume as read-only when true; default false"`
+}
+
+type PersistentVolume struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	//Spec defines a persistent volume owned by the cluster
+	Spec PersistentVolumeSpec `json:"spec,omitempty" protobuf:"3" description:"specification of a persistent volume as provisioned by an administrator"`
+
+	// Status represents the current information about persistent volume.
+	Status PersistentVolumeStatus `json:"status,omitempty" protobuf:"4" description:"current status of a persistent volume; populated by the system, read-only"`
+}
+
+// PersistentVolumeSpec has most of the details required to define a persistent volume
+type PersistentVolumeSpec struct {
+	// Resources represents the actual resources of the volume
+	Capacity ResourceList `json:"capacity,omitempty" protobuf:"1" description:"a description of the persistent volume's resources and capacity"`
+	// Source represents the location and type of a volume to mount.
+	PersistentVolumeSource `json:",inline" protobuf:"2" description:"the actual volume backing the persistent volume"`
+	// AccessModes contains all ways the volume can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty" protobuf:"3" description:"all ways the volume can be mounted"`
+	// holds the binding reference to a PersistentVolumeClaim
+	ClaimRef *ObjectReference `json:"claimRef,omitempty" protobuf:"4" description:"when bound, a reference to the bound claim"`
+}
+
+type PersistentVolumeStatus struct {
+	// Phase indicates if a volume is available, bound to a claim, or released by a claim
+	Phase PersistentVolumePhase `json:"phase,omitempty" protobuf:"1" description:"the current phase of a persistent volume"`
+}
+
+type PersistentVolumeList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	Items    []PersistentVolume `json:"items,omitempty" protobuf:"3" description:"list of persistent volumes"`
+}
+
+// PersistentVolumeClaim is a user's request for and claim to a persistent volume
+type PersistentVolumeClaim struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the volume requested by a pod author
+	Spec PersistentVolumeClaimSpec `json:"spec,omitempty" protobuf:"3" description:"the desired characteristics of a volume"`
+
+	// Status represents the current information about a claim
+	Status PersistentVolumeClaimStatus `json:"status,omitempty" protobuf:"4" description:"the current status of a persistent volume claim; read-only"`
+}
+
+type PersistentVolumeClaimList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	Items    []PersistentVolumeClaim `json:"items,omitempty" protobuf:"3" description:"a list of persistent volume claims"`
+}
+
+// PersistentVolumeClaimSpec describes the common attributes of storage devices
+// and allows a Source for provider-specific attributes
+type PersistentVolumeClaimSpec struct {
+	// Contains the types of access modes required
+	AccessModes []AccessModeType `json:"accessModes,omitempty" protobuf:"1" description:"the desired access modes the volume should have"`
+	// Resources represents the minimum resources required
+	Resources ResourceRequirements `json:"resources,omitempty" protobuf:"2" description:"the desired resources the volume should have"`
+}
+
+type PersistentVolumeClaimStatus struct {
+	// Phase represents the current phase of PersistentVolumeClaim
+	Phase PersistentVolumeClaimPhase `json:"phase,omitempty" protobuf:"1" description:"the current phase of the claim"`
+	// AccessModes contains all ways the volume backing the PVC can be mounted
+	AccessModes []AccessModeType `json:"accessModes,omitempty" protobuf:"2" description:"the actual access modes the volume has"`
+	// Represents the actual resources of the underlying volume
+	Capacity ResourceList `json:"capacity,omitempty" protobuf:"3" description:"the actual resources the volume has"`
+	// VolumeRef is a reference to the PersistentVolume bound to the PersistentVolumeClaim
+	VolumeRef *ObjectReference `json:"volumeRef,omitempty" protobuf:"4" description:"a reference to the backing persistent volume, when bound"`
 }
 
+type PersistentVolumePhase string
+
+const (
+	// used for PersistentVolumes that are not yet bound
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// used for PersistentVolumes that are bound
+	VolumeBound PersistentVolumePhase = "Bound"
+	// used for PersistentVolumes where the bound PersistentVolumeClaim was deleted
+	// released volumes must be recycled before becoming available again
+	VolumeReleased PersistentVolumePhase = "Released"
+)
+
+type PersistentVolumeClaimPhase string
+
+const (
+	// used for PersistentVolumeClaims that are not yet bound
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// used for PersistentVolumeClaims that are bound
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+)
+
 // used by VolumeSources to describe their mounting/access modes
 type AccessModeType string
 
@@ -228,13 +378,13 @@ const (
 
 // HostPathVolumeSource represents bare host directory volume.
 type HostPathVolumeSource struct {
-	Path string `json:"path" description:"path of the directory on the host"`
+	Path string `json:"path" protobuf:"1" description:"path of the directory on the host"`
 }
 
 type EmptyDirVolumeSource struct {
 	// Optional: what type of storage medium should back this directory.
 	// The default is "" which means to use the node's default medium.
-	Medium StorageType `json:"medium" description:"type of storage used to back the volume; must be an empty string (default) or Memory"`
+	Medium StorageType `json:"medium" protobuf:"1" description:"type of storage used to back the volume; must be an empty string (default) or Memory"`
 }
 
 // StorageType defines ways that storage can be allocated to a volume.
@@ -262,27 +412,27 @@ const (
 // A GCE PD can only be mounted as read/write once.
 type GCEPersistentDiskVolumeSource struct {
 	// Unique name of the PD resource. Used to identify the disk in GCE
-	PDName string `json:"pdName" description:"unique name of the PD resource in GCE"`
+	PDName string `json:"pdName" protobuf:"1" description:"unique name of the PD resource in GCE"`
 	// Required: Filesystem type to mount.
 	// Must be a filesystem type supported by the host operating system.
 	// Ex. "ext4", "xfs", "ntfs"
 	// TODO: how do we prevent errors in the filesystem from compromising the machine
-	FSType string `json:"fsType,omitempty" description:"file system type to mount, such as ext4, xfs, ntfs"`
+	FSType string `json:"fsType,omitempty" protobuf:"2" description:"file system type to mount, such as ext4, xfs, ntfs"`
 	// Optional: Partition on the disk to mount.
 	// If omitted, kubelet will attempt to mount the device name.
 	// Ex. For /dev/sda1, this field is "1", for /dev/sda, this field is 0 or empty.
-	Partition int `json:"partition,omitempty" description:"partition on the disk to mount (e.g., '1' for /dev/sda1); if omitted the plain device name (e.g., /dev/sda) will be mounted"`
+	Partition int `json:"partition,omitempty" protobuf:"3" description:"partition on the disk to mount (e.g., '1' for /dev/sda1); if omitted the plain device name (e.g., /dev/sda) will be mounted"`
 	// Optional: Defaults to false (read/write). ReadOnly here will force
 	// the ReadOnly setting in VolumeMounts.
-	ReadOnly bool `json:"readOnly,omitempty" description:"read-only if true, read-write otherwise (false or unspecified)"`
+	ReadOnly bool `json:"readOnly,omitempty" protobuf:"4" description:"read-only if true, read-write otherwise (false or unspecified)"`
 }
 
 // GitRepoVolumeSource represents a volume that is pulled from git when the pod is created.
 type GitRepoVolumeSource struct {
 	// Repository URL
-	Repository string `json:"repository" description:"repository URL"`
+	Repository string `json:"repository" protobuf:"1" description:"repository URL"`
 	// Commit hash, this is optional
-	Revision string `json:"revision" description:"commit hash for the specified revision"`
+	Revision string `json:"revision" protobuf:"2" description:"commit hash for the specified revision"`
 }
 
 // SecretVolumeSource adapts a Secret into a VolumeSource
@@ -290,70 +440,126 @@ type GitRepoVolumeSource struct {
 // https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/design/secrets.md
 type SecretVolumeSource struct {
 	// Name of the secret in the pod's namespace to use
-	SecretName string `json:"secretName" description:"secretName is the name of a secret in the pod's namespace"`
+	SecretName string `json:"secretName" protobuf:"1" description:"secretName is the name of a secret in the pod's namespace"`
+}
+
+// ConfigMapVolumeSource adapts a ConfigMap into a VolumeSource. The keys of
+// the config map are projected as files in the volume.
+type ConfigMapVolumeSource struct {
+	// Name of the config map in the pod's namespace to use.
+	Name string `json:"name" protobuf:"1" description:"name of a config map in the pod's namespace"`
+	// Items selects the keys to project and the paths to project them to.
+	// If unspecified, every key is projected into a file named after the key.
+	Items []KeyToPath `json:"items,omitempty" protobuf:"2" description:"keys to project and the paths to project them to; defaults to every key, projected into a file named after the key"`
+}
+
+// KeyToPath maps a key of a config map to a path in a volume.
+type KeyToPath struct {
+	// The key to project.
+	Key string `json:"key" protobuf:"1" description:"the key to project"`
+	// The path, relative to the volume, of the file to project the key to.
+	Path string `json:"path" protobuf:"2" description:"the relative path of the file to project the key to; may not contain '..'"`
+}
+
+// DownwardAPIVolumeSource projects fields of the pod into files of a volume.
+type DownwardAPIVolumeSource struct {
+	// Items is the list of files to write, one per pod field.
+	Items []DownwardAPIVolumeFile `json:"items,omitempty" protobuf:"1" description:"files to write, one per pod field"`
+}
+
+// DownwardAPIVolumeFile maps a field of the pod to a file in a volume.
+type DownwardAPIVolumeFile struct {
+	// The path, relative to the volume, of the file to write.
+	Path string `json:"path" protobuf:"1" description:"the relative path of the file to write; may not contain '..'"`
+	// Selects the pod field to write; only name, namespace, labels and annotations are supported.
+	FieldRef ObjectFieldSelector `json:"fieldRef" protobuf:"2" description:"selects the pod field to write; only metadata.name, metadata.namespace, metadata.labels and metadata.annotations are supported"`
 }
 
 // NFSVolumeSource represents an NFS mount that lasts the lifetime of a pod
 type NFSVolumeSource struct {
 	// Server is the hostname or IP address of the NFS server
-	Server string `json:"server" description:"the hostname or IP address of the NFS server"`
+	Server string `json:"server" protobuf:"1" description:"the hostname or IP address of the NFS server"`
 
 	// Path is the exported NFS share
-	Path string `json:"path" description:"the path that is exported by the NFS server"`
+	Path string `json:"path" protobuf:"2" description:"the path that is exported by the NFS server"`
 
 	// Optional: Defaults to false (read/write). ReadOnly here will force
 	// the NFS export to be mounted with read-only permissions
-	ReadOnly bool `json:"readOnly,omitempty" description:"forces the NFS export to be mounted with read-only permissions"`
+	ReadOnly bool `json:"readOnly,omitempty" protobuf:"3" description:"forces the NFS export to be mounted with read-only permissions"`
 }
 
 // ContainerPort represents a network port in a single container.
 type ContainerPort struct {
 	// Optional: If specified, this must be a DNS_LABEL.  Each named port
 	// in a pod must have a unique name.
-	Name string `json:"name,omitempty" description:"name for the port that can be referred to by services; must be a DNS_LABEL and unique without the pod"`
+	Name string `json:"name,omitempty" protobuf:"1" description:"name for the port that can be referred to by services; must be a DNS_LABEL and unique without the pod"`
 	// Optional: If specified, this must be a valid port number, 0 < x < 65536.
 	// If HostNetwork is specified, this must match ContainerPort.
-	HostPort int `json:"hostPort,omitempty" description:"number of port to expose on the host; most containers do not need this"`
+	HostPort int `json:"hostPort,omitempty" protobuf:"2" description:"number of port to expose on the host; most containers do not need this"`
 	// Required: This must be a valid port number, 0 < x < 65536.
-	ContainerPort int `json:"containerPort" description:"number of port to expose on the pod's IP address"`
+	ContainerPort int `json:"containerPort" protobuf:"3" description:"number of port to expose on the pod's IP address"`
 	// Optional: Defaults to "TCP".
-	Protocol Protocol `json:"protocol,omitempty" description:"protocol for port; must be UDP or TCP; TCP if unspecified"`
+	Protocol Protocol `json:"protocol,omitempty" protobuf:"4" description:"protocol for port; must be UDP or TCP; TCP if unspecified"`
 	// Optional: What host IP to bind the external port to.
-	HostIP string `json:"hostIP,omitempty" description:"host IP to bind the port to"`
+	HostIP string `json:"hostIP,omitempty" protobuf:"5" description:"host IP to bind the port to"`
 }
 
 // VolumeMount describes a mounting of a Volume within a container.
 type VolumeMount struct {
 	// Required: This must match the Name of a Volume [above].
-	Name string `json:"name" description:"name of the volume to mount"`
+	Name string `json:"name" protobuf:"1" description:"name of the volume to mount"`
 	// Optional: Defaults to false (read-write).
-	ReadOnly bool `json:"readOnly,omitempty" description:"mounted read-only if true, read-write otherwise (false or unspecified)"`
+	ReadOnly bool `json:"readOnly,omitempty" protobuf:"2" description:"mounted read-only if true, read-write otherwise (false or unspecified)"`
 	// Required.
-	MountPath string `json:"mountPath" description:"path within the container at which the volume should be mounted"`
+	MountPath string `json:"mountPath" protobuf:"3" description:"path within the container at which the volume should be mounted"`
 }
 
 // EnvVar represents an environment variable present in a Container.
 type EnvVar struct {
 	// Required: This must be a C_IDENTIFIER.
-	Name string `json:"name" description:"name of the environment variable; must be a C_IDENTIFIER"`
+	Name string `json:"name" protobuf:"1" description:"name of the environment variable; must be a C_IDENTIFIER"`
 	// Optional: defaults to "".
-	Value string `json:"value,omitempty" description:"value of the environment variable; defaults to empty string"`
+	Value string `json:"value,omitempty" protobuf:"2" description:"value of the environment variable; defaults to empty string"`
+	// Optional: specifies a source the value of this var should come from.
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty" protobuf:"3" description:"source for the environment variable's value; cannot be used if value is not empty"`
+}
+
+// EnvVarSource represents a source for the value of an EnvVar.
+type EnvVarSource struct {
+	// Selects a key of a config map in the pod's namespace.
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"1" description:"selects a key of a config map in the pod's namespace"`
+	// Selects a field of the pod; only name, namespace and podIP are supported.
+	FieldRef *ObjectFieldSelector `json:"fieldRef,omitempty" protobuf:"2" description:"selects a field of the pod; only metadata.name, metadata.namespace and status.podIP are supported"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// The name of the config map in the pod's namespace.
+	Name string `json:"name" protobuf:"1" description:"name of the config map in the pod's namespace"`
+	// The key to select.
+	Key string `json:"key" protobuf:"2" description:"the key to select"`
+}
+
+// ObjectFieldSelector selects a field of the pod a container runs in.
+type ObjectFieldSelector struct {
+	// The path of the field to select, such as "metadata.name".
+	FieldPath string `json:"fieldPath" protobuf:"1" description:"path of the field to select, such as metadata.name"`
 }
 
 // HTTPGetAction describes an action based on HTTP Get requests.
 type HTTPGetAction struct {
 	// Optional: Path to access on the HTTP server.
-	Path string `json:"path,omitempty" description:"path to access on the HTTP server"`
+	Path string `json:"path,omitempty" protobuf:"1" description:"path to access on the HTTP server"`
 	// Required: Name or number of the port to access on the container.
-	Port util.IntOrString `json:"port,omitempty" description:"number or name of the port to access on the container"`
+	Port util.IntOrString `json:"port,omitempty" protobuf:"2" description:"number or name of the port to access on the container"`
 	// Optional: Host name to connect to, defaults to the pod IP.
-	Host string `json:"host,omitempty" description:"hostname to connect to; defaults to pod IP"`
+	Host string `json:"host,omitempty" protobuf:"3" description:"hostname to connect to; defaults to pod IP"`
 }
 
 // TCPSocketAction describes an action based on opening a socket
 type TCPSocketAction struct {
 	// Required: Port to connect to.
-	Port util.IntOrString `json:"port,omitempty" description:"number of name of the port to access on the container"`
+	Port util.IntOrString `json:"port,omitempty" protobuf:"1" description:"number of name of the port to access on the container"`
 }
 
 // ExecAction describes a "run in container" action.
@@ -362,17 +568,17 @@ type ExecAction struct {
 	// command  is root ('/') in the container's filesystem.  The command is simply exec'd, it is
 	// not run inside a shell, so traditional shell instructions ('|', etc) won't work.  To use
 	// a shell, you need to explicitly call out to that shell.
-	Command []string `json:"command,omitempty" description:"command line to execute inside the container; working directory for the command is root ('/') in the container's file system; the command is exec'd, not run inside a shell; exit status of 0 is treated as live/healthy and non-zero is unhealthy"`
+	Command []string `json:"command,omitempty" protobuf:"1" description:"command line to execute inside the container; working directory for the command is root ('/') in the container's file system; the command is exec'd, not run inside a shell; exit status of 0 is treated as live/healthy and non-zero is unhealthy"`
 }
 
 // Probe describes a liveness probe to be examined to the container.
 type Probe struct {
 	// The action taken to determine the health of a container
-	Handler `json:",inline"`
+	Handler `json:",inline" protobuf:"1"`
 	// Length of time before health checking is activated.  In seconds.
-	InitialDelaySeconds int64 `json:"initialDelaySeconds,omitempty" description:"number of seconds after the container has started before liveness probes are initiated"`
+	InitialDelaySeconds int64 `json:"initialDelaySeconds,omitempty" protobuf:"2" description:"number of seconds after the container has started before liveness probes are initiated"`
 	// Length of time before health checking times out.  In seconds.
-	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" description:"number of seconds after which liveness probes timeout; defaults to 1 second"`
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"3" description:"number of seconds after which liveness probes timeout; defaults to 1 second"`
 }
 
 // PullPolicy describes a policy for if/when to pull a container image
@@ -393,15 +599,18 @@ type CapabilityType string
 // Capabilities represent POSIX capabilities that can be added or removed to a running container.
 type Capabilities struct {
 	// Added capabilities
-	Add []CapabilityType `json:"add,omitempty" description:"added capabilities"`
+	Add []CapabilityType `json:"add,omitempty" protobuf:"1" description:"added capabilities"`
 	// Removed capabilities
-	Drop []CapabilityType `json:"drop,omitempty" description:"droped capabilities"`
+	Drop []CapabilityType `json:"drop,omitempty" protobuf:"2" description:"droped capabilities"`
 }
 
 // ResourceRequirements describes the compute resource requirements.
 type ResourceRequirements struct {
 	// Limits describes the maximum amount of compute resources required.
-	Limits ResourceList `json:"limits,omitempty" description:"Maximum amount of compute resources allowed"`
+	Limits ResourceList `json:"limits,omitempty" protobuf:"1" description:"Maximum amount of compute resources allowed"`
+	// Requests describes the minimum amount of compute resources required, which is
+	// reserved for the container when it is scheduled. Requests default to Limits.
+	Requests ResourceList `json:"requests,omitempty" protobuf:"2" description:"Minimum amount of resources requested; defaults to Limits"`
 }
 
 const (
@@ -413,28 +622,28 @@ const (
 type Container struct {
 	// Required: This must be a DNS_LABEL.  Each container in a pod must
 	// have a unique name.
-	Name string `json:"name" description:"name of the container; must be a DNS_LABEL and unique within the pod; cannot be updated"`
+	Name string `json:"name" protobuf:"1" description:"name of the container; must be a DNS_LABEL and unique within the pod; cannot be updated"`
 	// Required.
-	Image string `json:"image" description:"Docker image name"`
+	Image string `json:"image" protobuf:"2" description:"Docker image name"`
 	// Optional: Defaults to whatever is defined in the image.
-	Command []string `json:"command,omitempty" description:"command argv array; not executed within a shell; defaults to entrypoint or command in the image; cannot be updated"`
+	Command []string `json:"command,omitempty" protobuf:"3" description:"command argv array; not executed within a shell; defaults to entrypoint or command in the image; cannot be updated"`
 	// Optional: Defaults to Docker's default.
-	WorkingDir     string               `json:"workingDir,omitempty" description:"container's working directory; defaults to image's default; cannot be updated"`
-	Ports          []ContainerPort      `json:"ports,omitempty" description:"list of ports to expose from the container; cannot be updated"`
-	Env            []EnvVar             `json:"env,omitempty" description:"list of environment variables to set in the container; cannot be updated"`
-	Resources      ResourceRequirements `json:"resources,omitempty" description:"Compute Resources required by this container; cannot be updated"`
-	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty" description:"pod volumes to mount into the container's filesyste; cannot be updated"`
-	LivenessProbe  *Probe               `json:"livenessProbe,omitempty" description:"periodic probe of container liveness; container will be restarted if the probe fails; cannot be updated"`
-	ReadinessProbe *Probe               `json:"readinessProbe,omitempty" description:"periodic probe of container service readiness; container will be removed from service endpoints if the probe fails; cannot be updated"`
-	Lifecycle      *Lifecycle           `json:"lifecycle,omitempty" description:"actions that the management system should take in response to container lifecycle events; cannot be updated"`
+	WorkingDir     string               `json:"workingDir,omitempty" protobuf:"4" description:"container's working directory; defaults to image's default; cannot be updated"`
+	Ports          []ContainerPort      `json:"ports,omitempty" protobuf:"5" patchStrategy:"merge" patchMergeKey:"containerPort" description:"list of ports to expose from the container; cannot be updated"`
+	Env            []EnvVar             `json:"env,omitempty" protobuf:"6" patchStrategy:"merge" patchMergeKey:"name" description:"list of environment variables to set in the container; cannot be updated"`
+	Resources      ResourceRequirements `json:"resources,omitempty" protobuf:"7" description:"Compute Resources required by this container; cannot be updated"`
+	VolumeMounts   []VolumeMount        `json:"volumeMounts,omitempty" protobuf:"8" patchStrategy:"merge" patchMergeKey:"mountPath" description:"pod volumes to mount into the container's filesyste; cannot be updated"`
+	LivenessProbe  *Probe               `json:"livenessProbe,omitempty" protobuf:"9" description:"periodic probe of container liveness; container will be restarted if the probe fails; cannot be updated"`
+	ReadinessProbe *Probe               `json:"readinessProbe,omitempty" protobuf:"10" description:"periodic probe of container service readiness; container will be removed from service endpoints if the probe fails; cannot be updated"`
+	Lifecycle      *Lifecycle           `json:"lifecycle,omitempty" protobuf:"11" description:"actions that the management system should take in response to container lifecycle events; cannot be updated"`
 	// Optional: Defaults to /dev/termination-log
-	TerminationMessagePath string `json:"terminationMessagePath,omitempty" description:"path at which the file to which the container's termination message will be written is mounted into the container's filesystem; message written is intended to be brief final status, such as an assertion failure message; defaults to /dev/termination-log; cannot be updated"`
+	TerminationMessagePath string `json:"terminationMessagePath,omitempty" protobuf:"12" description:"path at which the file to which the container's termination message will be written is mounted into the container's filesystem; message written is intended to be brief final status, such as an assertion failure message; defaults to /dev/termination-log; cannot be updated"`
 	// Optional: Default to false.
-	Privileged bool `json:"privileged,omitempty" description:"whether or not the container is granted privileged status; defaults to false; cannot be updated"`
+	Privileged bool `json:"privileged,omitempty" protobuf:"13" description:"whether or not the container is granted privileged status; defaults to false; cannot be updated"`
 	// Optional: Policy for pulling images for this container
-	ImagePullPolicy PullPolicy `json:"imagePullPolicy" description:"image pull policy; one of PullAlways, PullNever, PullIfNotPresent; defaults to PullAlways if :latest tag is specified, or PullIfNotPresent otherwise; cannot be updated"`
+	ImagePullPolicy PullPolicy `json:"imagePullPolicy" protobuf:"14" description:"image pull policy; one of PullAlways, PullNever, PullIfNotPresent; defaults to PullAlways if :latest tag is specified, or PullIfNotPresent otherwise; cannot be updated"`
 	// Optional: Capabilities for container.
-	Capabilities Capabilities `json:"capabilities,omitempty" description:"capabilities for container; cannot be updated"`
+	Capabilities Capabilities `json:"capabilities,omitempty" protobuf:"15" description:"capabilities for container; cannot be updated"`
 }
 
 // Handler defines a specific action that should be taken
@@ -442,12 +651,12 @@ type Container struct {
 type Handler struct {
 	// One and only one of the following should be specified.
 	// Exec specifies the action to take.
-	Exec *ExecAction `json:"exec,omitempty" description:"exec-based handler"`
+	Exec *ExecAction `json:"exec,omitempty" protobuf:"1" description:"exec-based handler"`
 	// HTTPGet specifies the http request to perform.
-	HTTPGet *HTTPGetAction `json:"httpGet,omitempty" description:"HTTP-based handler"`
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty" protobuf:"2" description:"HTTP-based handler"`
 	// TCPSocket specifies an action involving a TCP port.
 	// TODO: implement a realistic TCP lifecycle hook
-	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty"  description:"TCP-based handler; TCP hooks not yet supported"`
+	TCPSocket *TCPSocketAction `json:"tcpSocket,omitempty" protobuf:"3"  description:"TCP-based handler; TCP hooks not yet supported"`
 }
 
 // Lifecycle describes actions that the management system should take in response to container lifecycle
@@ -456,10 +665,10 @@ type Handler struct {
 type Lifecycle struct {
 	// PostStart is called immediately after a container is created.  If the handler fails, the container
 	// is terminated and restarted.
-	PostStart *Handler `json:"postStart,omitempty" description:"called immediately after a container is started; if the handler fails, the container is terminated and restarted according to its restart policy; other management of the container blocks until the hook completes"`
+	PostStart *Handler `json:"postStart,omitempty" protobuf:"1" description:"called immediately after a container is started; if the handler fails, the container is terminated and restarted according to its restart policy; other management of the container blocks until the hook completes"`
 	// PreStop is called immediately before a container is terminated.  The reason for termination is
 	// passed to the handler.  Regardless of the outcome of the handler, the container is eventually terminated.
-	PreStop *Handler `json:"preStop,omitempty" description:"called before a container is terminated; the container is terminated after the handler completes; other management of the container blocks until the hook completes"`
+	PreStop *Handler `json:"preStop,omitempty" protobuf:"2" description:"called before a container is terminated; the container is terminated after the handler completes; other management of the container blocks until the hook completes"`
 }
 
 type ConditionStatus string
@@ -476,46 +685,46 @@ const (
 
 type ContainerStateWaiting struct {
 	// Reason could be pulling image,
-	Reason string `json:"reason,omitempty" description:"(brief) reason the container is not yet running, such as pulling its image"`
+	Reason string `json:"reason,omitempty" protobuf:"1" description:"(brief) reason the container is not yet running, such as pulling its image"`
 }
 
 type ContainerStateRunning struct {
-	StartedAt util.Time `json:"startedAt,omitempty" description:"time at which the container was last (re-)started"`
+	StartedAt util.Time `json:"startedAt,omitempty" protobuf:"1" description:"time at which the container was last (re-)started"`
 }
 
 type ContainerStateTerminated struct {
-	ExitCode    int       `json:"exitCode" description:"exit status from the last termination of the container"`
-	Signal      int       `json:"signal,omitempty" description:"signal from the last termination of the container"`
-	Reason      string    `json:"reason,omitempty" description:"(brief) reason from the last termination of the container"`
-	Message     string    `json:"message,omitempty" description:"message regarding the last termination of the container"`
-	StartedAt   util.Time `json:"startedAt,omitempty" description:"time at which previous execution of the container started"`
-	FinishedAt  util.Time `json:"finishedAt,omitempty" description:"time at which the container last terminated"`
-	ContainerID string    `json:"containerID,omitempty" description:"container's ID in the format 'docker://<container_id>'"`
+	ExitCode    int       `json:"exitCode" protobuf:"1" description:"exit status from the last termination of the container"`
+	Signal      int       `json:"signal,omitempty" protobuf:"2" description:"signal from the last termination of the container"`
+	Reason      string    `json:"reason,omitempty" protobuf:"3" description:"(brief) reason from the last termination of the container"`
+	Message     string    `json:"message,omitempty" protobuf:"4" description:"message regarding the last termination of the container"`
+	StartedAt   util.Time `json:"startedAt,omitempty" protobuf:"5" description:"time at which previous execution of the container started"`
+	FinishedAt  util.Time `json:"finishedAt,omitempty" protobuf:"6" description:"time at which the container last terminated"`
+	ContainerID string    `json:"containerID,omitempty" protobuf:"7" description:"container's ID in the format 'docker://<container_id>'"`
 }
 
 // ContainerState holds a possible state of container.
 // Only one of its members may be specified.
 // If none of them is specified, the default one is ContainerStateWaiting.
 type ContainerState struct {
-	Waiting     *ContainerStateWaiting    `json:"waiting,omitempty" description:"details about a waiting container"`
-	Running     *ContainerStateRunning    `json:"running,omitempty" description:"details about a running container"`
-	Termination *ContainerStateTerminated `json:"termination,omitempty" description:"details about a terminated container"`
+	Waiting     *ContainerStateWaiting    `json:"waiting,omitempty" protobuf:"1" description:"details about a waiting container"`
+	Running     *ContainerStateRunning    `json:"running,omitempty" protobuf:"2" description:"details about a running container"`
+	Termination *ContainerStateTerminated `json:"termination,omitempty" protobuf:"3" description:"details about a terminated container"`
 }
 
 type ContainerStatus struct {
 	// TODO(dchen1107): Should we rename PodStatus to a more generic name or have a separate states
 	// defined for container?
-	State                ContainerState `json:"state,omitempty" description:"details about the container's current condition"`
-	LastTerminationState ContainerState `json:"lastState,omitempty" description:"details about the container's last termination condition"`
-	Ready                bool           `json:"ready" description:"specifies whether the container has passed its readiness probe"`
+	State                ContainerState `json:"state,omitempty" protobuf:"1" description:"details about the container's current condition"`
+	LastTerminationState ContainerState `json:"lastState,omitempty" protobuf:"2" description:"details about the container's last termination condition"`
+	Ready                bool           `json:"ready" protobuf:"3" description:"specifies whether the container has passed its readiness probe"`
 	// Note that this is calculated from dead containers.  But those containers are subject to
 	// garbage collection.  This value will get capped at 5 by GC.
-	RestartCount int `json:"restartCount" description:"the number of times the container has been restarted, currently based on the number of dead containers that have not yet been removed"`
+	RestartCount int `json:"restartCount" protobuf:"4" description:"the number of times the container has been restarted, currently based on the number of dead containers that have not yet been removed"`
 	// TODO(dchen1107): Which image the container is running with?
 	// The image the container is running
-	Image       string `json:"image" description:"image of the container"`
-	ImageID     string `json:"imageID" description:"ID of the container's image"`
-	ContainerID string `json:"containerID,omitempty" description:"container's ID in the format 'docker://<container_id>'"`
+	Image       string `json:"image" protobuf:"5" description:"image of the container"`
+	ImageID     string `json:"imageID" protobuf:"6" description:"ID of the container's image"`
+	ContainerID string `json:"containerID,omitempty" protobuf:"7" description:"container's ID in the format 'docker://<container_id>'"`
 }
 
 // PodPhase is a label for the condition of a pod at the current time.
@@ -554,9 +763,9 @@ const (
 // TODO: add LastTransitionTime, Reason, Message to match NodeCondition api.
 type PodCondition struct {
 	// Type is the type of the condition
-	Type PodConditionType `json:"type" description:"kind of the condition"`
+	Type PodConditionType `json:"type" protobuf:"1" description:"kind of the condition"`
 	// Status is the status of the condition
-	Status ConditionStatus `json:"status" description:"status of the condition, one of Full, None, Unknown"`
+	Status ConditionStatus `json:"status" protobuf:"2" description:"status of the condition, one of Full, None, Unknown"`
 }
 
 // PodInfo contains one entry for every container with available info.
@@ -590,38 +799,181 @@ const (
 
 // PodSpec is a description of a pod
 type PodSpec struct {
-	Volumes []Volume `json:"volumes" description:"list of volumes that can be mounted by containers belonging to the pod"`
+	Volumes []Volume `json:"volumes" protobuf:"1" patchStrategy:"merge" patchMergeKey:"name" description:"list of volumes that can be mounted by containers belonging to the pod"`
 	// Required: there must be at least one container in a pod.
-	Containers    []Container   `json:"containers" description:"list of containers belonging to the pod; cannot be updated; containers cannot currently be added or removed; there must be at least one container in a Pod"`
-	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" description:"restart policy for all containers within the pod; one of RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever"`
+	Containers    []Container   `json:"containers" protobuf:"2" patchStrategy:"merge" patchMergeKey:"name" description:"list of containers belonging to the pod; cannot be updated; containers cannot currently be added or removed; there must be at least one container in a Pod"`
+	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty" protobuf:"3" description:"restart policy for all containers within the pod; one of RestartPolicyAlways, RestartPolicyOnFailure, RestartPolicyNever"`
+	// List of initialization containers, which are run one at a time, in order, and must
+	// each terminate successfully before the containers of the pod are started. If one of
+	// them fails, it is restarted according to the RestartPolicy of the pod.
+	InitContainers []Container `json:"initContainers,omitempty" protobuf:"4" patchStrategy:"merge" patchMergeKey:"name" description:"list of initialization containers belonging to the pod, run one at a time to successful completion before the containers are started; cannot be updated"`
 	// Optional: Set DNS policy.  Defaults to "ClusterFirst"
-	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
+	DNSPolicy DNSPolicy `json:"dnsPolicy,omitempty" protobuf:"5" description:"DNS policy for containers within the pod; one of 'ClusterFirst' or 'Default'"`
 	// NodeSelector is a selector which must be true for the pod to fit on a node
-	NodeSelector map[string]string `json:"nodeSelector,omitempty" description:"selector which must match a node's labels for the pod to be scheduled on that node"`
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"6" description:"selector which must match a node's labels for the pod to be scheduled on that node"`
+	// NodeAffinity is a set of constraints and preferences on the labels of the node
+	// the pod is scheduled onto, beyond the exact matches of NodeSelector.
+	NodeAffinity *NodeAffinity `json:"nodeAffinity,omitempty" protobuf:"7" description:"required and preferred terms on the labels of the node the pod is scheduled onto"`
+	// PodAffinity is a set of pods which the pod should be scheduled near to.
+	PodAffinity *PodAffinity `json:"podAffinity,omitempty" protobuf:"8" description:"pods which the pod should be scheduled in the same topology domain as"`
+	// PodAntiAffinity is a set of pods which the pod should be scheduled away from.
+	PodAntiAffinity *PodAntiAffinity `json:"podAntiAffinity,omitempty" protobuf:"9" description:"pods which the pod should not be scheduled in the same topology domain as"`
 
 	// Host is a request to schedule this pod onto a specific host.  If it is non-empty,
 	// the the scheduler simply schedules this pod onto that host, assuming that it fits
 	// resource requirements.
-	Host string `json:"host,omitempty" description:"host requested for this pod"`
+	Host string `json:"host,omitempty" protobuf:"10" description:"host requested for this pod"`
 	// Uses the host's network namespace. If this option is set, the ports that will be
 	// used must be specified.
 	// Optional: Default to false.
-	HostNetwork bool `json:"hostNetwork,omitempty" description:"host networking requested for this pod"`
+	HostNetwork bool `json:"hostNetwork,omitempty" protobuf:"11" description:"host networking requested for this pod"`
+	// ServiceAccount is the name of the ServiceAccount to use to run this pod
+	ServiceAccount string `json:"serviceAccount,omitempty" protobuf:"12" description:"name of the ServiceAccount to use to run this pod"`
+	// Optional duration in seconds the pod needs to terminate gracefully. When the pod is
+	// deleted, its containers are sent a termination signal, and forcibly killed once this
+	// period has elapsed. Zero means the containers are killed immediately.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty" protobuf:"13" description:"optional duration in seconds the pod needs to terminate gracefully; containers are sent a termination signal, then forcibly killed once the period has elapsed; zero means kill immediately; defaults to 30 seconds"`
+	// Tolerations let the pod be scheduled onto nodes with matching taints.
+	Tolerations []Toleration `json:"tolerations,omitempty" protobuf:"14" description:"list of tolerations of the pod, which let it be scheduled onto and keep running on nodes with matching taints"`
+	// PriorityClassName is the name of the PriorityClass of the pod. If empty, the
+	// global default class is used, if any.
+	PriorityClassName string `json:"priorityClassName,omitempty" protobuf:"15" description:"name of the PriorityClass of the pod; if empty, the global default class is used, if any"`
+	// Priority is resolved from the PriorityClass of the pod on creation, and cannot be
+	// set directly. Pods without a priority have a priority of zero.
+	Priority *int `json:"priority,omitempty" protobuf:"16" description:"priority of the pod, resolved from its PriorityClass on creation; read-only"`
+}
+
+// NodeAffinity describes the constraints and preferences of a pod on the labels of
+// the nodes it is scheduled onto.
+type NodeAffinity struct {
+	// The pod only fits on the nodes matching at least one of these terms.
+	RequiredDuringScheduling []NodeSelectorTerm `json:"requiredDuringScheduling,omitempty" protobuf:"1" description:"terms of which a node must match at least one for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes matching the preferred terms with the greatest
+	// total weight, but may choose a node matching none of them.
+	PreferredDuringScheduling []PreferredSchedulingTerm `json:"preferredDuringScheduling,omitempty" protobuf:"2" description:"weighted terms which the scheduler prefers the nodes of the pod to match"`
+}
+
+// NodeSelectorTerm is matched by the nodes whose labels satisfy all of its requirements.
+type NodeSelectorTerm struct {
+	// Required. The requirements on the labels of the node.
+	MatchExpressions []NodeSelectorRequirement `json:"matchExpressions" protobuf:"1" description:"requirements on the labels of the node, which must all be satisfied"`
+}
+
+// NodeSelectorOperator is the operator relating the key and values of a NodeSelectorRequirement.
+type NodeSelectorOperator string
+
+const (
+	// The node has the key, with one of the values.
+	NodeSelectorOpIn NodeSelectorOperator = "In"
+	// The node does not have the key, or has it with none of the values.
+	NodeSelectorOpNotIn NodeSelectorOperator = "NotIn"
+	// The node has the key, with any value.
+	NodeSelectorOpExists NodeSelectorOperator = "Exists"
+)
+
+// NodeSelectorRequirement is a requirement on the value of a node label.
+type NodeSelectorRequirement struct {
+	// Required. The label key the requirement applies to.
+	Key string `json:"key" protobuf:"1" description:"label key the requirement applies to"`
+	// Required. How the value of the label is matched.
+	Operator NodeSelectorOperator `json:"operator" protobuf:"2" description:"how the value of the label is matched; one of In, NotIn or Exists"`
+	// The values of the label, which must be set for the In and NotIn operators,
+	// and empty for Exists.
+	Values []string `json:"values,omitempty" protobuf:"3" description:"values of the label; required for In and NotIn, empty for Exists"`
+}
+
+// PreferredSchedulingTerm is a node selector term with the weight it adds to the
+// nodes which match it.
+type PreferredSchedulingTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight" protobuf:"1" description:"weight added to the nodes matching the term, from 1 to 100"`
+	// Required. The term the node should match.
+	Preference NodeSelectorTerm `json:"preference" protobuf:"2" description:"term the node should match"`
+}
+
+// PodAffinity describes the pods which a pod should be scheduled in the same topology
+// domain as.
+type PodAffinity struct {
+	// The pod only fits on the nodes which are in the same topology domain as a pod
+	// matching each of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty" protobuf:"1" description:"terms which must each be matched by a pod in the same topology domain as the node for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes in the same topology domain as the pods matching
+	// the preferred terms with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty" protobuf:"2" description:"weighted terms which the scheduler prefers to be matched by pods in the same topology domain as the node of the pod"`
+}
+
+// PodAntiAffinity describes the pods which a pod should not be scheduled in the same
+// topology domain as.
+type PodAntiAffinity struct {
+	// The pod does not fit on the nodes which are in the same topology domain as a pod
+	// matching any of these terms.
+	RequiredDuringScheduling []PodAffinityTerm `json:"requiredDuringScheduling,omitempty" protobuf:"1" description:"terms none of which may be matched by a pod in the same topology domain as the node for the pod to be scheduled onto it"`
+	// The scheduler favors the nodes away from the pods matching the preferred terms
+	// with the greatest weight, but may choose any other node.
+	PreferredDuringScheduling []WeightedPodAffinityTerm `json:"preferredDuringScheduling,omitempty" protobuf:"2" description:"weighted terms which the scheduler prefers not to be matched by pods in the same topology domain as the node of the pod"`
+}
+
+// PodAffinityTerm selects a set of pods, and the nodes which are in the same topology
+// domain as them.
+type PodAffinityTerm struct {
+	// Required. The labels of the pods the term applies to. Only the pods in the
+	// namespace of the pod being scheduled are considered.
+	LabelSelector map[string]string `json:"labelSelector" protobuf:"1" description:"selector over the labels of the pods in the namespace of the pod"`
+	// The key of the node label defining the topology domains: the nodes which have the
+	// same value for this label are in the same domain. If empty, each node is its own
+	// domain.
+	TopologyKey string `json:"topologyKey,omitempty" protobuf:"2" description:"node label whose value defines the topology domain of a node; if empty, each node is its own domain"`
+}
+
+// WeightedPodAffinityTerm is a pod affinity term with the weight it adds to the nodes
+// which satisfy it.
+type WeightedPodAffinityTerm struct {
+	// Required. The weight of the term, from 1 to 100.
+	Weight int `json:"weight" protobuf:"1" description:"weight of the term, from 1 to 100"`
+	// Required. The term the node should satisfy.
+	PodAffinityTerm PodAffinityTerm `json:"podAffinityTerm" protobuf:"2" description:"term the node should satisfy"`
+}
+
+// TolerationOperator is the operator a toleration uses to match the value of a taint.
+type TolerationOperator string
+
+const (
+	// The toleration matches a taint with an equal value.
+	TolerationOpEqual TolerationOperator = "Equal"
+	// The toleration matches a taint with any value.
+	TolerationOpExists TolerationOperator = "Exists"
+)
+
+// Toleration lets a pod be scheduled onto, and keep running on, a node whose taints
+// it matches.
+type Toleration struct {
+	// Required. The key of the taints the toleration matches.
+	Key string `json:"key" protobuf:"1" description:"taint key the toleration applies to"`
+	// Optional. How the value of a taint is matched; empty means Equal.
+	Operator TolerationOperator `json:"operator,omitempty" protobuf:"2" description:"how the taint value is matched; one of Equal or Exists; empty means Equal"`
+	// The value of the taints the toleration matches, if the operator is Equal.
+	Value string `json:"value,omitempty" protobuf:"3" description:"taint value the toleration matches when the operator is Equal"`
+	// Optional. The effect of the taints the toleration matches; all effects are
+	// matched when empty.
+	Effect TaintEffect `json:"effect,omitempty" protobuf:"4" description:"taint effect the toleration matches; empty matches all effects"`
 }
 
 // PodStatus represents information about the status of a pod. Status may trail the actual
 // state of a system.
 type PodStatus struct {
-	Phase      PodPhase       `json:"phase,omitempty" description:"current condition of the pod."`
-	Conditions []PodCondition `json:"Condition,omitempty" description:"current service state of pod"`
+	Phase      PodPhase       `json:"phase,omitempty" protobuf:"1" description:"current condition of the pod."`
+	Conditions []PodCondition `json:"Condition,omitempty" protobuf:"2" description:"current service state of pod"`
 	// A human readable message indicating details about why the pod is in this state.
-	Message string `json:"message,omitempty" description:"human readable message indicating details about why the pod is in this condition"`
+	Message string `json:"message,omitempty" protobuf:"3" description:"human readable message indicating details about why the pod is in this condition"`
 
 	// Host is the name of the node that this Pod is currently bound to, or empty if no
 	// assignment has been done.
-	Host   string `json:"host,omitempty" description:"host to which the pod is assigned; empty if not yet scheduled; cannot be updated"`
-	HostIP string `json:"hostIP,omitempty" description:"IP address of the host to which the pod is assigned; empty if not yet scheduled"`
-	PodIP  string `json:"podIP,omitempty" description:"IP address allocated to the pod; routable at least within the cluster; empty if not yet allocated"`
+	Host   string `json:"host,omitempty" protobuf:"4" description:"host to which the pod is assigned; empty if not yet scheduled; cannot be updated"`
+	HostIP string `json:"hostIP,omitempty" protobuf:"5" description:"IP address of the host to which the pod is assigned; empty if not yet scheduled"`
+	PodIP  string `json:"podIP,omitempty" protobuf:"6" description:"IP address allocated to the pod; routable at least within the cluster; empty if not yet allocated"`
+	// NominatedHost is the node the scheduler made room on for the pod, by preempting pods
+	// with a lower priority. The pod may still be scheduled onto another node.
+	NominatedHost string `json:"nominatedHost,omitempty" protobuf:"7" description:"node the pod is expected to be scheduled onto once the pods it preempted there have terminated; empty if it preempted no pod"`
 
 	// The key of this map is the *name* of the container within the manifest; it has one
 	// entry per container in the manifest. The value of this map is currently the output
@@ -629,110 +981,414 @@ type PodStatus struct {
 	// upon.
 	// TODO: Make real decisions about what our info should look like. Re-enable fuzz test
 	// when we have done this.
-	Info PodInfo `json:"info,omitempty" description:"map of container name to container status"`
+	Info PodInfo `json:"info,omitempty" protobuf:"8" description:"map of container name to container status"`
+	// InitContainerInfo holds the status of the init containers of the pod, keyed by name.
+	InitContainerInfo PodInfo `json:"initContainerInfo,omitempty" protobuf:"9" description:"map of init container name to container status"`
 }
 
 // PodStatusResult is a wrapper for PodStatus returned by kubelet that can be encode/decoded
 type PodStatusResult struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 	// Status represents the current information about a pod. This data may not be up
 	// to date.
-	Status PodStatus `json:"status,omitempty" description:"most recently observed status of the pod; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status PodStatus `json:"status,omitempty" protobuf:"3" description:"most recently observed status of the pod; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // Pod is a collection of containers that can run on a host. This resource is created
 // by clients and scheduled onto hosts.
 type Pod struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of a pod.
-	Spec PodSpec `json:"spec,omitempty" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec PodSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status represents the current information about a pod. This data may not be up
 	// to date.
-	Status PodStatus `json:"status,omitempty" description:"most recently observed status of the pod; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status PodStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the pod; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // PodList is a list of Pods.
 type PodList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#types-kinds`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#types-kinds`
 
-	Items []Pod `json:"items" description:"list of pods"`
+	Items []Pod `json:"items" protobuf:"3" description:"list of pods"`
 }
 
 // PodTemplateSpec describes the data a pod should have when created from a template
 type PodTemplateSpec struct {
 	// Metadata of the pods created from this template.
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"1" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of a pod.
-	Spec PodSpec `json:"spec,omitempty" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec PodSpec `json:"spec,omitempty" protobuf:"2" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // PodTemplate describes a template for creating copies of a predefined pod.
 type PodTemplate struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of a pod.
-	Spec PodTemplateSpec `json:"spec,omitempty" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec PodTemplateSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the pod; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // PodTemplateList is a list of PodTemplates.
 type PodTemplateList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []PodTemplate `json:"items" description:"list of pod templates"`
+	Items []PodTemplate `json:"items" protobuf:"3" description:"list of pod templates"`
 }
 
 // ReplicationControllerSpec is the specification of a replication controller.
 type ReplicationControllerSpec struct {
 	// Replicas is the number of desired replicas.
-	Replicas int `json:"replicas" description:"number of replicas desired"`
+	Replicas int `json:"replicas" protobuf:"1" description:"number of replicas desired"`
 
 	// Selector is a label query over pods that should match the Replicas count.
-	Selector map[string]string `json:"selector,omitempty" description:"label keys and values that must match in order to be controlled by this replication controller"`
+	Selector map[string]string `json:"selector,omitempty" protobuf:"2" description:"label keys and values that must match in order to be controlled by this replication controller"`
 
 	// TemplateRef is a reference to an object that describes the pod that will be created if
 	// insufficient replicas are detected.
-	TemplateRef *ObjectReference `json:"templateRef,omitempty" description:"reference to an object that describes the pod that will be created if insufficient replicas are detected"`
+	TemplateRef *ObjectReference `json:"templateRef,omitempty" protobuf:"3" description:"reference to an object that describes the pod that will be created if insufficient replicas are detected"`
 
 	// Template is the object that describes the pod that will be created if
 	// insufficient replicas are detected. This takes precedence over a
 	// TemplateRef.
-	Template *PodTemplateSpec `json:"template,omitempty" description:"object that describes the pod that will be created if insufficient replicas are detected; takes precendence over templateRef"`
+	Template *PodTemplateSpec `json:"template,omitempty" protobuf:"4" description:"object that describes the pod that will be created if insufficient replicas are detected; takes precendence over templateRef"`
 }
 
 // ReplicationControllerStatus represents the current status of a replication
 // controller.
 type ReplicationControllerStatus struct {
 	// Replicas is the number of actual replicas.
-	Replicas int `json:"replicas" description:"most recently oberved number of replicas"`
+	Replicas int `json:"replicas" protobuf:"1" description:"most recently oberved number of replicas"`
 }
 
 // ReplicationController represents the configuration of a replication controller.
 type ReplicationController struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the desired behavior of this replication controller.
-	Spec ReplicationControllerSpec `json:"spec,omitempty" description:"specification of the desired behavior of the replication controller; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec ReplicationControllerSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the replication controller; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status is the current status of this replication controller. This data may be
 	// out of date by some window of time.
-	Status ReplicationControllerStatus `json:"status,omitempty" description:"most recently observed status of the replication controller; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status ReplicationControllerStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the replication controller; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // ReplicationControllerList is a collection of replication controllers.
 type ReplicationControllerList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ReplicationController `json:"items" protobuf:"3" description:"list of replication controllers"`
+}
+
+// JobSpec describes how a job execution will look.
+type JobSpec struct {
+	// Parallelism is the maximum number of pods the job should run at any given time.
+	Parallelism int `json:"parallelism,omitempty" protobuf:"1" description:"maximum number of pods the job should run at any given time; defaults to 1"`
+
+	// Completions is the number of pods that must terminate successfully before the
+	// job is considered complete.
+	Completions int `json:"completions,omitempty" protobuf:"2" description:"number of successfully terminated pods required to complete the job; defaults to 1"`
+
+	// RetryLimit is the number of failures that are tolerated before the job is
+	// marked as failed. A failed pod and a container restart each count as a failure.
+	RetryLimit int `json:"retryLimit,omitempty" protobuf:"3" description:"number of failed pods and container restarts tolerated before the job is marked as failed"`
+
+	// Selector is a label query over pods that are owned by this job.
+	Selector map[string]string `json:"selector" protobuf:"4" description:"label keys and values that must match in order to be controlled by this job"`
+
+	// Template is the object that describes the pod that will be created when
+	// executing the job.
+	Template *PodTemplateSpec `json:"template,omitempty" protobuf:"5" description:"object that describes the pods that will be created when executing the job"`
+}
+
+// JobPhase is a label for the condition of a job at the current time.
+type JobPhase string
+
+// These are the valid phases of a job.
+const (
+	// JobRunning means the job still has completions outstanding.
+	JobRunning JobPhase = "Running"
+	// JobComplete means the job has reached its desired number of completions.
+	JobComplete JobPhase = "Complete"
+	// JobFailed means the job failed more often than its retry limit allows.
+	JobFailed JobPhase = "Failed"
+)
+
+// JobStatus represents the current state of a job.
+type JobStatus struct {
+	Phase     JobPhase `json:"phase,omitempty" protobuf:"1" description:"current lifecycle phase of the job"`
+	Active    int      `json:"active,omitempty" protobuf:"2" description:"number of actively running pods"`
+	Succeeded int      `json:"succeeded,omitempty" protobuf:"3" description:"number of pods which terminated successfully"`
+	Failed    int      `json:"failed,omitempty" protobuf:"4" description:"number of pods which terminated with a failure plus container restarts of the job's pods"`
+}
+
+// Job represents the configuration of a run-to-completion workload.
+type Job struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of this job.
+	Spec JobSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the job; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this job. This data may be out of date by
+	// some window of time.
+	Status JobStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the job; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// JobList is a collection of jobs.
+type JobList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Job `json:"items" protobuf:"3" description:"list of jobs"`
+}
+
+// DaemonSetSpec is the specification of a daemon set.
+type DaemonSetSpec struct {
+	// Selector is a label query over pods that are managed by the daemon set.
+	Selector map[string]string `json:"selector" protobuf:"1" description:"label keys and values that must match in order to be controlled by this daemon set"`
+
+	// Template is the object that describes the pod that will be created on every
+	// node that matches the template's node selector.
+	Template *PodTemplateSpec `json:"template,omitempty" protobuf:"2" description:"object that describes the pod that will be created on every node that matches the template's node selector"`
+}
+
+// DaemonSetStatus represents the current status of a daemon set.
+type DaemonSetStatus struct {
+	CurrentNumberScheduled int `json:"currentNumberScheduled" protobuf:"1" description:"number of nodes that are running a daemon pod and are supposed to run it"`
+	NumberMisscheduled     int `json:"numberMisscheduled" protobuf:"2" description:"number of nodes that are running a daemon pod but are not supposed to run it"`
+	DesiredNumberScheduled int `json:"desiredNumberScheduled" protobuf:"3" description:"number of nodes that should be running a daemon pod"`
+}
+
+// DaemonSet represents the configuration of a daemon set, which runs a copy of a
+// pod on every matching node.
+type DaemonSet struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of this daemon set.
+	Spec DaemonSetSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the daemon set; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this daemon set. This data may be out of date
+	// by some window of time.
+	Status DaemonSetStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the daemon set; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// DaemonSetList is a collection of daemon sets.
+type DaemonSetList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []DaemonSet `json:"items" protobuf:"3" description:"list of daemon sets"`
+}
+
+// DeploymentSpec is the specification of a deployment.
+type DeploymentSpec struct {
+	// Replicas is the number of desired pods.
+	Replicas int `json:"replicas" protobuf:"1" description:"number of desired pods"`
+
+	// Selector is a label query over pods that are managed by the deployment.
+	Selector map[string]string `json:"selector" protobuf:"2" description:"label keys and values that must match in order to be controlled by this deployment"`
+
+	// Template is the object that describes the pods that will be created. Every
+	// distinct template is rolled out through its own replication controller.
+	Template *PodTemplateSpec `json:"template,omitempty" protobuf:"3" description:"object that describes the pods that will be created; every distinct template is rolled out through its own replication controller"`
+
+	// Strategy is how existing pods are replaced by new ones.
+	Strategy DeploymentStrategy `json:"strategy,omitempty" protobuf:"4" description:"how existing pods are replaced by new ones"`
+
+	// RevisionHistoryLimit is the number of old replication controllers to retain
+	// so that the deployment can be rolled back. Zero retains all of them.
+	RevisionHistoryLimit int `json:"revisionHistoryLimit,omitempty" protobuf:"5" description:"number of old replication controllers to retain for rollback; zero retains all of them"`
+
+	// RollbackTo, if set, asks the deployment to return to the template of an
+	// earlier revision. It is cleared once the rollback has been applied.
+	RollbackTo *RollbackConfig `json:"rollbackTo,omitempty" protobuf:"6" description:"earlier revision whose template the deployment should return to; cleared once the rollback has been applied"`
+}
+
+// RollbackConfig names the revision a deployment should be rolled back to.
+type RollbackConfig struct {
+	// Revision to roll back to. Zero means the revision preceding the current one.
+	Revision int64 `json:"revision,omitempty" protobuf:"1" description:"revision to roll back to; zero means the revision preceding the current one"`
+}
+
+// DeploymentStrategyType is the kind of strategy used to replace pods.
+type DeploymentStrategyType string
+
+const (
+	// RecreateDeploymentStrategyType kills all existing pods before creating new ones.
+	RecreateDeploymentStrategyType DeploymentStrategyType = "Recreate"
+	// RollingUpdateDeploymentStrategyType gradually replaces old pods with new ones.
+	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+)
+
+// DeploymentStrategy describes how to replace existing pods with new ones.
+type DeploymentStrategy struct {
+	// Type of the strategy, either Recreate or RollingUpdate.
+	Type DeploymentStrategyType `json:"type,omitempty" protobuf:"1" description:"type of the strategy; Recreate or RollingUpdate"`
+
+	// RollingUpdate holds the parameters of a RollingUpdate strategy.
+	RollingUpdate *RollingUpdateDeployment `json:"rollingUpdate,omitempty" protobuf:"2" description:"parameters of a RollingUpdate strategy"`
+}
+
+// RollingUpdateDeployment controls the pace of a rolling update.
+type RollingUpdateDeployment struct {
+	// MaxUnavailable is the number of pods, or the percentage of desired pods, that
+	// may be unavailable during the update.
+	MaxUnavailable util.IntOrString `json:"maxUnavailable,omitempty" protobuf:"1" description:"number of pods, or percentage of desired pods, that may be unavailable during the update"`
+
+	// MaxSurge is the number of pods, or the percentage of desired pods, that may
+	// be created above the desired number of pods during the update.
+	MaxSurge util.IntOrString `json:"maxSurge,omitempty" protobuf:"2" description:"number of pods, or percentage of desired pods, that may be created above the desired number of pods during the update"`
+}
+
+// DeploymentStatus represents the current status of a deployment.
+type DeploymentStatus struct {
+	Replicas            int `json:"replicas" protobuf:"1" description:"total number of pods targeted by the deployment"`
+	UpdatedReplicas     int `json:"updatedReplicas" protobuf:"2" description:"number of pods running the current template"`
+	AvailableReplicas   int `json:"availableReplicas" protobuf:"3" description:"number of pods that are running and ready"`
+	UnavailableReplicas int `json:"unavailableReplicas" protobuf:"4" description:"number of pods that exist but are not yet available"`
+}
+
+// Deployment represents the desired state of a set of pods that is rolled out
+// by the server through replication controllers.
+type Deployment struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of this deployment.
+	Spec DeploymentSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the deployment; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this deployment. This data may be out of date
+	// by some window of time.
+	Status DeploymentStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the deployment; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// DeploymentList is a collection of deployments.
+type DeploymentList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []ReplicationController `json:"items" description:"list of replication controllers"`
+	Items []Deployment `json:"items" protobuf:"3" description:"list of deployments"`
+}
+
+// HorizontalPodAutoscalerSpec is the specification of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerSpec struct {
+	// ReplicationController is the name of the replication controller, in the same
+	// namespace, whose replica count is managed by the autoscaler.
+	ReplicationController string `json:"replicationController" protobuf:"1" description:"name of the replication controller, in the same namespace, whose replica count is managed by the autoscaler"`
+
+	// MinReplicas is the lower limit for the number of replicas.
+	MinReplicas int `json:"minReplicas,omitempty" protobuf:"2" description:"lower limit for the number of replicas; defaults to 1"`
+
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int `json:"maxReplicas" protobuf:"3" description:"upper limit for the number of replicas"`
+
+	// TargetCPUUtilization is the target average CPU usage of the pods, as a
+	// percentage of the CPU request of their containers.
+	TargetCPUUtilization int `json:"targetCPUUtilization,omitempty" protobuf:"4" description:"target average CPU usage of the pods, as a percentage of the CPU request of their containers; defaults to 80"`
+}
+
+// HorizontalPodAutoscalerStatus represents the current status of a horizontal pod autoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	CurrentReplicas       int        `json:"currentReplicas" protobuf:"1" description:"number of replicas last observed by the autoscaler"`
+	DesiredReplicas       int        `json:"desiredReplicas" protobuf:"2" description:"number of replicas last computed by the autoscaler"`
+	CurrentCPUUtilization *int       `json:"currentCPUUtilization,omitempty" protobuf:"3" description:"last observed average CPU usage of the pods, as a percentage of the CPU request of their containers; unset if no usage could be collected"`
+	LastScaleTime         *util.Time `json:"lastScaleTime,omitempty" protobuf:"4" description:"last time the autoscaler changed the number of replicas"`
+}
+
+// HorizontalPodAutoscaler scales the number of replicas of a replication
+// controller to keep the CPU usage of its pods close to a target.
+type HorizontalPodAutoscaler struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the desired behavior of this autoscaler.
+	Spec HorizontalPodAutoscalerSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the autoscaler; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this autoscaler. This data may be out of date
+	// by some window of time.
+	Status HorizontalPodAutoscalerStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the autoscaler; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// HorizontalPodAutoscalerList is a collection of horizontal pod autoscalers.
+type HorizontalPodAutoscalerList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []HorizontalPodAutoscaler `json:"items" protobuf:"3" description:"list of horizontal pod autoscalers"`
+}
+
+// IngressSpec describes how requests received by the router are routed to services.
+type IngressSpec struct {
+	// Backend is the service port receiving the requests which match no rule.
+	Backend *IngressBackend `json:"backend,omitempty" protobuf:"1" description:"service port receiving the requests which match no rule; such requests are refused if not set"`
+
+	// TLS lists the certificates served for the hosts of the rules.
+	TLS []IngressTLS `json:"tls,omitempty" protobuf:"2" description:"certificates served for the hosts of the rules; requests for other hosts are only served over plain HTTP"`
+
+	// Rules route requests to services by host name and URL path.
+	Rules []IngressRule `json:"rules,omitempty" protobuf:"3" description:"rules routing requests to services by host name and URL path"`
+}
+
+// IngressTLS names the secret holding the certificate served for a set of hosts.
+type IngressTLS struct {
+	Hosts      []string `json:"hosts,omitempty" protobuf:"1" description:"host names the certificate is served for"`
+	SecretName string   `json:"secretName" protobuf:"2" description:"name of the secret, in the same namespace, holding the certificate and private key under the keys tls.crt and tls.key"`
+}
+
+// IngressRule routes the requests for a host name by URL path.
+type IngressRule struct {
+	Host  string        `json:"host,omitempty" protobuf:"1" description:"fully qualified domain name matched against the host of requests; a rule without a host matches every host which has no rule of its own"`
+	Paths []IngressPath `json:"paths" protobuf:"2" description:"URL paths mapped to backends; the longest path matching a request wins"`
+}
+
+// IngressPath routes the requests under a URL path to a backend.
+type IngressPath struct {
+	Path    string         `json:"path,omitempty" protobuf:"1" description:"path matched against the leading segments of the URL path of requests; an empty path matches every request"`
+	Backend IngressBackend `json:"backend" protobuf:"2" description:"service port receiving the matching requests"`
+}
+
+// IngressBackend names a port of a service.
+type IngressBackend struct {
+	ServiceName string           `json:"serviceName" protobuf:"1" description:"name of a service in the same namespace as the ingress"`
+	ServicePort util.IntOrString `json:"servicePort" protobuf:"2" description:"port number or name of a port of the service"`
+}
+
+// IngressStatus represents the current status of an ingress.
+type IngressStatus struct {
+	Address string `json:"address,omitempty" protobuf:"1" description:"address at which the router serves the ingress"`
+}
+
+// Ingress is a collection of rules routing HTTP requests to services by host
+// name and URL path.
+type Ingress struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the rules of this ingress.
+	Spec IngressSpec `json:"spec,omitempty" protobuf:"3" description:"rules of the ingress; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this ingress. This data may be out of date
+	// by some window of time.
+	Status IngressStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the ingress; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// IngressList is a collection of ingresses.
+type IngressList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Ingress `json:"items" protobuf:"3" description:"list of ingresses"`
 }
 
 // Session Affinity Type string
@@ -746,56 +1402,95 @@ const (
 	AffinityTypeNone AffinityType = "None"
 )
 
+// ServiceType describes how a service is exposed.
+type ServiceType string
+
+const (
+	// ServiceTypeClusterIP means a service will only be accessible inside the
+	// cluster, via the portal IP.
+	ServiceTypeClusterIP ServiceType = "ClusterIP"
+
+	// ServiceTypeNodePort means a service will be exposed on one port of
+	// every node, in addition to 'ClusterIP' type.
+	ServiceTypeNodePort ServiceType = "NodePort"
+
+	// ServiceTypeLoadBalancer means a service will be exposed via an
+	// external load balancer (if the cloud provider supports it), in addition
+	// to 'NodePort' type.
+	ServiceTypeLoadBalancer ServiceType = "LoadBalancer"
+)
+
 // ServiceStatus represents the current status of a service
 type ServiceStatus struct{}
 
 // ServiceSpec describes the attributes that a user creates on a service
 type ServiceSpec struct {
-	// Port is the TCP or UDP port that will be made available to each pod for connecting to the pods
-	// proxied by this service.
-	Port int `json:"port" description:"port exposed by the service"`
-
-	// Optional: Supports "TCP" and "UDP".  Defaults to "TCP".
-	Protocol Protocol `json:"protocol,omitempty" description:"protocol for port; must be UDP or TCP; TCP if unspecified"`
+	// Required: The list of ports that are exposed by this service.
+	Ports []ServicePort `json:"ports" protobuf:"1" description:"ports exposed by the service"`
 
 	// This service will route traffic to pods having labels matching this selector. If null, no endpoints will be automatically created. If empty, all pods will be selected.
-	Selector map[string]string `json:"selector" description:"label keys and values that must match in order to receive traffic for this service; if empty, all pods are selected, if not specified, endpoints must be manually specified"`
+	Selector map[string]string `json:"selector" protobuf:"2" description:"label keys and values that must match in order to receive traffic for this service; if empty, all pods are selected, if not specified, endpoints must be manually specified"`
 
 	// PortalIP is usually assigned by the master.  If specified by the user
 	// we will try to respect it or else fail the request.  This field can
 	// not be changed by updates.
 	// Valid values are None, empty string (""), or a valid IP address
 	// None can be specified for headless services when proxying is not required
-	PortalIP string `json:"portalIP,omitempty description: IP address of the service; usually assigned by the system; if specified, it will be allocated to the service if unused, and creation of the service will fail otherwise; cannot be updated; 'None' can be specified for a headless service when proxying is not required"`
+	PortalIP string `json:"portalIP,omitempty description: IP address of the service; usually assigned by the system; if specified, it will be allocated to the service if unused, and creation of the service will fail otherwise; cannot be updated; 'None' can be specified for a headless service when proxying is not required" protobuf:"3"`
 
 	// CreateExternalLoadBalancer indicates whether a load balancer should be created for this service.
-	CreateExternalLoadBalancer bool `json:"createExternalLoadBalancer,omitempty" description:"set up a cloud-provider-specific load balancer on an external IP"`
+	// Deprecated: use Type instead.
+	CreateExternalLoadBalancer bool `json:"createExternalLoadBalancer,omitempty" protobuf:"4" description:"set up a cloud-provider-specific load balancer on an external IP; deprecated, use type LoadBalancer instead"`
+
+	// Optional: Type determines how the service will be exposed.  Valid
+	// options: ClusterIP, NodePort, LoadBalancer
+	Type ServiceType `json:"type,omitempty" protobuf:"5" description:"type of this service; must be ClusterIP, NodePort, or LoadBalancer; defaults to ClusterIP, or LoadBalancer if createExternalLoadBalancer is set"`
 
 	// PublicIPs are used by external load balancers, or can be set by
 	// users to handle external traffic that arrives at a node.
-	PublicIPs []string `json:"publicIPs,omitempty" description:"externally visible IPs (e.g. load balancers) that should be proxied to this service"`
-
-	// TargetPort is the name or number of the port on the container to direct traffic to.
-	// This is useful if the containers the service points to have multiple open ports.
-	// Optional: If unspecified, the service port is used (an identity map).
-	TargetPort util.IntOrString `json:"targetPort,omitempty" description:"number or name of the port to access on the containers belonging to pods targeted by the service; defaults to the container's first open port"`
+	PublicIPs []string `json:"publicIPs,omitempty" protobuf:"6" description:"externally visible IPs (e.g. load balancers) that should be proxied to this service"`
 
 	// Optional: Supports "ClientIP" and "None".  Used to maintain session affinity.
-	SessionAffinity AffinityType `json:"sessionAffinity,omitempty" description:"enable client IP based session affinity; must be ClientIP or None; defaults to None"`
+	SessionAffinity AffinityType `json:"sessionAffinity,omitempty" protobuf:"7" description:"enable client IP based session affinity; must be ClientIP or None; defaults to None"`
+}
+
+// ServicePort is a single port exposed by a service.
+type ServicePort struct {
+	// Optional if only one ServicePort is defined on this service: The
+	// name of this port within the service.  This must be a DNS_LABEL.
+	// All ports within a ServiceSpec must have unique names.  This maps to
+	// the 'Name' field in EndpointPort objects.
+	Name string `json:"name,omitempty" protobuf:"1" description:"the name of this port; optional if only one port is defined"`
+
+	// Optional: The IP protocol for this port.  Supports "TCP" and "UDP",
+	// default is TCP.
+	Protocol Protocol `json:"protocol,omitempty" protobuf:"2" description:"the protocol used by this port; must be UDP or TCP; TCP if unspecified"`
+
+	// Required: The port that will be exposed by this service.
+	Port int `json:"port" protobuf:"3" description:"the port number that is exposed"`
+
+	// Optional: The target port on pods selected by this service.
+	// If this is a string, it will be looked up as a named port in the
+	// target Pod's container ports.  If this is not specified, the value
+	// of Port is used (an identity map).
+	TargetPort util.IntOrString `json:"targetPort,omitempty" protobuf:"4" description:"the port to access on the pods targeted by the service; defaults to the service port"`
+
+	// The port on each node on which this service is exposed.
+	NodePort int `json:"nodePort,omitempty" protobuf:"5" description:"the port on each node on which this service is exposed when type is NodePort or LoadBalancer; usually assigned by the system; if specified, it will be allocated to the service if unused, and creation of the service will fail otherwise"`
 }
 
 // Service is a named abstraction of software service (for example, mysql) consisting of local port
 // (for example 3306) that the proxy listens on, and the selector that determines which pods
 // will answer requests sent through the proxy.
 type Service struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of a service.
-	Spec ServiceSpec `json:"spec,omitempty" description:"specification of the desired behavior of the service; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec ServiceSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the desired behavior of the service; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status represents the current status of a service.
-	Status ServiceStatus `json:"status,omitempty" description:"most recently observed status of the service; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status ServiceStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the service; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 const (
@@ -806,86 +1501,137 @@ const (
 
 // ServiceList holds a list of services.
 type ServiceList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []Service `json:"items" description:"list of services"`
+	Items []Service `json:"items" protobuf:"3" description:"list of services"`
 }
 
 // Endpoints is a collection of endpoints that implement the actual service, for example:
 // Name: "mysql", Endpoints: [{"ip": "10.10.1.1", "port": 1909}, {"ip": "10.10.2.2", "port": 8834}]
 type Endpoints struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	// Optional: The IP protocol for these endpoints. Supports "TCP" and
-	// "UDP".  Defaults to "TCP".
-	Protocol Protocol `json:"protocol,omitempty" description:"IP protocol for endpoint ports; must be UDP or TCP; TCP if unspecified"`
+	// The set of all endpoints is the union of all subsets.
+	Subsets []EndpointSubset `json:"subsets" protobuf:"3" description:"sets of addresses and ports that comprise a service"`
+}
 
-	Endpoints []Endpoint `json:"endpoints,omitempty" description:"list of endpoints corresponding to a service"`
+// EndpointSubset is a group of addresses with a common set of ports.  The
+// expanded set of endpoints is the Cartesian product of Addresses x Ports.
+// For example, given:
+//
+//	{
+//	  Addresses: [{"ip": "10.10.1.1"}, {"ip": "10.10.2.2"}],
+//	  Ports:     [{"name": "a", "port": 8675}, {"name": "b", "port": 309}]
+//	}
+//
+// The resulting set of endpoints can be viewed as:
+//
+//	a: [ 10.10.1.1:8675, 10.10.2.2:8675 ],
+//	b: [ 10.10.1.1:309, 10.10.2.2:309 ]
+type EndpointSubset struct {
+	Addresses []EndpointAddress `json:"addresses,omitempty" protobuf:"1" description:"IP addresses which offer the related ports"`
+	Ports     []EndpointPort    `json:"ports,omitempty" protobuf:"2" description:"port numbers available on the related IP addresses"`
 }
 
-// Endpoint is a single IP endpoint of a service.
-type Endpoint struct {
-	// Required: The IP of this endpoint.
+// EndpointAddress is a tuple that describes single IP address.
+type EndpointAddress struct {
+	// The IP of this endpoint.
 	// TODO: This should allow hostname or IP, see #4447.
-	IP string `json:"ip" description:"IP of this endpoint"`
-
-	// Required: The destination port to access.
-	Port int `json:"port" description:"destination port of this endpoint"`
+	IP string `json:"ip" protobuf:"1" description:"IP address of the endpoint"`
 
 	// Optional: The kubernetes object related to the entry point.
-	TargetRef *ObjectReference `json:"targetRef,omitempty" description:"reference to object providing the endpoint"`
+	TargetRef *ObjectReference `json:"targetRef,omitempty" protobuf:"2" description:"reference to object providing the endpoint"`
+}
+
+// EndpointPort is a tuple that describes a single port.
+type EndpointPort struct {
+	// The name of this port (corresponds to ServicePort.Name).  Optional
+	// if only one port is defined.  Must be a DNS_LABEL.
+	Name string `json:"name,omitempty" protobuf:"1" description:"name of this port"`
+
+	// The port number.
+	Port int `json:"port" protobuf:"2" description:"port number of the endpoint"`
+
+	// The IP protocol for this port.
+	Protocol Protocol `json:"protocol,omitempty" protobuf:"3" description:"protocol for this port; must be UDP or TCP; TCP if unspecified"`
 }
 
 // EndpointsList is a list of endpoints.
 type EndpointsList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []Endpoints `json:"items" description:"list of endpoints"`
+	Items []Endpoints `json:"items" protobuf:"3" description:"list of endpoints"`
 }
 
 // NodeSpec describes the attributes that a node is created with.
 type NodeSpec struct {
 	// Capacity represents the available resources of a node.
 	// see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/resources.md for more details.
-	Capacity ResourceList `json:"capacity,omitempty" description:"compute resource capacity of the node; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/resources.md"`
+	Capacity ResourceList `json:"capacity,omitempty" protobuf:"1" description:"compute resource capacity of the node; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/resources.md"`
 	// PodCIDR represents the pod IP range assigned to the node
-	PodCIDR string `json:"podCIDR,omitempty" description:"pod IP range assigned to the node"`
+	PodCIDR string `json:"podCIDR,omitempty" protobuf:"2" description:"pod IP range assigned to the node"`
 	// External ID of the node assigned by some machine database (e.g. a cloud provider)
-	ExternalID string `json:"externalID,omitempty" description:"external ID assigned to the node by some machine database (e.g. a cloud provider)"`
+	ExternalID string `json:"externalID,omitempty" protobuf:"3" description:"external ID assigned to the node by some machine database (e.g. a cloud provider)"`
 	// Unschedulable controls node schedulability of new pods. By default node is schedulable.
-	Unschedulable bool `json:"unschedulable,omitempty" description:"disable pod scheduling on the node"`
+	Unschedulable bool `json:"unschedulable,omitempty" protobuf:"4" description:"disable pod scheduling on the node"`
+	// Taints repel the pods which do not tolerate them.
+	Taints []Taint `json:"taints,omitempty" protobuf:"5" description:"list of taints of the node, which repel the pods that do not tolerate them"`
+}
+
+// TaintEffect is the effect of a node taint on the pods which do not tolerate it.
+type TaintEffect string
+
+const (
+	// Do not schedule new pods onto the node unless they tolerate the taint.
+	TaintEffectNoSchedule TaintEffect = "NoSchedule"
+	// Like TaintEffectNoSchedule, but the scheduler only tries to avoid the node
+	// instead of prohibiting it.
+	TaintEffectPreferNoSchedule TaintEffect = "PreferNoSchedule"
+	// Do not schedule new pods onto the node, and evict the pods already running
+	// on it, unless they tolerate the taint.
+	TaintEffectNoExecute TaintEffect = "NoExecute"
+)
+
+// Taint is attached to a node and repels the pods which do not tolerate it.
+type Taint struct {
+	// Required. The key of the taint.
+	Key string `json:"key" protobuf:"1" description:"taint key; must be a valid label key"`
+	// The value of the taint.
+	Value string `json:"value,omitempty" protobuf:"2" description:"taint value; must be a valid label value"`
+	// Required. The effect of the taint on the pods which do not tolerate it.
+	Effect TaintEffect `json:"effect" protobuf:"3" description:"effect of the taint on the pods which do not tolerate it; one of NoSchedule, PreferNoSchedule or NoExecute"`
 }
 
 // NodeSystemInfo is a set of ids/uuids to uniquely identify the node.
 type NodeSystemInfo struct {
 	// MachineID is the machine-id reported by the node
-	MachineID string `json:"machineID"`
+	MachineID string `json:"machineID" protobuf:"1"`
 	// SystemUUID is the system-uuid reported by the node
-	SystemUUID string `json:"systemUUID"`
+	SystemUUID string `json:"systemUUID" protobuf:"2"`
 }
 
 // NodeStatus is information about the current status of a node.
 type NodeStatus struct {
 	// NodePhase is the current lifecycle phase of the node.
-	Phase NodePhase `json:"phase,omitempty" description:"most recently observed lifecycle phase of the node"`
+	Phase NodePhase `json:"phase,omitempty" protobuf:"1" description:"most recently observed lifecycle phase of the node"`
 	// Conditions is an array of current node conditions.
-	Conditions []NodeCondition `json:"conditions,omitempty" description:"list of node conditions observed"`
+	Conditions []NodeCondition `json:"conditions,omitempty" protobuf:"2" description:"list of node conditions observed"`
 	// Queried from cloud provider, if available.
-	Addresses []NodeAddress `json:"addresses,omitempty" description:"list of addresses reachable to the node"`
+	Addresses []NodeAddress `json:"addresses,omitempty" protobuf:"3" description:"list of addresses reachable to the node"`
 	// NodeSystemInfo is a set of ids/uuids to uniquely identify the node
-	NodeInfo NodeSystemInfo `json:"nodeInfo,omitempty"`
+	NodeInfo NodeSystemInfo `json:"nodeInfo,omitempty" protobuf:"4"`
 }
 
 // NodeInfo is the information collected on the node.
 type NodeInfo struct {
-	TypeMeta `json:",inline"`
+	TypeMeta `json:",inline" protobuf:"1"`
 	// Capacity represents the available resources of a node
-	Capacity ResourceList `json:"capacity,omitempty"`
+	Capacity ResourceList `json:"capacity,omitempty" protobuf:"2"`
 	// NodeSystemInfo is a set of ids/uuids to uniquely identify the node
-	NodeSystemInfo `json:",inline,omitempty"`
+	NodeSystemInfo `json:",inline,omitempty" protobuf:"3"`
 }
 
 type NodePhase string
@@ -915,12 +1661,12 @@ const (
 )
 
 type NodeCondition struct {
-	Type               NodeConditionType `json:"type" description:"type of node condition, one of Reachable, Ready"`
-	Status             ConditionStatus   `json:"status" description:"status of the condition, one of Full, None, Unknown"`
-	LastProbeTime      util.Time         `json:"lastProbeTime,omitempty" description:"last time the condition was probed"`
-	LastTransitionTime util.Time         `json:"lastTransitionTime,omitempty" description:"last time the condition transit from one status to another"`
-	Reason             string            `json:"reason,omitempty" description:"(brief) reason for the condition's last transition"`
-	Message            string            `json:"message,omitempty" description:"human readable message indicating details about last transition"`
+	Type               NodeConditionType `json:"type" protobuf:"1" description:"type of node condition, one of Reachable, Ready"`
+	Status             ConditionStatus   `json:"status" protobuf:"2" description:"status of the condition, one of Full, None, Unknown"`
+	LastProbeTime      util.Time         `json:"lastProbeTime,omitempty" protobuf:"3" description:"last time the condition was probed"`
+	LastTransitionTime util.Time         `json:"lastTransitionTime,omitempty" protobuf:"4" description:"last time the condition transit from one status to another"`
+	Reason             string            `json:"reason,omitempty" protobuf:"5" description:"(brief) reason for the condition's last transition"`
+	Message            string            `json:"message,omitempty" protobuf:"6" description:"human readable message indicating details about last transition"`
 }
 
 type NodeAddressType string
@@ -933,8 +1679,8 @@ const (
 )
 
 type NodeAddress struct {
-	Type    NodeAddressType `json:"type"`
-	Address string          `json:"address"`
+	Type    NodeAddressType `json:"type" protobuf:"1"`
+	Address string          `json:"address" protobuf:"2"`
 }
 
 // ResourceName is the name identifying various resources in a ResourceList.
@@ -945,6 +1691,8 @@ const (
 	ResourceCPU ResourceName = "cpu"
 	// Memory, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)
 	ResourceMemory ResourceName = "memory"
+	// Volume size, in bytes (e,g. 5Gi = 5GiB = 5 * 1024 * 1024 * 1024)
+	ResourceStorage ResourceName = "storage"
 )
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -953,22 +1701,22 @@ type ResourceList map[ResourceName]resource.Quantity
 // Node is a worker node in Kubernetes.
 // The name of the node according to etcd is in ID.
 type Node struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of a node.
-	Spec NodeSpec `json:"spec,omitempty" description:"specification of a node; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec NodeSpec `json:"spec,omitempty" protobuf:"3" description:"specification of a node; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status describes the current status of a Node
-	Status NodeStatus `json:"status,omitempty" description:"most recently observed status of the node; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status NodeStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the node; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // NodeList is a list of minions.
 type NodeList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []Node `json:"items" description:"list of nodes"`
+	Items []Node `json:"items" protobuf:"3" description:"list of nodes"`
 }
 
 type FinalizerName string
@@ -981,13 +1729,13 @@ const (
 // NamespaceSpec describes the attributes on a Namespace
 type NamespaceSpec struct {
 	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage
-	Finalizers []FinalizerName `json:"finalizers,omitempty" description:"an opaque list of values that must be empty to permanently remove object from storage"`
+	Finalizers []FinalizerName `json:"finalizers,omitempty" protobuf:"1" description:"an opaque list of values that must be empty to permanently remove object from storage"`
 }
 
 // NamespaceStatus is information about the current status of a Namespace.
 type NamespaceStatus struct {
 	// Phase is the current lifecycle phase of the namespace.
-	Phase NamespacePhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the namespace"`
+	Phase NamespacePhase `json:"phase,omitempty" protobuf:"1" description:"phase is the current lifecycle phase of the namespace"`
 }
 
 type NamespacePhase string
@@ -1003,66 +1751,82 @@ const (
 // A namespace provides a scope for Names.
 // Use of multiple namespaces is optional
 type Namespace struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the behavior of the Namespace.
-	Spec NamespaceSpec `json:"spec,omitempty" description:"spec defines the behavior of the Namespace; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec NamespaceSpec `json:"spec,omitempty" protobuf:"3" description:"spec defines the behavior of the Namespace; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status describes the current status of a Namespace
-	Status NamespaceStatus `json:"status,omitempty" description:"status describes the current status of a Namespace; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status NamespaceStatus `json:"status,omitempty" protobuf:"4" description:"status describes the current status of a Namespace; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // NamespaceList is a list of Namespaces.
 type NamespaceList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Items is the list of Namespace objects in the list
-	Items []Namespace `json:"items"  description:"items is the list of Namespace objects in the list"`
+	Items []Namespace `json:"items" protobuf:"3"  description:"items is the list of Namespace objects in the list"`
 }
 
 // Binding ties one object to another - for example, a pod is bound to a node by a scheduler.
 type Binding struct {
-	TypeMeta `json:",inline"`
+	TypeMeta `json:",inline" protobuf:"1"`
 	// ObjectMeta describes the object that is being bound.
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Target is the object to bind to.
-	Target ObjectReference `json:"target" description:"an object to bind to"`
+	Target ObjectReference `json:"target" protobuf:"3" description:"an object to bind to"`
+}
+
+// Eviction is posted to the eviction subresource of a pod to delete it, unless that
+// would leave fewer healthy pods than one of its disruption budgets allows.
+type Eviction struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	// ObjectMeta describes the pod being evicted.
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// DeleteOptions may be provided to delete the pod with a specific grace period.
+	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty" protobuf:"3" description:"options of the deletion of the pod"`
 }
 
 // DeleteOptions may be provided when deleting an API object
 type DeleteOptions struct {
-	TypeMeta `json:",inline"`
+	TypeMeta `json:",inline" protobuf:"1"`
 
 	// Optional duration in seconds before the object should be deleted. Value must be non-negative integer.
 	// The value zero indicates delete immediately. If this value is nil, the default grace period for the
 	// specified type will be used.
-	GracePeriodSeconds *int64 `json:"gracePeriodSeconds" description:"the duration in seconds to wait before deleting this object; defaults to a per object value if not specified; zero means delete immediately"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds" protobuf:"2" description:"the duration in seconds to wait before deleting this object; defaults to a per object value if not specified; zero means delete immediately"`
+
+	// Should the dependents of the object be orphaned. If true, the object is kept with the
+	// "orphan" finalizer until the garbage collector has removed it from the owner references
+	// of its dependents; otherwise the garbage collector deletes its dependents.
+	OrphanDependents *bool `json:"orphanDependents,omitempty" protobuf:"3" description:"whether the dependents of the object are orphaned instead of deleted by the garbage collector"`
 }
 
 // Status is a return value for calls that don't return other objects.
 type Status struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// One of: "Success" or "Failure"
-	Status string `json:"status,omitempty" description:"status of the operation; either Success, or Failure"`
+	Status string `json:"status,omitempty" protobuf:"3" description:"status of the operation; either Success, or Failure"`
 	// A human-readable description of the status of this operation.
-	Message string `json:"message,omitempty" description:"human-readable description of the status of this operation"`
+	Message string `json:"message,omitempty" protobuf:"4" description:"human-readable description of the status of this operation"`
 	// A machine-readable description of why this operation is in the
 	// "Failure" status. If this value is empty there
 	// is no information available. A Reason clarifies an HTTP status
 	// code but does not override it.
-	Reason StatusReason `json:"reason,omitempty" description:"machine-readable description of why this operation is in the 'Failure' status; if this value is empty there is no information available; a reason clarifies an HTTP status code but does not override it"`
+	Reason StatusReason `json:"reason,omitempty" protobuf:"5" description:"machine-readable description of why this operation is in the 'Failure' status; if this value is empty there is no information available; a reason clarifies an HTTP status code but does not override it"`
 	// Extended data associated with the reason.  Each reason may define its
 	// own extended details. This field is optional and the data returned
 	// is not guaranteed to conform to any schema except that defined by
 	// the reason type.
-	Details *StatusDetails `json:"details,omitempty" description:"extended data associated with the reason; each reason may define its own extended details; this field is optional and the data returned is not guaranteed to conform to any schema except that defined by the reason type"`
+	Details *StatusDetails `json:"details,omitempty" protobuf:"6" description:"extended data associated with the reason; each reason may define its own extended details; this field is optional and the data returned is not guaranteed to conform to any schema except that defined by the reason type"`
 	// Suggested HTTP return code for this status, 0 if not set.
-	Code int `json:"code,omitempty" description:"suggested HTTP return code for this status; 0 if not set"`
+	Code int `json:"code,omitempty" protobuf:"7" description:"suggested HTTP return code for this status; 0 if not set"`
 }
 
 // StatusDetails is a set of additional properties that MAY be set by the
@@ -1074,13 +1838,13 @@ type Status struct {
 type StatusDetails struct {
 	// The ID attribute of the resource associated with the status StatusReason
 	// (when there is a single ID which can be described).
-	ID string `json:"id,omitempty" description:"the ID attribute of the resource associated with the status StatusReason (when there is a single ID which can be described)"`
+	ID string `json:"id,omitempty" protobuf:"1" description:"the ID attribute of the resource associated with the status StatusReason (when there is a single ID which can be described)"`
 	// The kind attribute of the resource associated with the status StatusReason.
 	// On some operations may differ from the requested resource Kind.
-	Kind string `json:"kind,omitempty" description:"the kind attribute of the resource associated with the status StatusReason; on some operations may differ from the requested resource Kind"`
+	Kind string `json:"kind,omitempty" protobuf:"2" description:"the kind attribute of the resource associated with the status StatusReason; on some operations may differ from the requested resource Kind"`
 	// The Causes array includes more details associated with the StatusReason
 	// failure. Not all StatusReasons may provide detailed causes.
-	Causes []StatusCause `json:"causes,omitempty" description:"the Causes array includes more details associated with the StatusReason failure; not all StatusReasons may provide detailed causes"`
+	Causes []StatusCause `json:"causes,omitempty" protobuf:"3" description:"the Causes array includes more details associated with the StatusReason failure; not all StatusReasons may provide detailed causes"`
 }
 
 // Values of Status.Status
@@ -1155,10 +1919,10 @@ const (
 type StatusCause struct {
 	// A machine-readable description of the cause of the error. If this value is
 	// empty there is no information available.
-	Type CauseType `json:"reason,omitempty" description:"machine-readable description of the cause of the error; if this value is empty there is no information available"`
+	Type CauseType `json:"reason,omitempty" protobuf:"1" description:"machine-readable description of the cause of the error; if this value is empty there is no information available"`
 	// A human-readable description of the cause of the error.  This field may be
 	// presented as-is to a reader.
-	Message string `json:"message,omitempty" description:"human-readable description of the cause of the error; this field may be presented as-is to a reader"`
+	Message string `json:"message,omitempty" protobuf:"2" description:"human-readable description of the cause of the error; this field may be presented as-is to a reader"`
 	// The field of the resource that has caused this error, as named by its JSON
 	// serialization. May include dot and postfix notation for nested attributes.
 	// Arrays are zero-indexed.  Fields may appear more than once in an array of
@@ -1168,7 +1932,7 @@ type StatusCause struct {
 	// Examples:
 	//   "name" - the field "name" on the current resource
 	//   "items[0].name" - the field "name" on the first array entry in "items"
-	Field string `json:"field,omitempty" description:"field of the resource that has caused this error, as named by its JSON serialization; may include dot and postfix notation for nested attributes; arrays are zero-indexed; fields may appear more than once in an array of causes due to fields having multiple errors"`
+	Field string `json:"field,omitempty" protobuf:"3" description:"field of the resource that has caused this error, as named by its JSON serialization; may include dot and postfix notation for nested attributes; arrays are zero-indexed; fields may appear more than once in an array of causes due to fields having multiple errors"`
 }
 
 // CauseType is a machine readable value providing more detail about what
@@ -1196,12 +1960,12 @@ const (
 
 // ObjectReference contains enough information to let you inspect or modify the referred object.
 type ObjectReference struct {
-	Kind            string    `json:"kind,omitempty" description:"kind of the referent"`
-	Namespace       string    `json:"namespace,omitempty" description:"namespace of the referent"`
-	Name            string    `json:"name,omitempty" description:"name of the referent"`
-	UID             types.UID `json:"uid,omitempty" description:"uid of the referent"`
-	APIVersion      string    `json:"apiVersion,omitempty" description:"API version of the referent"`
-	ResourceVersion string    `json:"resourceVersion,omitempty" description:"specific resourceVersion to which this reference is made, if any: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
+	Kind            string    `json:"kind,omitempty" protobuf:"1" description:"kind of the referent"`
+	Namespace       string    `json:"namespace,omitempty" protobuf:"2" description:"namespace of the referent"`
+	Name            string    `json:"name,omitempty" protobuf:"3" description:"name of the referent"`
+	UID             types.UID `json:"uid,omitempty" protobuf:"4" description:"uid of the referent"`
+	APIVersion      string    `json:"apiVersion,omitempty" protobuf:"5" description:"API version of the referent"`
+	ResourceVersion string    `json:"resourceVersion,omitempty" protobuf:"6" description:"specific resourceVersion to which this reference is made, if any: https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#concurrency-control-and-consistency"`
 
 	// Optional. If referring to a piece of an object instead of an entire object, this string
 	// should contain information to identify the sub-object. For example, if the object
@@ -1211,61 +1975,61 @@ type ObjectReference struct {
 	// index 2 in this pod). This syntax is chosen only to have some well-defined way of
 	// referencing a part of an object.
 	// TODO: this design is not final and this field is subject to change in the future.
-	FieldPath string `json:"fieldPath,omitempty" description:"if referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]"`
+	FieldPath string `json:"fieldPath,omitempty" protobuf:"7" description:"if referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]"`
 }
 
 type EventSource struct {
 	// Component from which the event is generated.
-	Component string `json:"component,omitempty" description:"component that generated the event"`
+	Component string `json:"component,omitempty" protobuf:"1" description:"component that generated the event"`
 	// Host name on which the event is generated.
-	Host string `json:"host,omitempty" description:"name of the host where the event is generated"`
+	Host string `json:"host,omitempty" protobuf:"2" description:"name of the host where the event is generated"`
 }
 
 // Event is a report of an event somewhere in the cluster.
 // TODO: Decide whether to store these separately or with the object they apply to.
 type Event struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Required. The object that this event is about.
-	InvolvedObject ObjectReference `json:"involvedObject,omitempty" description:"object this event is about"`
+	InvolvedObject ObjectReference `json:"involvedObject,omitempty" protobuf:"3" description:"object this event is about"`
 
 	// Optional; this should be a short, machine understandable string that gives the reason
 	// for this event being generated.
 	// TODO: provide exact specification for format.
-	Reason string `json:"reason,omitempty" description:"short, machine understandable string that gives the reason for the transition into the object's current status"`
+	Reason string `json:"reason,omitempty" protobuf:"4" description:"short, machine understandable string that gives the reason for the transition into the object's current status"`
 
 	// Optional. A human-readable description of the status of this operation.
 	// TODO: decide on maximum length.
-	Message string `json:"message,omitempty" description:"human-readable description of the status of this operation"`
+	Message string `json:"message,omitempty" protobuf:"5" description:"human-readable description of the status of this operation"`
 
 	// Optional. The component reporting this event. Should be a short machine understandable string.
-	Source EventSource `json:"source,omitempty" description:"component reporting this event"`
+	Source EventSource `json:"source,omitempty" protobuf:"6" description:"component reporting this event"`
 
 	// The time at which the event was first recorded. (Time of server receipt is in TypeMeta.)
-	FirstTimestamp util.Time `json:"firstTimestamp,omitempty" description:"the time at which the event was first recorded"`
+	FirstTimestamp util.Time `json:"firstTimestamp,omitempty" protobuf:"7" description:"the time at which the event was first recorded"`
 
 	// The time at which the most recent occurance of this event was recorded.
-	LastTimestamp util.Time `json:"lastTimestamp,omitempty" description:"the time at which the most recent occurance of this event was recorded"`
+	LastTimestamp util.Time `json:"lastTimestamp,omitempty" protobuf:"8" description:"the time at which the most recent occurance of this event was recorded"`
 
 	// The number of times this event has occurred.
-	Count int `json:"count,omitempty" description:"the number of times this event has occurred"`
+	Count int `json:"count,omitempty" protobuf:"9" description:"the number of times this event has occurred"`
 }
 
 // EventList is a list of events.
 type EventList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []Event `json:"items" description:"list of events"`
+	Items []Event `json:"items" protobuf:"3" description:"list of events"`
 }
 
 // List holds a list of objects, which may not be known by the server.
 type List struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
-	Items []runtime.RawExtension `json:"items" description:"list of objects"`
+	Items []runtime.RawExtension `json:"items" protobuf:"3" description:"list of objects"`
 }
 
 // A type of object that is limited
@@ -1281,35 +2045,35 @@ const (
 // LimitRangeItem defines a min/max usage limit for any resource that matches on kind
 type LimitRangeItem struct {
 	// Type of resource that this limit applies to
-	Type LimitType `json:"type,omitempty" description:"type of resource that this limit applies to"`
+	Type LimitType `json:"type,omitempty" protobuf:"1" description:"type of resource that this limit applies to"`
 	// Max usage constraints on this kind by resource name
-	Max ResourceList `json:"max,omitempty" description:"max usage constraints on this kind by resource name"`
+	Max ResourceList `json:"max,omitempty" protobuf:"2" description:"max usage constraints on this kind by resource name"`
 	// Min usage constraints on this kind by resource name
-	Min ResourceList `json:"min,omitempty" description:"min usage constraints on this kind by resource name"`
+	Min ResourceList `json:"min,omitempty" protobuf:"3" description:"min usage constraints on this kind by resource name"`
 }
 
 // LimitRangeSpec defines a min/max usage limit for resources that match on kind
 type LimitRangeSpec struct {
 	// Limits is the list of LimitRangeItem objects that are enforced
-	Limits []LimitRangeItem `json:"limits" description:"limits is the list of LimitRangeItem objects that are enforced"`
+	Limits []LimitRangeItem `json:"limits" protobuf:"1" description:"limits is the list of LimitRangeItem objects that are enforced"`
 }
 
 // LimitRange sets resource usage limits for each kind of resource in a Namespace
 type LimitRange struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the limits enforced
-	Spec LimitRangeSpec `json:"spec,omitempty" description:"spec defines the limits enforced; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec LimitRangeSpec `json:"spec,omitempty" protobuf:"3" description:"spec defines the limits enforced; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // LimitRangeList is a list of LimitRange items.
 type LimitRangeList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Items is a list of LimitRange objects
-	Items []LimitRange `json:"items" description:"items is a list of LimitRange objects"`
+	Items []LimitRange `json:"items" protobuf:"3" description:"items is a list of LimitRange objects"`
 }
 
 // The following identify resource constants for Kubernetes object types
@@ -1322,56 +2086,62 @@ const (
 	ResourceReplicationControllers ResourceName = "replicationcontrollers"
 	// ResourceQuotas, number
 	ResourceQuotas ResourceName = "resourcequotas"
+	// ResourceLimitsCPU, the CPU limits of the containers of pods, in cores.
+	// Quota on ResourceCPU applies to their CPU requests.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory, the memory limits of the containers of pods, in bytes.
+	// Quota on ResourceMemory applies to their memory requests.
+	ResourceLimitsMemory ResourceName = "limits.memory"
 )
 
 // ResourceQuotaSpec defines the desired hard limits to enforce for Quota
 type ResourceQuotaSpec struct {
 	// Hard is the set of desired hard limits for each named resource
-	Hard ResourceList `json:"hard,omitempty" description:"hard is the set of desired hard limits for each named resource"`
+	Hard ResourceList `json:"hard,omitempty" protobuf:"1" description:"hard is the set of desired hard limits for each named resource"`
 }
 
 // ResourceQuotaStatus defines the enforced hard limits and observed use
 type ResourceQuotaStatus struct {
 	// Hard is the set of enforced hard limits for each named resource
-	Hard ResourceList `json:"hard,omitempty" description:"hard is the set of enforced hard limits for each named resource"`
+	Hard ResourceList `json:"hard,omitempty" protobuf:"1" description:"hard is the set of enforced hard limits for each named resource"`
 	// Used is the current observed total usage of the resource in the namespace
-	Used ResourceList `json:"used,omitempty" description:"used is the current observed total usage of the resource in the namespace"`
+	Used ResourceList `json:"used,omitempty" protobuf:"2" description:"used is the current observed total usage of the resource in the namespace"`
 }
 
 // ResourceQuota sets aggregate quota restrictions enforced per namespace
 type ResourceQuota struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Spec defines the desired quota
-	Spec ResourceQuotaSpec `json:"spec,omitempty" description:"spec defines the desired quota; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Spec ResourceQuotaSpec `json:"spec,omitempty" protobuf:"3" description:"spec defines the desired quota; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 
 	// Status defines the actual enforced quota and its current usage
-	Status ResourceQuotaStatus `json:"status,omitempty" description:"status defines the actual enforced quota and current usage; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+	Status ResourceQuotaStatus `json:"status,omitempty" protobuf:"4" description:"status defines the actual enforced quota and current usage; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
 }
 
 // ResourceQuotaList is a list of ResourceQuota items
 type ResourceQuotaList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Items is a list of ResourceQuota objects
-	Items []ResourceQuota `json:"items" description:"items is a list of ResourceQuota objects"`
+	Items []ResourceQuota `json:"items" protobuf:"3" description:"items is a list of ResourceQuota objects"`
 }
 
 // Secret holds secret data of a certain type.  The total bytes of the values in
 // the Data field must be less than MaxSecretSize bytes.
 type Secret struct {
-	TypeMeta   `json:",inline"`
-	ObjectMeta `json:"metadata,omitempty" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
 
 	// Data contains the secret data.  Each key must be a valid DNS_SUBDOMAIN.
 	// The serialized form of the secret data is a base64 encoded string,
 	// representing the arbitrary (possibly non-string) data value here.
-	Data map[string][]byte `json:"data,omitempty" description:"data contains the secret data.  Each key must be a valid DNS_SUBDOMAIN.  Each value must be a base64 encoded string"`
+	Data map[string][]byte `json:"data,omitempty" protobuf:"3" description:"data contains the secret data.  Each key must be a valid DNS_SUBDOMAIN.  Each value must be a base64 encoded string"`
 
 	// Used to facilitate programatic handling of secret data.
-	Type SecretType `json:"type,omitempty" description:"type facilitates programmatic handling of secret data"`
+	Type SecretType `json:"type,omitempty" protobuf:"4" description:"type facilitates programmatic handling of secret data"`
 }
 
 const MaxSecretSize = 1 * 1024 * 1024
@@ -1380,11 +2150,191 @@ type SecretType string
 
 const (
 	SecretTypeOpaque SecretType = "Opaque" // Default; arbitrary user-defined data
+
+	// SecretTypeServiceAccountToken contains a token that identifies a service account to the API
+	//
+	// Required fields:
+	// - Secret.Annotations["kubernetes.io/service-account.name"] - the name of the ServiceAccount the token identifies
+	// - Secret.Annotations["kubernetes.io/service-account.uid"] - the UID of the ServiceAccount the token identifies
+	// - Secret.Data["token"] - a token that identifies the service account to the API
+	SecretTypeServiceAccountToken SecretType = "kubernetes.io/service-account-token"
+
+	// ServiceAccountNameKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountNameKey = "kubernetes.io/service-account.name"
+	// ServiceAccountUIDKey is the key of the required annotation for SecretTypeServiceAccountToken secrets
+	ServiceAccountUIDKey = "kubernetes.io/service-account.uid"
+	// ServiceAccountTokenKey is the key of the required data for SecretTypeServiceAccountToken secrets
+	ServiceAccountTokenKey = "token"
 )
 
 type SecretList struct {
-	TypeMeta `json:",inline"`
-	ListMeta `json:"metadata,omitempty" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []Secret `json:"items" protobuf:"3" description:"items is a list of secret objects"`
+}
+
+// ServiceAccount binds together a name, understood by users and perhaps by peripheral
+// systems, with a principal that can be authenticated and a set of secrets.
+type ServiceAccount struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount
+	Secrets []ObjectReference `json:"secrets" protobuf:"3" description:"list of secrets that can be used by pods running as this service account"`
+}
+
+// ServiceAccountList is a list of ServiceAccount objects
+type ServiceAccountList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ServiceAccount `json:"items" protobuf:"3" description:"list of ServiceAccounts"`
+}
+
+// ConfigMap holds configuration data for pods to consume.
+type ConfigMap struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Data contains the configuration data. Each key must be a valid DNS_SUBDOMAIN.
+	Data map[string]string `json:"data,omitempty" protobuf:"3" description:"data contains the configuration data; each key must be a valid DNS_SUBDOMAIN"`
+}
+
+// ConfigMapList is a list of ConfigMaps.
+type ConfigMapList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []ConfigMap `json:"items" protobuf:"3" description:"list of config maps"`
+}
+
+// PriorityClass maps a name to the priority of the pods referring to it. The pods
+// with a higher priority are scheduled first, and may preempt the pods with a lower
+// priority when no node has room for them. PriorityClasses are not namespaced.
+type PriorityClass struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Value is the priority of the pods of the class. The higher, the more important.
+	Value int `json:"value" protobuf:"3" description:"priority of the pods of the class; the higher, the more important"`
+	// GlobalDefault makes the class the default of the pods which do not name one. At
+	// most one class should be the global default.
+	GlobalDefault bool `json:"globalDefault,omitempty" protobuf:"4" description:"make the class the default of the pods which do not name one"`
+	// Description is a human readable explanation of when to use the class.
+	Description string `json:"description,omitempty" protobuf:"5" description:"human readable explanation of when to use the class"`
+}
+
+// PriorityClassList is a list of PriorityClasses.
+type PriorityClassList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []PriorityClass `json:"items" protobuf:"3" description:"list of priority classes"`
+}
+
+// PodDisruptionBudgetSpec is the specification of a pod disruption budget.
+type PodDisruptionBudgetSpec struct {
+	// MinAvailable is the number of pods selected by the budget which must still be
+	// healthy after an eviction.
+	MinAvailable int `json:"minAvailable" protobuf:"1" description:"number of pods selected by the budget which must still be healthy after an eviction"`
+
+	// Selector is a label query over the pods whose evictions are limited by the budget.
+	Selector map[string]string `json:"selector" protobuf:"2" description:"label keys and values that must match in order for the evictions of a pod to be limited by this budget"`
+}
+
+// PodDisruptionBudgetStatus represents the current status of a pod disruption budget.
+type PodDisruptionBudgetStatus struct {
+	// PodDisruptionsAllowed is the number of pods which may currently be evicted.
+	PodDisruptionsAllowed int `json:"podDisruptionsAllowed" protobuf:"1" description:"number of pods which may currently be evicted"`
+
+	// CurrentHealthy is the number of healthy pods selected by the budget.
+	CurrentHealthy int `json:"currentHealthy" protobuf:"2" description:"number of healthy pods selected by the budget"`
+
+	// DesiredHealthy is the minimum number of healthy pods desired.
+	DesiredHealthy int `json:"desiredHealthy" protobuf:"3" description:"minimum number of healthy pods desired"`
+
+	// ExpectedPods is the number of pods selected by the budget which are neither
+	// terminated nor being deleted.
+	ExpectedPods int `json:"expectedPods" protobuf:"4" description:"number of pods selected by the budget which are neither terminated nor being deleted"`
+
+	// DisruptedPods maps the names of the pods whose eviction was admitted to the time
+	// of the eviction, until the controller observes that they are being deleted. These
+	// pods are not counted as healthy in the meantime.
+	DisruptedPods map[string]util.Time `json:"disruptedPods,omitempty" protobuf:"5" description:"map of the pods whose eviction was admitted but whose deletion was not observed yet to the time of the eviction"`
+}
+
+// PodDisruptionBudget limits the number of pods of a group which may be evicted at
+// the same time, such as the members of a quorum.
+type PodDisruptionBudget struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	// Spec defines the pods selected by this budget, and how many of them must stay healthy.
+	Spec PodDisruptionBudgetSpec `json:"spec,omitempty" protobuf:"3" description:"specification of the pods selected by the budget, and of how many of them must stay healthy; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+
+	// Status is the current status of this budget. This data may be out of date by some
+	// window of time.
+	Status PodDisruptionBudgetStatus `json:"status,omitempty" protobuf:"4" description:"most recently observed status of the budget; populated by the system, read-only; https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#spec-and-status"`
+}
+
+// PodDisruptionBudgetList is a collection of pod disruption budgets.
+type PodDisruptionBudgetList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see https://github.com/GoogleCloudPlatform/kubernetes/blob/master/docs/api-conventions.md#metadata"`
+
+	Items []PodDisruptionBudget `json:"items" protobuf:"3" description:"list of pod disruption budgets"`
+}
+
+// ThirdPartyResource registers a kind of object which the API server stores and serves
+// without knowing its schema. Its name is made of the kind, in lower case with dashes
+// between the words, followed by the API group of the kind: "database-cluster.example.com"
+// registers the kind DatabaseCluster, served under /thirdparty/example.com/{version}.
+type ThirdPartyResource struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Description is a free-form description of the kind, for humans.
+	Description string `json:"description,omitempty" protobuf:"3" description:"free-form description of the kind"`
+
+	// Versions are the API versions the kind is served in.
+	Versions []string `json:"versions,omitempty" protobuf:"4" description:"API versions the kind is served in"`
+}
+
+// ThirdPartyResourceList is a list of ThirdPartyResources.
+type ThirdPartyResourceList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []ThirdPartyResource `json:"items" protobuf:"3" description:"list of third party resources"`
+}
+
+// ThirdPartyResourceData is an object of a kind registered by a ThirdPartyResource, as
+// it is stored. The content of the object other than its metadata is kept as opaque JSON.
+type ThirdPartyResourceData struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	// Data is the JSON content of the object, without its kind, API version and metadata.
+	Data []byte `json:"data,omitempty" protobuf:"3" description:"JSON content of the object, without its kind, API version and metadata"`
+}
+
+// ThirdPartyResourceDataList is a list of ThirdPartyResourceData.
+type ThirdPartyResourceDataList struct {
+	TypeMeta `json:",inline" protobuf:"1"`
+	ListMeta `json:"metadata,omitempty" protobuf:"2" description:"standard list metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
+
+	Items []ThirdPartyResourceData `json:"items" protobuf:"3" description:"list of third party resource data"`
+}
+
+// RangeAllocation is the persisted state of an allocator of a range of values, such
+// as the node ports of services.
+type RangeAllocation struct {
+	TypeMeta   `json:",inline" protobuf:"1"`
+	ObjectMeta `json:"metadata,omitempty" protobuf:"2" description:"standard object metadata; see http://docs.k8s.io/api-conventions.md#metadata"`
 
-	Items []Secret `json:"items" description:"items is a list of secret objects"`
+	// Range is the range the allocator hands out values from, such as "30000-32767".
+	Range string `json:"range" protobuf:"3" description:"range the allocator hands out values from"`
+	// Data is a bit array with a bit set for every allocated value of the range.
+	Data []byte `json:"data" protobuf:"4" description:"bit array with a bit set for every allocated value of the range"`
 }
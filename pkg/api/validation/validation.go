@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -108,6 +109,41 @@ func ValidateReplicationControllerName(name string, prefix bool) (bool, string)
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateJobName can be used to check whether the given job name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateJobName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDaemonSetName can be used to check whether the given daemon set name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDaemonSetName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateDeploymentName can be used to check whether the given deployment name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateDeploymentName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateHorizontalPodAutoscalerName can be used to check whether the given autoscaler name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateHorizontalPodAutoscalerName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateIngressName can be used to check whether the given ingress name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateIngressName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // ValidateServiceName can be used to check whether the given service name is valid.
 // Prefix indicates this name will be used as part of generation, in which case
 // trailing dashes are allowed.
@@ -151,6 +187,73 @@ func ValidateSecretName(name string, prefix bool) (bool, string) {
 	return nameIsDNSSubdomain(name, prefix)
 }
 
+// ValidateServiceAccountName can be used to check whether the given service account name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateServiceAccountName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateConfigMapName can be used to check whether the given config map name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateConfigMapName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePersistentVolumeName can be used to check whether the given persistent volume name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePersistentVolumeName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePriorityClassName can be used to check whether the given priority class name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePriorityClassName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePodDisruptionBudgetName can be used to check whether the given pod disruption budget
+// name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePodDisruptionBudgetName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateThirdPartyResourceName can be used to check whether the given third party resource
+// name is valid. The name is made of the kind and of the API group of the kind, which must
+// be a domain name of at least two segments.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateThirdPartyResourceName(name string, prefix bool) (bool, string) {
+	if ok, msg := nameIsDNSSubdomain(name, prefix); !ok {
+		return false, msg
+	}
+	if strings.Count(name, ".") < 2 {
+		return false, "must be the kind followed by a domain name of at least two segments, such as database-cluster.example.com"
+	}
+	return true, ""
+}
+
+// ValidateThirdPartyResourceDataName can be used to check whether the given name of an
+// object of a third party resource is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateThirdPartyResourceDataName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidatePersistentVolumeClaimName can be used to check whether the given persistent volume
+// claim name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidatePersistentVolumeClaimName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
 // nameIsDNSSubdomain is a ValidateNameFunc for names that must be a DNS subdomain.
 func nameIsDNSSubdomain(name string, prefix bool) (bool, string) {
 	if prefix {
@@ -206,7 +309,37 @@ func ValidateObjectMeta(meta *api.ObjectMeta, requiresNamespace bool, nameFn Val
 	}
 	allErrs = append(allErrs, ValidateLabels(meta.Labels, "labels")...)
 	allErrs = append(allErrs, ValidateAnnotations(meta.Annotations, "annotations")...)
+	allErrs = append(allErrs, validateOwnerReferences(meta.OwnerReferences).Prefix("ownerReferences")...)
+	allErrs = append(allErrs, validateFinalizers(meta.Finalizers).Prefix("finalizers")...)
+
+	return allErrs
+}
+
+func validateOwnerReferences(refs []api.OwnerReference) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, ref := range refs {
+		refErrs := errs.ValidationErrorList{}
+		if len(ref.Kind) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("kind"))
+		}
+		if len(ref.Name) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("name"))
+		}
+		if len(ref.UID) == 0 {
+			refErrs = append(refErrs, errs.NewFieldRequired("uid"))
+		}
+		allErrs = append(allErrs, refErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
 
+func validateFinalizers(finalizers []string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, finalizer := range finalizers {
+		if !util.IsQualifiedName(finalizer) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("[%d]", i), finalizer, qualifiedNameErrorMsg))
+		}
+	}
 	return allErrs
 }
 
@@ -224,6 +357,9 @@ func ValidateObjectMetaUpdate(old, meta *api.ObjectMeta) errs.ValidationErrorLis
 	} else {
 		meta.CreationTimestamp = old.CreationTimestamp
 	}
+	// the deletion timestamp and grace period may only be changed by deleting the object
+	meta.DeletionTimestamp = old.DeletionTimestamp
+	meta.DeletionGracePeriodSeconds = old.DeletionGracePeriodSeconds
 
 	if old.Name != meta.Name {
 		allErrs = append(allErrs, errs.NewFieldInvalid("name", meta.Name, "field is immutable"))
@@ -237,9 +373,20 @@ func ValidateObjectMetaUpdate(old, meta *api.ObjectMeta) errs.ValidationErrorLis
 	if old.CreationTimestamp != meta.CreationTimestamp {
 		allErrs = append(allErrs, errs.NewFieldInvalid("creationTimestamp", meta.CreationTimestamp, "field is immutable"))
 	}
+	if old.DeletionTimestamp != nil {
+		// the finalizers of an object being deleted may only be cleared
+		oldFinalizers := util.NewStringSet(old.Finalizers...)
+		for _, finalizer := range meta.Finalizers {
+			if !oldFinalizers.Has(finalizer) {
+				allErrs = append(allErrs, errs.NewFieldInvalid("finalizers", finalizer, "may not be added to an object being deleted"))
+			}
+		}
+	}
 
 	allErrs = append(allErrs, ValidateLabels(meta.Labels, "labels")...)
 	allErrs = append(allErrs, ValidateAnnotations(meta.Annotations, "annotations")...)
+	allErrs = append(allErrs, validateOwnerReferences(meta.OwnerReferences).Prefix("ownerReferences")...)
+	allErrs = append(allErrs, validateFinalizers(meta.Finalizers).Prefix("finalizers")...)
 
 	return allErrs
 }
@@ -293,6 +440,18 @@ func validateSource(source *api.VolumeSource) errs.ValidationErrorList {
 		numVolumes++
 		allErrs = append(allErrs, validateNFS(source.NFS).Prefix("nfs")...)
 	}
+	if source.PersistentVolumeClaim != nil {
+		numVolumes++
+		allErrs = append(allErrs, validatePersistentClaimVolumeSource(source.PersistentVolumeClaim).Prefix("persistentVolumeClaim")...)
+	}
+	if source.ConfigMap != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateConfigMapVolumeSource(source.ConfigMap).Prefix("configMap")...)
+	}
+	if source.DownwardAPI != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateDownwardAPIVolumeSource(source.DownwardAPI).Prefix("downwardAPI")...)
+	}
 	if numVolumes != 1 {
 		allErrs = append(allErrs, errs.NewFieldInvalid("", source, "exactly 1 volume type is required"))
 	}
@@ -351,6 +510,187 @@ func validateNFS(nfs *api.NFSVolumeSource) errs.ValidationErrorList {
 	return allErrs
 }
 
+func validatePersistentClaimVolumeSource(claim *api.PersistentVolumeClaimVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if claim.ClaimName == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("claimName"))
+	}
+	return allErrs
+}
+
+func validateConfigMapVolumeSource(configMapSource *api.ConfigMapVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if configMapSource.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	}
+	for i, item := range configMapSource.Items {
+		iErrs := errs.ValidationErrorList{}
+		if item.Key == "" {
+			iErrs = append(iErrs, errs.NewFieldRequired("key"))
+		} else if !util.IsDNS1123Subdomain(item.Key) {
+			iErrs = append(iErrs, errs.NewFieldInvalid("key", item.Key, dnsSubdomainErrorMsg))
+		}
+		iErrs = append(iErrs, validateVolumeRelativePath(item.Path, "path")...)
+		allErrs = append(allErrs, iErrs.PrefixIndex(i).Prefix("items")...)
+	}
+	return allErrs
+}
+
+var supportedDownwardAPIVolumeFieldPaths = util.NewStringSet("metadata.name", "metadata.namespace", "metadata.labels", "metadata.annotations")
+
+func validateDownwardAPIVolumeSource(downwardAPISource *api.DownwardAPIVolumeSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	paths := util.StringSet{}
+	for i, item := range downwardAPISource.Items {
+		iErrs := validateVolumeRelativePath(item.Path, "path")
+		if paths.Has(item.Path) {
+			iErrs = append(iErrs, errs.NewFieldDuplicate("path", item.Path))
+		}
+		paths.Insert(item.Path)
+		iErrs = append(iErrs, validateObjectFieldSelector(&item.FieldRef, supportedDownwardAPIVolumeFieldPaths).Prefix("fieldRef")...)
+		allErrs = append(allErrs, iErrs.PrefixIndex(i).Prefix("items")...)
+	}
+	return allErrs
+}
+
+func validateObjectFieldSelector(selector *api.ObjectFieldSelector, supported util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if selector.FieldPath == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("fieldPath"))
+	} else if !supported.Has(selector.FieldPath) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("fieldPath", selector.FieldPath))
+	}
+	return allErrs
+}
+
+// validateVolumeRelativePath checks that path names a file inside a volume.
+func validateVolumeRelativePath(targetPath, field string) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if targetPath == "" {
+		return append(allErrs, errs.NewFieldRequired(field))
+	}
+	if path.IsAbs(targetPath) {
+		allErrs = append(allErrs, errs.NewFieldInvalid(field, targetPath, "must be a relative path"))
+	}
+	for _, element := range strings.Split(targetPath, "/") {
+		if element == ".." {
+			allErrs = append(allErrs, errs.NewFieldInvalid(field, targetPath, "must not contain '..'"))
+			break
+		}
+	}
+	return allErrs
+}
+
+var supportedAccessModes = util.NewStringSet(string(api.ReadWriteOnce), string(api.ReadOnlyMany), string(api.ReadWriteMany))
+
+func validateAccessModes(accessModes []api.AccessModeType) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(accessModes) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("accessModes"))
+	}
+	for i, mode := range accessModes {
+		if !supportedAccessModes.Has(string(mode)) {
+			allErrs = append(allErrs, errs.NewFieldNotSupported(fmt.Sprintf("accessModes[%d]", i), mode))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePersistentVolume tests if required fields in the PersistentVolume are set.
+func ValidatePersistentVolume(pv *api.PersistentVolume) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pv.ObjectMeta, false, ValidatePersistentVolumeName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateAccessModes(pv.Spec.AccessModes).Prefix("spec")...)
+
+	if len(pv.Spec.Capacity) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("spec.capacity"))
+	}
+	if _, ok := pv.Spec.Capacity[api.ResourceStorage]; !ok || len(pv.Spec.Capacity) > 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("spec.capacity", pv.Spec.Capacity, fmt.Sprintf("only %s is expected", api.ResourceStorage)))
+	}
+	for r, qty := range pv.Spec.Capacity {
+		allErrs = append(allErrs, validateBasicResource(qty).Prefix(fmt.Sprintf("spec.capacity[%s]", r))...)
+	}
+
+	numVolumes := 0
+	if pv.Spec.HostPath != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateHostPathVolumeSource(pv.Spec.HostPath).Prefix("spec.hostPath")...)
+	}
+	if pv.Spec.GCEPersistentDisk != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateGCEPersistentDiskVolumeSource(pv.Spec.GCEPersistentDisk).Prefix("spec.persistentDisk")...)
+	}
+	if pv.Spec.NFS != nil {
+		numVolumes++
+		allErrs = append(allErrs, validateNFS(pv.Spec.NFS).Prefix("spec.nfs")...)
+	}
+	if numVolumes != 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("spec", pv.Spec.PersistentVolumeSource, "exactly 1 volume type is required"))
+	}
+	return allErrs
+}
+
+// ValidatePersistentVolumeUpdate tests to see if the update is legal for an end user to make.
+// newPv is updated with fields that cannot be changed.
+func ValidatePersistentVolumeUpdate(newPv, oldPv *api.PersistentVolume) errs.ValidationErrorList {
+	allErrs := ValidatePersistentVolume(newPv)
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPv.ObjectMeta, &newPv.ObjectMeta).Prefix("metadata")...)
+	newPv.Status = oldPv.Status
+	return allErrs
+}
+
+// ValidatePersistentVolumeStatusUpdate tests to see if the status update is legal for an end user to make.
+// newPv is updated with fields that cannot be changed.
+func ValidatePersistentVolumeStatusUpdate(newPv, oldPv *api.PersistentVolume) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPv.ObjectMeta, &newPv.ObjectMeta).Prefix("metadata")...)
+	if newPv.ResourceVersion == "" {
+		allErrs = append(allErrs, fmt.Errorf("ResourceVersion must be specified"))
+	}
+	newPv.Spec = oldPv.Spec
+	return allErrs
+}
+
+// ValidatePersistentVolumeClaim tests if required fields in the PersistentVolumeClaim are set.
+func ValidatePersistentVolumeClaim(pvc *api.PersistentVolumeClaim) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pvc.ObjectMeta, true, ValidatePersistentVolumeClaimName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateAccessModes(pvc.Spec.AccessModes).Prefix("spec")...)
+	_, requested := pvc.Spec.Resources.Requests[api.ResourceStorage]
+	if _, limited := pvc.Spec.Resources.Limits[api.ResourceStorage]; !requested && !limited {
+		allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("spec.resources.requests[%s]", api.ResourceStorage)))
+	}
+	for r, qty := range pvc.Spec.Resources.Requests {
+		allErrs = append(allErrs, validateBasicResource(qty).Prefix(fmt.Sprintf("spec.resources.requests[%s]", r))...)
+	}
+	for r, qty := range pvc.Spec.Resources.Limits {
+		allErrs = append(allErrs, validateBasicResource(qty).Prefix(fmt.Sprintf("spec.resources.limits[%s]", r))...)
+	}
+	return allErrs
+}
+
+// ValidatePersistentVolumeClaimUpdate tests to see if the update is legal for an end user to make.
+// newPvc is updated with fields that cannot be changed.
+func ValidatePersistentVolumeClaimUpdate(newPvc, oldPvc *api.PersistentVolumeClaim) errs.ValidationErrorList {
+	allErrs := ValidatePersistentVolumeClaim(newPvc)
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPvc.ObjectMeta, &newPvc.ObjectMeta).Prefix("metadata")...)
+	newPvc.Status = oldPvc.Status
+	return allErrs
+}
+
+// ValidatePersistentVolumeClaimStatusUpdate tests to see if the status update is legal for an end user to make.
+// newPvc is updated with fields that cannot be changed.
+func ValidatePersistentVolumeClaimStatusUpdate(newPvc, oldPvc *api.PersistentVolumeClaim) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPvc.ObjectMeta, &newPvc.ObjectMeta).Prefix("metadata")...)
+	if newPvc.ResourceVersion == "" {
+		allErrs = append(allErrs, fmt.Errorf("ResourceVersion must be specified"))
+	}
+	newPvc.Spec = oldPvc.Spec
+	return allErrs
+}
+
 var supportedPortProtocols = util.NewStringSet(string(api.ProtocolTCP), string(api.ProtocolUDP))
 
 func validatePorts(ports []api.ContainerPort) errs.ValidationErrorList {
@@ -397,11 +737,43 @@ func validateEnv(vars []api.EnvVar) errs.ValidationErrorList {
 		if !util.IsCIdentifier(ev.Name) {
 			vErrs = append(vErrs, errs.NewFieldInvalid("name", ev.Name, cIdentifierErrorMsg))
 		}
+		if ev.ValueFrom != nil {
+			if len(ev.Value) != 0 {
+				vErrs = append(vErrs, errs.NewFieldInvalid("value", ev.Value, "may not be specified when valueFrom is set"))
+			}
+			vErrs = append(vErrs, validateEnvVarSource(ev.ValueFrom).Prefix("valueFrom")...)
+		}
 		allErrs = append(allErrs, vErrs.PrefixIndex(i)...)
 	}
 	return allErrs
 }
 
+var supportedEnvVarFieldPaths = util.NewStringSet("metadata.name", "metadata.namespace", "status.podIP")
+
+func validateEnvVarSource(source *api.EnvVarSource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	numSources := 0
+	if source.ConfigMapKeyRef != nil {
+		numSources++
+		if source.ConfigMapKeyRef.Name == "" {
+			allErrs = append(allErrs, errs.NewFieldRequired("configMapKeyRef.name"))
+		}
+		if source.ConfigMapKeyRef.Key == "" {
+			allErrs = append(allErrs, errs.NewFieldRequired("configMapKeyRef.key"))
+		} else if !util.IsDNS1123Subdomain(source.ConfigMapKeyRef.Key) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("configMapKeyRef.key", source.ConfigMapKeyRef.Key, dnsSubdomainErrorMsg))
+		}
+	}
+	if source.FieldRef != nil {
+		numSources++
+		allErrs = append(allErrs, validateObjectFieldSelector(source.FieldRef, supportedEnvVarFieldPaths).Prefix("fieldRef")...)
+	}
+	if numSources != 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", source, "exactly 1 source is required"))
+	}
+	return allErrs
+}
+
 func validateVolumeMounts(mounts []api.VolumeMount, volumes util.StringSet) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 
@@ -664,54 +1036,252 @@ func ValidatePod(pod *api.Pod) errs.ValidationErrorList {
 	return allErrs
 }
 
-// ValidatePodSpec tests that the specified PodSpec has valid data.
-// This includes checking formatting and uniqueness.  It also canonicalizes the
-// structure by setting default values and implementing any backwards-compatibility
-// tricks.
-func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
+// validateInitContainers tests that the init containers of a pod are valid containers, whose
+// names are not used by the other containers of the pod. As init containers run to completion,
+// they can't have probes nor lifecycle hooks.
+func validateInitContainers(initContainers, containers []api.Container, volumes util.StringSet) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
+	if len(initContainers) == 0 {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateContainers(initContainers, volumes)...)
 
-	allVolumes, vErrs := validateVolumes(spec.Volumes)
-	allErrs = append(allErrs, vErrs.Prefix("volumes")...)
-	allErrs = append(allErrs, validateContainers(spec.Containers, allVolumes).Prefix("containers")...)
-	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy).Prefix("restartPolicy")...)
-	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy).Prefix("dnsPolicy")...)
-	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
-	allErrs = append(allErrs, validateHostNetwork(spec.HostNetwork, spec.Containers).Prefix("hostNetwork")...)
+	containerNames := util.StringSet{}
+	for _, ctr := range containers {
+		containerNames.Insert(ctr.Name)
+	}
+	for i, ctr := range initContainers {
+		cErrs := errs.ValidationErrorList{}
+		if containerNames.Has(ctr.Name) {
+			cErrs = append(cErrs, errs.NewFieldDuplicate("name", ctr.Name))
+		}
+		if ctr.Lifecycle != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("lifecycle", ctr.Lifecycle))
+		}
+		if ctr.LivenessProbe != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("livenessProbe", ctr.LivenessProbe))
+		}
+		if ctr.ReadinessProbe != nil {
+			cErrs = append(cErrs, errs.NewFieldForbidden("readinessProbe", ctr.ReadinessProbe))
+		}
+		allErrs = append(allErrs, cErrs.PrefixIndex(i)...)
+	}
 	return allErrs
 }
 
-// ValidatePodUpdate tests to see if the update is legal for an end user to make. newPod is updated with fields
-// that cannot be changed.
-func ValidatePodUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
+var supportedTaintEffects = util.NewStringSet(string(api.TaintEffectNoSchedule), string(api.TaintEffectPreferNoSchedule), string(api.TaintEffectNoExecute))
+
+func validateTaints(taints []api.Taint) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
+	for i, taint := range taints {
+		tErrs := errs.ValidationErrorList{}
+		if len(taint.Key) == 0 {
+			tErrs = append(tErrs, errs.NewFieldRequired("key"))
+		} else if !util.IsQualifiedName(taint.Key) {
+			tErrs = append(tErrs, errs.NewFieldInvalid("key", taint.Key, qualifiedNameErrorMsg))
+		}
+		if !util.IsValidLabelValue(taint.Value) {
+			tErrs = append(tErrs, errs.NewFieldInvalid("value", taint.Value, labelValueErrorMsg))
+		}
+		if len(taint.Effect) == 0 {
+			tErrs = append(tErrs, errs.NewFieldRequired("effect"))
+		} else if !supportedTaintEffects.Has(string(taint.Effect)) {
+			tErrs = append(tErrs, errs.NewFieldNotSupported("effect", taint.Effect))
+		}
+		for j := 0; j < i; j++ {
+			if taints[j].Key == taint.Key && taints[j].Effect == taint.Effect {
+				tErrs = append(tErrs, errs.NewFieldDuplicate("key", taint.Key))
+				break
+			}
+		}
+		allErrs = append(allErrs, tErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
 
-	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPod.ObjectMeta, &newPod.ObjectMeta).Prefix("metadata")...)
+func validateNodeSelectorTerm(term *api.NodeSelectorTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(term.MatchExpressions) == 0 {
+		return append(allErrs, errs.NewFieldRequired("matchExpressions"))
+	}
+	for i, req := range term.MatchExpressions {
+		rErrs := errs.ValidationErrorList{}
+		if len(req.Key) == 0 {
+			rErrs = append(rErrs, errs.NewFieldRequired("key"))
+		} else if !util.IsQualifiedName(req.Key) {
+			rErrs = append(rErrs, errs.NewFieldInvalid("key", req.Key, qualifiedNameErrorMsg))
+		}
+		switch req.Operator {
+		case api.NodeSelectorOpIn, api.NodeSelectorOpNotIn:
+			if len(req.Values) == 0 {
+				rErrs = append(rErrs, errs.NewFieldRequired("values"))
+			}
+		case api.NodeSelectorOpExists:
+			if len(req.Values) > 0 {
+				rErrs = append(rErrs, errs.NewFieldInvalid("values", req.Values, "must be empty when the operator is Exists"))
+			}
+		case "":
+			rErrs = append(rErrs, errs.NewFieldRequired("operator"))
+		default:
+			rErrs = append(rErrs, errs.NewFieldNotSupported("operator", req.Operator))
+		}
+		for _, value := range req.Values {
+			if !util.IsValidLabelValue(value) {
+				rErrs = append(rErrs, errs.NewFieldInvalid("values", value, labelValueErrorMsg))
+			}
+		}
+		allErrs = append(allErrs, rErrs.PrefixIndex(i).Prefix("matchExpressions")...)
+	}
+	return allErrs
+}
 
-	if len(newPod.Spec.Containers) != len(oldPod.Spec.Containers) {
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containers", newPod.Spec.Containers, "may not add or remove containers"))
-		return allErrs
+func validateNodeAffinity(affinity *api.NodeAffinity) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i := range affinity.RequiredDuringScheduling {
+		allErrs = append(allErrs, validateNodeSelectorTerm(&affinity.RequiredDuringScheduling[i]).PrefixIndex(i).Prefix("requiredDuringScheduling")...)
+	}
+	for i := range affinity.PreferredDuringScheduling {
+		term := &affinity.PreferredDuringScheduling[i]
+		tErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			tErrs = append(tErrs, errs.NewFieldInvalid("weight", term.Weight, "must be between 1 and 100"))
+		}
+		tErrs = append(tErrs, validateNodeSelectorTerm(&term.Preference).Prefix("preference")...)
+		allErrs = append(allErrs, tErrs.PrefixIndex(i).Prefix("preferredDuringScheduling")...)
 	}
-	pod := *newPod
-	// Tricky, we need to copy the container list so that we don't overwrite the update
-	var newContainers []api.Container
-	for ix, container := range pod.Spec.Containers {
-		container.Image = oldPod.Spec.Containers[ix].Image
-		newContainers = append(newContainers, container)
+	return allErrs
+}
+
+func validatePodAffinityTerm(term *api.PodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(term.LabelSelector) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("labelSelector"))
 	}
-	pod.Spec.Containers = newContainers
-	if !api.Semantic.DeepEqual(pod.Spec, oldPod.Spec) {
-		// TODO: a better error would include all immutable fields explicitly.
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containers", newPod.Spec.Containers, "some fields are immutable"))
+	allErrs = append(allErrs, ValidateLabels(term.LabelSelector, "labelSelector")...)
+	if len(term.TopologyKey) > 0 && !util.IsQualifiedName(term.TopologyKey) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("topologyKey", term.TopologyKey, qualifiedNameErrorMsg))
 	}
-
-	newPod.Status = oldPod.Status
 	return allErrs
 }
 
-// ValidatePodStatusUpdate tests to see if the update is legal for an end user to make. newPod is updated with fields
-// that cannot be changed.
-func ValidatePodStatusUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
+// validatePodAffinityTerms validates the terms of a PodAffinity or a PodAntiAffinity.
+func validatePodAffinityTerms(required []api.PodAffinityTerm, preferred []api.WeightedPodAffinityTerm) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i := range required {
+		allErrs = append(allErrs, validatePodAffinityTerm(&required[i]).PrefixIndex(i).Prefix("requiredDuringScheduling")...)
+	}
+	for i := range preferred {
+		term := &preferred[i]
+		tErrs := errs.ValidationErrorList{}
+		if term.Weight < 1 || term.Weight > 100 {
+			tErrs = append(tErrs, errs.NewFieldInvalid("weight", term.Weight, "must be between 1 and 100"))
+		}
+		tErrs = append(tErrs, validatePodAffinityTerm(&term.PodAffinityTerm).Prefix("podAffinityTerm")...)
+		allErrs = append(allErrs, tErrs.PrefixIndex(i).Prefix("preferredDuringScheduling")...)
+	}
+	return allErrs
+}
+
+var supportedTolerationOperators = util.NewStringSet(string(api.TolerationOpEqual), string(api.TolerationOpExists))
+
+func validateTolerations(tolerations []api.Toleration) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	for i, toleration := range tolerations {
+		tErrs := errs.ValidationErrorList{}
+		if len(toleration.Key) == 0 {
+			tErrs = append(tErrs, errs.NewFieldRequired("key"))
+		} else if !util.IsQualifiedName(toleration.Key) {
+			tErrs = append(tErrs, errs.NewFieldInvalid("key", toleration.Key, qualifiedNameErrorMsg))
+		}
+		if len(toleration.Operator) > 0 && !supportedTolerationOperators.Has(string(toleration.Operator)) {
+			tErrs = append(tErrs, errs.NewFieldNotSupported("operator", toleration.Operator))
+		}
+		if toleration.Operator == api.TolerationOpExists && len(toleration.Value) > 0 {
+			tErrs = append(tErrs, errs.NewFieldInvalid("value", toleration.Value, "must be empty when the operator is Exists"))
+		} else if !util.IsValidLabelValue(toleration.Value) {
+			tErrs = append(tErrs, errs.NewFieldInvalid("value", toleration.Value, labelValueErrorMsg))
+		}
+		if len(toleration.Effect) > 0 && !supportedTaintEffects.Has(string(toleration.Effect)) {
+			tErrs = append(tErrs, errs.NewFieldNotSupported("effect", toleration.Effect))
+		}
+		allErrs = append(allErrs, tErrs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
+// ValidatePodSpec tests that the specified PodSpec has valid data.
+// This includes checking formatting and uniqueness.  It also canonicalizes the
+// structure by setting default values and implementing any backwards-compatibility
+// tricks.
+func ValidatePodSpec(spec *api.PodSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	allVolumes, vErrs := validateVolumes(spec.Volumes)
+	allErrs = append(allErrs, vErrs.Prefix("volumes")...)
+	allErrs = append(allErrs, validateContainers(spec.Containers, allVolumes).Prefix("containers")...)
+	allErrs = append(allErrs, validateInitContainers(spec.InitContainers, spec.Containers, allVolumes).Prefix("initContainers")...)
+	allErrs = append(allErrs, validateRestartPolicy(&spec.RestartPolicy).Prefix("restartPolicy")...)
+	allErrs = append(allErrs, validateDNSPolicy(&spec.DNSPolicy).Prefix("dnsPolicy")...)
+	allErrs = append(allErrs, ValidateLabels(spec.NodeSelector, "nodeSelector")...)
+	allErrs = append(allErrs, validateTolerations(spec.Tolerations).Prefix("tolerations")...)
+	if spec.NodeAffinity != nil {
+		allErrs = append(allErrs, validateNodeAffinity(spec.NodeAffinity).Prefix("nodeAffinity")...)
+	}
+	if spec.PodAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(spec.PodAffinity.RequiredDuringScheduling, spec.PodAffinity.PreferredDuringScheduling).Prefix("podAffinity")...)
+	}
+	if spec.PodAntiAffinity != nil {
+		allErrs = append(allErrs, validatePodAffinityTerms(spec.PodAntiAffinity.RequiredDuringScheduling, spec.PodAntiAffinity.PreferredDuringScheduling).Prefix("podAntiAffinity")...)
+	}
+	allErrs = append(allErrs, validateHostNetwork(spec.HostNetwork, spec.Containers).Prefix("hostNetwork")...)
+	allErrs = append(allErrs, validateHostNetwork(spec.HostNetwork, spec.InitContainers).Prefix("hostNetwork")...)
+	if len(spec.ServiceAccount) > 0 {
+		if ok, msg := ValidateServiceAccountName(spec.ServiceAccount, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("serviceAccount", spec.ServiceAccount, msg))
+		}
+	}
+	if spec.TerminationGracePeriodSeconds != nil && *spec.TerminationGracePeriodSeconds < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("terminationGracePeriodSeconds", *spec.TerminationGracePeriodSeconds, "must be non-negative"))
+	}
+	if len(spec.PriorityClassName) > 0 {
+		if ok, msg := ValidatePriorityClassName(spec.PriorityClassName, false); !ok {
+			allErrs = append(allErrs, errs.NewFieldInvalid("priorityClassName", spec.PriorityClassName, msg))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePodUpdate tests to see if the update is legal for an end user to make. newPod is updated with fields
+// that cannot be changed.
+func ValidatePodUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPod.ObjectMeta, &newPod.ObjectMeta).Prefix("metadata")...)
+
+	if len(newPod.Spec.Containers) != len(oldPod.Spec.Containers) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containers", newPod.Spec.Containers, "may not add or remove containers"))
+		return allErrs
+	}
+	pod := *newPod
+	// Tricky, we need to copy the container list so that we don't overwrite the update
+	var newContainers []api.Container
+	for ix, container := range pod.Spec.Containers {
+		container.Image = oldPod.Spec.Containers[ix].Image
+		newContainers = append(newContainers, container)
+	}
+	pod.Spec.Containers = newContainers
+	if !api.Semantic.DeepEqual(pod.Spec, oldPod.Spec) {
+		// TODO: a better error would include all immutable fields explicitly.
+		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containers", newPod.Spec.Containers, "some fields are immutable"))
+	}
+
+	newPod.Status = oldPod.Status
+	return allErrs
+}
+
+// ValidatePodStatusUpdate tests to see if the update is legal for an end user to make. newPod is updated with fields
+// that cannot be changed.
+func ValidatePodStatusUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 
 	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPod.ObjectMeta, &newPod.ObjectMeta).Prefix("metadata")...)
@@ -728,24 +1298,20 @@ func ValidatePodStatusUpdate(newPod, oldPod *api.Pod) errs.ValidationErrorList {
 }
 
 var supportedSessionAffinityType = util.NewStringSet(string(api.AffinityTypeClientIP), string(api.AffinityTypeNone))
+var supportedServiceType = util.NewStringSet(string(api.ServiceTypeClusterIP), string(api.ServiceTypeNodePort),
+	string(api.ServiceTypeLoadBalancer))
 
 // ValidateService tests if required fields in the service are set.
 func ValidateService(service *api.Service) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMeta(&service.ObjectMeta, true, ValidateServiceName).Prefix("metadata")...)
 
-	if !util.IsValidPortNum(service.Spec.Port) {
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.port", service.Spec.Port, portRangeErrorMsg))
+	if len(service.Spec.Ports) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("spec.ports"))
 	}
-	if len(service.Spec.Protocol) == 0 {
-		allErrs = append(allErrs, errs.NewFieldRequired("spec.protocol"))
-	} else if !supportedPortProtocols.Has(strings.ToUpper(string(service.Spec.Protocol))) {
-		allErrs = append(allErrs, errs.NewFieldNotSupported("spec.protocol", service.Spec.Protocol))
-	}
-	if service.Spec.TargetPort.Kind == util.IntstrInt && service.Spec.TargetPort.IntVal != 0 && !util.IsValidPortNum(service.Spec.TargetPort.IntVal) {
-		allErrs = append(allErrs, errs.NewFieldInvalid("spec.containerPort", service.Spec.Port, portRangeErrorMsg))
-	} else if service.Spec.TargetPort.Kind == util.IntstrString && len(service.Spec.TargetPort.StrVal) == 0 {
-		allErrs = append(allErrs, errs.NewFieldRequired("spec.containerPort"))
+	allPortNames := util.StringSet{}
+	for i := range service.Spec.Ports {
+		allErrs = append(allErrs, validateServicePort(&service.Spec.Ports[i], len(service.Spec.Ports) > 1, &allPortNames).PrefixIndex(i).Prefix("spec.ports")...)
 	}
 
 	if service.Spec.Selector != nil {
@@ -764,6 +1330,61 @@ func ValidateService(service *api.Service) errs.ValidationErrorList {
 		}
 	}
 
+	if service.Spec.Type == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("spec.type"))
+	} else if !supportedServiceType.Has(string(service.Spec.Type)) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("spec.type", service.Spec.Type))
+	}
+
+	allNodePorts := map[int]bool{}
+	for i := range service.Spec.Ports {
+		nodePort := service.Spec.Ports[i].NodePort
+		if nodePort == 0 {
+			continue
+		}
+		if service.Spec.Type == api.ServiceTypeClusterIP {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("spec.ports[%d].nodePort", i), nodePort, "may not be used when type is 'ClusterIP'"))
+		} else if !util.IsValidPortNum(nodePort) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("spec.ports[%d].nodePort", i), nodePort, portRangeErrorMsg))
+		} else if allNodePorts[nodePort] {
+			allErrs = append(allErrs, errs.NewFieldDuplicate(fmt.Sprintf("spec.ports[%d].nodePort", i), nodePort))
+		}
+		allNodePorts[nodePort] = true
+	}
+
+	return allErrs
+}
+
+func validateServicePort(sp *api.ServicePort, requireName bool, allNames *util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if requireName && sp.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if sp.Name != "" {
+		if !util.IsDNS1123Label(sp.Name) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("name", sp.Name, dns1123LabelErrorMsg))
+		} else if allNames.Has(sp.Name) {
+			allErrs = append(allErrs, errs.NewFieldDuplicate("name", sp.Name))
+		}
+	}
+	allNames.Insert(sp.Name)
+
+	if !util.IsValidPortNum(sp.Port) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("port", sp.Port, portRangeErrorMsg))
+	}
+
+	if len(sp.Protocol) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("protocol"))
+	} else if !supportedPortProtocols.Has(strings.ToUpper(string(sp.Protocol))) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("protocol", sp.Protocol))
+	}
+
+	if sp.TargetPort.Kind == util.IntstrInt && sp.TargetPort.IntVal != 0 && !util.IsValidPortNum(sp.TargetPort.IntVal) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("targetPort", sp.TargetPort, portRangeErrorMsg))
+	} else if sp.TargetPort.Kind == util.IntstrString && len(sp.TargetPort.StrVal) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("targetPort"))
+	}
+
 	return allErrs
 }
 
@@ -826,6 +1447,422 @@ func ValidateReplicationControllerSpec(spec *api.ReplicationControllerSpec) errs
 	return allErrs
 }
 
+// ValidateJob tests if required fields in the job are set.
+func ValidateJob(job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&job.ObjectMeta, true, ValidateJobName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateJobUpdate tests if required fields in the job are set. The status of
+// a job can only be changed through ValidateJobStatusUpdate.
+func ValidateJobUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldJob.ObjectMeta, &job.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateJobSpec(&job.Spec).Prefix("spec")...)
+	job.Status = oldJob.Status
+	return allErrs
+}
+
+// ValidateJobStatusUpdate tests to see if the status update on a job is valid.
+// The spec of a job cannot be changed through a status update.
+func ValidateJobStatusUpdate(oldJob, job *api.Job) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldJob.ObjectMeta, &job.ObjectMeta).Prefix("metadata")...)
+	if job.Status.Active < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.active", job.Status.Active, isNegativeErrorMsg))
+	}
+	if job.Status.Succeeded < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.succeeded", job.Status.Succeeded, isNegativeErrorMsg))
+	}
+	if job.Status.Failed < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.failed", job.Status.Failed, isNegativeErrorMsg))
+	}
+	job.Spec = oldJob.Spec
+	return allErrs
+}
+
+// ValidateJobSpec tests if required fields in the job spec are set.
+func ValidateJobSpec(spec *api.JobSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+	if spec.Completions <= 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("completions", spec.Completions, "value must be greater than zero"))
+	}
+	if spec.Parallelism < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("parallelism", spec.Parallelism, isNegativeErrorMsg))
+	}
+	if spec.RetryLimit < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("retryLimit", spec.RetryLimit, isNegativeErrorMsg))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, spec.Parallelism).Prefix("template")...)
+		// Pods of a job run to completion, so they must not be restarted after they succeed.
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyOnFailure && spec.Template.Spec.RestartPolicy != api.RestartPolicyNever {
+			allErrs = append(allErrs, errs.NewFieldNotSupported("template.restartPolicy", spec.Template.Spec.RestartPolicy))
+		}
+	}
+	return allErrs
+}
+
+// ValidateDaemonSet tests if required fields in the daemon set are set.
+func ValidateDaemonSet(ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&ds.ObjectMeta, true, ValidateDaemonSetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDaemonSetUpdate tests if required fields in the daemon set are set. The
+// status of a daemon set can only be changed through ValidateDaemonSetStatusUpdate.
+func ValidateDaemonSetUpdate(oldDaemonSet, ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDaemonSet.ObjectMeta, &ds.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDaemonSetSpec(&ds.Spec).Prefix("spec")...)
+	ds.Status = oldDaemonSet.Status
+	return allErrs
+}
+
+// ValidateDaemonSetStatusUpdate tests to see if the status update on a daemon set is
+// valid. The spec of a daemon set cannot be changed through a status update.
+func ValidateDaemonSetStatusUpdate(oldDaemonSet, ds *api.DaemonSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDaemonSet.ObjectMeta, &ds.ObjectMeta).Prefix("metadata")...)
+	if ds.Status.CurrentNumberScheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentNumberScheduled", ds.Status.CurrentNumberScheduled, isNegativeErrorMsg))
+	}
+	if ds.Status.NumberMisscheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.numberMisscheduled", ds.Status.NumberMisscheduled, isNegativeErrorMsg))
+	}
+	if ds.Status.DesiredNumberScheduled < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.desiredNumberScheduled", ds.Status.DesiredNumberScheduled, isNegativeErrorMsg))
+	}
+	ds.Spec = oldDaemonSet.Spec
+	return allErrs
+}
+
+// ValidateDaemonSetSpec tests if required fields in the daemon set spec are set.
+func ValidateDaemonSetSpec(spec *api.DaemonSetSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, 1).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+			allErrs = append(allErrs, errs.NewFieldNotSupported("template.restartPolicy", spec.Template.Spec.RestartPolicy))
+		}
+		// The daemon controller places pods itself, so the template may not name a host.
+		if len(spec.Template.Spec.Host) != 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.spec.host", spec.Template.Spec.Host, "host is assigned by the daemon controller"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateDeployment tests if required fields in the deployment are set.
+func ValidateDeployment(deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&deployment.ObjectMeta, true, ValidateDeploymentName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateDeploymentUpdate tests if required fields in the deployment are set. The
+// status of a deployment can only be changed through ValidateDeploymentStatusUpdate.
+func ValidateDeploymentUpdate(oldDeployment, deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDeployment.ObjectMeta, &deployment.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateDeploymentSpec(&deployment.Spec).Prefix("spec")...)
+	deployment.Status = oldDeployment.Status
+	return allErrs
+}
+
+// ValidateDeploymentStatusUpdate tests to see if the status update on a deployment is
+// valid. The spec of a deployment cannot be changed through a status update.
+func ValidateDeploymentStatusUpdate(oldDeployment, deployment *api.Deployment) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldDeployment.ObjectMeta, &deployment.ObjectMeta).Prefix("metadata")...)
+	if deployment.Status.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.replicas", deployment.Status.Replicas, isNegativeErrorMsg))
+	}
+	if deployment.Status.UpdatedReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.updatedReplicas", deployment.Status.UpdatedReplicas, isNegativeErrorMsg))
+	}
+	if deployment.Status.AvailableReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.availableReplicas", deployment.Status.AvailableReplicas, isNegativeErrorMsg))
+	}
+	if deployment.Status.UnavailableReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.unavailableReplicas", deployment.Status.UnavailableReplicas, isNegativeErrorMsg))
+	}
+	deployment.Spec = oldDeployment.Spec
+	return allErrs
+}
+
+// ValidateDeploymentSpec tests if required fields in the deployment spec are set.
+func ValidateDeploymentSpec(spec *api.DeploymentSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	if spec.Replicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("replicas", spec.Replicas, isNegativeErrorMsg))
+	}
+	if spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("revisionHistoryLimit", spec.RevisionHistoryLimit, isNegativeErrorMsg))
+	}
+	if spec.RollbackTo != nil && spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("rollbackTo.revision", spec.RollbackTo.Revision, isNegativeErrorMsg))
+	}
+
+	selector := labels.Set(spec.Selector).AsSelector()
+	if selector.Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+
+	if spec.Template == nil {
+		allErrs = append(allErrs, errs.NewFieldRequired("template"))
+	} else {
+		labels := labels.Set(spec.Template.Labels)
+		if !selector.Matches(labels) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("template.labels", spec.Template.Labels, "selector does not match template"))
+		}
+		allErrs = append(allErrs, ValidatePodTemplateSpec(spec.Template, spec.Replicas).Prefix("template")...)
+		// RestartPolicy has already been first-order validated as per ValidatePodTemplateSpec().
+		if spec.Template.Spec.RestartPolicy != api.RestartPolicyAlways {
+			allErrs = append(allErrs, errs.NewFieldNotSupported("template.restartPolicy", spec.Template.Spec.RestartPolicy))
+		}
+	}
+
+	allErrs = append(allErrs, validateDeploymentStrategy(&spec.Strategy).Prefix("strategy")...)
+	return allErrs
+}
+
+func validateDeploymentStrategy(strategy *api.DeploymentStrategy) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	switch strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate", strategy.RollingUpdate, "may not be set for the Recreate strategy"))
+		}
+	case api.RollingUpdateDeploymentStrategyType:
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, errs.NewFieldRequired("rollingUpdate"))
+			break
+		}
+		maxUnavailable := strategy.RollingUpdate.MaxUnavailable
+		maxSurge := strategy.RollingUpdate.MaxSurge
+		allErrs = append(allErrs, validateIntOrPercent(maxUnavailable).Prefix("rollingUpdate.maxUnavailable")...)
+		allErrs = append(allErrs, validateIntOrPercent(maxSurge).Prefix("rollingUpdate.maxSurge")...)
+		if isZeroIntOrPercent(maxUnavailable) && isZeroIntOrPercent(maxSurge) {
+			// Neither an old pod could be removed nor a new pod created.
+			allErrs = append(allErrs, errs.NewFieldInvalid("rollingUpdate.maxUnavailable", maxUnavailable.String(), "may not be 0 when maxSurge is 0"))
+		}
+	case "":
+		allErrs = append(allErrs, errs.NewFieldRequired("type"))
+	default:
+		allErrs = append(allErrs, errs.NewFieldNotSupported("type", strategy.Type))
+	}
+	return allErrs
+}
+
+// validateIntOrPercent checks that the value is a non-negative integer or a
+// percentage between 0% and 100%.
+func validateIntOrPercent(value util.IntOrString) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if value.Kind == util.IntstrInt {
+		if value.IntVal < 0 {
+			allErrs = append(allErrs, errs.NewFieldInvalid("", value.IntVal, isNegativeErrorMsg))
+		}
+		return allErrs
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+	if !strings.HasSuffix(value.StrVal, "%") || err != nil || percent < 0 || percent > 100 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("", value.StrVal, "must be an integer or a percentage between 0% and 100%"))
+	}
+	return allErrs
+}
+
+func isZeroIntOrPercent(value util.IntOrString) bool {
+	if value.Kind == util.IntstrInt {
+		return value.IntVal == 0
+	}
+	return value.StrVal == "0%"
+}
+
+// ValidateHorizontalPodAutoscaler tests if required fields in the autoscaler are set.
+func ValidateHorizontalPodAutoscaler(autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&autoscaler.ObjectMeta, true, ValidateHorizontalPodAutoscalerName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerUpdate tests if required fields in the autoscaler are set. The
+// status of an autoscaler can only be changed through ValidateHorizontalPodAutoscalerStatusUpdate.
+func ValidateHorizontalPodAutoscalerUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldAutoscaler.ObjectMeta, &autoscaler.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateHorizontalPodAutoscalerSpec(&autoscaler.Spec).Prefix("spec")...)
+	autoscaler.Status = oldAutoscaler.Status
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerStatusUpdate tests to see if the status update on an autoscaler
+// is valid. The spec of an autoscaler cannot be changed through a status update.
+func ValidateHorizontalPodAutoscalerStatusUpdate(oldAutoscaler, autoscaler *api.HorizontalPodAutoscaler) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldAutoscaler.ObjectMeta, &autoscaler.ObjectMeta).Prefix("metadata")...)
+	if autoscaler.Status.CurrentReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentReplicas", autoscaler.Status.CurrentReplicas, isNegativeErrorMsg))
+	}
+	if autoscaler.Status.DesiredReplicas < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.desiredReplicas", autoscaler.Status.DesiredReplicas, isNegativeErrorMsg))
+	}
+	if utilization := autoscaler.Status.CurrentCPUUtilization; utilization != nil && *utilization < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentCPUUtilization", *utilization, isNegativeErrorMsg))
+	}
+	autoscaler.Spec = oldAutoscaler.Spec
+	return allErrs
+}
+
+// ValidateHorizontalPodAutoscalerSpec tests if required fields in the autoscaler spec are set.
+func ValidateHorizontalPodAutoscalerSpec(spec *api.HorizontalPodAutoscalerSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(spec.ReplicationController) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("replicationController"))
+	} else if ok, qualifier := ValidateReplicationControllerName(spec.ReplicationController, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("replicationController", spec.ReplicationController, qualifier))
+	}
+	if spec.MinReplicas < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("minReplicas", spec.MinReplicas, "must be at least 1"))
+	}
+	if spec.MaxReplicas < spec.MinReplicas {
+		allErrs = append(allErrs, errs.NewFieldInvalid("maxReplicas", spec.MaxReplicas, "must be at least minReplicas"))
+	}
+	if spec.TargetCPUUtilization < 1 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("targetCPUUtilization", spec.TargetCPUUtilization, "must be at least 1"))
+	}
+	return allErrs
+}
+
+// ValidateIngress tests if required fields in the ingress are set.
+func ValidateIngress(ingress *api.Ingress) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&ingress.ObjectMeta, true, ValidateIngressName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateIngressSpec(&ingress.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidateIngressUpdate tests if required fields in the ingress are set. The status
+// of an ingress can only be changed through ValidateIngressStatusUpdate.
+func ValidateIngressUpdate(oldIngress, ingress *api.Ingress) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldIngress.ObjectMeta, &ingress.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateIngressSpec(&ingress.Spec).Prefix("spec")...)
+	ingress.Status = oldIngress.Status
+	return allErrs
+}
+
+// ValidateIngressStatusUpdate tests to see if the status update on an ingress is
+// valid. The spec of an ingress cannot be changed through a status update.
+func ValidateIngressStatusUpdate(oldIngress, ingress *api.Ingress) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldIngress.ObjectMeta, &ingress.ObjectMeta).Prefix("metadata")...)
+	ingress.Spec = oldIngress.Spec
+	return allErrs
+}
+
+// ValidateIngressSpec tests if required fields in the ingress spec are set.
+func ValidateIngressSpec(spec *api.IngressSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if spec.Backend == nil && len(spec.Rules) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("rules"))
+	}
+	if spec.Backend != nil {
+		allErrs = append(allErrs, validateIngressBackend(spec.Backend).Prefix("backend")...)
+	}
+	for i := range spec.TLS {
+		tls := &spec.TLS[i]
+		tlsErrs := errs.ValidationErrorList{}
+		if len(tls.SecretName) == 0 {
+			tlsErrs = append(tlsErrs, errs.NewFieldRequired("secretName"))
+		} else if ok, qualifier := ValidateSecretName(tls.SecretName, false); !ok {
+			tlsErrs = append(tlsErrs, errs.NewFieldInvalid("secretName", tls.SecretName, qualifier))
+		}
+		for j, host := range tls.Hosts {
+			if !util.IsDNS1123Subdomain(host) {
+				tlsErrs = append(tlsErrs, errs.NewFieldInvalid(fmt.Sprintf("hosts[%d]", j), host, dnsSubdomainErrorMsg))
+			}
+		}
+		allErrs = append(allErrs, tlsErrs.PrefixIndex(i).Prefix("tls")...)
+	}
+	allHosts := util.StringSet{}
+	for i := range spec.Rules {
+		rule := &spec.Rules[i]
+		ruleErrs := errs.ValidationErrorList{}
+		if len(rule.Host) != 0 && !util.IsDNS1123Subdomain(rule.Host) {
+			ruleErrs = append(ruleErrs, errs.NewFieldInvalid("host", rule.Host, dnsSubdomainErrorMsg))
+		} else if allHosts.Has(rule.Host) {
+			ruleErrs = append(ruleErrs, errs.NewFieldDuplicate("host", rule.Host))
+		}
+		allHosts.Insert(rule.Host)
+		if len(rule.Paths) == 0 {
+			ruleErrs = append(ruleErrs, errs.NewFieldRequired("paths"))
+		}
+		allPaths := util.StringSet{}
+		for j := range rule.Paths {
+			path := &rule.Paths[j]
+			pathErrs := errs.ValidationErrorList{}
+			if len(path.Path) != 0 && !strings.HasPrefix(path.Path, "/") {
+				pathErrs = append(pathErrs, errs.NewFieldInvalid("path", path.Path, "must start with '/'"))
+			} else if allPaths.Has(path.Path) {
+				pathErrs = append(pathErrs, errs.NewFieldDuplicate("path", path.Path))
+			}
+			allPaths.Insert(path.Path)
+			pathErrs = append(pathErrs, validateIngressBackend(&path.Backend).Prefix("backend")...)
+			ruleErrs = append(ruleErrs, pathErrs.PrefixIndex(j).Prefix("paths")...)
+		}
+		allErrs = append(allErrs, ruleErrs.PrefixIndex(i).Prefix("rules")...)
+	}
+	return allErrs
+}
+
+func validateIngressBackend(backend *api.IngressBackend) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(backend.ServiceName) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("serviceName"))
+	} else if ok, qualifier := ValidateServiceName(backend.ServiceName, false); !ok {
+		allErrs = append(allErrs, errs.NewFieldInvalid("serviceName", backend.ServiceName, qualifier))
+	}
+	if backend.ServicePort.Kind == util.IntstrInt && !util.IsValidPortNum(backend.ServicePort.IntVal) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("servicePort", backend.ServicePort, portRangeErrorMsg))
+	} else if backend.ServicePort.Kind == util.IntstrString && !util.IsDNS1123Label(backend.ServicePort.StrVal) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("servicePort", backend.ServicePort, dns1123LabelErrorMsg))
+	}
+	return allErrs
+}
+
 // ValidatePodTemplateSpec validates the spec of a pod template
 func ValidatePodTemplateSpec(spec *api.PodTemplateSpec, replicas int) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
@@ -854,6 +1891,7 @@ func ValidateReadOnlyPersistentDisks(volumes []api.Volume) errs.ValidationErrorL
 func ValidateMinion(node *api.Node) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMeta(&node.ObjectMeta, false, ValidateNodeName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateTaints(node.Spec.Taints).Prefix("spec.taints")...)
 	return allErrs
 }
 
@@ -861,6 +1899,7 @@ func ValidateMinion(node *api.Node) errs.ValidationErrorList {
 func ValidateMinionUpdate(oldMinion *api.Node, minion *api.Node) errs.ValidationErrorList {
 	allErrs := errs.ValidationErrorList{}
 	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldMinion.ObjectMeta, &minion.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, validateTaints(minion.Spec.Taints).Prefix("spec.taints")...)
 
 	// TODO: Enable the code once we have better api object.status update model. Currently,
 	// anyone can update node status.
@@ -875,6 +1914,8 @@ func ValidateMinionUpdate(oldMinion *api.Node, minion *api.Node) errs.Validation
 	oldMinion.Spec.Capacity = minion.Spec.Capacity
 	// Allow users to unschedule node
 	oldMinion.Spec.Unschedulable = minion.Spec.Unschedulable
+	// Allow users to taint node
+	oldMinion.Spec.Taints = minion.Spec.Taints
 	// Clear status
 	oldMinion.Status = minion.Status
 
@@ -941,6 +1982,181 @@ func ValidateSecret(secret *api.Secret) errs.ValidationErrorList {
 		allErrs = append(allErrs, errs.NewFieldForbidden("data", "Maximum secret size exceeded"))
 	}
 
+	if secret.Type == api.SecretTypeServiceAccountToken {
+		if len(secret.Annotations[api.ServiceAccountNameKey]) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("metadata.annotations[%s]", api.ServiceAccountNameKey)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateServiceAccount tests if required fields in the ServiceAccount are set.
+func ValidateServiceAccount(serviceAccount *api.ServiceAccount) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&serviceAccount.ObjectMeta, true, ValidateServiceAccountName).Prefix("metadata")...)
+	for i, secret := range serviceAccount.Secrets {
+		if len(secret.Name) == 0 {
+			allErrs = append(allErrs, errs.NewFieldRequired(fmt.Sprintf("secrets[%d].name", i)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateServiceAccountUpdate tests if required fields in the ServiceAccount are set and
+// that the update does not change immutable metadata.
+func ValidateServiceAccountUpdate(newServiceAccount, oldServiceAccount *api.ServiceAccount) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldServiceAccount.ObjectMeta, &newServiceAccount.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateServiceAccount(newServiceAccount)...)
+	return allErrs
+}
+
+// ValidateConfigMap tests if required fields in the ConfigMap are set.
+func ValidateConfigMap(configMap *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&configMap.ObjectMeta, true, ValidateConfigMapName).Prefix("metadata")...)
+	for key := range configMap.Data {
+		if !util.IsDNS1123Subdomain(key) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(fmt.Sprintf("data[%s]", key), key, dnsSubdomainErrorMsg))
+		}
+	}
+	return allErrs
+}
+
+// ValidateConfigMapUpdate tests if required fields in the ConfigMap are set and
+// that the update does not change immutable metadata.
+func ValidateConfigMapUpdate(newConfigMap, oldConfigMap *api.ConfigMap) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldConfigMap.ObjectMeta, &newConfigMap.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateConfigMap(newConfigMap)...)
+	return allErrs
+}
+
+// ValidatePriorityClass tests if required fields in the PriorityClass are set.
+func ValidatePriorityClass(priorityClass *api.PriorityClass) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&priorityClass.ObjectMeta, false, ValidatePriorityClassName).Prefix("metadata")...)
+	return allErrs
+}
+
+// ValidatePriorityClassUpdate tests if required fields in the PriorityClass are set and
+// that the update does not change its value, which is already resolved onto the pods
+// of the class.
+func ValidatePriorityClassUpdate(newPriorityClass, oldPriorityClass *api.PriorityClass) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPriorityClass.ObjectMeta, &newPriorityClass.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePriorityClass(newPriorityClass)...)
+	if newPriorityClass.Value != oldPriorityClass.Value {
+		allErrs = append(allErrs, errs.NewFieldInvalid("value", newPriorityClass.Value, "field is immutable"))
+	}
+	return allErrs
+}
+
+// ValidatePodDisruptionBudget tests if required fields in the pod disruption budget are set.
+func ValidatePodDisruptionBudget(pdb *api.PodDisruptionBudget) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&pdb.ObjectMeta, true, ValidatePodDisruptionBudgetName).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePodDisruptionBudgetSpec(&pdb.Spec).Prefix("spec")...)
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetUpdate tests if required fields in the pod disruption budget are
+// set. The status of a budget can only be changed through ValidatePodDisruptionBudgetStatusUpdate.
+func ValidatePodDisruptionBudgetUpdate(oldPDB, pdb *api.PodDisruptionBudget) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPDB.ObjectMeta, &pdb.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidatePodDisruptionBudgetSpec(&pdb.Spec).Prefix("spec")...)
+	pdb.Status = oldPDB.Status
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetStatusUpdate tests to see if the status update on a pod disruption
+// budget is valid. The spec of a budget cannot be changed through a status update.
+func ValidatePodDisruptionBudgetStatusUpdate(oldPDB, pdb *api.PodDisruptionBudget) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldPDB.ObjectMeta, &pdb.ObjectMeta).Prefix("metadata")...)
+	if pdb.Status.PodDisruptionsAllowed < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.podDisruptionsAllowed", pdb.Status.PodDisruptionsAllowed, isNegativeErrorMsg))
+	}
+	if pdb.Status.CurrentHealthy < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.currentHealthy", pdb.Status.CurrentHealthy, isNegativeErrorMsg))
+	}
+	if pdb.Status.DesiredHealthy < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.desiredHealthy", pdb.Status.DesiredHealthy, isNegativeErrorMsg))
+	}
+	if pdb.Status.ExpectedPods < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("status.expectedPods", pdb.Status.ExpectedPods, isNegativeErrorMsg))
+	}
+	pdb.Spec = oldPDB.Spec
+	return allErrs
+}
+
+// ValidatePodDisruptionBudgetSpec tests if required fields in the pod disruption budget spec are set.
+func ValidatePodDisruptionBudgetSpec(spec *api.PodDisruptionBudgetSpec) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if labels.Set(spec.Selector).AsSelector().Empty() {
+		allErrs = append(allErrs, errs.NewFieldRequired("selector"))
+	}
+	if spec.MinAvailable < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("minAvailable", spec.MinAvailable, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// ValidateEviction tests if the pod to evict is named, and if the options of its deletion
+// are valid.
+func ValidateEviction(eviction *api.Eviction) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if len(eviction.Name) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("metadata.name"))
+	}
+	if eviction.DeleteOptions != nil && eviction.DeleteOptions.GracePeriodSeconds != nil && *eviction.DeleteOptions.GracePeriodSeconds < 0 {
+		allErrs = append(allErrs, errs.NewFieldInvalid("deleteOptions.gracePeriodSeconds", *eviction.DeleteOptions.GracePeriodSeconds, isNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// ValidateThirdPartyResource tests if required fields in the third party resource are set.
+func ValidateThirdPartyResource(rsrc *api.ThirdPartyResource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&rsrc.ObjectMeta, false, ValidateThirdPartyResourceName).Prefix("metadata")...)
+	if len(rsrc.Versions) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("versions"))
+	}
+	versions := util.StringSet{}
+	for i, version := range rsrc.Versions {
+		field := fmt.Sprintf("versions[%d]", i)
+		if !util.IsDNS1123Label(version) {
+			allErrs = append(allErrs, errs.NewFieldInvalid(field, version, dns1123LabelErrorMsg))
+		} else if versions.Has(version) {
+			allErrs = append(allErrs, errs.NewFieldDuplicate(field, version))
+		}
+		versions.Insert(version)
+	}
+	return allErrs
+}
+
+// ValidateThirdPartyResourceUpdate tests if required fields in the third party resource are set.
+func ValidateThirdPartyResourceUpdate(newRsrc, oldRsrc *api.ThirdPartyResource) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldRsrc.ObjectMeta, &newRsrc.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateThirdPartyResource(newRsrc)...)
+	return allErrs
+}
+
+// ValidateThirdPartyResourceData tests if the metadata of an object of a third party resource
+// is valid. Its content is opaque to the server and not validated.
+func ValidateThirdPartyResourceData(obj *api.ThirdPartyResourceData) errs.ValidationErrorList {
+	return ValidateObjectMeta(&obj.ObjectMeta, true, ValidateThirdPartyResourceDataName).Prefix("metadata")
+}
+
+// ValidateThirdPartyResourceDataUpdate tests if the metadata of an object of a third party
+// resource is valid, and was not changed in ways the update forbids.
+func ValidateThirdPartyResourceDataUpdate(newObj, oldObj *api.ThirdPartyResourceData) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMetaUpdate(&oldObj.ObjectMeta, &newObj.ObjectMeta).Prefix("metadata")...)
+	allErrs = append(allErrs, ValidateThirdPartyResourceData(newObj)...)
 	return allErrs
 }
 
@@ -962,6 +2178,18 @@ func validateResourceRequirements(container *api.Container) errs.ValidationError
 		}
 		allErrs = append(allErrs, errs...)
 	}
+	for resourceName, quantity := range container.Resources.Requests {
+		field := fmt.Sprintf("resources.requests[%s]", resourceName)
+		// Validate resource name.
+		allErrs = append(allErrs, validateResourceName(resourceName.String(), field)...)
+		if api.IsStandardResourceName(resourceName.String()) {
+			allErrs = append(allErrs, validateBasicResource(quantity).Prefix(fmt.Sprintf("Resource %s: ", resourceName))...)
+		}
+		// A request may not exceed the limit of the same resource.
+		if limit, found := container.Resources.Limits[resourceName]; found && quantity.MilliValue() > limit.MilliValue() {
+			allErrs = append(allErrs, errs.NewFieldInvalid(field, quantity.String(), "must be less than or equal to the limit "+limit.String()))
+		}
+	}
 
 	return allErrs
 }
@@ -1079,3 +2307,77 @@ func ValidateNamespaceFinalizeUpdate(newNamespace, oldNamespace *api.Namespace)
 	fmt.Printf("NEW NAMESPACE FINALIZERS : %v\n", newNamespace.Spec.Finalizers)
 	return allErrs
 }
+
+// ValidateEndpointsName can be used to check whether the given endpoints name is valid.
+// Prefix indicates this name will be used as part of generation, in which case
+// trailing dashes are allowed.
+func ValidateEndpointsName(name string, prefix bool) (bool, string) {
+	return nameIsDNSSubdomain(name, prefix)
+}
+
+// ValidateEndpoints tests if required fields are set.
+func ValidateEndpoints(endpoints *api.Endpoints) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	allErrs = append(allErrs, ValidateObjectMeta(&endpoints.ObjectMeta, true, ValidateEndpointsName).Prefix("metadata")...)
+	allErrs = append(allErrs, validateEndpointSubsets(endpoints.Subsets).Prefix("subsets")...)
+	return allErrs
+}
+
+func validateEndpointSubsets(subsets []api.EndpointSubset) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+
+	for i := range subsets {
+		ss := &subsets[i]
+
+		ssErrs := errs.ValidationErrorList{}
+
+		if len(ss.Addresses) == 0 {
+			ssErrs = append(ssErrs, errs.NewFieldRequired("addresses"))
+		}
+		if len(ss.Ports) == 0 {
+			ssErrs = append(ssErrs, errs.NewFieldRequired("ports"))
+		}
+		for addr := range ss.Addresses {
+			ssErrs = append(ssErrs, validateEndpointAddress(&ss.Addresses[addr]).PrefixIndex(addr).Prefix("addresses")...)
+		}
+		allPortNames := util.StringSet{}
+		for port := range ss.Ports {
+			ssErrs = append(ssErrs, validateEndpointPort(&ss.Ports[port], len(ss.Ports) > 1, &allPortNames).PrefixIndex(port).Prefix("ports")...)
+		}
+
+		allErrs = append(allErrs, ssErrs.PrefixIndex(i)...)
+	}
+
+	return allErrs
+}
+
+func validateEndpointAddress(address *api.EndpointAddress) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if ip := net.ParseIP(address.IP); ip == nil {
+		allErrs = append(allErrs, errs.NewFieldInvalid("ip", address.IP, "invalid IP address"))
+	}
+	return allErrs
+}
+
+func validateEndpointPort(port *api.EndpointPort, requireName bool, allNames *util.StringSet) errs.ValidationErrorList {
+	allErrs := errs.ValidationErrorList{}
+	if requireName && port.Name == "" {
+		allErrs = append(allErrs, errs.NewFieldRequired("name"))
+	} else if port.Name != "" {
+		if !util.IsDNS1123Label(port.Name) {
+			allErrs = append(allErrs, errs.NewFieldInvalid("name", port.Name, dns1123LabelErrorMsg))
+		} else if allNames.Has(port.Name) {
+			allErrs = append(allErrs, errs.NewFieldDuplicate("name", port.Name))
+		}
+	}
+	allNames.Insert(port.Name)
+	if !util.IsValidPortNum(port.Port) {
+		allErrs = append(allErrs, errs.NewFieldInvalid("port", port.Port, portRangeErrorMsg))
+	}
+	if len(port.Protocol) == 0 {
+		allErrs = append(allErrs, errs.NewFieldRequired("protocol"))
+	} else if !supportedPortProtocols.Has(strings.ToUpper(string(port.Protocol))) {
+		allErrs = append(allErrs, errs.NewFieldNotSupported("protocol", port.Protocol))
+	}
+	return allErrs
+}
@@ -75,6 +75,55 @@ func TestValidateObjectMetaUpdateIgnoresCreationTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidateObjectMetaUpdateKeepsPendingDeletion(t *testing.T) {
+	deletionTimestamp := util.NewTime(time.Unix(10, 0))
+	gracePeriod := int64(30)
+	old := api.ObjectMeta{Name: "test", DeletionTimestamp: &deletionTimestamp, DeletionGracePeriodSeconds: &gracePeriod}
+	meta := api.ObjectMeta{Name: "test"}
+	if errs := ValidateObjectMetaUpdate(&old, &meta); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if meta.DeletionTimestamp != old.DeletionTimestamp || meta.DeletionGracePeriodSeconds != old.DeletionGracePeriodSeconds {
+		t.Errorf("expected the pending deletion to be kept, got %#v", meta)
+	}
+}
+
+func TestValidateObjectMetaUpdateFinalizersOfPendingDeletion(t *testing.T) {
+	deletionTimestamp := util.NewTime(time.Unix(10, 0))
+	old := api.ObjectMeta{Name: "test", DeletionTimestamp: &deletionTimestamp, Finalizers: []string{api.FinalizerOrphan}}
+	if errs := ValidateObjectMetaUpdate(&old, &api.ObjectMeta{Name: "test"}); len(errs) != 0 {
+		t.Errorf("unexpected errors clearing the finalizers: %v", errs)
+	}
+	if errs := ValidateObjectMetaUpdate(&old, &api.ObjectMeta{Name: "test", Finalizers: []string{api.FinalizerOrphan, "other"}}); len(errs) != 1 {
+		t.Errorf("expected an error adding a finalizer, got %v", errs)
+	}
+	if errs := ValidateObjectMetaUpdate(&api.ObjectMeta{Name: "test"}, &api.ObjectMeta{Name: "test", DeletionTimestamp: &deletionTimestamp}); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func TestValidateObjectMetaOwnerReferences(t *testing.T) {
+	meta := api.ObjectMeta{
+		Name:            "test",
+		OwnerReferences: []api.OwnerReference{{Kind: "ReplicationController", Name: "foo", UID: "1234"}},
+		Finalizers:      []string{api.FinalizerOrphan},
+	}
+	if errs := ValidateObjectMeta(&meta, false, nameIsDNSSubdomain); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	meta.OwnerReferences = append(meta.OwnerReferences, api.OwnerReference{Kind: "ReplicationController"})
+	meta.Finalizers = append(meta.Finalizers, "not a finalizer")
+	errs := ValidateObjectMeta(&meta, false, nameIsDNSSubdomain)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	for i, field := range []string{"ownerReferences[1].name", "ownerReferences[1].uid", "finalizers[1]"} {
+		if errs[i].(*errors.ValidationError).Field != field {
+			t.Errorf("expected an error on %s, got %v", field, errs[i])
+		}
+	}
+}
+
 // Ensure trailing slash is allowed in generate name
 func TestValidateObjectMetaTrimsTrailingSlash(t *testing.T) {
 	errs := ValidateObjectMeta(&api.ObjectMeta{Name: "test", GenerateName: "foo-"}, false, nameIsDNSSubdomain)
@@ -212,12 +261,14 @@ func TestValidateVolumes(t *testing.T) {
 		{Name: "gcepd", VolumeSource: api.VolumeSource{GCEPersistentDisk: &api.GCEPersistentDiskVolumeSource{"my-PD", "ext4", 1, false}}},
 		{Name: "gitrepo", VolumeSource: api.VolumeSource{GitRepo: &api.GitRepoVolumeSource{"my-repo", "hashstring"}}},
 		{Name: "secret", VolumeSource: api.VolumeSource{Secret: &api.SecretVolumeSource{"my-secret"}}},
+		{Name: "claim", VolumeSource: api.VolumeSource{PersistentVolumeClaim: &api.PersistentVolumeClaimVolumeSource{ClaimName: "my-claim"}}},
+		{Name: "configmap", VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{Name: "my-config"}}},
 	}
 	names, errs := validateVolumes(successCase)
 	if len(errs) != 0 {
 		t.Errorf("expected success: %v", errs)
 	}
-	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret") {
+	if len(names) != len(successCase) || !names.HasAll("abc", "123", "abc-123", "empty", "gcepd", "gitrepo", "secret", "claim", "configmap") {
 		t.Errorf("wrong names result: %v", names)
 	}
 	emptyVS := api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}
@@ -339,6 +390,111 @@ func TestValidateEnv(t *testing.T) {
 	}
 }
 
+func TestValidateEnvVarSource(t *testing.T) {
+	successCase := []api.EnvVar{
+		{Name: "abc", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "my-config", Key: "key"}}},
+		{Name: "POD_NAME", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+		{Name: "POD_IP", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+	}
+	if errs := validateEnv(successCase); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		V []api.EnvVar
+		F string
+	}{
+		"value and valueFrom": {[]api.EnvVar{{Name: "abc", Value: "value", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "my-config", Key: "key"}}}}, "[0].value"},
+		"no source":           {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{}}}, "[0].valueFrom"},
+		"missing name":        {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Key: "key"}}}}, "[0].valueFrom.configMapKeyRef.name"},
+		"missing key":         {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "my-config"}}}}, "[0].valueFrom.configMapKeyRef.key"},
+		"invalid key":         {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "my-config", Key: "a/b"}}}}, "[0].valueFrom.configMapKeyRef.key"},
+		"two sources":         {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "my-config", Key: "key"}, FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"}}}}, "[0].valueFrom"},
+		"missing field path":  {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{}}}}, "[0].valueFrom.fieldRef.fieldPath"},
+		"unsupported field":   {[]api.EnvVar{{Name: "abc", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.labels"}}}}, "[0].valueFrom.fieldRef.fieldPath"},
+	}
+	for k, v := range errorCases {
+		errs := validateEnv(v.V)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", k, errs)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.F {
+			t.Errorf("%s: expected error on field %s, got %s", k, v.F, field)
+		}
+	}
+}
+
+func TestValidateConfigMapVolumeSource(t *testing.T) {
+	successCases := []api.ConfigMapVolumeSource{
+		{Name: "my-config"},
+		{Name: "my-config", Items: []api.KeyToPath{{Key: "key", Path: "key"}, {Key: "other", Path: "conf/other.properties"}}},
+	}
+	for _, source := range successCases {
+		if errs := validateConfigMapVolumeSource(&source); len(errs) != 0 {
+			t.Errorf("expected success for %#v: %v", source, errs)
+		}
+	}
+
+	errorCases := map[string]struct {
+		S api.ConfigMapVolumeSource
+		F string
+	}{
+		"missing name":  {api.ConfigMapVolumeSource{}, "name"},
+		"missing key":   {api.ConfigMapVolumeSource{Name: "my-config", Items: []api.KeyToPath{{Path: "key"}}}, "items[0].key"},
+		"missing path":  {api.ConfigMapVolumeSource{Name: "my-config", Items: []api.KeyToPath{{Key: "key"}}}, "items[0].path"},
+		"absolute path": {api.ConfigMapVolumeSource{Name: "my-config", Items: []api.KeyToPath{{Key: "key", Path: "/etc/key"}}}, "items[0].path"},
+		"path escapes":  {api.ConfigMapVolumeSource{Name: "my-config", Items: []api.KeyToPath{{Key: "key", Path: "conf/../../key"}}}, "items[0].path"},
+	}
+	for k, v := range errorCases {
+		errs := validateConfigMapVolumeSource(&v.S)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", k, errs)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.F {
+			t.Errorf("%s: expected error on field %s, got %s", k, v.F, field)
+		}
+	}
+}
+
+func TestValidateDownwardAPIVolumeSource(t *testing.T) {
+	successCase := api.DownwardAPIVolumeSource{
+		Items: []api.DownwardAPIVolumeFile{
+			{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+			{Path: "meta/annotations", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+			{Path: "name", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		},
+	}
+	if errs := validateDownwardAPIVolumeSource(&successCase); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		S api.DownwardAPIVolumeSource
+		F string
+	}{
+		"missing path":       {api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{{FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}}}}, "items[0].path"},
+		"path escapes":       {api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{{Path: "../labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}}}}, "items[0].path"},
+		"missing field path": {api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{{Path: "labels"}}}, "items[0].fieldRef.fieldPath"},
+		"unsupported field":  {api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{{Path: "ip", FieldRef: api.ObjectFieldSelector{FieldPath: "status.podIP"}}}}, "items[0].fieldRef.fieldPath"},
+		"duplicate path": {api.DownwardAPIVolumeSource{Items: []api.DownwardAPIVolumeFile{
+			{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+			{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.annotations"}},
+		}}, "items[1].path"},
+	}
+	for k, v := range errorCases {
+		errs := validateDownwardAPIVolumeSource(&v.S)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", k, errs)
+			continue
+		}
+		if field := errs[0].(*errors.ValidationError).Field; field != v.F {
+			t.Errorf("%s: expected error on field %s, got %s", k, v.F, field)
+		}
+	}
+}
+
 func TestValidateVolumeMounts(t *testing.T) {
 	volumes := util.NewStringSet("abc", "123", "abc-123")
 
@@ -499,6 +655,18 @@ func TestValidateContainers(t *testing.T) {
 			},
 			ImagePullPolicy: "IfNotPresent",
 		},
+		{
+			Name:  "resources-request-test",
+			Image: "image",
+			Resources: api.ResourceRequirements{
+				Limits: getResourceLimits("1", "1G"),
+				Requests: api.ResourceList{
+					api.ResourceName(api.ResourceCPU):    resource.MustParse("250m"),
+					api.ResourceName(api.ResourceMemory): resource.MustParse("1G"),
+				},
+			},
+			ImagePullPolicy: "IfNotPresent",
+		},
 		{Name: "abc-1234", Image: "image", Privileged: true, ImagePullPolicy: "IfNotPresent"},
 	}
 	if errs := validateContainers(successCase, volumes); len(errs) != 0 {
@@ -647,6 +815,27 @@ func TestValidateContainers(t *testing.T) {
 				ImagePullPolicy: "IfNotPresent",
 			},
 		},
+		"Resource CPU request invalid": {
+			{
+				Name:  "abc-123",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Requests: getResourceLimits("-10", "0"),
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
+		"Resource request exceeds limit": {
+			{
+				Name:  "abc-123",
+				Image: "image",
+				Resources: api.ResourceRequirements{
+					Limits:   getResourceLimits("1", "1G"),
+					Requests: getResourceLimits("2", "1G"),
+				},
+				ImagePullPolicy: "IfNotPresent",
+			},
+		},
 	}
 	for k, v := range errorCases {
 		if errs := validateContainers(v, volumes); len(errs) == 0 {
@@ -693,6 +882,7 @@ func TestValidateDNSPolicy(t *testing.T) {
 }
 
 func TestValidatePodSpec(t *testing.T) {
+	gracePeriod, negativeGracePeriod := int64(30), int64(-1)
 	successCases := []api.PodSpec{
 		{ // Populate basic fields, leave defaults for most.
 			Volumes:       []api.Volume{{Name: "vol", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}},
@@ -700,6 +890,13 @@ func TestValidatePodSpec(t *testing.T) {
 			RestartPolicy: api.RestartPolicyAlways,
 			DNSPolicy:     api.DNSClusterFirst,
 		},
+		{ // Populate init containers.
+			Volumes:        []api.Volume{{Name: "vol", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}}},
+			InitContainers: []api.Container{{Name: "init", Image: "image", ImagePullPolicy: "IfNotPresent", VolumeMounts: []api.VolumeMount{{Name: "vol", MountPath: "/data"}}}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+		},
 		{ // Populate all fields.
 			Volumes: []api.Volume{
 				{Name: "vol", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
@@ -709,8 +906,51 @@ func TestValidatePodSpec(t *testing.T) {
 			NodeSelector: map[string]string{
 				"key": "value",
 			},
-			Host:      "foobar",
-			DNSPolicy: api.DNSClusterFirst,
+			Host:                          "foobar",
+			DNSPolicy:                     api.DNSClusterFirst,
+			TerminationGracePeriodSeconds: &gracePeriod,
+		},
+		{ // Populate tolerations.
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Tolerations: []api.Toleration{
+				{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+				{Key: "example.com/ingress", Operator: api.TolerationOpExists},
+			},
+		},
+		{ // Populate node affinity.
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringScheduling: []api.NodeSelectorTerm{
+					{MatchExpressions: []api.NodeSelectorRequirement{
+						{Key: "zone", Operator: api.NodeSelectorOpIn, Values: []string{"a", "b"}},
+						{Key: "unhealthy", Operator: api.NodeSelectorOpNotIn, Values: []string{"true"}},
+					}},
+				},
+				PreferredDuringScheduling: []api.PreferredSchedulingTerm{
+					{Weight: 10, Preference: api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{
+						{Key: "ssd", Operator: api.NodeSelectorOpExists},
+					}}},
+				},
+			},
+		},
+		{ // Populate pod affinity and anti-affinity.
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			PodAffinity: &api.PodAffinity{
+				RequiredDuringScheduling: []api.PodAffinityTerm{
+					{LabelSelector: map[string]string{"app": "web"}, TopologyKey: "zone"},
+				},
+			},
+			PodAntiAffinity: &api.PodAntiAffinity{
+				PreferredDuringScheduling: []api.WeightedPodAffinityTerm{
+					{Weight: 50, PodAffinityTerm: api.PodAffinityTerm{LabelSelector: map[string]string{"app": "db"}}},
+				},
+			},
 		},
 		{ // Populate HostNetwork.
 			Containers: []api.Container{
@@ -722,6 +962,12 @@ func TestValidatePodSpec(t *testing.T) {
 			RestartPolicy: api.RestartPolicyAlways,
 			DNSPolicy:     api.DNSClusterFirst,
 		},
+		{ // Populate PriorityClassName.
+			Containers:        []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			RestartPolicy:     api.RestartPolicyAlways,
+			DNSPolicy:         api.DNSClusterFirst,
+			PriorityClassName: "system.critical",
+		},
 	}
 	for i := range successCases {
 		if errs := ValidatePodSpec(&successCases[i]); len(errs) != 0 {
@@ -755,6 +1001,140 @@ func TestValidatePodSpec(t *testing.T) {
 			DNSPolicy:     api.DNSClusterFirst,
 			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
 		},
+		"init container named like a container": {
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+			InitContainers: []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+		"init container without image": {
+			RestartPolicy:  api.RestartPolicyAlways,
+			DNSPolicy:      api.DNSClusterFirst,
+			InitContainers: []api.Container{{Name: "init", ImagePullPolicy: "IfNotPresent"}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+		"init container with readiness probe": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			InitContainers: []api.Container{{Name: "init", Image: "image", ImagePullPolicy: "IfNotPresent", ReadinessProbe: &api.Probe{
+				Handler: api.Handler{Exec: &api.ExecAction{Command: []string{"true"}}},
+			}}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+		"toleration without key": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Tolerations:   []api.Toleration{{Value: "gpu"}},
+		},
+		"toleration with bad operator": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Tolerations:   []api.Toleration{{Key: "dedicated", Operator: "In"}},
+		},
+		"toleration with value and Exists operator": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Tolerations:   []api.Toleration{{Key: "dedicated", Operator: api.TolerationOpExists, Value: "gpu"}},
+		},
+		"toleration with bad effect": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			Tolerations:   []api.Toleration{{Key: "dedicated", Value: "gpu", Effect: "NoWay"}},
+		},
+		"node affinity term without expressions": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringScheduling: []api.NodeSelectorTerm{{}},
+			},
+		},
+		"node affinity requirement with bad operator": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringScheduling: []api.NodeSelectorTerm{
+					{MatchExpressions: []api.NodeSelectorRequirement{{Key: "zone", Operator: "Gt", Values: []string{"a"}}}},
+				},
+			},
+		},
+		"node affinity In requirement without values": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringScheduling: []api.NodeSelectorTerm{
+					{MatchExpressions: []api.NodeSelectorRequirement{{Key: "zone", Operator: api.NodeSelectorOpIn}}},
+				},
+			},
+		},
+		"node affinity Exists requirement with values": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			NodeAffinity: &api.NodeAffinity{
+				RequiredDuringScheduling: []api.NodeSelectorTerm{
+					{MatchExpressions: []api.NodeSelectorRequirement{{Key: "ssd", Operator: api.NodeSelectorOpExists, Values: []string{"true"}}}},
+				},
+			},
+		},
+		"node affinity preferred term with bad weight": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			NodeAffinity: &api.NodeAffinity{
+				PreferredDuringScheduling: []api.PreferredSchedulingTerm{
+					{Weight: 101, Preference: api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{
+						{Key: "ssd", Operator: api.NodeSelectorOpExists},
+					}}},
+				},
+			},
+		},
+		"pod affinity term without label selector": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			PodAffinity: &api.PodAffinity{
+				RequiredDuringScheduling: []api.PodAffinityTerm{{TopologyKey: "zone"}},
+			},
+		},
+		"pod affinity term with bad topology key": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			PodAffinity: &api.PodAffinity{
+				RequiredDuringScheduling: []api.PodAffinityTerm{
+					{LabelSelector: map[string]string{"app": "web"}, TopologyKey: "bad key"},
+				},
+			},
+		},
+		"pod anti-affinity preferred term with bad weight": {
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			PodAntiAffinity: &api.PodAntiAffinity{
+				PreferredDuringScheduling: []api.WeightedPodAffinityTerm{
+					{Weight: 0, PodAffinityTerm: api.PodAffinityTerm{LabelSelector: map[string]string{"app": "db"}}},
+				},
+			},
+		},
+		"negative termination grace period": {
+			RestartPolicy:                 api.RestartPolicyAlways,
+			DNSPolicy:                     api.DNSClusterFirst,
+			Containers:                    []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			TerminationGracePeriodSeconds: &negativeGracePeriod,
+		},
+		"bad priority class name": {
+			RestartPolicy:     api.RestartPolicyAlways,
+			DNSPolicy:         api.DNSClusterFirst,
+			Containers:        []api.Container{{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+			PriorityClassName: "High_Priority",
+		},
 		"with hostNetwork hostPort not equal to containerPort": {
 			Containers: []api.Container{
 				{Name: "ctr", Image: "image", ImagePullPolicy: "IfNotPresent", Ports: []api.ContainerPort{
@@ -1141,17 +1521,56 @@ func TestValidateService(t *testing.T) {
 			},
 			numErrs: 1,
 		},
+		{
+			name: "missing type",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = ""
+			},
+			numErrs: 1,
+		},
+		{
+			name: "invalid type",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = "InvalidType"
+			},
+			numErrs: 1,
+		},
+		{
+			name: "node port with type ClusterIP",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports[0].NodePort = 30123
+			},
+			numErrs: 1,
+		},
+		{
+			name: "invalid node port",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = api.ServiceTypeNodePort
+				s.Spec.Ports[0].NodePort = 65536
+			},
+			numErrs: 1,
+		},
+		{
+			name: "dup node port",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = api.ServiceTypeNodePort
+				s.Spec.Ports[0].Name = "p"
+				s.Spec.Ports[0].NodePort = 30123
+				s.Spec.Ports = append(s.Spec.Ports, api.ServicePort{Name: "q", Port: 12345, Protocol: "TCP", NodePort: 30123})
+			},
+			numErrs: 1,
+		},
 		{
 			name: "missing protocol",
 			makeSvc: func(s *api.Service) {
-				s.Spec.Protocol = ""
+				s.Spec.Ports[0].Protocol = ""
 			},
 			numErrs: 1,
 		},
 		{
 			name: "invalid protocol",
 			makeSvc: func(s *api.Service) {
-				s.Spec.Protocol = "INVALID"
+				s.Spec.Ports[0].Protocol = "INVALID"
 			},
 			numErrs: 1,
 		},
@@ -1165,28 +1584,57 @@ func TestValidateService(t *testing.T) {
 		{
 			name: "missing port",
 			makeSvc: func(s *api.Service) {
-				s.Spec.Port = 0
+				s.Spec.Ports[0].Port = 0
 			},
 			numErrs: 1,
 		},
 		{
 			name: "invalid port",
 			makeSvc: func(s *api.Service) {
-				s.Spec.Port = 65536
+				s.Spec.Ports[0].Port = 65536
 			},
 			numErrs: 1,
 		},
 		{
 			name: "missing targetPort string",
 			makeSvc: func(s *api.Service) {
-				s.Spec.TargetPort = util.NewIntOrStringFromString("")
+				s.Spec.Ports[0].TargetPort = util.NewIntOrStringFromString("")
 			},
 			numErrs: 1,
 		},
 		{
 			name: "invalid targetPort int",
 			makeSvc: func(s *api.Service) {
-				s.Spec.TargetPort = util.NewIntOrStringFromInt(65536)
+				s.Spec.Ports[0].TargetPort = util.NewIntOrStringFromInt(65536)
+			},
+			numErrs: 1,
+		},
+		{
+			name: "missing ports",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports = nil
+			},
+			numErrs: 1,
+		},
+		{
+			name: "missing multi-port name",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports = append(s.Spec.Ports, api.ServicePort{Name: "p", Port: 12345, Protocol: "TCP"})
+			},
+			numErrs: 1,
+		},
+		{
+			name: "invalid port name",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports[0].Name = "INVALID"
+			},
+			numErrs: 1,
+		},
+		{
+			name: "dup port name",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports[0].Name = "p"
+				s.Spec.Ports = append(s.Spec.Ports, api.ServicePort{Name: "p", Port: 12345, Protocol: "TCP"})
 			},
 			numErrs: 1,
 		},
@@ -1207,15 +1655,38 @@ func TestValidateService(t *testing.T) {
 		{
 			name: "valid 2",
 			makeSvc: func(s *api.Service) {
-				s.Spec.Protocol = "UDP"
-				s.Spec.TargetPort = util.NewIntOrStringFromInt(12345)
+				s.Spec.Ports[0].Protocol = "UDP"
+				s.Spec.Ports[0].TargetPort = util.NewIntOrStringFromInt(12345)
 			},
 			numErrs: 0,
 		},
 		{
 			name: "valid 3",
 			makeSvc: func(s *api.Service) {
-				s.Spec.TargetPort = util.NewIntOrStringFromString("http")
+				s.Spec.Ports[0].TargetPort = util.NewIntOrStringFromString("http")
+			},
+			numErrs: 0,
+		},
+		{
+			name: "valid multi-port",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Ports[0].Name = "p"
+				s.Spec.Ports = append(s.Spec.Ports, api.ServicePort{Name: "q", Port: 12345, Protocol: "UDP"})
+			},
+			numErrs: 0,
+		},
+		{
+			name: "valid type - node port",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = api.ServiceTypeNodePort
+				s.Spec.Ports[0].NodePort = 30123
+			},
+			numErrs: 0,
+		},
+		{
+			name: "valid type - load balancer",
+			makeSvc: func(s *api.Service) {
+				s.Spec.Type = api.ServiceTypeLoadBalancer
 			},
 			numErrs: 0,
 		},
@@ -1246,8 +1717,8 @@ func TestValidateService(t *testing.T) {
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"key": "val"},
 				SessionAffinity: "None",
-				Port:            8675,
-				Protocol:        "TCP",
+				Type:            api.ServiceTypeClusterIP,
+				Ports:           []api.ServicePort{{Port: 8675, Protocol: "TCP"}},
 			},
 		}
 		tc.makeSvc(&svc)
@@ -1625,18 +2096,683 @@ func TestValidateReplicationController(t *testing.T) {
 	}
 }
 
-func TestValidateMinion(t *testing.T) {
+func TestValidateJob(t *testing.T) {
 	validSelector := map[string]string{"a": "b"}
-	invalidSelector := map[string]string{"NoUppercaseOrSpecialCharsLike=Equals": "b"}
-	successCases := []api.Node{
+	validPodTemplate := api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: validSelector,
+		},
+		Spec: api.PodSpec{
+			RestartPolicy: api.RestartPolicyOnFailure,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+	}
+	successCases := []api.Job{
 		{
-			ObjectMeta: api.ObjectMeta{
-				Name:   "abc",
-				Labels: validSelector,
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    validSelector,
+				Template:    &validPodTemplate,
 			},
-			Status: api.NodeStatus{
-				Addresses: []api.NodeAddress{
-					{Type: api.NodeLegacyHostIP, Address: "something"},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 5,
+				Parallelism: 2,
+				RetryLimit:  3,
+				Selector:    validSelector,
+				Template: &api.PodTemplateSpec{
+					ObjectMeta: api.ObjectMeta{Labels: validSelector},
+					Spec: api.PodSpec{
+						RestartPolicy: api.RestartPolicyNever,
+						DNSPolicy:     api.DNSClusterFirst,
+						Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+					},
+				},
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateJob(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	errorCases := map[string]api.Job{
+		"zero-length name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    validSelector,
+				Template:    &validPodTemplate,
+			},
+		},
+		"missing-namespace": {
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    validSelector,
+				Template:    &validPodTemplate,
+			},
+		},
+		"empty selector": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Template:    &validPodTemplate,
+			},
+		},
+		"selector doesn't match": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    map[string]string{"foo": "bar"},
+				Template:    &validPodTemplate,
+			},
+		},
+		"missing template": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    validSelector,
+			},
+		},
+		"zero completions": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+			},
+		},
+		"negative parallelism": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Parallelism: -1,
+				Selector:    validSelector,
+				Template:    &validPodTemplate,
+			},
+		},
+		"negative retry limit": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				RetryLimit:  -1,
+				Selector:    validSelector,
+				Template:    &validPodTemplate,
+			},
+		},
+		"restart policy always": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.JobSpec{
+				Completions: 1,
+				Selector:    validSelector,
+				Template: &api.PodTemplateSpec{
+					ObjectMeta: api.ObjectMeta{Labels: validSelector},
+					Spec: api.PodSpec{
+						RestartPolicy: api.RestartPolicyAlways,
+						DNSPolicy:     api.DNSClusterFirst,
+						Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+					},
+				},
+			},
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateJob(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+		for i := range errs {
+			field := errs[i].(*errors.ValidationError).Field
+			if !strings.HasPrefix(field, "spec.template.") &&
+				field != "metadata.name" &&
+				field != "metadata.namespace" &&
+				field != "spec.selector" &&
+				field != "spec.template" &&
+				field != "spec.completions" &&
+				field != "spec.parallelism" &&
+				field != "spec.retryLimit" {
+				t.Errorf("%s: missing prefix for: %v", k, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateDaemonSet(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	validPodTemplate := api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: validSelector,
+		},
+		Spec: api.PodSpec{
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+	}
+	nodeSelectorPodTemplate := validPodTemplate
+	nodeSelectorPodTemplate.Spec.NodeSelector = map[string]string{"role": "logging"}
+	successCases := []api.DaemonSet{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &nodeSelectorPodTemplate,
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateDaemonSet(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	hostPodTemplate := validPodTemplate
+	hostPodTemplate.Spec.Host = "node1"
+	neverPodTemplate := validPodTemplate
+	neverPodTemplate.Spec.RestartPolicy = api.RestartPolicyNever
+	errorCases := map[string]api.DaemonSet{
+		"zero-length name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+			},
+		},
+		"missing-namespace": {
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+			},
+		},
+		"empty selector": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Template: &validPodTemplate,
+			},
+		},
+		"selector doesn't match": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: map[string]string{"foo": "bar"},
+				Template: &validPodTemplate,
+			},
+		},
+		"missing template": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+			},
+		},
+		"template with host": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &hostPodTemplate,
+			},
+		},
+		"restart policy never": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DaemonSetSpec{
+				Selector: validSelector,
+				Template: &neverPodTemplate,
+			},
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateDaemonSet(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+		for i := range errs {
+			field := errs[i].(*errors.ValidationError).Field
+			if !strings.HasPrefix(field, "spec.template.") &&
+				field != "metadata.name" &&
+				field != "metadata.namespace" &&
+				field != "spec.selector" &&
+				field != "spec.template" {
+				t.Errorf("%s: missing prefix for: %v", k, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateDeployment(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	validPodTemplate := api.PodTemplateSpec{
+		ObjectMeta: api.ObjectMeta{
+			Labels: validSelector,
+		},
+		Spec: api.PodSpec{
+			RestartPolicy: api.RestartPolicyAlways,
+			DNSPolicy:     api.DNSClusterFirst,
+			Containers:    []api.Container{{Name: "abc", Image: "image", ImagePullPolicy: "IfNotPresent"}},
+		},
+	}
+	rollingUpdate := func(maxUnavailable, maxSurge util.IntOrString) api.DeploymentStrategy {
+		return api.DeploymentStrategy{
+			Type: api.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &api.RollingUpdateDeployment{
+				MaxUnavailable: maxUnavailable,
+				MaxSurge:       maxSurge,
+			},
+		}
+	}
+	successCases := []api.Deployment{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Replicas: 3,
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: rollingUpdate(util.NewIntOrStringFromInt(1), util.NewIntOrStringFromInt(0)),
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Replicas:             10,
+				Selector:             validSelector,
+				Template:             &validPodTemplate,
+				Strategy:             rollingUpdate(util.NewIntOrStringFromString("25%"), util.NewIntOrStringFromString("100%")),
+				RevisionHistoryLimit: 2,
+				RollbackTo:           &api.RollbackConfig{Revision: 1},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "recreate", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateDeployment(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	validStrategy := rollingUpdate(util.NewIntOrStringFromInt(1), util.NewIntOrStringFromInt(1))
+	neverPodTemplate := validPodTemplate
+	neverPodTemplate.Spec.RestartPolicy = api.RestartPolicyNever
+	errorCases := map[string]api.Deployment{
+		"zero-length name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"missing-namespace": {
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"negative replicas": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Replicas: -1,
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"negative revision history limit": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector:             validSelector,
+				Template:             &validPodTemplate,
+				Strategy:             validStrategy,
+				RevisionHistoryLimit: -1,
+			},
+		},
+		"negative rollback revision": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector:   validSelector,
+				Template:   &validPodTemplate,
+				Strategy:   validStrategy,
+				RollbackTo: &api.RollbackConfig{Revision: -1},
+			},
+		},
+		"empty selector": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Template: &validPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"selector doesn't match": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: map[string]string{"foo": "bar"},
+				Template: &validPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"missing template": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Strategy: validStrategy,
+			},
+		},
+		"restart policy never": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &neverPodTemplate,
+				Strategy: validStrategy,
+			},
+		},
+		"missing strategy type": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+			},
+		},
+		"unknown strategy type": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: api.DeploymentStrategy{Type: "BlueGreen"},
+			},
+		},
+		"recreate with rolling update parameters": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: api.DeploymentStrategy{
+					Type:          api.RecreateDeploymentStrategyType,
+					RollingUpdate: validStrategy.RollingUpdate,
+				},
+			},
+		},
+		"missing rolling update parameters": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: api.DeploymentStrategy{Type: api.RollingUpdateDeploymentStrategyType},
+			},
+		},
+		"negative max unavailable": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: rollingUpdate(util.NewIntOrStringFromInt(-1), util.NewIntOrStringFromInt(1)),
+			},
+		},
+		"max surge above 100%": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: rollingUpdate(util.NewIntOrStringFromInt(1), util.NewIntOrStringFromString("110%")),
+			},
+		},
+		"max surge not a percentage": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: rollingUpdate(util.NewIntOrStringFromInt(1), util.NewIntOrStringFromString("abc")),
+			},
+		},
+		"max unavailable and max surge both zero": {
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec: api.DeploymentSpec{
+				Selector: validSelector,
+				Template: &validPodTemplate,
+				Strategy: rollingUpdate(util.NewIntOrStringFromInt(0), util.NewIntOrStringFromString("0%")),
+			},
+		},
+	}
+	for k, v := range errorCases {
+		errs := ValidateDeployment(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+		for i := range errs {
+			field := errs[i].(*errors.ValidationError).Field
+			if !strings.HasPrefix(field, "spec.template.") &&
+				!strings.HasPrefix(field, "spec.strategy.") &&
+				field != "metadata.name" &&
+				field != "metadata.namespace" &&
+				field != "spec.replicas" &&
+				field != "spec.revisionHistoryLimit" &&
+				field != "spec.rollbackTo.revision" &&
+				field != "spec.selector" &&
+				field != "spec.template" {
+				t.Errorf("%s: missing prefix for: %v", k, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateHorizontalPodAutoscaler(t *testing.T) {
+	validSpec := api.HorizontalPodAutoscalerSpec{
+		ReplicationController: "frontend",
+		MinReplicas:           1,
+		MaxReplicas:           5,
+		TargetCPUUtilization:  80,
+	}
+	successCases := []api.HorizontalPodAutoscaler{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec:       validSpec,
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec: api.HorizontalPodAutoscalerSpec{
+				ReplicationController: "frontend",
+				MinReplicas:           3,
+				MaxReplicas:           3,
+				TargetCPUUtilization:  150,
+			},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateHorizontalPodAutoscaler(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	withSpec := func(f func(spec *api.HorizontalPodAutoscalerSpec)) api.HorizontalPodAutoscaler {
+		autoscaler := api.HorizontalPodAutoscaler{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec:       validSpec,
+		}
+		f(&autoscaler.Spec)
+		return autoscaler
+	}
+	errorCases := map[string]api.HorizontalPodAutoscaler{
+		"zero-length name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec:       validSpec,
+		},
+		"missing-namespace": {
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec:       validSpec,
+		},
+		"missing replication controller": withSpec(func(spec *api.HorizontalPodAutoscalerSpec) {
+			spec.ReplicationController = ""
+		}),
+		"invalid replication controller": withSpec(func(spec *api.HorizontalPodAutoscalerSpec) {
+			spec.ReplicationController = "Not_A_Name"
+		}),
+		"zero min replicas": withSpec(func(spec *api.HorizontalPodAutoscalerSpec) {
+			spec.MinReplicas = 0
+		}),
+		"max replicas below min replicas": withSpec(func(spec *api.HorizontalPodAutoscalerSpec) {
+			spec.MinReplicas = 3
+			spec.MaxReplicas = 2
+		}),
+		"zero target utilization": withSpec(func(spec *api.HorizontalPodAutoscalerSpec) {
+			spec.TargetCPUUtilization = 0
+		}),
+	}
+	for k, v := range errorCases {
+		errs := ValidateHorizontalPodAutoscaler(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+		for i := range errs {
+			field := errs[i].(*errors.ValidationError).Field
+			if field != "metadata.name" &&
+				field != "metadata.namespace" &&
+				field != "spec.replicationController" &&
+				field != "spec.minReplicas" &&
+				field != "spec.maxReplicas" &&
+				field != "spec.targetCPUUtilization" {
+				t.Errorf("%s: missing prefix for: %v", k, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateIngress(t *testing.T) {
+	backend := api.IngressBackend{ServiceName: "frontend", ServicePort: util.NewIntOrStringFromInt(80)}
+	newValidSpec := func() api.IngressSpec {
+		return api.IngressSpec{
+			TLS: []api.IngressTLS{{Hosts: []string{"foo.example.com"}, SecretName: "foo-cert"}},
+			Rules: []api.IngressRule{
+				{
+					Host: "foo.example.com",
+					Paths: []api.IngressPath{
+						{Path: "/", Backend: backend},
+						{Path: "/api", Backend: api.IngressBackend{ServiceName: "api", ServicePort: util.NewIntOrStringFromString("http")}},
+					},
+				},
+				{
+					Paths: []api.IngressPath{{Backend: backend}},
+				},
+			},
+		}
+	}
+	validSpec := newValidSpec()
+
+	successCases := []api.Ingress{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+			Spec:       validSpec,
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "abc-123", Namespace: api.NamespaceDefault},
+			Spec:       api.IngressSpec{Backend: &backend},
+		},
+	}
+	for _, successCase := range successCases {
+		if errs := ValidateIngress(&successCase); len(errs) != 0 {
+			t.Errorf("expected success: %v", errs)
+		}
+	}
+
+	withSpec := func(f func(spec *api.IngressSpec)) api.Ingress {
+		ingress := api.Ingress{
+			ObjectMeta: api.ObjectMeta{Name: "abc", Namespace: api.NamespaceDefault},
+		}
+		ingress.Spec = newValidSpec()
+		f(&ingress.Spec)
+		return ingress
+	}
+	errorCases := map[string]api.Ingress{
+		"zero-length name": {
+			ObjectMeta: api.ObjectMeta{Name: "", Namespace: api.NamespaceDefault},
+			Spec:       validSpec,
+		},
+		"missing-namespace": {
+			ObjectMeta: api.ObjectMeta{Name: "abc"},
+			Spec:       validSpec,
+		},
+		"missing rules and backend": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules = nil
+		}),
+		"invalid default backend": withSpec(func(spec *api.IngressSpec) {
+			spec.Backend = &api.IngressBackend{ServiceName: "Not_A_Name", ServicePort: util.NewIntOrStringFromInt(80)}
+		}),
+		"missing tls secret": withSpec(func(spec *api.IngressSpec) {
+			spec.TLS[0].SecretName = ""
+		}),
+		"invalid tls host": withSpec(func(spec *api.IngressSpec) {
+			spec.TLS[0].Hosts = []string{"Not_A_Host"}
+		}),
+		"invalid host": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Host = "Not_A_Host"
+		}),
+		"duplicate host": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[1].Host = spec.Rules[0].Host
+		}),
+		"missing paths": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[1].Paths = nil
+		}),
+		"relative path": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Paths[1].Path = "api"
+		}),
+		"duplicate path": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Paths[1].Path = "/"
+		}),
+		"missing service name": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Paths[0].Backend.ServiceName = ""
+		}),
+		"invalid service port": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Paths[0].Backend.ServicePort = util.NewIntOrStringFromInt(65536)
+		}),
+		"invalid service port name": withSpec(func(spec *api.IngressSpec) {
+			spec.Rules[0].Paths[0].Backend.ServicePort = util.NewIntOrStringFromString("Not_A_Port")
+		}),
+	}
+	for k, v := range errorCases {
+		errs := ValidateIngress(&v)
+		if len(errs) == 0 {
+			t.Errorf("expected failure for %s", k)
+		}
+		for i := range errs {
+			field := errs[i].(*errors.ValidationError).Field
+			if !strings.HasPrefix(field, "metadata.") &&
+				!strings.HasPrefix(field, "spec.backend.") &&
+				!strings.HasPrefix(field, "spec.tls[0].") &&
+				!strings.HasPrefix(field, "spec.rules") {
+				t.Errorf("%s: missing prefix for: %v", k, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateMinion(t *testing.T) {
+	validSelector := map[string]string{"a": "b"}
+	invalidSelector := map[string]string{"NoUppercaseOrSpecialCharsLike=Equals": "b"}
+	successCases := []api.Node{
+		{
+			ObjectMeta: api.ObjectMeta{
+				Name:   "abc",
+				Labels: validSelector,
+			},
+			Status: api.NodeStatus{
+				Addresses: []api.NodeAddress{
+					{Type: api.NodeLegacyHostIP, Address: "something"},
 				},
 			},
 		},
@@ -1650,6 +2786,17 @@ func TestValidateMinion(t *testing.T) {
 				},
 			},
 		},
+		{
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoExecute},
+				},
+			},
+		},
 	}
 	for _, successCase := range successCases {
 		if errs := ValidateMinion(&successCase); len(errs) != 0 {
@@ -1673,6 +2820,33 @@ func TestValidateMinion(t *testing.T) {
 				Labels: invalidSelector,
 			},
 		},
+		"taint without effect": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "gpu"}},
+			},
+		},
+		"taint with invalid key": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "NoUppercaseOrSpecialCharsLike=Equals", Effect: api.TaintEffectNoSchedule}},
+			},
+		},
+		"duplicate taints": {
+			ObjectMeta: api.ObjectMeta{
+				Name: "abc-123",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectNoSchedule},
+				},
+			},
+		},
 	}
 	for k, v := range errorCases {
 		errs := ValidateMinion(&v)
@@ -1684,7 +2858,8 @@ func TestValidateMinion(t *testing.T) {
 			if field != "metadata.name" &&
 				field != "metadata.labels" &&
 				field != "metadata.annotations" &&
-				field != "metadata.namespace" {
+				field != "metadata.namespace" &&
+				!strings.HasPrefix(field, "spec.taints") {
 				t.Errorf("%s: missing prefix for: %v", k, errs[i])
 			}
 		}
@@ -1820,9 +2995,33 @@ func TestValidateMinionUpdate(t *testing.T) {
 				Name: "foo",
 			},
 			Spec: api.NodeSpec{
-				Unschedulable: true,
+				Unschedulable: true,
+			},
+		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoExecute}},
+			},
+		}, true},
+		{api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+		}, api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{{Key: "dedicated", Value: "gpu"}},
 			},
-		}, true},
+		}, false},
 	}
 	for i, test := range tests {
 		errs := ValidateMinionUpdate(&test.oldMinion, &test.minion)
@@ -2407,22 +3606,537 @@ func TestValidateSecret(t *testing.T) {
 		"over": make([]byte, api.MaxSecretSize+1),
 	}
 	invalidKey.Data["a..b"] = []byte("whoops")
+	serviceAccountToken := validSecret()
+	serviceAccountToken.Type = api.SecretTypeServiceAccountToken
+	serviceAccountToken.Annotations = map[string]string{api.ServiceAccountNameKey: "default"}
+	missingServiceAccountName := serviceAccountToken
+	missingServiceAccountName.Annotations = nil
 
 	tests := map[string]struct {
 		secret api.Secret
 		valid  bool
 	}{
-		"valid":             {validSecret(), true},
+		"valid":                        {validSecret(), true},
+		"valid service account token":  {serviceAccountToken, true},
+		"missing service account name": {missingServiceAccountName, false},
+		"empty name":                   {emptyName, false},
+		"invalid name":                 {invalidName, false},
+		"empty namespace":              {emptyNs, false},
+		"invalid namespace":            {invalidNs, false},
+		"over max size":                {overMaxSize, false},
+		"invalid key":                  {invalidKey, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateSecret(&tc.secret)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateServiceAccount(t *testing.T) {
+	validServiceAccount := func() api.ServiceAccount {
+		return api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "bar"},
+			Secrets:    []api.ObjectReference{{Name: "default-token-abcde"}},
+		}
+	}
+
+	var (
+		emptyName   = validServiceAccount()
+		invalidName = validServiceAccount()
+		emptyNs     = validServiceAccount()
+		emptySecret = validServiceAccount()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	emptyNs.Namespace = ""
+	emptySecret.Secrets = []api.ObjectReference{{}}
+
+	tests := map[string]struct {
+		serviceAccount api.ServiceAccount
+		valid          bool
+	}{
+		"valid":             {validServiceAccount(), true},
 		"empty name":        {emptyName, false},
 		"invalid name":      {invalidName, false},
 		"empty namespace":   {emptyNs, false},
-		"invalid namespace": {invalidNs, false},
-		"over max size":     {overMaxSize, false},
-		"invalid key":       {invalidKey, false},
+		"empty secret name": {emptySecret, false},
 	}
 
 	for name, tc := range tests {
-		errs := ValidateSecret(&tc.secret)
+		errs := ValidateServiceAccount(&tc.serviceAccount)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateConfigMap(t *testing.T) {
+	validConfigMap := func() api.ConfigMap {
+		return api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{Name: "my-config", Namespace: "bar"},
+			Data: map[string]string{
+				"app.properties": "color=blue",
+				"log-level":      "debug",
+			},
+		}
+	}
+
+	var (
+		emptyName   = validConfigMap()
+		invalidName = validConfigMap()
+		emptyNs     = validConfigMap()
+		invalidKey  = validConfigMap()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	emptyNs.Namespace = ""
+	invalidKey.Data["a/b"] = "value"
+
+	tests := map[string]struct {
+		configMap api.ConfigMap
+		valid     bool
+	}{
+		"valid":           {validConfigMap(), true},
+		"empty name":      {emptyName, false},
+		"invalid name":    {invalidName, false},
+		"empty namespace": {emptyNs, false},
+		"invalid key":     {invalidKey, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateConfigMap(&tc.configMap)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePriorityClass(t *testing.T) {
+	validPriorityClass := func() api.PriorityClass {
+		return api.PriorityClass{
+			ObjectMeta:  api.ObjectMeta{Name: "high-priority"},
+			Value:       1000,
+			Description: "for the pods serving users",
+		}
+	}
+
+	var (
+		emptyName   = validPriorityClass()
+		invalidName = validPriorityClass()
+		namespaced  = validPriorityClass()
+		negative    = validPriorityClass()
+	)
+
+	emptyName.Name = ""
+	invalidName.Name = "NoUppercaseOrSpecialCharsLike=Equals"
+	namespaced.Namespace = "bar"
+	negative.Value = -10
+
+	tests := map[string]struct {
+		priorityClass api.PriorityClass
+		valid         bool
+	}{
+		"valid":          {validPriorityClass(), true},
+		"negative value": {negative, true},
+		"empty name":     {emptyName, false},
+		"invalid name":   {invalidName, false},
+		"namespaced":     {namespaced, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePriorityClass(&tc.priorityClass)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePriorityClassUpdate(t *testing.T) {
+	old := api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{Name: "high-priority", ResourceVersion: "1"},
+		Value:      1000,
+	}
+
+	newDescription := old
+	newDescription.Description = "for the pods serving users"
+	if errs := ValidatePriorityClassUpdate(&newDescription, &old); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+
+	newValue := old
+	newValue.Value = 2000
+	errs := ValidatePriorityClassUpdate(&newValue, &old)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if field := errs[0].(*errors.ValidationError).Field; field != "value" {
+		t.Errorf("expected error on field value, got %s", field)
+	}
+}
+
+func TestValidatePodDisruptionBudget(t *testing.T) {
+	validPDB := func() api.PodDisruptionBudget {
+		return api.PodDisruptionBudget{
+			ObjectMeta: api.ObjectMeta{Name: "zookeeper", Namespace: api.NamespaceDefault},
+			Spec: api.PodDisruptionBudgetSpec{
+				MinAvailable: 2,
+				Selector:     map[string]string{"app": "zookeeper"},
+			},
+		}
+	}
+
+	var (
+		emptyName        = validPDB()
+		missingNamespace = validPDB()
+		emptySelector    = validPDB()
+		zeroAvailable    = validPDB()
+		negative         = validPDB()
+	)
+
+	emptyName.Name = ""
+	missingNamespace.Namespace = ""
+	emptySelector.Spec.Selector = nil
+	zeroAvailable.Spec.MinAvailable = 0
+	negative.Spec.MinAvailable = -1
+
+	tests := map[string]struct {
+		pdb   api.PodDisruptionBudget
+		valid bool
+	}{
+		"valid":                  {validPDB(), true},
+		"zero min available":     {zeroAvailable, true},
+		"empty name":             {emptyName, false},
+		"missing namespace":      {missingNamespace, false},
+		"empty selector":         {emptySelector, false},
+		"negative min available": {negative, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePodDisruptionBudget(&tc.pdb)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePodDisruptionBudgetStatusUpdate(t *testing.T) {
+	old := api.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "zookeeper", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+		Spec: api.PodDisruptionBudgetSpec{
+			MinAvailable: 2,
+			Selector:     map[string]string{"app": "zookeeper"},
+		},
+	}
+
+	update := old
+	update.Spec.MinAvailable = 3
+	update.Status = api.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3}
+	if errs := ValidatePodDisruptionBudgetStatusUpdate(&old, &update); len(errs) != 0 {
+		t.Errorf("expected success: %v", errs)
+	}
+	if update.Spec.MinAvailable != 2 {
+		t.Errorf("expected the spec to be left unchanged, got %v", update.Spec)
+	}
+
+	negative := old
+	negative.Status.PodDisruptionsAllowed = -1
+	errs := ValidatePodDisruptionBudgetStatusUpdate(&old, &negative)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if field := errs[0].(*errors.ValidationError).Field; field != "status.podDisruptionsAllowed" {
+		t.Errorf("expected error on field status.podDisruptionsAllowed, got %s", field)
+	}
+}
+
+func TestValidateEviction(t *testing.T) {
+	grace := int64(30)
+	negativeGrace := int64(-1)
+	tests := map[string]struct {
+		eviction api.Eviction
+		valid    bool
+	}{
+		"valid":                 {api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}}, true},
+		"valid with options":    {api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}, DeleteOptions: &api.DeleteOptions{GracePeriodSeconds: &grace}}, true},
+		"missing name":          {api.Eviction{}, false},
+		"negative grace period": {api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}, DeleteOptions: &api.DeleteOptions{GracePeriodSeconds: &negativeGrace}}, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateEviction(&tc.eviction)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateThirdPartyResource(t *testing.T) {
+	validThirdPartyResource := func() api.ThirdPartyResource {
+		return api.ThirdPartyResource{
+			ObjectMeta:  api.ObjectMeta{Name: "database-cluster.example.com"},
+			Description: "replicated database clusters",
+			Versions:    []string{"v1", "v2beta1"},
+		}
+	}
+
+	var (
+		noGroup          = validThirdPartyResource()
+		singleSegment    = validThirdPartyResource()
+		namespaced       = validThirdPartyResource()
+		noVersions       = validThirdPartyResource()
+		invalidVersion   = validThirdPartyResource()
+		duplicateVersion = validThirdPartyResource()
+	)
+
+	noGroup.Name = "database-cluster"
+	singleSegment.Name = "database-cluster.example"
+	namespaced.Namespace = "bar"
+	noVersions.Versions = nil
+	invalidVersion.Versions = []string{"V1"}
+	duplicateVersion.Versions = []string{"v1", "v1"}
+
+	tests := map[string]struct {
+		rsrc  api.ThirdPartyResource
+		valid bool
+	}{
+		"valid":                     {validThirdPartyResource(), true},
+		"no group":                  {noGroup, false},
+		"group of a single segment": {singleSegment, false},
+		"namespaced":                {namespaced, false},
+		"no versions":               {noVersions, false},
+		"invalid version":           {invalidVersion, false},
+		"version listed twice":      {duplicateVersion, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateThirdPartyResource(&tc.rsrc)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateThirdPartyResourceData(t *testing.T) {
+	tests := map[string]struct {
+		obj   api.ThirdPartyResourceData
+		valid bool
+	}{
+		"valid":             {api.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "db", Namespace: "default"}, Data: []byte(`{"replicas":3}`)}, true},
+		"missing namespace": {api.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "db"}}, false},
+		"invalid name":      {api.ThirdPartyResourceData{ObjectMeta: api.ObjectMeta{Name: "DB", Namespace: "default"}}, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateThirdPartyResourceData(&tc.obj)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePersistentVolume(t *testing.T) {
+	validVolume := func() api.PersistentVolume {
+		return api.PersistentVolume{
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec: api.PersistentVolumeSpec{
+				Capacity: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10G"),
+				},
+				AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+				PersistentVolumeSource: api.PersistentVolumeSource{
+					HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+				},
+			},
+		}
+	}
+
+	var (
+		emptyName         = validVolume()
+		namespaced        = validVolume()
+		missingCapacity   = validVolume()
+		extraCapacity     = validVolume()
+		missingModes      = validVolume()
+		unsupportedMode   = validVolume()
+		missingSource     = validVolume()
+		multipleSources   = validVolume()
+		invalidHostSource = validVolume()
+	)
+
+	emptyName.Name = ""
+	namespaced.Namespace = "bar"
+	missingCapacity.Spec.Capacity = nil
+	extraCapacity.Spec.Capacity[api.ResourceCPU] = resource.MustParse("1")
+	missingModes.Spec.AccessModes = nil
+	unsupportedMode.Spec.AccessModes = []api.AccessModeType{"WriteSometimes"}
+	missingSource.Spec.HostPath = nil
+	multipleSources.Spec.NFS = &api.NFSVolumeSource{Server: "localhost", Path: "/export"}
+	invalidHostSource.Spec.HostPath.Path = ""
+
+	tests := map[string]struct {
+		volume api.PersistentVolume
+		valid  bool
+	}{
+		"valid":               {validVolume(), true},
+		"empty name":          {emptyName, false},
+		"namespaced":          {namespaced, false},
+		"missing capacity":    {missingCapacity, false},
+		"extra capacity":      {extraCapacity, false},
+		"missing accessModes": {missingModes, false},
+		"unsupported mode":    {unsupportedMode, false},
+		"missing source":      {missingSource, false},
+		"multiple sources":    {multipleSources, false},
+		"invalid hostPath":    {invalidHostSource, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePersistentVolume(&tc.volume)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidatePersistentVolumeClaim(t *testing.T) {
+	validClaim := func() api.PersistentVolumeClaim {
+		return api.PersistentVolumeClaim{
+			ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec: api.PersistentVolumeClaimSpec{
+				AccessModes: []api.AccessModeType{api.ReadWriteOnce, api.ReadOnlyMany},
+				Resources: api.ResourceRequirements{
+					Limits: api.ResourceList{
+						api.ResourceStorage: resource.MustParse("10G"),
+					},
+				},
+			},
+		}
+	}
+
+	var (
+		emptyName       = validClaim()
+		emptyNs         = validClaim()
+		missingModes    = validClaim()
+		missingStorage  = validClaim()
+		negativeStorage = validClaim()
+		requestOnly     = validClaim()
+		negativeRequest = validClaim()
+	)
+
+	emptyName.Name = ""
+	emptyNs.Namespace = ""
+	missingModes.Spec.AccessModes = nil
+	missingStorage.Spec.Resources.Limits = nil
+	negativeStorage.Spec.Resources.Limits[api.ResourceStorage] = resource.MustParse("-10G")
+	requestOnly.Spec.Resources = api.ResourceRequirements{Requests: api.ResourceList{api.ResourceStorage: resource.MustParse("10G")}}
+	negativeRequest.Spec.Resources = api.ResourceRequirements{Requests: api.ResourceList{api.ResourceStorage: resource.MustParse("-10G")}}
+
+	tests := map[string]struct {
+		claim api.PersistentVolumeClaim
+		valid bool
+	}{
+		"valid":               {validClaim(), true},
+		"empty name":          {emptyName, false},
+		"empty namespace":     {emptyNs, false},
+		"missing accessModes": {missingModes, false},
+		"missing storage":     {missingStorage, false},
+		"negative storage":    {negativeStorage, false},
+		"request only":        {requestOnly, true},
+		"negative request":    {negativeRequest, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidatePersistentVolumeClaim(&tc.claim)
+		if tc.valid && len(errs) > 0 {
+			t.Errorf("%v: Unexpected error: %v", name, errs)
+		}
+		if !tc.valid && len(errs) == 0 {
+			t.Errorf("%v: Unexpected non-error", name)
+		}
+	}
+}
+
+func TestValidateEndpoints(t *testing.T) {
+	validEndpoints := func() api.Endpoints {
+		return api.Endpoints{
+			ObjectMeta: api.ObjectMeta{Name: "mysvc", Namespace: "namespace"},
+			Subsets: []api.EndpointSubset{
+				{
+					Addresses: []api.EndpointAddress{{IP: "10.10.1.1"}, {IP: "10.10.2.2"}},
+					Ports:     []api.EndpointPort{{Name: "a", Port: 8675, Protocol: "TCP"}, {Name: "b", Port: 309, Protocol: "TCP"}},
+				},
+				{
+					Addresses: []api.EndpointAddress{{IP: "10.10.3.3"}},
+					Ports:     []api.EndpointPort{{Name: "a", Port: 93, Protocol: "TCP"}, {Name: "b", Port: 76, Protocol: "TCP"}},
+				},
+			},
+		}
+	}
+
+	var (
+		emptyName        = validEndpoints()
+		missingAddresses = validEndpoints()
+		missingPorts     = validEndpoints()
+		invalidIP        = validEndpoints()
+		invalidPort      = validEndpoints()
+		invalidProtocol  = validEndpoints()
+		missingPortName  = validEndpoints()
+	)
+
+	emptyName.Name = ""
+	missingAddresses.Subsets[0].Addresses = nil
+	missingPorts.Subsets[0].Ports = nil
+	invalidIP.Subsets[1].Addresses[0].IP = "[2001:db8::1]"
+	invalidPort.Subsets[0].Ports[0].Port = 66000
+	invalidProtocol.Subsets[0].Ports[0].Protocol = "Protocol"
+	missingPortName.Subsets[0].Ports[0].Name = ""
+
+	tests := map[string]struct {
+		endpoints api.Endpoints
+		valid     bool
+	}{
+		"valid":             {validEndpoints(), true},
+		"empty name":        {emptyName, false},
+		"missing addresses": {missingAddresses, false},
+		"missing ports":     {missingPorts, false},
+		"invalid IP":        {invalidIP, false},
+		"invalid port":      {invalidPort, false},
+		"invalid protocol":  {invalidProtocol, false},
+		"missing port name": {missingPortName, false},
+	}
+
+	for name, tc := range tests {
+		errs := ValidateEndpoints(&tc.endpoints)
 		if tc.valid && len(errs) > 0 {
 			t.Errorf("%v: Unexpected error: %v", name, errs)
 		}
@@ -64,6 +64,8 @@ func (a *APIInstaller) Install() (ws *restful.WebService, errors []error) {
 		codec:   a.group.Codec,
 		linker:  a.group.Linker,
 		info:    a.info,
+
+		protobufCodec: a.group.ProtobufCodec,
 	})
 	redirectHandler := (&RedirectHandler{a.group.Storage, a.group.Codec, a.group.Context, a.info})
 	proxyHandler := (&ProxyHandler{a.prefix + "/proxy/", a.group.Storage, a.group.Codec, a.group.Context, a.info})
@@ -90,7 +92,11 @@ func (a *APIInstaller) newWebService() *restful.WebService {
 	ws.Doc("API at " + a.prefix + " version " + a.group.Version)
 	// TODO: change to restful.MIME_JSON when we set content type in client
 	ws.Consumes("*/*")
-	ws.Produces(restful.MIME_JSON)
+	if a.group.ProtobufCodec != nil {
+		ws.Produces(restful.MIME_JSON, runtime.ProtobufContentType)
+	} else {
+		ws.Produces(restful.MIME_JSON)
+	}
 	ws.ApiVersion(a.group.Version)
 	return ws
 }
@@ -136,6 +142,11 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 	if err != nil {
 		return err
 	}
+	// the handlers encode their results in protobuf for the clients which accept it
+	codec := mapping.Codec
+	if a.group.ProtobufCodec != nil {
+		codec = protobufCodec{mapping.Codec, a.group.ProtobufCodec}
+	}
 
 	// what verbs are supported by the storage, used to know what verbs we support per path
 	creater, isCreater := storage.(rest.Creater)
@@ -287,7 +298,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 		m := monitorFilter(action.Verb, resource)
 		switch action.Verb {
 		case "GET": // Get a resource.
-			route := ws.GET(action.Path).To(GetResource(getter, ctxFn, action.Namer, mapping.Codec)).
+			route := ws.GET(action.Path).To(GetResource(getter, ctxFn, action.Namer, codec)).
 				Filter(m).
 				Doc("read the specified " + kind).
 				Operation("read" + kind).
@@ -295,7 +306,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "LIST": // List all resources of a kind.
-			route := ws.GET(action.Path).To(ListResource(lister, ctxFn, action.Namer, mapping.Codec, a.group.Version, resource)).
+			route := ws.GET(action.Path).To(ListResource(lister, ctxFn, action.Namer, codec, a.group.Version, resource)).
 				Filter(m).
 				Doc("list objects of kind " + kind).
 				Operation("list" + kind).
@@ -303,7 +314,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "PUT": // Update a resource.
-			route := ws.PUT(action.Path).To(UpdateResource(updater, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, admit)).
+			route := ws.PUT(action.Path).To(UpdateResource(updater, ctxFn, action.Namer, codec, a.group.Typer, resource, admit)).
 				Filter(m).
 				Doc("replace the specified " + kind).
 				Operation("replace" + kind).
@@ -311,17 +322,16 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "PATCH": // Partially update a resource
-			route := ws.PATCH(action.Path).To(PatchResource(patcher, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, admit)).
+			route := ws.PATCH(action.Path).To(PatchResource(patcher, ctxFn, action.Namer, codec, a.group.Typer, resource, admit, versionedPtr)).
 				Filter(m).
+				// the patch strategy is chosen from the content type by the handler
 				Doc("partially update the specified " + kind).
-				// TODO: toggle patch strategy by content type
-				// Consumes("application/merge-patch+json", "application/json-patch+json").
 				Operation("patch" + kind).
 				Reads(versionedObject)
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "POST": // Create a resource.
-			route := ws.POST(action.Path).To(CreateResource(creater, ctxFn, action.Namer, mapping.Codec, a.group.Typer, resource, admit)).
+			route := ws.POST(action.Path).To(CreateResource(creater, ctxFn, action.Namer, codec, a.group.Typer, resource, admit)).
 				Filter(m).
 				Doc("create a " + kind).
 				Operation("create" + kind).
@@ -329,7 +339,7 @@ func (a *APIInstaller) registerResourceHandlers(path string, storage rest.Storag
 			addParams(route, action.Params)
 			ws.Route(route)
 		case "DELETE": // Delete a resource.
-			route := ws.DELETE(action.Path).To(DeleteResource(gracefulDeleter, isGracefulDeleter, ctxFn, action.Namer, mapping.Codec, resource, kind, admit)).
+			route := ws.DELETE(action.Path).To(DeleteResource(gracefulDeleter, isGracefulDeleter, ctxFn, action.Namer, codec, resource, kind, admit)).
 				Filter(m).
 				Doc("delete a " + kind).
 				Operation("delete" + kind)
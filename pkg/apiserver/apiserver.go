@@ -98,7 +98,9 @@ type Mux interface {
 type APIGroupVersion struct {
 	Storage map[string]rest.Storage
 
-	Root    string
+	Root string
+	// Group is the API group served under Root, if any, as {Root}/{Group}/{Version}.
+	Group   string
 	Version string
 
 	Mapper meta.RESTMapper
@@ -107,6 +109,9 @@ type APIGroupVersion struct {
 	Typer   runtime.ObjectTyper
 	Creater runtime.ObjectCreater
 	Linker  runtime.SelfLinker
+	// ProtobufCodec, if set, encodes the responses to the clients which accept protobuf.
+	// Codec must decode the request bodies sent in protobuf.
+	ProtobufCodec runtime.Codec
 
 	Admit   admission.Interface
 	Context api.RequestContextMapper
@@ -116,9 +121,14 @@ type APIGroupVersion struct {
 // It is expected that the provided path root prefix will serve all operations. Root MUST NOT end
 // in a slash. A restful WebService is created for the group and version.
 func (g *APIGroupVersion) InstallREST(container *restful.Container) error {
-	info := &APIRequestInfoResolver{util.NewStringSet(strings.TrimPrefix(g.Root, "/")), g.Mapper}
+	info := &APIRequestInfoResolver{RestMapper: g.Mapper}
+	if len(g.Group) > 0 {
+		info.APIGroupPrefixes = util.NewStringSet(strings.TrimPrefix(g.Root, "/"))
+	} else {
+		info.APIPrefixes = util.NewStringSet(strings.TrimPrefix(g.Root, "/"))
+	}
 
-	prefix := path.Join(g.Root, g.Version)
+	prefix := path.Join(g.Root, g.Group, g.Version)
 	installer := &APIInstaller{
 		group:  g,
 		info:   info,
@@ -196,13 +206,49 @@ func APIVersionHandler(versions ...string) restful.RouteFunction {
 	}
 }
 
-// writeJSON renders an object as JSON to the response.
+// protobufCodec is the codec of the handlers of an API group served in protobuf: it embeds
+// the JSON codec of the group, and holds the protobuf codec used to encode the responses to
+// the clients which accept protobuf.
+type protobufCodec struct {
+	runtime.Codec
+	protobuf runtime.Codec
+}
+
+// responseCodec returns the codec to encode the response to req with.
+func responseCodec(req *http.Request, codec runtime.Codec) runtime.Codec {
+	if c, ok := codec.(protobufCodec); ok && acceptsProtobuf(req) {
+		return c.protobuf
+	}
+	return codec
+}
+
+// acceptsProtobuf returns true if the Accept header of req lists protobuf.
+func acceptsProtobuf(req *http.Request) bool {
+	for _, mediaRange := range strings.Split(req.Header.Get("Accept"), ",") {
+		if idx := strings.Index(mediaRange, ";"); idx >= 0 {
+			mediaRange = mediaRange[:idx]
+		}
+		if strings.TrimSpace(mediaRange) == runtime.ProtobufContentType {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSON renders an object as JSON to the response, or as protobuf if codec encodes
+// in protobuf.
 func writeJSON(statusCode int, codec runtime.Codec, object runtime.Object, w http.ResponseWriter) {
 	output, err := codec.Encode(object)
 	if err != nil {
 		errorJSONFatal(err, codec, w)
 		return
 	}
+	if runtime.IsProtobuf(output) {
+		w.Header().Set("Content-Type", runtime.ProtobufContentType)
+		w.WriteHeader(statusCode)
+		w.Write(output)
+		return
+	}
 	// PR #2243: Pretty-print JSON by default.
 	formatted := &bytes.Buffer{}
 	err = json.Indent(formatted, output, "", "  ")
@@ -233,7 +279,11 @@ func errorJSONFatal(err error, codec runtime.Codec, w http.ResponseWriter) int {
 		fmt.Fprintf(w, "%s: %s", status.Reason, status.Message)
 		return status.Code
 	}
-	w.Header().Set("Content-Type", "application/json")
+	if runtime.IsProtobuf(output) {
+		w.Header().Set("Content-Type", runtime.ProtobufContentType)
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
 	w.WriteHeader(status.Code)
 	w.Write(output)
 	return status.Code
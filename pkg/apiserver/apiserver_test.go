@@ -34,8 +34,10 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	apierrs "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
@@ -218,6 +220,10 @@ type SimpleRESTStorage struct {
 	actualNamespace  string
 	namespacePresent bool
 
+	// These are set when List is called
+	requestedLimit    int
+	requestedContinue string
+
 	// These are set when Watch is called
 	fakeWatch                  *watch.FakeWatcher
 	requestedLabelSelector     labels.Selector
@@ -237,6 +243,8 @@ type SimpleRESTStorage struct {
 
 func (storage *SimpleRESTStorage) List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
 	storage.checkContext(ctx)
+	storage.requestedLimit, _ = api.LimitFrom(ctx)
+	storage.requestedContinue, _ = api.ContinueFrom(ctx)
 	result := &SimpleList{
 		Items: storage.list,
 	}
@@ -599,6 +607,36 @@ func TestNonEmptyList(t *testing.T) {
 	}
 }
 
+func TestListPage(t *testing.T) {
+	storage := map[string]rest.Storage{}
+	simpleStorage := SimpleRESTStorage{}
+	storage["simple"] = &simpleStorage
+	handler := handle(storage)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/version/simple?limit=10&continue=token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Unexpected status: %d, Expected: %d, %#v", resp.StatusCode, http.StatusOK, resp)
+	}
+	if simpleStorage.requestedLimit != 10 || simpleStorage.requestedContinue != "token" {
+		t.Errorf("Unexpected page: %d %q", simpleStorage.requestedLimit, simpleStorage.requestedContinue)
+	}
+
+	for _, limit := range []string{"-1", "ten"} {
+		resp, err := http.Get(server.URL + "/api/version/simple?limit=" + limit)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: Unexpected status: %d, Expected: %d", limit, resp.StatusCode, http.StatusBadRequest)
+		}
+	}
+}
+
 func TestSelfLinkSkipsEmptyName(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{
@@ -685,6 +723,80 @@ func TestGet(t *testing.T) {
 	}
 }
 
+type podGetter struct {
+	pod api.Pod
+}
+
+func (s *podGetter) New() runtime.Object {
+	return &api.Pod{}
+}
+
+func (s *podGetter) Get(ctx api.Context, name string) (runtime.Object, error) {
+	pod := s.pod
+	return &pod, nil
+}
+
+func TestGetProtobuf(t *testing.T) {
+	group := &APIGroupVersion{
+		Storage: map[string]rest.Storage{
+			"pods": &podGetter{api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "default"}}},
+		},
+		Mapper: latest.RESTMapper,
+
+		Root:    "/api",
+		Version: "v1beta3",
+
+		Creater:       api.Scheme,
+		Typer:         api.Scheme,
+		Codec:         v1beta3.Codec,
+		ProtobufCodec: v1beta3.ProtobufCodec,
+		Linker:        latest.SelfLinker,
+
+		Admit:   admissionControl,
+		Context: requestContextMapper,
+	}
+	container := restful.NewContainer()
+	container.Router(restful.CurlyRouter{})
+	group.InstallREST(container)
+	server := httptest.NewServer(container)
+	defer server.Close()
+
+	for _, accept := range []string{"", "application/json", runtime.ProtobufContentType + ", application/json"} {
+		req, err := http.NewRequest("GET", server.URL+"/api/v1beta3/namespaces/default/pods/foo", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%q: unexpected response: %d %s", accept, resp.StatusCode, string(body))
+		}
+
+		protobuf := strings.HasPrefix(accept, runtime.ProtobufContentType)
+		if protobuf != runtime.IsProtobuf(body) {
+			t.Errorf("%q: unexpected encoding of %q", accept, string(body))
+		}
+		if contentType := resp.Header.Get("Content-Type"); protobuf != (contentType == runtime.ProtobufContentType) {
+			t.Errorf("%q: unexpected content type %q", accept, contentType)
+		}
+		obj, err := v1beta3.Codec.Decode(body)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", accept, err)
+		}
+		if pod, ok := obj.(*api.Pod); !ok || pod.Name != "foo" || pod.SelfLink != "/api/v1beta3/namespaces/default/pods/foo" {
+			t.Errorf("%q: unexpected object: %#v", accept, obj)
+		}
+	}
+}
+
 func TestGetAlternateSelfLink(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{
@@ -1006,6 +1118,55 @@ func TestPatchRequiresMatchingName(t *testing.T) {
 	}
 }
 
+func TestPatchContentTypes(t *testing.T) {
+	testCases := []struct {
+		contentType    string
+		patch          string
+		expectedStatus int
+		expectedOther  string
+		expectedLabels map[string]string
+	}{
+		{"application/merge-patch+json", `{"other":"baz","labels":{"foo":"bar"}}`, http.StatusOK, "baz", map[string]string{"foo": "bar", "a": "b"}},
+		{"application/json-patch+json", `[{"op":"replace","path":"/other","value":"baz"},{"op":"remove","path":"/labels/a"}]`, http.StatusOK, "baz", map[string]string{}},
+		{"application/strategic-merge-patch+json; charset=UTF-8", `{"labels":{"$patch":"replace","foo":"bar"}}`, http.StatusOK, "bar", map[string]string{"foo": "bar"}},
+		{"application/json-patch+json", `{"other":"baz"}`, http.StatusBadRequest, "", nil},
+		{"application/json-patch+json", `[{"op":"remove","path":"/missing/field"}]`, http.StatusBadRequest, "", nil},
+		{"text/plain", `{"other":"baz"}`, http.StatusBadRequest, "", nil},
+	}
+	for _, testCase := range testCases {
+		ID := "id"
+		item := &Simple{
+			ObjectMeta: api.ObjectMeta{Name: ID},
+			Other:      "bar",
+			Labels:     map[string]string{"a": "b"},
+		}
+		simpleStorage := SimpleRESTStorage{item: *item}
+		server := httptest.NewServer(handle(map[string]rest.Storage{"simple": &simpleStorage}))
+
+		request, _ := http.NewRequest("PATCH", server.URL+"/api/version/simple/"+ID, bytes.NewReader([]byte(testCase.patch)))
+		request.Header.Set("Content-Type", testCase.contentType)
+		response, err := http.DefaultClient.Do(request)
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", testCase.contentType, err)
+			continue
+		}
+		if response.StatusCode != testCase.expectedStatus {
+			t.Errorf("%s: expected status %d, got %d", testCase.contentType, testCase.expectedStatus, response.StatusCode)
+			continue
+		}
+		if testCase.expectedStatus != http.StatusOK {
+			if simpleStorage.updated != nil {
+				t.Errorf("%s: unexpected update %#v", testCase.contentType, simpleStorage.updated)
+			}
+			continue
+		}
+		if simpleStorage.updated == nil || simpleStorage.updated.Other != testCase.expectedOther || !reflect.DeepEqual(simpleStorage.updated.Labels, testCase.expectedLabels) {
+			t.Errorf("%s: unexpected update %#v", testCase.contentType, simpleStorage.updated)
+		}
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	storage := map[string]rest.Storage{}
 	simpleStorage := SimpleRESTStorage{}
@@ -1473,6 +1634,41 @@ func TestCreateInNamespace(t *testing.T) {
 	}
 }
 
+func TestCreateSubresourceRequiresMatchingName(t *testing.T) {
+	subresourceStorage := SimpleRESTStorage{}
+	handler := handle(map[string]rest.Storage{"simple": &SimpleRESTStorage{}, "simple/sub": &subresourceStorage})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := http.Client{}
+
+	for _, name := range []string{"", "id"} {
+		data, _ := codec.Encode(&Simple{ObjectMeta: api.ObjectMeta{Name: name}, Other: "bar"})
+		response, err := client.Post(server.URL+"/api/version/simple/id/sub", "application/json", bytes.NewBuffer(data))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if response.StatusCode != http.StatusCreated {
+			t.Errorf("Unexpected status for the name %q: %d", name, response.StatusCode)
+		}
+		if subresourceStorage.created == nil || subresourceStorage.created.Name != "id" {
+			t.Errorf("Expected the subresource to be created for id, got %#v", subresourceStorage.created)
+		}
+		subresourceStorage.created = nil
+	}
+
+	data, _ := codec.Encode(&Simple{ObjectMeta: api.ObjectMeta{Name: "other"}, Other: "bar"})
+	response, err := client.Post(server.URL+"/api/version/simple/id/sub", "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("Unexpected status for another name: %d", response.StatusCode)
+	}
+	if subresourceStorage.created != nil {
+		t.Errorf("Expected no subresource to be created for another name, got %#v", subresourceStorage.created)
+	}
+}
+
 func TestCreateInvokesAdmissionControl(t *testing.T) {
 	storage := SimpleRESTStorage{
 		injectedFunction: func(obj runtime.Object) (runtime.Object, error) {
@@ -19,18 +19,43 @@ package apiserver
 import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/authenticator/bearertoken"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/request/union"
+	serviceaccounttoken "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/serviceaccount"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/auth/authenticator/token/tokenfile"
 )
 
-// NewAuthenticatorFromTokenFile returns an authenticator.Request or an error
-func NewAuthenticatorFromTokenFile(tokenAuthFile string) (authenticator.Request, error) {
-	var authenticator authenticator.Request
+// NewAuthenticator returns an authenticator.Request or an error. Bearer tokens
+// are checked against tokenAuthFile and, when serviceAccountKeyFile is set,
+// verified as service account tokens. If serviceAccountLookup is true,
+// service account tokens are only accepted while their secret exists.
+func NewAuthenticator(tokenAuthFile, serviceAccountKeyFile string, serviceAccountLookup bool, client client.Interface) (authenticator.Request, error) {
+	authenticators := []authenticator.Request{}
 	if len(tokenAuthFile) != 0 {
 		tokenAuthenticator, err := tokenfile.NewCSV(tokenAuthFile)
 		if err != nil {
 			return nil, err
 		}
-		authenticator = bearertoken.New(tokenAuthenticator)
+		authenticators = append(authenticators, bearertoken.New(tokenAuthenticator))
+	}
+	if len(serviceAccountKeyFile) != 0 {
+		key, err := serviceaccount.ReadPublicKey(serviceAccountKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if !serviceAccountLookup {
+			client = nil
+		}
+		authenticators = append(authenticators, bearertoken.New(serviceaccounttoken.NewJWT(key, client)))
+	}
+
+	switch len(authenticators) {
+	case 0:
+		return nil, nil
+	case 1:
+		return authenticators[0], nil
+	default:
+		return union.New(authenticators...), nil
 	}
-	return authenticator, nil
 }
@@ -160,8 +160,8 @@ type requestAttributeGetter struct {
 }
 
 // NewAttributeGetter returns an object which implements the RequestAttributeGetter interface.
-func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, restMapper meta.RESTMapper, apiRoots ...string) RequestAttributeGetter {
-	return &requestAttributeGetter{requestContextMapper, &APIRequestInfoResolver{util.NewStringSet(apiRoots...), restMapper}}
+func NewRequestAttributeGetter(requestContextMapper api.RequestContextMapper, apiRequestInfoResolver *APIRequestInfoResolver) RequestAttributeGetter {
+	return &requestAttributeGetter{requestContextMapper, apiRequestInfoResolver}
 }
 
 func (r *requestAttributeGetter) GetAttribs(req *http.Request) authorizer.Attributes {
@@ -208,7 +208,9 @@ type APIRequestInfo struct {
 	// Verb is the kube verb associated with the request, not the http verb.  This includes things like list and watch.
 	Verb       string
 	APIVersion string
-	Namespace  string
+	// APIGroup is the group of the API, for the requests made under a group prefix.
+	APIGroup  string
+	Namespace string
 	// Resource is the name of the resource being requested.  This is not the kind.  For example: pods
 	Resource string
 	// Kind is the type of object being manipulated.  For example: Pod
@@ -235,7 +237,10 @@ func (info APIRequestInfo) URLPath() string {
 
 type APIRequestInfoResolver struct {
 	APIPrefixes util.StringSet
-	RestMapper  meta.RESTMapper
+	// APIGroupPrefixes are the prefixes under which the APIs are served by group, as
+	// /{prefix}/{group}/{version}.
+	APIGroupPrefixes util.StringSet
+	RestMapper       meta.RESTMapper
 }
 
 // GetAPIRequestInfo returns the information from the http request.  If error is not nil, APIRequestInfo holds the information as best it is known before the failure
@@ -261,6 +266,7 @@ type APIRequestInfoResolver struct {
 // Fully qualified paths for above:
 // /api/{version}/*
 // /api/{version}/*
+// /{groupPrefix}/{group}/{version}/*
 func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIRequestInfo, error) {
 	requestInfo := APIRequestInfo{
 		Raw: splitPath(req.URL.Path),
@@ -271,6 +277,22 @@ func (r *APIRequestInfoResolver) GetAPIRequestInfo(req *http.Request) (APIReques
 		return requestInfo, fmt.Errorf("Unable to determine kind and namespace from an empty URL path")
 	}
 
+	// handle input of form /{groupPrefix}/{group}/{version}/*
+	if r.APIGroupPrefixes.Has(currentParts[0]) {
+		if len(currentParts) > 1 {
+			requestInfo.APIGroup = currentParts[1]
+		}
+		if len(currentParts) > 2 {
+			requestInfo.APIVersion = currentParts[2]
+		}
+
+		if len(currentParts) > 3 {
+			currentParts = currentParts[3:]
+		} else {
+			return requestInfo, fmt.Errorf("Unable to determine kind and namespace from url, %v", req.URL)
+		}
+	}
+
 	for _, currPrefix := range r.APIPrefixes.List() {
 		// handle input of form /api/{version}/* by adjusting special paths
 		if currentParts[0] == currPrefix {
@@ -113,7 +113,7 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		{"GET", "/api/v1beta1/watch/namespaces/other/pods", "watch", "v1beta1", "other", "pods", "Pod", "", []string{"pods"}},
 	}
 
-	apiRequestInfoResolver := &APIRequestInfoResolver{util.NewStringSet("api"), latest.RESTMapper}
+	apiRequestInfoResolver := &APIRequestInfoResolver{APIPrefixes: util.NewStringSet("api"), RestMapper: latest.RESTMapper}
 
 	for _, successCase := range successCases {
 		req, _ := http.NewRequest(successCase.method, successCase.url, nil)
@@ -164,3 +164,47 @@ func TestGetAPIRequestInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestGetAPIRequestInfoWithGroup(t *testing.T) {
+	apiRequestInfoResolver := &APIRequestInfoResolver{APIGroupPrefixes: util.NewStringSet("thirdparty"), RestMapper: latest.RESTMapper}
+
+	successCases := []struct {
+		method, url        string
+		expectedVerb       string
+		expectedAPIGroup   string
+		expectedNamespace  string
+		expectedResource   string
+		expectedName       string
+		expectedAPIVersion string
+	}{
+		{"GET", "/thirdparty/example.com/v1/namespaces/other/databaseclusters", "list", "example.com", "other", "databaseclusters", "", "v1"},
+		{"GET", "/thirdparty/example.com/v1/namespaces/other/databaseclusters/foo", "get", "example.com", "other", "databaseclusters", "foo", "v1"},
+		{"POST", "/thirdparty/example.com/v1/namespaces/other/databaseclusters", "create", "example.com", "other", "databaseclusters", "", "v1"},
+		{"GET", "/thirdparty/example.com/v1/watch/databaseclusters", "watch", "example.com", api.NamespaceAll, "databaseclusters", "", "v1"},
+	}
+	for _, successCase := range successCases {
+		req, _ := http.NewRequest(successCase.method, successCase.url, nil)
+		apiRequestInfo, err := apiRequestInfoResolver.GetAPIRequestInfo(req)
+		if err != nil {
+			t.Errorf("Unexpected error for url: %s %v", successCase.url, err)
+		}
+		if successCase.expectedVerb != apiRequestInfo.Verb ||
+			successCase.expectedAPIGroup != apiRequestInfo.APIGroup ||
+			successCase.expectedAPIVersion != apiRequestInfo.APIVersion ||
+			successCase.expectedNamespace != apiRequestInfo.Namespace ||
+			successCase.expectedResource != apiRequestInfo.Resource ||
+			successCase.expectedName != apiRequestInfo.Name {
+			t.Errorf("Unexpected request info for url: %s, got %#v", successCase.url, apiRequestInfo)
+		}
+		if e, a := successCase.url, apiRequestInfo.URLPath(); e != a {
+			t.Errorf("Expected %v, got %v", e, a)
+		}
+	}
+
+	for _, url := range []string{"/thirdparty/example.com", "/thirdparty/example.com/v1"} {
+		req, _ := http.NewRequest("GET", url, nil)
+		if _, err := apiRequestInfoResolver.GetAPIRequestInfo(req); err == nil {
+			t.Errorf("Expected error for url: %s", url)
+		}
+	}
+}
@@ -21,15 +21,19 @@ import (
 	"net/http"
 	"net/url"
 	gpath "path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/strategicpatch"
 
 	"github.com/emicklei/go-restful"
 	"github.com/evanphx/json-patch"
@@ -80,7 +84,7 @@ func GetResource(r rest.Getter, ctxFn ContextFunc, namer ScopeNamer, codec runti
 			errorJSON(err, codec, w)
 			return
 		}
-		writeJSON(http.StatusOK, codec, result, w)
+		writeJSON(http.StatusOK, responseCodec(req.Request, codec), result, w)
 	}
 }
 
@@ -120,6 +124,21 @@ func ListResource(r rest.Lister, ctxFn ContextFunc, namer ScopeNamer, codec runt
 			errorJSON(err, codec, w)
 			return
 		}
+		query := req.Request.URL.Query()
+		if resourceVersion := query.Get("resourceVersion"); len(resourceVersion) > 0 {
+			ctx = api.WithResourceVersion(ctx, resourceVersion)
+		}
+		if limitString := query.Get("limit"); len(limitString) > 0 {
+			limit, err := strconv.Atoi(limitString)
+			if err != nil || limit < 0 {
+				errorJSON(errors.NewBadRequest(fmt.Sprintf("The 'limit' parameter (%s) must be a non-negative integer", limitString)), codec, w)
+				return
+			}
+			ctx = api.WithLimit(ctx, limit)
+		}
+		if continueToken := query.Get("continue"); len(continueToken) > 0 {
+			ctx = api.WithContinue(ctx, continueToken)
+		}
 
 		result, err := r.List(ctx, label, field)
 		if err != nil {
@@ -130,7 +149,7 @@ func ListResource(r rest.Lister, ctxFn ContextFunc, namer ScopeNamer, codec runt
 			errorJSON(err, codec, w)
 			return
 		}
-		writeJSON(http.StatusOK, codec, result, w)
+		writeJSON(http.StatusOK, responseCodec(req.Request, codec), result, w)
 	}
 }
 
@@ -163,6 +182,14 @@ func CreateResource(r rest.Creater, ctxFn ContextFunc, namer ScopeNamer, codec r
 			return
 		}
 
+		// the subresources of an object are created for the object named on the URL
+		if len(req.PathParameter("name")) > 0 {
+			if err := setSubresourceName(req, obj, namespace, namer); err != nil {
+				errorJSON(err, codec, w)
+				return
+			}
+		}
+
 		err = admit.Admit(admission.NewAttributesRecord(obj, namespace, resource, "CREATE"))
 		if err != nil {
 			errorJSON(err, codec, w)
@@ -186,13 +213,15 @@ func CreateResource(r rest.Creater, ctxFn ContextFunc, namer ScopeNamer, codec r
 			return
 		}
 
-		writeJSON(http.StatusCreated, codec, result, w)
+		writeJSON(http.StatusCreated, responseCodec(req.Request, codec), result, w)
 	}
 }
 
 // PatchResource returns a function that will handle a resource patch
 // TODO: Eventually PatchResource should just use AtomicUpdate and this routine should be a bit cleaner
-func PatchResource(r rest.Patcher, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, typer runtime.ObjectTyper, resource string, admit admission.Interface) restful.RouteFunction {
+// versionedObj is an object of the version of the request, which declares how its lists are merged
+// by a strategic merge patch.
+func PatchResource(r rest.Patcher, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, typer runtime.ObjectTyper, resource string, admit admission.Interface, versionedObj runtime.Object) restful.RouteFunction {
 	return func(req *restful.Request, res *restful.Response) {
 		w := res.ResponseWriter
 
@@ -232,7 +261,12 @@ func PatchResource(r rest.Patcher, ctxFn ContextFunc, namer ScopeNamer, codec ru
 			errorJSON(err, codec, w)
 			return
 		}
-		patchedObjJs, err := jsonpatch.MergePatch(originalObjJs, patchJs)
+		contentType := req.HeaderParameter("Content-Type")
+		// Remove "; charset=" if included in header.
+		if idx := strings.Index(contentType, ";"); idx > 0 {
+			contentType = contentType[:idx]
+		}
+		patchedObjJs, err := applyPatch(api.PatchType(strings.TrimSpace(contentType)), originalObjJs, patchJs, versionedObj)
 		if err != nil {
 			errorJSON(err, codec, w)
 			return
@@ -262,10 +296,52 @@ func PatchResource(r rest.Patcher, ctxFn ContextFunc, namer ScopeNamer, codec ru
 			return
 		}
 
-		writeJSON(http.StatusOK, codec, result, w)
+		writeJSON(http.StatusOK, responseCodec(req.Request, codec), result, w)
+	}
+}
+
+// applyPatch applies a patch of the given type to the JSON of an object. A patch without
+// content type, or sent as plain JSON, is a merge patch.
+func applyPatch(patchType api.PatchType, originalJs, patchJs []byte, versionedObj runtime.Object) ([]byte, error) {
+	switch patchType {
+	case api.JSONPatchType:
+		patch, err := jsonpatch.DecodePatch(patchJs)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the JSON patch could not be decoded: %v", err))
+		}
+		patchedJs, err := applyJSONPatch(patch, originalJs)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the JSON patch could not be applied: %v", err))
+		}
+		return patchedJs, nil
+	case api.StrategicMergePatchType:
+		patchedJs, err := strategicpatch.StrategicMergePatch(originalJs, patchJs, versionedObj)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the strategic merge patch could not be applied: %v", err))
+		}
+		return patchedJs, nil
+	case api.MergePatchType, "", "application/json":
+		patchedJs, err := jsonpatch.MergePatch(originalJs, patchJs)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the merge patch could not be applied: %v", err))
+		}
+		return patchedJs, nil
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("the patch content type %q is not supported, use one of %q, %q or %q", patchType, api.JSONPatchType, api.MergePatchType, api.StrategicMergePatchType))
 	}
 }
 
+// applyJSONPatch applies a JSON patch, turning the panics of the patch library on
+// paths missing from the object into errors.
+func applyJSONPatch(patch jsonpatch.Patch, originalJs []byte) (patchedJs []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return patch.Apply(originalJs)
+}
+
 // UpdateResource returns a function that will handle a resource update
 func UpdateResource(r rest.Updater, ctxFn ContextFunc, namer ScopeNamer, codec runtime.Codec, typer runtime.ObjectTyper, resource string, admit admission.Interface) restful.RouteFunction {
 	return func(req *restful.Request, res *restful.Response) {
@@ -326,7 +402,7 @@ func UpdateResource(r rest.Updater, ctxFn ContextFunc, namer ScopeNamer, codec r
 		if wasCreated {
 			status = http.StatusCreated
 		}
-		writeJSON(status, codec, result, w)
+		writeJSON(status, responseCodec(req.Request, codec), result, w)
 	}
 }
 
@@ -397,7 +473,7 @@ func DeleteResource(r rest.GracefulDeleter, checkBody bool, ctxFn ContextFunc, n
 				}
 			}
 		}
-		writeJSON(http.StatusOK, codec, result, w)
+		writeJSON(http.StatusOK, responseCodec(req.Request, codec), result, w)
 	}
 }
 
@@ -465,6 +541,19 @@ func setSelfLink(obj runtime.Object, req *restful.Request, namer ScopeNamer) err
 	return namer.SetSelfLink(obj, newURL.String())
 }
 
+// setSubresourceName names obj after the object named on the URL of req, if obj has no
+// name, and checks that it is not named after another object.
+func setSubresourceName(req *restful.Request, obj runtime.Object, namespace string, namer ScopeNamer) error {
+	_, name, err := namer.Name(req)
+	if err != nil {
+		return err
+	}
+	if accessor, err := meta.Accessor(obj); err == nil && len(accessor.Name()) == 0 {
+		accessor.SetName(name)
+	}
+	return checkName(obj, name, namespace, namer)
+}
+
 // checkName checks the provided name against the request
 func checkName(obj runtime.Object, name, namespace string, namer ScopeNamer) error {
 	if objNamespace, objName, err := namer.ObjectName(obj); err == nil {
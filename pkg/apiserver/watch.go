@@ -31,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 	watchjson "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/json"
+	watchprotobuf "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/protobuf"
 
 	"github.com/golang/glog"
 	"golang.org/x/net/websocket"
@@ -41,6 +42,9 @@ type WatchHandler struct {
 	codec   runtime.Codec
 	linker  runtime.SelfLinker
 	info    *APIRequestInfoResolver
+
+	// protobufCodec, if set, encodes the events streamed to the clients which accept protobuf.
+	protobufCodec runtime.Codec
 }
 
 // setSelfLinkAddName sets the self link, appending the object's name to the canonical path & type.
@@ -117,7 +121,10 @@ func (h *WatchHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		if err := h.setSelfLinkAddName(obj, req); err != nil {
 			glog.Errorf("Failed to set self link for object %#v", obj)
 		}
-	}}
+	}, false}
+	if h.protobufCodec != nil && acceptsProtobuf(req) && !isWebsocketRequest(req) {
+		watchServer.codec, watchServer.protobuf = h.protobufCodec, true
+	}
 	if isWebsocketRequest(req) {
 		websocket.Handler(watchServer.HandleWS).ServeHTTP(httplog.Unlogged(w), req)
 	} else {
@@ -130,6 +137,8 @@ type WatchServer struct {
 	watching watch.Interface
 	codec    runtime.Codec
 	fixup    func(runtime.Object)
+	// protobuf is set to stream the events in protobuf rather than JSON over HTTP.
+	protobuf bool
 }
 
 // HandleWS implements a websocket handler.
@@ -187,11 +196,18 @@ func (self *WatchServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	var encoder interface {
+		Encode(*watch.Event) error
+	} = watchjson.NewEncoder(w, self.codec)
+	if self.protobuf {
+		encoder = watchprotobuf.NewEncoder(w, self.codec)
+		w.Header().Set("Content-Type", runtime.ProtobufContentType)
+	}
+
 	w.Header().Set("Transfer-Encoding", "chunked")
 	w.WriteHeader(http.StatusOK)
 	flusher.Flush()
 
-	encoder := watchjson.NewEncoder(w, self.codec)
 	for {
 		select {
 		case <-cn.CloseNotify():
@@ -17,6 +17,8 @@ limitations under the License.
 package cache
 
 import (
+	"strconv"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
@@ -27,26 +29,41 @@ import (
 // ListFunc knows how to list resources
 type ListFunc func() (runtime.Object, error)
 
+// ListPageFunc knows how to list a page of at most limit resources, following the page
+// which returned continueToken if it is not empty
+type ListPageFunc func(limit int, continueToken string) (runtime.Object, error)
+
 // WatchFunc knows how to watch resources
 type WatchFunc func(resourceVersion string) (watch.Interface, error)
 
 // ListWatch knows how to list and watch a set of apiserver resources.  It satisfies the ListerWatcher interface.
 // It is a convenience function for users of NewReflector, etc.
-// ListFunc and WatchFunc must not be nil
+// ListFunc and WatchFunc must not be nil. ListPageFunc is optional; without it, every page
+// lists all the resources.
 type ListWatch struct {
-	ListFunc  ListFunc
-	WatchFunc WatchFunc
+	ListFunc     ListFunc
+	ListPageFunc ListPageFunc
+	WatchFunc    WatchFunc
 }
 
 // NewListWatchFromClient creates a new ListWatch from the specified client, resource, namespace and field selector.
+// The lists accept any resource version, since the watches start from the version of the list,
+// and may thus be served from the watch cache of the server.
 func NewListWatchFromClient(c *client.Client, resource string, namespace string, fieldSelector fields.Selector) *ListWatch {
 	listFunc := func() (runtime.Object, error) {
-		return c.Get().Namespace(namespace).Resource(resource).FieldsSelectorParam(api.FieldSelectorQueryParam(c.APIVersion()), fieldSelector).Do().Get()
+		return c.Get().Namespace(namespace).Resource(resource).FieldsSelectorParam(api.FieldSelectorQueryParam(c.APIVersion()), fieldSelector).Param("resourceVersion", "0").Do().Get()
+	}
+	listPageFunc := func(limit int, continueToken string) (runtime.Object, error) {
+		request := c.Get().Namespace(namespace).Resource(resource).FieldsSelectorParam(api.FieldSelectorQueryParam(c.APIVersion()), fieldSelector).Param("resourceVersion", "0").Param("limit", strconv.Itoa(limit))
+		if len(continueToken) > 0 {
+			request.Param("continue", continueToken)
+		}
+		return request.Do().Get()
 	}
 	watchFunc := func(resourceVersion string) (watch.Interface, error) {
 		return c.Get().Prefix("watch").Namespace(namespace).Resource(resource).FieldsSelectorParam(api.FieldSelectorQueryParam(c.APIVersion()), fieldSelector).Param("resourceVersion", resourceVersion).Watch()
 	}
-	return &ListWatch{ListFunc: listFunc, WatchFunc: watchFunc}
+	return &ListWatch{ListFunc: listFunc, ListPageFunc: listPageFunc, WatchFunc: watchFunc}
 }
 
 // List a set of apiserver resources
@@ -54,6 +71,14 @@ func (lw *ListWatch) List() (runtime.Object, error) {
 	return lw.ListFunc()
 }
 
+// ListPage lists a page of a set of apiserver resources
+func (lw *ListWatch) ListPage(limit int, continueToken string) (runtime.Object, error) {
+	if lw.ListPageFunc == nil {
+		return lw.ListFunc()
+	}
+	return lw.ListPageFunc(limit, continueToken)
+}
+
 // Watch a set of apiserver resources
 func (lw *ListWatch) Watch(resourceVersion string) (watch.Interface, error) {
 	return lw.WatchFunc(resourceVersion)
@@ -87,7 +87,7 @@ func TestListWatchesCanList(t *testing.T) {
 	}{
 		// Minion
 		{
-			location:      buildLocation(buildResourcePath("", api.NamespaceAll, "minions"), buildQueryValues(api.NamespaceAll, nil)),
+			location:      buildLocation(buildResourcePath("", api.NamespaceAll, "minions"), buildQueryValues(api.NamespaceAll, url.Values{"resourceVersion": []string{"0"}})),
 			resource:      "minions",
 			namespace:     api.NamespaceAll,
 			fieldSelector: parseSelectorOrDie(""),
@@ -96,7 +96,7 @@ func TestListWatchesCanList(t *testing.T) {
 		{
 			location: buildLocation(
 				buildResourcePath("", api.NamespaceAll, "pods"),
-				buildQueryValues(api.NamespaceAll, url.Values{fieldSelectorQueryParamName: []string{getHostFieldLabel() + "="}})),
+				buildQueryValues(api.NamespaceAll, url.Values{fieldSelectorQueryParamName: []string{getHostFieldLabel() + "="}, "resourceVersion": []string{"0"}})),
 			resource:      "pods",
 			namespace:     api.NamespaceAll,
 			fieldSelector: fields.Set{getHostFieldLabel(): ""}.AsSelector(),
@@ -105,7 +105,7 @@ func TestListWatchesCanList(t *testing.T) {
 		{
 			location: buildLocation(
 				buildResourcePath("", "foo", "pods"),
-				buildQueryValues("foo", url.Values{fieldSelectorQueryParamName: []string{getHostFieldLabel() + "="}})),
+				buildQueryValues("foo", url.Values{fieldSelectorQueryParamName: []string{getHostFieldLabel() + "="}, "resourceVersion": []string{"0"}})),
 			resource:      "pods",
 			namespace:     "foo",
 			fieldSelector: fields.Set{getHostFieldLabel(): ""}.AsSelector(),
@@ -127,6 +127,39 @@ func TestListWatchesCanList(t *testing.T) {
 	}
 }
 
+func TestListWatchesCanListPages(t *testing.T) {
+	table := []struct {
+		location      string
+		continueToken string
+	}{
+		{
+			location: buildLocation(
+				buildResourcePath("", "foo", "pods"),
+				buildQueryValues("foo", url.Values{"resourceVersion": []string{"0"}, "limit": []string{"10"}})),
+		},
+		{
+			location: buildLocation(
+				buildResourcePath("", "foo", "pods"),
+				buildQueryValues("foo", url.Values{"resourceVersion": []string{"0"}, "limit": []string{"10"}, "continue": []string{"next"}})),
+			continueToken: "next",
+		},
+	}
+	for _, item := range table {
+		handler := util.FakeHandler{
+			StatusCode:   500,
+			ResponseBody: "",
+			T:            t,
+		}
+		server := httptest.NewServer(&handler)
+		defer server.Close()
+		client := client.NewOrDie(&client.Config{Host: server.URL, Version: testapi.Version()})
+		lw := NewListWatchFromClient(client, "pods", "foo", parseSelectorOrDie(""))
+		// This test merely tests that the correct request is made.
+		lw.ListPage(10, item.continueToken)
+		handler.ValidateRequest(t, item.location, "GET", nil)
+	}
+}
+
 func TestListWatchesCanWatch(t *testing.T) {
 	fieldSelectorQueryParamName := api.FieldSelectorQueryParam(testapi.Version())
 	table := []struct {
@@ -0,0 +1,236 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+)
+
+// PriorityFunc returns the priority of an object. Objects with a higher priority are
+// popped first.
+type PriorityFunc func(obj interface{}) int
+
+// PriorityFIFO is like FIFO, except that Pop returns the item with the highest priority
+// first. Items of the same priority are returned in the order in which they were added.
+type PriorityFIFO struct {
+	lock sync.RWMutex
+	cond sync.Cond
+	// We depend on the property that items in the set are in the queue and vice versa.
+	items map[string]*priorityItem
+	queue priorityQueue
+	// nextSeq numbers the items in the order they are enqueued.
+	nextSeq uint64
+	// keyFunc is used to make the key used for queued item insertion and retrieval, and
+	// should be deterministic.
+	keyFunc KeyFunc
+	// priorityFunc is used to order the queued items, and is evaluated again when an
+	// item is updated.
+	priorityFunc PriorityFunc
+}
+
+// priorityItem is an item of a PriorityFIFO, along with its place in the queue.
+type priorityItem struct {
+	key      string
+	obj      interface{}
+	priority int
+	seq      uint64
+	// index is the position of the item in the heap.
+	index int
+}
+
+// priorityQueue implements heap.Interface, with the item of the highest priority first.
+type priorityQueue []*priorityItem
+
+func (q priorityQueue) Len() int { return len(q) }
+
+func (q priorityQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q priorityQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *priorityQueue) Push(x interface{}) {
+	item := x.(*priorityItem)
+	item.index = len(*q)
+	*q = append(*q, item)
+}
+
+func (q *priorityQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// addLocked adds or updates an item. The lock must be held.
+func (f *PriorityFIFO) addLocked(id string, obj interface{}) {
+	if item, exists := f.items[id]; exists {
+		item.obj = obj
+		item.priority = f.priorityFunc(obj)
+		heap.Fix(&f.queue, item.index)
+		return
+	}
+	item := &priorityItem{key: id, obj: obj, priority: f.priorityFunc(obj), seq: f.nextSeq}
+	f.nextSeq++
+	heap.Push(&f.queue, item)
+	f.items[id] = item
+}
+
+// Add inserts an item, and puts it in the queue. If the item is already queued, it
+// keeps its place among the items of its priority.
+func (f *PriorityFIFO) Add(obj interface{}) error {
+	id, err := f.keyFunc(obj)
+	if err != nil {
+		return fmt.Errorf("couldn't create key for object: %v", err)
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.addLocked(id, obj)
+	f.cond.Broadcast()
+	return nil
+}
+
+// AddIfNotPresent inserts an item, and puts it in the queue. If the item is already
+// present in the set, it is neither enqueued nor added to the set.
+func (f *PriorityFIFO) AddIfNotPresent(obj interface{}) error {
+	id, err := f.keyFunc(obj)
+	if err != nil {
+		return fmt.Errorf("couldn't create key for object: %v", err)
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, exists := f.items[id]; exists {
+		return nil
+	}
+	f.addLocked(id, obj)
+	f.cond.Broadcast()
+	return nil
+}
+
+// Update is the same as Add in this implementation.
+func (f *PriorityFIFO) Update(obj interface{}) error {
+	return f.Add(obj)
+}
+
+// Delete removes an item from the set and the queue.
+func (f *PriorityFIFO) Delete(obj interface{}) error {
+	id, err := f.keyFunc(obj)
+	if err != nil {
+		return fmt.Errorf("couldn't create key for object: %v", err)
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if item, exists := f.items[id]; exists {
+		heap.Remove(&f.queue, item.index)
+		delete(f.items, id)
+	}
+	return nil
+}
+
+// List returns a list of all the items.
+func (f *PriorityFIFO) List() []interface{} {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	list := make([]interface{}, 0, len(f.items))
+	for _, item := range f.items {
+		list = append(list, item.obj)
+	}
+	return list
+}
+
+// Get returns the requested item, or sets exists=false.
+func (f *PriorityFIFO) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	key, err := f.keyFunc(obj)
+	if err != nil {
+		return nil, false, fmt.Errorf("couldn't create key for object: %v", err)
+	}
+	return f.GetByKey(key)
+}
+
+// GetByKey returns the requested item, or sets exists=false.
+func (f *PriorityFIFO) GetByKey(key string) (item interface{}, exists bool, err error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	if i, exists := f.items[key]; exists {
+		return i.obj, true, nil
+	}
+	return nil, false, nil
+}
+
+// Pop waits until an item is ready and returns it. If multiple items are
+// ready, the one with the highest priority is returned, and among those of
+// the same priority, the one added first.
+// The item is removed from the queue (and the store) before it is returned,
+// so if you don't succesfully process it, you need to add it back with Add().
+func (f *PriorityFIFO) Pop() interface{} {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for len(f.queue) == 0 {
+		f.cond.Wait()
+	}
+	item := heap.Pop(&f.queue).(*priorityItem)
+	delete(f.items, item.key)
+	return item.obj
+}
+
+// Replace will delete the contents of 'f', using instead the given list.
+// Upon return, the queue will contain the items of the list, in the order
+// of their priority, and of the list among the items of the same priority.
+func (f *PriorityFIFO) Replace(list []interface{}) error {
+	ids := make([]string, 0, len(list))
+	for _, item := range list {
+		key, err := f.keyFunc(item)
+		if err != nil {
+			return fmt.Errorf("couldn't create key for object: %v", err)
+		}
+		ids = append(ids, key)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.items = map[string]*priorityItem{}
+	f.queue = f.queue[:0]
+	for i, id := range ids {
+		f.addLocked(id, list[i])
+	}
+	if len(f.queue) > 0 {
+		f.cond.Broadcast()
+	}
+	return nil
+}
+
+// NewPriorityFIFO returns a Store which can be used to queue up items to
+// process in the order of their priority.
+func NewPriorityFIFO(keyFunc KeyFunc, priorityFunc PriorityFunc) *PriorityFIFO {
+	f := &PriorityFIFO{
+		items:        map[string]*priorityItem{},
+		queue:        priorityQueue{},
+		keyFunc:      keyFunc,
+		priorityFunc: priorityFunc,
+	}
+	f.cond.L = &f.lock
+	return f
+}
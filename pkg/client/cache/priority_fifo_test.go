@@ -0,0 +1,107 @@
+/*
+Copyright 2014 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+)
+
+// testPriorityFunc uses the value of a testFifoObject as its priority.
+func testPriorityFunc(obj interface{}) int {
+	return obj.(testFifoObject).val.(int)
+}
+
+func TestPriorityFIFO_order(t *testing.T) {
+	mkObj := func(name string, val interface{}) testFifoObject {
+		return testFifoObject{name: name, val: val}
+	}
+
+	f := NewPriorityFIFO(testFifoObjectKeyFunc, testPriorityFunc)
+	f.Add(mkObj("low", 1))
+	f.Add(mkObj("first-high", 10))
+	f.Add(mkObj("medium", 5))
+	f.Add(mkObj("second-high", 10))
+
+	for _, expected := range []string{"first-high", "second-high", "medium", "low"} {
+		if actual := f.Pop().(testFifoObject).name; actual != expected {
+			t.Fatalf("expected %s, got %s", expected, actual)
+		}
+	}
+}
+
+func TestPriorityFIFO_addUpdate(t *testing.T) {
+	mkObj := func(name string, val interface{}) testFifoObject {
+		return testFifoObject{name: name, val: val}
+	}
+
+	f := NewPriorityFIFO(testFifoObjectKeyFunc, testPriorityFunc)
+	f.Add(mkObj("foo", 1))
+	f.Add(mkObj("bar", 5))
+	f.Update(mkObj("foo", 10))
+	f.AddIfNotPresent(mkObj("bar", 20))
+
+	if e, a := 2, len(f.List()); a != e {
+		t.Fatalf("expected %d items, got %d", e, a)
+	}
+	if e, a := 10, f.Pop().(testFifoObject).val; a != e {
+		t.Fatalf("expected the updated item with priority %d first, got %d", e, a)
+	}
+	if e, a := 5, f.Pop().(testFifoObject).val; a != e {
+		t.Fatalf("expected %d, got %d", e, a)
+	}
+	if _, exists, _ := f.Get(mkObj("foo", 0)); exists {
+		t.Errorf("item did not get removed")
+	}
+}
+
+func TestPriorityFIFO_delete(t *testing.T) {
+	mkObj := func(name string, val interface{}) testFifoObject {
+		return testFifoObject{name: name, val: val}
+	}
+
+	f := NewPriorityFIFO(testFifoObjectKeyFunc, testPriorityFunc)
+	f.Add(mkObj("a", 3))
+	f.Add(mkObj("b", 2))
+	f.Add(mkObj("c", 1))
+	f.Delete(mkObj("a", 3))
+
+	if e, a := "b", f.Pop().(testFifoObject).name; a != e {
+		t.Fatalf("expected %s, got %s", e, a)
+	}
+	if e, a := "c", f.Pop().(testFifoObject).name; a != e {
+		t.Fatalf("expected %s, got %s", e, a)
+	}
+}
+
+func TestPriorityFIFO_replace(t *testing.T) {
+	mkObj := func(name string, val interface{}) testFifoObject {
+		return testFifoObject{name: name, val: val}
+	}
+
+	f := NewPriorityFIFO(testFifoObjectKeyFunc, testPriorityFunc)
+	f.Add(mkObj("foo", 100))
+	f.Replace([]interface{}{mkObj("a", 1), mkObj("b", 2), mkObj("c", 1)})
+
+	if _, exists, _ := f.Get(mkObj("foo", 0)); exists {
+		t.Errorf("item was not replaced")
+	}
+	for _, expected := range []string{"b", "a", "c"} {
+		if actual := f.Pop().(testFifoObject).name; actual != expected {
+			t.Fatalf("expected %s, got %s", expected, actual)
+		}
+	}
+}
@@ -18,8 +18,10 @@ package cache
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"reflect"
+	"sync"
 	"time"
 
 	apierrs "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -39,6 +41,18 @@ type ListerWatcher interface {
 	Watch(resourceVersion string) (watch.Interface, error)
 }
 
+// ListPager is a ListerWatcher which can list a page of the resources at a time. A
+// Reflector lists the resources of a ListPager in pages of listPageSize resources.
+type ListPager interface {
+	// ListPage should return a list type object of at most limit items, following the
+	// page which returned continueToken if it is not empty. The continue token of the
+	// list is empty once no items remain.
+	ListPage(limit int, continueToken string) (runtime.Object, error)
+}
+
+// listPageSize is the number of resources requested by each list of a ListPager.
+const listPageSize = 500
+
 // Reflector watches a specified resource and causes all changes to be reflected in the given store.
 type Reflector struct {
 	// The type of object we expect to place in the store.
@@ -51,6 +65,12 @@ type Reflector struct {
 	// the beginning of the next one.
 	period       time.Duration
 	resyncPeriod time.Duration
+
+	// lastSyncResourceVersion is the resource version of the last list or watch event
+	// applied to the store.
+	lastSyncResourceVersion string
+	// lastSyncResourceVersionMutex guards read/write access to lastSyncResourceVersion
+	lastSyncResourceVersionMutex sync.RWMutex
 }
 
 // NewNamespaceKeyedIndexerAndReflector creates an Indexer and a Reflector
@@ -110,26 +130,16 @@ func (r *Reflector) listAndWatch() {
 	var resourceVersion string
 	exitWatch := r.resyncChan()
 
-	list, err := r.listerWatcher.List()
+	items, resourceVersion, err := r.list()
 	if err != nil {
 		glog.Errorf("Failed to list %v: %v", r.expectedType, err)
 		return
 	}
-	meta, err := meta.Accessor(list)
-	if err != nil {
-		glog.Errorf("Unable to understand list result %#v", list)
-		return
-	}
-	resourceVersion = meta.ResourceVersion()
-	items, err := runtime.ExtractList(list)
-	if err != nil {
-		glog.Errorf("Unable to understand list result %#v (%v)", list, err)
-		return
-	}
 	if err := r.syncWith(items); err != nil {
 		glog.Errorf("Unable to sync list result: %v", err)
 		return
 	}
+	r.setLastSyncResourceVersion(resourceVersion)
 
 	for {
 		w, err := r.listerWatcher.Watch(resourceVersion)
@@ -153,6 +163,44 @@ func (r *Reflector) listAndWatch() {
 	}
 }
 
+// list returns the items of the list of the resources, and the resource version of the
+// list. The list is requested a page at a time if the ListerWatcher is a ListPager.
+func (r *Reflector) list() ([]runtime.Object, string, error) {
+	pager, paged := r.listerWatcher.(ListPager)
+	items := []runtime.Object{}
+	resourceVersion := ""
+	continueToken := ""
+	for {
+		var list runtime.Object
+		var err error
+		if paged {
+			list, err = pager.ListPage(listPageSize, continueToken)
+		} else {
+			list, err = r.listerWatcher.List()
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		meta, err := meta.Accessor(list)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to understand list result %#v", list)
+		}
+		page, err := runtime.ExtractList(list)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to understand list result %#v (%v)", list, err)
+		}
+		items = append(items, page...)
+		// every page has the resource version of the first one
+		if len(continueToken) == 0 {
+			resourceVersion = meta.ResourceVersion()
+		}
+		continueToken = meta.Continue()
+		if !paged || len(continueToken) == 0 {
+			return items, resourceVersion, nil
+		}
+	}
+}
+
 // syncWith replaces the store's items with the given list.
 func (r *Reflector) syncWith(items []runtime.Object) error {
 	found := make([]interface{}, 0, len(items))
@@ -203,6 +251,7 @@ loop:
 				glog.Errorf("unable to understand watch event %#v", event)
 			}
 			*resourceVersion = meta.ResourceVersion()
+			r.setLastSyncResourceVersion(*resourceVersion)
 			eventCount++
 		}
 	}
@@ -215,3 +264,17 @@ loop:
 	glog.V(4).Infof("Watch close - %v total %v items received", r.expectedType, eventCount)
 	return nil
 }
+
+// LastSyncResourceVersion is the resource version observed when last synced with the
+// underlying store. It is empty until the first list has been applied to the store.
+func (r *Reflector) LastSyncResourceVersion() string {
+	r.lastSyncResourceVersionMutex.RLock()
+	defer r.lastSyncResourceVersionMutex.RUnlock()
+	return r.lastSyncResourceVersion
+}
+
+func (r *Reflector) setLastSyncResourceVersion(v string) {
+	r.lastSyncResourceVersionMutex.Lock()
+	defer r.lastSyncResourceVersionMutex.Unlock()
+	r.lastSyncResourceVersion = v
+}
@@ -185,6 +185,35 @@ func TestReflector_listAndWatch(t *testing.T) {
 	}
 }
 
+func TestReflector_listPages(t *testing.T) {
+	pages := map[string]*api.PodList{
+		"": {
+			ListMeta: api.ListMeta{ResourceVersion: "5", Continue: "next"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "bar"}}, {ObjectMeta: api.ObjectMeta{Name: "foo"}}},
+		},
+		"next": {
+			ListMeta: api.ListMeta{ResourceVersion: "5"},
+			Items:    []api.Pod{{ObjectMeta: api.ObjectMeta{Name: "qux"}}},
+		},
+	}
+	lw := &ListWatch{
+		ListPageFunc: func(limit int, continueToken string) (runtime.Object, error) {
+			if limit != listPageSize {
+				t.Errorf("Expected a limit of %d, got %d", listPageSize, limit)
+			}
+			return pages[continueToken], nil
+		},
+	}
+	r := NewReflector(lw, &api.Pod{}, NewStore(MetaNamespaceKeyFunc), 0)
+	items, resourceVersion, err := r.list()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 3 || items[2].(*api.Pod).Name != "qux" || resourceVersion != "5" {
+		t.Errorf("Unexpected list: %#v at %s", items, resourceVersion)
+	}
+}
+
 func TestReflector_listAndWatchWithErrors(t *testing.T) {
 	mkPod := func(id string, rv string) *api.Pod {
 		return &api.Pod{ObjectMeta: api.ObjectMeta{Name: id, ResourceVersion: rv}}
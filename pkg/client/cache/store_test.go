@@ -146,6 +146,10 @@ func TestFIFOCache(t *testing.T) {
 	doTestStore(t, NewFIFO(testStoreKeyFunc))
 }
 
+func TestPriorityFIFOCache(t *testing.T) {
+	doTestStore(t, NewPriorityFIFO(testStoreKeyFunc, func(interface{}) int { return 0 }))
+}
+
 func TestUndeltaStore(t *testing.T) {
 	nop := func([]interface{}) {}
 	doTestStore(t, NewUndeltaStore(nop, testStoreKeyFunc))
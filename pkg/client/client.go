@@ -40,7 +40,19 @@ type Interface interface {
 	LimitRangesNamespacer
 	ResourceQuotasNamespacer
 	SecretsNamespacer
+	ServiceAccountsNamespacer
+	ConfigMapsNamespacer
 	NamespacesInterface
+	PersistentVolumesInterface
+	PersistentVolumeClaimsNamespacer
+	PriorityClassesInterface
+	JobsNamespacer
+	DaemonSetsNamespacer
+	PodDisruptionBudgetsNamespacer
+	DeploymentsNamespacer
+	HorizontalPodAutoscalersNamespacer
+	IngressesNamespacer
+	ThirdPartyResourcesInterface
 }
 
 func (c *Client) ReplicationControllers(namespace string) ReplicationControllerInterface {
@@ -79,10 +91,58 @@ func (c *Client) Secrets(namespace string) SecretsInterface {
 	return newSecrets(c, namespace)
 }
 
+func (c *Client) ServiceAccounts(namespace string) ServiceAccountInterface {
+	return newServiceAccounts(c, namespace)
+}
+
+func (c *Client) ConfigMaps(namespace string) ConfigMapInterface {
+	return newConfigMaps(c, namespace)
+}
+
 func (c *Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
 
+func (c *Client) PersistentVolumes() PersistentVolumeInterface {
+	return newPersistentVolumes(c)
+}
+
+func (c *Client) PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface {
+	return newPersistentVolumeClaims(c, namespace)
+}
+
+func (c *Client) PriorityClasses() PriorityClassInterface {
+	return newPriorityClasses(c)
+}
+
+func (c *Client) Jobs(namespace string) JobInterface {
+	return newJobs(c, namespace)
+}
+
+func (c *Client) DaemonSets(namespace string) DaemonSetInterface {
+	return newDaemonSets(c, namespace)
+}
+
+func (c *Client) PodDisruptionBudgets(namespace string) PodDisruptionBudgetInterface {
+	return newPodDisruptionBudgets(c, namespace)
+}
+
+func (c *Client) Deployments(namespace string) DeploymentInterface {
+	return newDeployments(c, namespace)
+}
+
+func (c *Client) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return newHorizontalPodAutoscalers(c, namespace)
+}
+
+func (c *Client) Ingresses(namespace string) IngressInterface {
+	return newIngresses(c, namespace)
+}
+
+func (c *Client) ThirdPartyResources() ThirdPartyResourceInterface {
+	return newThirdPartyResources(c)
+}
+
 // VersionInterface has a method to retrieve the server version.
 type VersionInterface interface {
 	ServerVersion() (*version.Info, error)
@@ -317,7 +317,21 @@ func TestDeletePod(t *testing.T) {
 		Request:  testRequest{Method: "DELETE", Path: buildResourcePath(ns, "/pods/foo"), Query: buildQueryValues(ns, nil)},
 		Response: Response{StatusCode: 200},
 	}
-	err := c.Setup().Pods(ns).Delete("foo")
+	err := c.Setup().Pods(ns).Delete("foo", nil)
+	c.Validate(t, nil, err)
+}
+
+func TestEvictPod(t *testing.T) {
+	ns := api.NamespaceDefault
+	eviction := &api.Eviction{
+		ObjectMeta:    api.ObjectMeta{Name: "foo"},
+		DeleteOptions: api.NewDeleteOptions(0),
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "POST", Path: buildResourcePath(ns, "/pods/foo/eviction"), Query: buildQueryValues(ns, nil), Body: eviction},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().Pods(ns).Evict(eviction)
 	c.Validate(t, nil, err)
 }
 
@@ -630,8 +644,10 @@ func TestListEndpooints(t *testing.T) {
 				Items: []api.Endpoints{
 					{
 						ObjectMeta: api.ObjectMeta{Name: "endpoint-1"},
-						Endpoints: []api.Endpoint{
-							{IP: "10.245.1.2", Port: 8080}, {IP: "10.245.1.3", Port: 8080}},
+						Subsets: []api.EndpointSubset{{
+							Addresses: []api.EndpointAddress{{IP: "10.245.1.2"}, {IP: "10.245.1.3"}},
+							Ports:     []api.EndpointPort{{Port: 8080}},
+						}},
 					},
 				},
 			},
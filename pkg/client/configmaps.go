@@ -0,0 +1,115 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// ConfigMapsNamespacer has methods to work with ConfigMap resources in a namespace
+type ConfigMapsNamespacer interface {
+	ConfigMaps(namespace string) ConfigMapInterface
+}
+
+// ConfigMapInterface has methods to work with ConfigMap resources.
+type ConfigMapInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.ConfigMapList, error)
+	Get(name string) (*api.ConfigMap, error)
+	Create(configMap *api.ConfigMap) (*api.ConfigMap, error)
+	Update(configMap *api.ConfigMap) (*api.ConfigMap, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// configMaps implements ConfigMapsNamespacer interface
+type configMaps struct {
+	r  *Client
+	ns string
+}
+
+// newConfigMaps returns a configMaps
+func newConfigMaps(c *Client, namespace string) *configMaps {
+	return &configMaps{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of config maps that match those selectors.
+func (c *configMaps) List(label labels.Selector, field fields.Selector) (result *api.ConfigMapList, err error) {
+	result = &api.ConfigMapList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("configMaps").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the config map, and returns the corresponding ConfigMap object, and an error if it occurs
+func (c *configMaps) Get(name string) (result *api.ConfigMap, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.ConfigMap{}
+	err = c.r.Get().Namespace(c.ns).Resource("configMaps").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a config map.  Returns the server's representation of the config map, and an error, if it occurs.
+func (c *configMaps) Create(configMap *api.ConfigMap) (result *api.ConfigMap, err error) {
+	result = &api.ConfigMap{}
+	err = c.r.Post().Namespace(c.ns).Resource("configMaps").Body(configMap).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a config map to update.  Returns the server's representation of the config map, and an error, if it occurs.
+func (c *configMaps) Update(configMap *api.ConfigMap) (result *api.ConfigMap, err error) {
+	result = &api.ConfigMap{}
+	if len(configMap.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", configMap)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("configMaps").Name(configMap.Name).Body(configMap).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the config map, and returns an error if one occurs
+func (c *configMaps) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("configMaps").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested config maps.
+func (c *configMaps) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("configMaps").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestConfigMapCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Data: map[string]string{"log-level": "debug"},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/configMaps"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   configMap,
+		},
+		Response: Response{StatusCode: 200, Body: configMap},
+	}
+
+	response, err := c.Setup().ConfigMaps(ns).Create(configMap)
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapList(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMapList := &api.ConfigMapList{
+		Items: []api.ConfigMap{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/configMaps"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: configMapList},
+	}
+	response, err := c.Setup().ConfigMaps(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestConfigMapUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{"log-level": "debug"},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/configMaps/abc"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: configMap},
+	}
+	response, err := c.Setup().ConfigMaps(ns).Update(configMap)
+	c.Validate(t, response, err)
+}
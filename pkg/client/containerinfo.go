@@ -25,12 +25,15 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
 	cadvisorApi "github.com/google/cadvisor/info/v1"
 )
 
 type ContainerInfoGetter interface {
 	// GetContainerInfo returns information about a container.
 	GetContainerInfo(host, podID, containerID string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error)
+	// GetPodContainerInfo returns information about a container of a pod in the given namespace.
+	GetPodContainerInfo(host, podNamespace, podName string, uid types.UID, containerName string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error)
 	// GetRootInfo returns information about the root container on a machine.
 	GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error)
 	// GetMachineInfo returns the machine's information like number of cores, memory capacity.
@@ -118,6 +121,14 @@ func (self *HTTPContainerInfoGetter) GetContainerInfo(host, podID, containerID s
 	)
 }
 
+func (self *HTTPContainerInfoGetter) GetPodContainerInfo(host, podNamespace, podName string, uid types.UID, containerName string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return self.getContainerInfo(
+		host,
+		fmt.Sprintf("%v/%v/%v/%v", podNamespace, podName, uid, containerName),
+		req,
+	)
+}
+
 func (self *HTTPContainerInfoGetter) GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
 	return self.getContainerInfo(host, "", req)
 }
@@ -121,6 +121,53 @@ func TestHTTPContainerInfoGetterGetContainerInfoSuccessfully(t *testing.T) {
 	testHTTPContainerInfoGetter(req, cinfo, "somePodID", "containerNameInK8S", 0, t)
 }
 
+func TestHTTPContainerInfoGetterGetPodContainerInfo(t *testing.T) {
+	req := &cadvisorApi.ContainerInfoRequest{
+		NumStats: 2,
+	}
+	cinfo := cadvisorApiTest.GenerateRandomContainerInfo(
+		"dockerIDWhichWillNotBeChecked", // docker ID
+		2, // Number of cores
+		req,
+		1*time.Second,
+	)
+	expectedPath := "/stats/someNamespace/somePod/someUID/containerNameInK8S"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != expectedPath {
+			t.Errorf("Received request to an invalid path. Should be %v. got %v",
+				expectedPath, r.URL.Path)
+		}
+		err := json.NewEncoder(w).Encode(cinfo)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer ts.Close()
+	hostURL, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(hostURL.Host, ":")
+
+	port, err := strconv.Atoi(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	containerInfoGetter := &HTTPContainerInfoGetter{
+		Client: http.DefaultClient,
+		Port:   port,
+	}
+
+	received, err := containerInfoGetter.GetPodContainerInfo(parts[0], "someNamespace", "somePod", "someUID", "containerNameInK8S", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !received.Eq(cinfo) {
+		t.Error("received unexpected container info")
+	}
+}
+
 func TestHTTPContainerInfoGetterGetRootInfoSuccessfully(t *testing.T) {
 	req := &cadvisorApi.ContainerInfoRequest{
 		NumStats: 10,
@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// DaemonSetsNamespacer has methods to work with DaemonSet resources in a namespace
+type DaemonSetsNamespacer interface {
+	DaemonSets(namespace string) DaemonSetInterface
+}
+
+// DaemonSetInterface has methods to work with DaemonSet resources.
+type DaemonSetInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.DaemonSetList, error)
+	Get(name string) (*api.DaemonSet, error)
+	Create(daemonSet *api.DaemonSet) (*api.DaemonSet, error)
+	Update(daemonSet *api.DaemonSet) (*api.DaemonSet, error)
+	UpdateStatus(daemonSet *api.DaemonSet) (*api.DaemonSet, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// daemonSets implements DaemonSetsNamespacer interface
+type daemonSets struct {
+	r  *Client
+	ns string
+}
+
+// newDaemonSets returns a daemonSets
+func newDaemonSets(c *Client, namespace string) *daemonSets {
+	return &daemonSets{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of daemonSets that match those selectors.
+func (c *daemonSets) List(label labels.Selector, field fields.Selector) (result *api.DaemonSetList, err error) {
+	result = &api.DaemonSetList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("daemonSets").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the daemonSet, and returns the corresponding DaemonSet object, and an error if it occurs
+func (c *daemonSets) Get(name string) (result *api.DaemonSet, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.DaemonSet{}
+	err = c.r.Get().Namespace(c.ns).Resource("daemonSets").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a daemonSet.  Returns the server's representation of the daemonSet, and an error, if it occurs.
+func (c *daemonSets) Create(daemonSet *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	err = c.r.Post().Namespace(c.ns).Resource("daemonSets").Body(daemonSet).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a daemonSet to update spec.  Returns the server's representation of the daemonSet, and an error, if it occurs.
+func (c *daemonSets) Update(daemonSet *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	if len(daemonSet.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", daemonSet)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("daemonSets").Name(daemonSet.Name).Body(daemonSet).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a daemonSet to update status.  Returns the server's representation of the daemonSet, and an error, if it occurs.
+func (c *daemonSets) UpdateStatus(daemonSet *api.DaemonSet) (result *api.DaemonSet, err error) {
+	result = &api.DaemonSet{}
+	if len(daemonSet.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", daemonSet)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("daemonSets").Name(daemonSet.Name).SubResource("status").Body(daemonSet).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the daemonSet, and returns an error if one occurs
+func (c *daemonSets) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("daemonSets").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested daemonSets.
+func (c *daemonSets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("daemonSets").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestDaemonSetCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	daemonSet := &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: map[string]string{"daemon": "abc"},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/daemonSets"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   daemonSet,
+		},
+		Response: Response{StatusCode: 200, Body: daemonSet},
+	}
+
+	response, err := c.Setup().DaemonSets(ns).Create(daemonSet)
+	c.Validate(t, response, err)
+}
+
+func TestDaemonSetList(t *testing.T) {
+	ns := api.NamespaceDefault
+	dsList := &api.DaemonSetList{
+		Items: []api.DaemonSet{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/daemonSets"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: dsList},
+	}
+	response, err := c.Setup().DaemonSets(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestDaemonSetStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	daemonSet := &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Status: api.DaemonSetStatus{
+			CurrentNumberScheduled: 1,
+			NumberMisscheduled:     1,
+			DesiredNumberScheduled: 2,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/daemonSets/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: daemonSet},
+	}
+	response, err := c.Setup().DaemonSets(ns).UpdateStatus(daemonSet)
+	c.Validate(t, response, err)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// DeploymentsNamespacer has methods to work with Deployment resources in a namespace
+type DeploymentsNamespacer interface {
+	Deployments(namespace string) DeploymentInterface
+}
+
+// DeploymentInterface has methods to work with Deployment resources.
+type DeploymentInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.DeploymentList, error)
+	Get(name string) (*api.Deployment, error)
+	Create(deployment *api.Deployment) (*api.Deployment, error)
+	Update(deployment *api.Deployment) (*api.Deployment, error)
+	UpdateStatus(deployment *api.Deployment) (*api.Deployment, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// deployments implements DeploymentsNamespacer interface
+type deployments struct {
+	r  *Client
+	ns string
+}
+
+// newDeployments returns a deployments
+func newDeployments(c *Client, namespace string) *deployments {
+	return &deployments{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of deployments that match those selectors.
+func (c *deployments) List(label labels.Selector, field fields.Selector) (result *api.DeploymentList, err error) {
+	result = &api.DeploymentList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("deployments").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the deployment, and returns the corresponding Deployment object, and an error if it occurs
+func (c *deployments) Get(name string) (result *api.Deployment, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.Deployment{}
+	err = c.r.Get().Namespace(c.ns).Resource("deployments").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a deployment.  Returns the server's representation of the deployment, and an error, if it occurs.
+func (c *deployments) Create(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	err = c.r.Post().Namespace(c.ns).Resource("deployments").Body(deployment).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a deployment to update spec.  Returns the server's representation of the deployment, and an error, if it occurs.
+func (c *deployments) Update(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	if len(deployment.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", deployment)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("deployments").Name(deployment.Name).Body(deployment).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a deployment to update status.  Returns the server's representation of the deployment, and an error, if it occurs.
+func (c *deployments) UpdateStatus(deployment *api.Deployment) (result *api.Deployment, err error) {
+	result = &api.Deployment{}
+	if len(deployment.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", deployment)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("deployments").Name(deployment.Name).SubResource("status").Body(deployment).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the deployment, and returns an error if one occurs
+func (c *deployments) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("deployments").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested deployments.
+func (c *deployments) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("deployments").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
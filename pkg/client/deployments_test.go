@@ -0,0 +1,102 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestDeploymentCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.DeploymentSpec{
+			Selector: map[string]string{"deployment": "abc"},
+			Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/deployments"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   deployment,
+		},
+		Response: Response{StatusCode: 200, Body: deployment},
+	}
+
+	response, err := c.Setup().Deployments(ns).Create(deployment)
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentList(t *testing.T) {
+	ns := api.NamespaceDefault
+	deploymentList := &api.DeploymentList{
+		Items: []api.Deployment{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentSpec{
+					Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/deployments"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: deploymentList},
+	}
+	response, err := c.Setup().Deployments(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestDeploymentStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Spec: api.DeploymentSpec{
+			Strategy: api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType},
+		},
+		Status: api.DeploymentStatus{
+			Replicas:            3,
+			UpdatedReplicas:     1,
+			AvailableReplicas:   2,
+			UnavailableReplicas: 1,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/deployments/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: deployment},
+	}
+	response, err := c.Setup().Deployments(ns).UpdateStatus(deployment)
+	c.Validate(t, response, err)
+}
@@ -35,22 +35,38 @@ type FakeAction struct {
 // Fake implements Interface. Meant to be embedded into a struct to get a default
 // implementation. This makes faking out just the method you want to test easier.
 type Fake struct {
-	Actions             []FakeAction
-	PodsList            api.PodList
-	CtrlList            api.ReplicationControllerList
-	Ctrl                api.ReplicationController
-	ServiceList         api.ServiceList
-	EndpointsList       api.EndpointsList
-	MinionsList         api.NodeList
-	EventsList          api.EventList
-	LimitRangesList     api.LimitRangeList
-	ResourceQuotaStatus api.ResourceQuota
-	ResourceQuotasList  api.ResourceQuotaList
-	NamespacesList      api.NamespaceList
-	SecretList          api.SecretList
-	Secret              api.Secret
-	Err                 error
-	Watch               watch.Interface
+	Actions                      []FakeAction
+	PodsList                     api.PodList
+	CtrlList                     api.ReplicationControllerList
+	Ctrl                         api.ReplicationController
+	ServiceList                  api.ServiceList
+	EndpointsList                api.EndpointsList
+	MinionsList                  api.NodeList
+	EventsList                   api.EventList
+	LimitRangesList              api.LimitRangeList
+	ResourceQuotaStatus          api.ResourceQuota
+	ResourceQuotasList           api.ResourceQuotaList
+	NamespacesList               api.NamespaceList
+	SecretList                   api.SecretList
+	Secret                       api.Secret
+	ServiceAccountsList          api.ServiceAccountList
+	ServiceAccount               api.ServiceAccount
+	ConfigMapsList               api.ConfigMapList
+	ConfigMap                    api.ConfigMap
+	PersistentVolumesList        api.PersistentVolumeList
+	PersistentVolumeClaimsList   api.PersistentVolumeClaimList
+	PriorityClassesList          api.PriorityClassList
+	PriorityClass                api.PriorityClass
+	JobsList                     api.JobList
+	DaemonSetsList               api.DaemonSetList
+	PodDisruptionBudgetsList     api.PodDisruptionBudgetList
+	DeploymentsList              api.DeploymentList
+	HorizontalPodAutoscalersList api.HorizontalPodAutoscalerList
+	IngressesList                api.IngressList
+	ThirdPartyResourcesList      api.ThirdPartyResourceList
+	ThirdPartyResource           api.ThirdPartyResource
+	Err                          error
+	Watch                        watch.Interface
 }
 
 func (c *Fake) LimitRanges(namespace string) LimitRangeInterface {
@@ -89,10 +105,58 @@ func (c *Fake) Secrets(namespace string) SecretsInterface {
 	return &FakeSecrets{Fake: c, Namespace: namespace}
 }
 
+func (c *Fake) ServiceAccounts(namespace string) ServiceAccountInterface {
+	return &FakeServiceAccounts{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) ConfigMaps(namespace string) ConfigMapInterface {
+	return &FakeConfigMaps{Fake: c, Namespace: namespace}
+}
+
 func (c *Fake) Namespaces() NamespaceInterface {
 	return &FakeNamespaces{Fake: c}
 }
 
+func (c *Fake) PersistentVolumes() PersistentVolumeInterface {
+	return &FakePersistentVolumes{Fake: c}
+}
+
+func (c *Fake) PersistentVolumeClaims(namespace string) PersistentVolumeClaimInterface {
+	return &FakePersistentVolumeClaims{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) PriorityClasses() PriorityClassInterface {
+	return &FakePriorityClasses{Fake: c}
+}
+
+func (c *Fake) Jobs(namespace string) JobInterface {
+	return &FakeJobs{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) DaemonSets(namespace string) DaemonSetInterface {
+	return &FakeDaemonSets{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) PodDisruptionBudgets(namespace string) PodDisruptionBudgetInterface {
+	return &FakePodDisruptionBudgets{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) Deployments(namespace string) DeploymentInterface {
+	return &FakeDeployments{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return &FakeHorizontalPodAutoscalers{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) Ingresses(namespace string) IngressInterface {
+	return &FakeIngresses{Fake: c, Namespace: namespace}
+}
+
+func (c *Fake) ThirdPartyResources() ThirdPartyResourceInterface {
+	return &FakeThirdPartyResources{Fake: c}
+}
+
 func (c *Fake) ServerVersion() (*version.Info, error) {
 	c.Actions = append(c.Actions, FakeAction{Action: "get-version", Value: nil})
 	versionInfo := version.Get()
@@ -128,6 +192,10 @@ func (c *FakeRESTClient) Put() *Request {
 	return NewRequest(c, "PUT", &url.URL{Host: "localhost"}, testapi.Version(), c.Codec, c.Legacy, c.Legacy)
 }
 
+func (c *FakeRESTClient) Patch() *Request {
+	return NewRequest(c, "PATCH", &url.URL{Host: "localhost"}, testapi.Version(), c.Codec, c.Legacy, c.Legacy)
+}
+
 func (c *FakeRESTClient) Post() *Request {
 	return NewRequest(c, "POST", &url.URL{Host: "localhost"}, testapi.Version(), c.Codec, c.Legacy, c.Legacy)
 }
@@ -0,0 +1,61 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeConfigMaps implements ConfigMapInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeConfigMaps struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeConfigMaps) List(label labels.Selector, field fields.Selector) (*api.ConfigMapList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-configMaps"})
+	return api.Scheme.CopyOrDie(&c.Fake.ConfigMapsList).(*api.ConfigMapList), c.Fake.Err
+}
+
+func (c *FakeConfigMaps) Get(name string) (*api.ConfigMap, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-configMap", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ConfigMap).(*api.ConfigMap), c.Fake.Err
+}
+
+func (c *FakeConfigMaps) Create(configMap *api.ConfigMap) (*api.ConfigMap, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-configMap", Value: configMap})
+	return &api.ConfigMap{}, nil
+}
+
+func (c *FakeConfigMaps) Update(configMap *api.ConfigMap) (*api.ConfigMap, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-configMap", Value: configMap})
+	return &api.ConfigMap{}, nil
+}
+
+func (c *FakeConfigMaps) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-configMap", Value: name})
+	return nil
+}
+
+func (c *FakeConfigMaps) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-configMaps", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeHorizontalPodAutoscalers implements HorizontalPodAutoscalerInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeHorizontalPodAutoscalers struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeHorizontalPodAutoscalers) List(label labels.Selector, field fields.Selector) (*api.HorizontalPodAutoscalerList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-horizontalPodAutoscalers"})
+	return api.Scheme.CopyOrDie(&c.Fake.HorizontalPodAutoscalersList).(*api.HorizontalPodAutoscalerList), nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) Get(name string) (*api.HorizontalPodAutoscaler, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-horizontalPodAutoscaler", Value: name})
+	return &api.HorizontalPodAutoscaler{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) Create(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-horizontalPodAutoscaler"})
+	return &api.HorizontalPodAutoscaler{}, nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) Update(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-horizontalPodAutoscaler", Value: horizontalPodAutoscaler.Name})
+	return &api.HorizontalPodAutoscaler{}, nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) UpdateStatus(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-status-horizontalPodAutoscaler", Value: horizontalPodAutoscaler.Name})
+	return &api.HorizontalPodAutoscaler{}, nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-horizontalPodAutoscaler", Value: name})
+	return nil
+}
+
+func (c *FakeHorizontalPodAutoscalers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-horizontalPodAutoscalers", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
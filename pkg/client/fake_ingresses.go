@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeIngresses implements IngressInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeIngresses struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeIngresses) List(label labels.Selector, field fields.Selector) (*api.IngressList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-ingresses"})
+	return api.Scheme.CopyOrDie(&c.Fake.IngressesList).(*api.IngressList), nil
+}
+
+func (c *FakeIngresses) Get(name string) (*api.Ingress, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-ingress", Value: name})
+	return &api.Ingress{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
+}
+
+func (c *FakeIngresses) Create(ingress *api.Ingress) (*api.Ingress, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-ingress"})
+	return &api.Ingress{}, nil
+}
+
+func (c *FakeIngresses) Update(ingress *api.Ingress) (*api.Ingress, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-ingress", Value: ingress.Name})
+	return &api.Ingress{}, nil
+}
+
+func (c *FakeIngresses) UpdateStatus(ingress *api.Ingress) (*api.Ingress, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-status-ingress", Value: ingress.Name})
+	return &api.Ingress{}, nil
+}
+
+func (c *FakeIngresses) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-ingress", Value: name})
+	return nil
+}
+
+func (c *FakeIngresses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-ingresses", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeJobs implements JobInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeJobs struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeJobs) List(label labels.Selector, field fields.Selector) (*api.JobList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-jobs"})
+	return api.Scheme.CopyOrDie(&c.Fake.JobsList).(*api.JobList), nil
+}
+
+func (c *FakeJobs) Get(name string) (*api.Job, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-job", Value: name})
+	return &api.Job{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
+}
+
+func (c *FakeJobs) Create(job *api.Job) (*api.Job, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-job"})
+	return &api.Job{}, nil
+}
+
+func (c *FakeJobs) Update(job *api.Job) (*api.Job, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-job", Value: job.Name})
+	return &api.Job{}, nil
+}
+
+func (c *FakeJobs) UpdateStatus(job *api.Job) (*api.Job, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-status-job", Value: job.Name})
+	return &api.Job{}, nil
+}
+
+func (c *FakeJobs) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-job", Value: name})
+	return nil
+}
+
+func (c *FakeJobs) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-jobs", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
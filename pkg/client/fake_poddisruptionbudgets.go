@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakePodDisruptionBudgets implements PodDisruptionBudgetInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakePodDisruptionBudgets struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakePodDisruptionBudgets) List(label labels.Selector, field fields.Selector) (*api.PodDisruptionBudgetList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-podDisruptionBudgets"})
+	return api.Scheme.CopyOrDie(&c.Fake.PodDisruptionBudgetsList).(*api.PodDisruptionBudgetList), nil
+}
+
+func (c *FakePodDisruptionBudgets) Get(name string) (*api.PodDisruptionBudget, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-podDisruptionBudget", Value: name})
+	return &api.PodDisruptionBudget{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
+}
+
+func (c *FakePodDisruptionBudgets) Create(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-podDisruptionBudget"})
+	return &api.PodDisruptionBudget{}, nil
+}
+
+func (c *FakePodDisruptionBudgets) Update(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-podDisruptionBudget", Value: podDisruptionBudget.Name})
+	return &api.PodDisruptionBudget{}, nil
+}
+
+func (c *FakePodDisruptionBudgets) UpdateStatus(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-status-podDisruptionBudget", Value: podDisruptionBudget.Name})
+	return &api.PodDisruptionBudget{}, nil
+}
+
+func (c *FakePodDisruptionBudgets) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-podDisruptionBudget", Value: name})
+	return nil
+}
+
+func (c *FakePodDisruptionBudgets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-podDisruptionBudgets", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
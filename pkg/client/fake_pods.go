@@ -40,7 +40,7 @@ func (c *FakePods) Get(name string) (*api.Pod, error) {
 	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name, Namespace: c.Namespace}}, nil
 }
 
-func (c *FakePods) Delete(name string) error {
+func (c *FakePods) Delete(name string, options *api.DeleteOptions) error {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-pod", Value: name})
 	return nil
 }
@@ -65,6 +65,11 @@ func (c *FakePods) Bind(bind *api.Binding) error {
 	return nil
 }
 
+func (c *FakePods) Evict(eviction *api.Eviction) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "evict-pod", Value: eviction.Name})
+	return nil
+}
+
 func (c *FakePods) UpdateStatus(name string, status *api.PodStatus) (*api.Pod, error) {
 	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-status-pod", Value: name})
 	return &api.Pod{}, nil
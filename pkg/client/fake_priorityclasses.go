@@ -0,0 +1,60 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakePriorityClasses implements PriorityClassInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakePriorityClasses struct {
+	Fake *Fake
+}
+
+func (c *FakePriorityClasses) List(label labels.Selector, field fields.Selector) (*api.PriorityClassList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-priorityClasses"})
+	return api.Scheme.CopyOrDie(&c.Fake.PriorityClassesList).(*api.PriorityClassList), c.Fake.Err
+}
+
+func (c *FakePriorityClasses) Get(name string) (*api.PriorityClass, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-priorityClass", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.PriorityClass).(*api.PriorityClass), c.Fake.Err
+}
+
+func (c *FakePriorityClasses) Create(priorityClass *api.PriorityClass) (*api.PriorityClass, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-priorityClass", Value: priorityClass})
+	return &api.PriorityClass{}, nil
+}
+
+func (c *FakePriorityClasses) Update(priorityClass *api.PriorityClass) (*api.PriorityClass, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-priorityClass", Value: priorityClass})
+	return &api.PriorityClass{}, nil
+}
+
+func (c *FakePriorityClasses) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-priorityClass", Value: name})
+	return nil
+}
+
+func (c *FakePriorityClasses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-priorityClasses", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
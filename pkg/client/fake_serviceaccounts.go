@@ -0,0 +1,61 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeServiceAccounts implements ServiceAccountInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeServiceAccounts struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeServiceAccounts) List(label labels.Selector, field fields.Selector) (*api.ServiceAccountList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-serviceAccounts"})
+	return api.Scheme.CopyOrDie(&c.Fake.ServiceAccountsList).(*api.ServiceAccountList), c.Fake.Err
+}
+
+func (c *FakeServiceAccounts) Get(name string) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-serviceAccount", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ServiceAccount).(*api.ServiceAccount), c.Fake.Err
+}
+
+func (c *FakeServiceAccounts) Create(serviceAccount *api.ServiceAccount) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-serviceAccount", Value: serviceAccount})
+	return &api.ServiceAccount{}, nil
+}
+
+func (c *FakeServiceAccounts) Update(serviceAccount *api.ServiceAccount) (*api.ServiceAccount, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-serviceAccount", Value: serviceAccount})
+	return &api.ServiceAccount{}, nil
+}
+
+func (c *FakeServiceAccounts) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-serviceAccount", Value: name})
+	return nil
+}
+
+func (c *FakeServiceAccounts) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-serviceAccounts", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// FakeThirdPartyResources implements ThirdPartyResourceInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeThirdPartyResources struct {
+	Fake *Fake
+}
+
+func (c *FakeThirdPartyResources) List(label labels.Selector, field fields.Selector) (*api.ThirdPartyResourceList, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "list-thirdPartyResources"})
+	return api.Scheme.CopyOrDie(&c.Fake.ThirdPartyResourcesList).(*api.ThirdPartyResourceList), c.Fake.Err
+}
+
+func (c *FakeThirdPartyResources) Get(name string) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "get-thirdPartyResource", Value: name})
+	return api.Scheme.CopyOrDie(&c.Fake.ThirdPartyResource).(*api.ThirdPartyResource), c.Fake.Err
+}
+
+func (c *FakeThirdPartyResources) Create(thirdPartyResource *api.ThirdPartyResource) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "create-thirdPartyResource", Value: thirdPartyResource})
+	return &api.ThirdPartyResource{}, nil
+}
+
+func (c *FakeThirdPartyResources) Update(thirdPartyResource *api.ThirdPartyResource) (*api.ThirdPartyResource, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "update-thirdPartyResource", Value: thirdPartyResource})
+	return &api.ThirdPartyResource{}, nil
+}
+
+func (c *FakeThirdPartyResources) Delete(name string) error {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "delete-thirdPartyResource", Value: name})
+	return nil
+}
+
+func (c *FakeThirdPartyResources) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	c.Fake.Actions = append(c.Fake.Actions, FakeAction{Action: "watch-thirdPartyResources", Value: resourceVersion})
+	return c.Fake.Watch, nil
+}
@@ -54,6 +54,10 @@ type Config struct {
 	// to a RESTClient or Client. Required when initializing a RESTClient, optional
 	// when initializing a Client.
 	Codec runtime.Codec
+	// ContentType is the content type the client asks the server for, and encodes
+	// objects in when Codec is not set: application/json, the default, or
+	// application/vnd.kubernetes.protobuf for versions which have a protobuf encoding.
+	ContentType string
 
 	// Server requires Basic authentication
 	Username string
@@ -169,7 +173,17 @@ func SetKubernetesDefaults(config *Config) error {
 		return fmt.Errorf("API version '%s' is not recognized (valid values: %s)", version, strings.Join(latest.Versions, ", "))
 	}
 	if config.Codec == nil {
-		config.Codec = versionInterfaces.Codec
+		switch config.ContentType {
+		case "", "application/json":
+			config.Codec = versionInterfaces.Codec
+		case runtime.ProtobufContentType:
+			if versionInterfaces.ProtobufCodec == nil {
+				return fmt.Errorf("API version '%s' has no protobuf encoding", version)
+			}
+			config.Codec = versionInterfaces.ProtobufCodec
+		default:
+			return fmt.Errorf("content type '%s' is not supported", config.ContentType)
+		}
 	}
 	config.LegacyBehavior = (version == "v1beta1" || version == "v1beta2")
 	return nil
@@ -193,6 +207,7 @@ func RESTClientFor(config *Config) (*RESTClient, error) {
 	}
 
 	client := NewRESTClient(baseURL, config.Version, config.Codec, config.LegacyBehavior)
+	client.ContentType = config.ContentType
 
 	transport, err := TransportFor(config)
 	if err != nil {
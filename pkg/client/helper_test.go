@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 )
 
 const (
@@ -283,6 +285,34 @@ func TestSetKubernetesDefaults(t *testing.T) {
 			Config{},
 			true,
 		},
+		{
+			Config{
+				Version:     "v1beta3",
+				ContentType: runtime.ProtobufContentType,
+			},
+			Config{
+				Prefix:      "/api",
+				Version:     "v1beta3",
+				Codec:       v1beta3.ProtobufCodec,
+				ContentType: runtime.ProtobufContentType,
+			},
+			false,
+		},
+		{
+			Config{
+				Version:     "v1beta1",
+				ContentType: runtime.ProtobufContentType,
+			},
+			Config{},
+			true,
+		},
+		{
+			Config{
+				ContentType: "application/xml",
+			},
+			Config{},
+			true,
+		},
 	}
 	for _, testCase := range testCases {
 		val := &testCase.Config
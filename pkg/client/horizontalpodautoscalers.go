@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// HorizontalPodAutoscalersNamespacer has methods to work with HorizontalPodAutoscaler resources in a namespace
+type HorizontalPodAutoscalersNamespacer interface {
+	HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface
+}
+
+// HorizontalPodAutoscalerInterface has methods to work with HorizontalPodAutoscaler resources.
+type HorizontalPodAutoscalerInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.HorizontalPodAutoscalerList, error)
+	Get(name string) (*api.HorizontalPodAutoscaler, error)
+	Create(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Update(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	UpdateStatus(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// horizontalPodAutoscalers implements HorizontalPodAutoscalersNamespacer interface
+type horizontalPodAutoscalers struct {
+	r  *Client
+	ns string
+}
+
+// newHorizontalPodAutoscalers returns a horizontalPodAutoscalers
+func newHorizontalPodAutoscalers(c *Client, namespace string) *horizontalPodAutoscalers {
+	return &horizontalPodAutoscalers{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of horizontalPodAutoscalers that match those selectors.
+func (c *horizontalPodAutoscalers) List(label labels.Selector, field fields.Selector) (result *api.HorizontalPodAutoscalerList, err error) {
+	result = &api.HorizontalPodAutoscalerList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("horizontalPodAutoscalers").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the horizontalPodAutoscaler, and returns the corresponding HorizontalPodAutoscaler object, and an error if it occurs
+func (c *horizontalPodAutoscalers) Get(name string) (result *api.HorizontalPodAutoscaler, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.HorizontalPodAutoscaler{}
+	err = c.r.Get().Namespace(c.ns).Resource("horizontalPodAutoscalers").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a horizontalPodAutoscaler.  Returns the server's representation of the horizontalPodAutoscaler, and an error, if it occurs.
+func (c *horizontalPodAutoscalers) Create(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	err = c.r.Post().Namespace(c.ns).Resource("horizontalPodAutoscalers").Body(horizontalPodAutoscaler).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a horizontalPodAutoscaler to update spec.  Returns the server's representation of the horizontalPodAutoscaler, and an error, if it occurs.
+func (c *horizontalPodAutoscalers) Update(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	if len(horizontalPodAutoscaler.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", horizontalPodAutoscaler)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("horizontalPodAutoscalers").Name(horizontalPodAutoscaler.Name).Body(horizontalPodAutoscaler).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a horizontalPodAutoscaler to update status.  Returns the server's representation of the horizontalPodAutoscaler, and an error, if it occurs.
+func (c *horizontalPodAutoscalers) UpdateStatus(horizontalPodAutoscaler *api.HorizontalPodAutoscaler) (result *api.HorizontalPodAutoscaler, err error) {
+	result = &api.HorizontalPodAutoscaler{}
+	if len(horizontalPodAutoscaler.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", horizontalPodAutoscaler)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("horizontalPodAutoscalers").Name(horizontalPodAutoscaler.Name).SubResource("status").Body(horizontalPodAutoscaler).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the horizontalPodAutoscaler, and returns an error if one occurs
+func (c *horizontalPodAutoscalers) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("horizontalPodAutoscalers").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested horizontalPodAutoscalers.
+func (c *horizontalPodAutoscalers) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("horizontalPodAutoscalers").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestHorizontalPodAutoscalerCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	horizontalPodAutoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ReplicationController: "frontend",
+			MinReplicas:           1,
+			MaxReplicas:           5,
+			TargetCPUUtilization:  80,
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/horizontalPodAutoscalers"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   horizontalPodAutoscaler,
+		},
+		Response: Response{StatusCode: 200, Body: horizontalPodAutoscaler},
+	}
+
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).Create(horizontalPodAutoscaler)
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerList(t *testing.T) {
+	ns := api.NamespaceDefault
+	autoscalerList := &api.HorizontalPodAutoscalerList{
+		Items: []api.HorizontalPodAutoscaler{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.HorizontalPodAutoscalerSpec{
+					ReplicationController: "frontend",
+					MinReplicas:           1,
+					MaxReplicas:           5,
+					TargetCPUUtilization:  80,
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/horizontalPodAutoscalers"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: autoscalerList},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestHorizontalPodAutoscalerStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	horizontalPodAutoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ReplicationController: "frontend",
+			MinReplicas:           1,
+			MaxReplicas:           5,
+			TargetCPUUtilization:  80,
+		},
+		Status: api.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 2,
+			DesiredReplicas: 3,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/horizontalPodAutoscalers/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: horizontalPodAutoscaler},
+	}
+	response, err := c.Setup().HorizontalPodAutoscalers(ns).UpdateStatus(horizontalPodAutoscaler)
+	c.Validate(t, response, err)
+}
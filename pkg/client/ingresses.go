@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// IngressesNamespacer has methods to work with Ingress resources in a namespace
+type IngressesNamespacer interface {
+	Ingresses(namespace string) IngressInterface
+}
+
+// IngressInterface has methods to work with Ingress resources.
+type IngressInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.IngressList, error)
+	Get(name string) (*api.Ingress, error)
+	Create(ingress *api.Ingress) (*api.Ingress, error)
+	Update(ingress *api.Ingress) (*api.Ingress, error)
+	UpdateStatus(ingress *api.Ingress) (*api.Ingress, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// ingresses implements IngressesNamespacer interface
+type ingresses struct {
+	r  *Client
+	ns string
+}
+
+// newIngresses returns a ingresses
+func newIngresses(c *Client, namespace string) *ingresses {
+	return &ingresses{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of ingresses that match those selectors.
+func (c *ingresses) List(label labels.Selector, field fields.Selector) (result *api.IngressList, err error) {
+	result = &api.IngressList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("ingresses").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the ingress, and returns the corresponding Ingress object, and an error if it occurs
+func (c *ingresses) Get(name string) (result *api.Ingress, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.Ingress{}
+	err = c.r.Get().Namespace(c.ns).Resource("ingresses").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a ingress.  Returns the server's representation of the ingress, and an error, if it occurs.
+func (c *ingresses) Create(ingress *api.Ingress) (result *api.Ingress, err error) {
+	result = &api.Ingress{}
+	err = c.r.Post().Namespace(c.ns).Resource("ingresses").Body(ingress).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a ingress to update spec.  Returns the server's representation of the ingress, and an error, if it occurs.
+func (c *ingresses) Update(ingress *api.Ingress) (result *api.Ingress, err error) {
+	result = &api.Ingress{}
+	if len(ingress.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", ingress)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("ingresses").Name(ingress.Name).Body(ingress).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a ingress to update status.  Returns the server's representation of the ingress, and an error, if it occurs.
+func (c *ingresses) UpdateStatus(ingress *api.Ingress) (result *api.Ingress, err error) {
+	result = &api.Ingress{}
+	if len(ingress.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", ingress)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("ingresses").Name(ingress.Name).SubResource("status").Body(ingress).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the ingress, and returns an error if one occurs
+func (c *ingresses) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("ingresses").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested ingresses.
+func (c *ingresses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("ingresses").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestIngressCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	ingress := &api.Ingress{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.IngressSpec{
+			Rules: []api.IngressRule{{
+				Host:  "foo.example.com",
+				Paths: []api.IngressPath{{Path: "/", Backend: api.IngressBackend{ServiceName: "frontend", ServicePort: util.NewIntOrStringFromInt(80)}}},
+			}},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/ingresses"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   ingress,
+		},
+		Response: Response{StatusCode: 200, Body: ingress},
+	}
+
+	response, err := c.Setup().Ingresses(ns).Create(ingress)
+	c.Validate(t, response, err)
+}
+
+func TestIngressList(t *testing.T) {
+	ns := api.NamespaceDefault
+	ingressList := &api.IngressList{
+		Items: []api.Ingress{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.IngressSpec{
+					Backend: &api.IngressBackend{ServiceName: "frontend", ServicePort: util.NewIntOrStringFromString("http")},
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/ingresses"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: ingressList},
+	}
+	response, err := c.Setup().Ingresses(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestIngressStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	ingress := &api.Ingress{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Spec: api.IngressSpec{
+			Rules: []api.IngressRule{{
+				Host:  "foo.example.com",
+				Paths: []api.IngressPath{{Path: "/", Backend: api.IngressBackend{ServiceName: "frontend", ServicePort: util.NewIntOrStringFromInt(80)}}},
+			}},
+		},
+		Status: api.IngressStatus{
+			Address: "10.0.0.1",
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/ingresses/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: ingress},
+	}
+	response, err := c.Setup().Ingresses(ns).UpdateStatus(ingress)
+	c.Validate(t, response, err)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestJobCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	job := &api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.JobSpec{
+			Completions: 3,
+			Parallelism: 1,
+			Selector:    map[string]string{"job": "abc"},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/jobs"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   job,
+		},
+		Response: Response{StatusCode: 200, Body: job},
+	}
+
+	response, err := c.Setup().Jobs(ns).Create(job)
+	c.Validate(t, response, err)
+}
+
+func TestJobList(t *testing.T) {
+	ns := api.NamespaceDefault
+	jobList := &api.JobList{
+		Items: []api.Job{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.JobSpec{
+					Completions: 1,
+					Parallelism: 1,
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/jobs"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: jobList},
+	}
+	response, err := c.Setup().Jobs(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestJobStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	job := &api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Spec: api.JobSpec{
+			Completions: 3,
+			Parallelism: 1,
+		},
+		Status: api.JobStatus{
+			Phase:     api.JobRunning,
+			Active:    1,
+			Succeeded: 2,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/jobs/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: job},
+	}
+	response, err := c.Setup().Jobs(ns).UpdateStatus(job)
+	c.Validate(t, response, err)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestPersistentVolumeClaimCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	pvc := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+			Resources: api.ResourceRequirements{
+				Limits: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10G"),
+				},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/persistentVolumeClaims"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   pvc,
+		},
+		Response: Response{StatusCode: 200, Body: pvc},
+	}
+
+	response, err := c.Setup().PersistentVolumeClaims(ns).Create(pvc)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimList(t *testing.T) {
+	ns := api.NamespaceDefault
+	pvcList := &api.PersistentVolumeClaimList{
+		Items: []api.PersistentVolumeClaim{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/persistentVolumeClaims"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: pvcList},
+	}
+	response, err := c.Setup().PersistentVolumeClaims(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeClaimStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	pvc := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase:     api.ClaimBound,
+			VolumeRef: &api.ObjectReference{Kind: "PersistentVolume", Name: "bar"},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/persistentVolumeClaims/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: pvc},
+	}
+	response, err := c.Setup().PersistentVolumeClaims(ns).UpdateStatus(pvc)
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeGet(t *testing.T) {
+	pv := &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: "abc"},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10G"),
+			},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/persistentVolumes/abc", Query: buildQueryValues("", nil)},
+		Response: Response{StatusCode: 200, Body: pv},
+	}
+	response, err := c.Setup().PersistentVolumes().Get("abc")
+	c.Validate(t, response, err)
+}
+
+func TestPersistentVolumeDelete(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: "/persistentVolumes/foo", Query: buildQueryValues("", nil)},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().PersistentVolumes().Delete("foo")
+	c.Validate(t, nil, err)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// PersistentVolumesInterface has methods to work with PersistentVolume resources.
+type PersistentVolumesInterface interface {
+	PersistentVolumes() PersistentVolumeInterface
+}
+
+// PersistentVolumeInterface has methods to work with PersistentVolume resources.
+type PersistentVolumeInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.PersistentVolumeList, error)
+	Get(name string) (*api.PersistentVolume, error)
+	Create(volume *api.PersistentVolume) (*api.PersistentVolume, error)
+	Update(volume *api.PersistentVolume) (*api.PersistentVolume, error)
+	UpdateStatus(volume *api.PersistentVolume) (*api.PersistentVolume, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// persistentVolumes implements PersistentVolumesInterface
+type persistentVolumes struct {
+	r *Client
+}
+
+// newPersistentVolumes returns a persistentVolumes
+func newPersistentVolumes(c *Client) *persistentVolumes {
+	return &persistentVolumes{r: c}
+}
+
+// List takes label and field selectors, and returns the list of persistentVolumes that match those selectors.
+func (c *persistentVolumes) List(label labels.Selector, field fields.Selector) (result *api.PersistentVolumeList, err error) {
+	result = &api.PersistentVolumeList{}
+	err = c.r.Get().
+		Resource("persistentVolumes").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the persistentVolume, and returns the corresponding PersistentVolume object, and an error if it occurs
+func (c *persistentVolumes) Get(name string) (result *api.PersistentVolume, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.PersistentVolume{}
+	err = c.r.Get().Resource("persistentVolumes").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a persistentVolume.  Returns the server's representation of the persistentVolume, and an error, if it occurs.
+func (c *persistentVolumes) Create(volume *api.PersistentVolume) (result *api.PersistentVolume, err error) {
+	result = &api.PersistentVolume{}
+	err = c.r.Post().Resource("persistentVolumes").Body(volume).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a persistentVolume to update spec.  Returns the server's representation of the persistentVolume, and an error, if it occurs.
+func (c *persistentVolumes) Update(volume *api.PersistentVolume) (result *api.PersistentVolume, err error) {
+	result = &api.PersistentVolume{}
+	if len(volume.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", volume)
+		return
+	}
+	err = c.r.Put().Resource("persistentVolumes").Name(volume.Name).Body(volume).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a persistentVolume to update status.  Returns the server's representation of the persistentVolume, and an error, if it occurs.
+func (c *persistentVolumes) UpdateStatus(volume *api.PersistentVolume) (result *api.PersistentVolume, err error) {
+	result = &api.PersistentVolume{}
+	if len(volume.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", volume)
+		return
+	}
+	err = c.r.Put().Resource("persistentVolumes").Name(volume.Name).SubResource("status").Body(volume).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the persistentVolume, and returns an error if one occurs
+func (c *persistentVolumes) Delete(name string) error {
+	return c.r.Delete().Resource("persistentVolumes").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested persistentVolumes.
+func (c *persistentVolumes) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Resource("persistentVolumes").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// PodDisruptionBudgetsNamespacer has methods to work with PodDisruptionBudget resources in a namespace
+type PodDisruptionBudgetsNamespacer interface {
+	PodDisruptionBudgets(namespace string) PodDisruptionBudgetInterface
+}
+
+// PodDisruptionBudgetInterface has methods to work with PodDisruptionBudget resources.
+type PodDisruptionBudgetInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.PodDisruptionBudgetList, error)
+	Get(name string) (*api.PodDisruptionBudget, error)
+	Create(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error)
+	Update(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error)
+	UpdateStatus(podDisruptionBudget *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// podDisruptionBudgets implements PodDisruptionBudgetsNamespacer interface
+type podDisruptionBudgets struct {
+	r  *Client
+	ns string
+}
+
+// newPodDisruptionBudgets returns a podDisruptionBudgets
+func newPodDisruptionBudgets(c *Client, namespace string) *podDisruptionBudgets {
+	return &podDisruptionBudgets{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// List takes label and field selectors, and returns the list of podDisruptionBudgets that match those selectors.
+func (c *podDisruptionBudgets) List(label labels.Selector, field fields.Selector) (result *api.PodDisruptionBudgetList, err error) {
+	result = &api.PodDisruptionBudgetList{}
+	err = c.r.Get().
+		Namespace(c.ns).
+		Resource("podDisruptionBudgets").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the podDisruptionBudget, and returns the corresponding PodDisruptionBudget object, and an error if it occurs
+func (c *podDisruptionBudgets) Get(name string) (result *api.PodDisruptionBudget, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.PodDisruptionBudget{}
+	err = c.r.Get().Namespace(c.ns).Resource("podDisruptionBudgets").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a podDisruptionBudget.  Returns the server's representation of the podDisruptionBudget, and an error, if it occurs.
+func (c *podDisruptionBudgets) Create(podDisruptionBudget *api.PodDisruptionBudget) (result *api.PodDisruptionBudget, err error) {
+	result = &api.PodDisruptionBudget{}
+	err = c.r.Post().Namespace(c.ns).Resource("podDisruptionBudgets").Body(podDisruptionBudget).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a podDisruptionBudget to update spec.  Returns the server's representation of the podDisruptionBudget, and an error, if it occurs.
+func (c *podDisruptionBudgets) Update(podDisruptionBudget *api.PodDisruptionBudget) (result *api.PodDisruptionBudget, err error) {
+	result = &api.PodDisruptionBudget{}
+	if len(podDisruptionBudget.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", podDisruptionBudget)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("podDisruptionBudgets").Name(podDisruptionBudget.Name).Body(podDisruptionBudget).Do().Into(result)
+	return
+}
+
+// UpdateStatus takes the representation of a podDisruptionBudget to update status.  Returns the server's representation of the podDisruptionBudget, and an error, if it occurs.
+func (c *podDisruptionBudgets) UpdateStatus(podDisruptionBudget *api.PodDisruptionBudget) (result *api.PodDisruptionBudget, err error) {
+	result = &api.PodDisruptionBudget{}
+	if len(podDisruptionBudget.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", podDisruptionBudget)
+		return
+	}
+	err = c.r.Put().Namespace(c.ns).Resource("podDisruptionBudgets").Name(podDisruptionBudget.Name).SubResource("status").Body(podDisruptionBudget).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the podDisruptionBudget, and returns an error if one occurs
+func (c *podDisruptionBudgets) Delete(name string) error {
+	return c.r.Delete().Namespace(c.ns).Resource("podDisruptionBudgets").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested podDisruptionBudgets.
+func (c *podDisruptionBudgets) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Namespace(c.ns).
+		Resource("podDisruptionBudgets").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestPodDisruptionBudgetCreate(t *testing.T) {
+	ns := api.NamespaceDefault
+	podDisruptionBudget := &api.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      "abc",
+			Namespace: "foo",
+		},
+		Spec: api.PodDisruptionBudgetSpec{
+			Selector: map[string]string{"app": "abc"},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   buildResourcePath(ns, "/podDisruptionBudgets"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   podDisruptionBudget,
+		},
+		Response: Response{StatusCode: 200, Body: podDisruptionBudget},
+	}
+
+	response, err := c.Setup().PodDisruptionBudgets(ns).Create(podDisruptionBudget)
+	c.Validate(t, response, err)
+}
+
+func TestPodDisruptionBudgetList(t *testing.T) {
+	ns := api.NamespaceDefault
+	pdbList := &api.PodDisruptionBudgetList{
+		Items: []api.PodDisruptionBudget{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "bar"},
+			},
+		},
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "GET",
+			Path:   buildResourcePath(ns, "/podDisruptionBudgets"),
+			Query:  buildQueryValues(ns, nil),
+			Body:   nil,
+		},
+		Response: Response{StatusCode: 200, Body: pdbList},
+	}
+	response, err := c.Setup().PodDisruptionBudgets(ns).List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPodDisruptionBudgetStatusUpdate(t *testing.T) {
+	ns := api.NamespaceDefault
+	podDisruptionBudget := &api.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "abc",
+			Namespace:       "foo",
+			ResourceVersion: "1",
+		},
+		Status: api.PodDisruptionBudgetStatus{
+			PodDisruptionsAllowed: 1,
+			CurrentHealthy:        3,
+			DesiredHealthy:        2,
+			ExpectedPods:          3,
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "PUT", Path: buildResourcePath(ns, "/podDisruptionBudgets/abc/status"), Query: buildQueryValues(ns, nil)},
+		Response: Response{StatusCode: 200, Body: podDisruptionBudget},
+	}
+	response, err := c.Setup().PodDisruptionBudgets(ns).UpdateStatus(podDisruptionBudget)
+	c.Validate(t, response, err)
+}
@@ -35,11 +35,12 @@ type PodsNamespacer interface {
 type PodInterface interface {
 	List(selector labels.Selector) (*api.PodList, error)
 	Get(name string) (*api.Pod, error)
-	Delete(name string) error
+	Delete(name string, options *api.DeleteOptions) error
 	Create(pod *api.Pod) (*api.Pod, error)
 	Update(pod *api.Pod) (*api.Pod, error)
 	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
 	Bind(binding *api.Binding) error
+	Evict(eviction *api.Eviction) error
 	UpdateStatus(name string, status *api.PodStatus) (*api.Pod, error)
 }
 
@@ -75,9 +76,14 @@ func (c *pods) Get(name string) (result *api.Pod, err error) {
 	return
 }
 
-// Delete takes the name of the pod, and returns an error if one occurs
-func (c *pods) Delete(name string) error {
-	return c.r.Delete().Namespace(c.ns).Resource("pods").Name(name).Do().Error()
+// Delete takes the name of the pod and optional deletion options, and returns an error if one occurs.
+// Unless the options say otherwise, the pod is given its termination grace period to shut down.
+func (c *pods) Delete(name string, options *api.DeleteOptions) error {
+	request := c.r.Delete().Namespace(c.ns).Resource("pods").Name(name)
+	if options != nil {
+		request = request.Body(options)
+	}
+	return request.Do().Error()
 }
 
 // Create takes the representation of a pod.  Returns the server's representation of the pod, and an error, if it occurs.
@@ -115,6 +121,12 @@ func (c *pods) Bind(binding *api.Binding) error {
 	return c.r.Post().Namespace(c.ns).Resource("pods").Name(binding.Name).SubResource("binding").Body(binding).Do().Error()
 }
 
+// Evict deletes the named pod in the current namespace (eviction.Namespace is ignored), unless
+// the server refuses it because of a disruption budget.
+func (c *pods) Evict(eviction *api.Eviction) error {
+	return c.r.Post().Namespace(c.ns).Resource("pods").Name(eviction.Name).SubResource("eviction").Body(eviction).Do().Error()
+}
+
 // UpdateStatus takes the name of the pod and the new status.  Returns the server's representation of the pod, and an error, if it occurs.
 func (c *pods) UpdateStatus(name string, newStatus *api.PodStatus) (result *api.Pod, err error) {
 	result = &api.Pod{}
@@ -0,0 +1,109 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// PriorityClassesInterface has methods to work with PriorityClass resources.
+type PriorityClassesInterface interface {
+	PriorityClasses() PriorityClassInterface
+}
+
+// PriorityClassInterface has methods to work with PriorityClass resources.
+type PriorityClassInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.PriorityClassList, error)
+	Get(name string) (*api.PriorityClass, error)
+	Create(priorityClass *api.PriorityClass) (*api.PriorityClass, error)
+	Update(priorityClass *api.PriorityClass) (*api.PriorityClass, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// priorityClasses implements PriorityClassesInterface
+type priorityClasses struct {
+	r *Client
+}
+
+// newPriorityClasses returns a priorityClasses
+func newPriorityClasses(c *Client) *priorityClasses {
+	return &priorityClasses{r: c}
+}
+
+// List takes label and field selectors, and returns the list of priorityClasses that match those selectors.
+func (c *priorityClasses) List(label labels.Selector, field fields.Selector) (result *api.PriorityClassList, err error) {
+	result = &api.PriorityClassList{}
+	err = c.r.Get().
+		Resource("priorityClasses").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the priorityClass, and returns the corresponding PriorityClass object, and an error if it occurs
+func (c *priorityClasses) Get(name string) (result *api.PriorityClass, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.PriorityClass{}
+	err = c.r.Get().Resource("priorityClasses").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a priorityClass.  Returns the server's representation of the priorityClass, and an error, if it occurs.
+func (c *priorityClasses) Create(priorityClass *api.PriorityClass) (result *api.PriorityClass, err error) {
+	result = &api.PriorityClass{}
+	err = c.r.Post().Resource("priorityClasses").Body(priorityClass).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a priorityClass to update.  Returns the server's representation of the priorityClass, and an error, if it occurs.
+func (c *priorityClasses) Update(priorityClass *api.PriorityClass) (result *api.PriorityClass, err error) {
+	result = &api.PriorityClass{}
+	if len(priorityClass.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", priorityClass)
+		return
+	}
+	err = c.r.Put().Resource("priorityClasses").Name(priorityClass.Name).Body(priorityClass).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the priorityClass, and returns an error if one occurs
+func (c *priorityClasses) Delete(name string) error {
+	return c.r.Delete().Resource("priorityClasses").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested priorityClasses.
+func (c *priorityClasses) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Resource("priorityClasses").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestPriorityClassCreate(t *testing.T) {
+	priorityClass := &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{Name: "high-priority"},
+		Value:      1000,
+	}
+	c := &testClient{
+		Request: testRequest{
+			Method: "POST",
+			Path:   "/priorityClasses",
+			Body:   priorityClass,
+		},
+		Response: Response{StatusCode: 200, Body: priorityClass},
+	}
+
+	response, err := c.Setup().PriorityClasses().Create(priorityClass)
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassGet(t *testing.T) {
+	priorityClass := &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{Name: "high-priority"},
+		Value:      1000,
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/priorityClasses/high-priority"},
+		Response: Response{StatusCode: 200, Body: priorityClass},
+	}
+
+	response, err := c.Setup().PriorityClasses().Get("high-priority")
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassList(t *testing.T) {
+	priorityClassList := &api.PriorityClassList{
+		Items: []api.PriorityClass{
+			{
+				ObjectMeta:    api.ObjectMeta{Name: "high-priority"},
+				Value:         1000,
+				GlobalDefault: true,
+			},
+		},
+	}
+	c := &testClient{
+		Request:  testRequest{Method: "GET", Path: "/priorityClasses"},
+		Response: Response{StatusCode: 200, Body: priorityClassList},
+	}
+	response, err := c.Setup().PriorityClasses().List(labels.Everything(), fields.Everything())
+	c.Validate(t, response, err)
+}
+
+func TestPriorityClassDelete(t *testing.T) {
+	c := &testClient{
+		Request:  testRequest{Method: "DELETE", Path: "/priorityClasses/high-priority"},
+		Response: Response{StatusCode: 200},
+	}
+	err := c.Setup().PriorityClasses().Delete("high-priority")
+	c.Validate(t, nil, err)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 	watchjson "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/json"
+	watchprotobuf "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/protobuf"
 	"github.com/golang/glog"
 )
 
@@ -112,6 +113,9 @@ type Request struct {
 
 	apiVersion string
 
+	// headers set on the request, such as the content type of the body
+	headers http.Header
+
 	// output
 	err  error
 	body io.Reader
@@ -348,6 +352,15 @@ func (r *Request) Timeout(d time.Duration) *Request {
 	return r
 }
 
+// SetHeader sets a header of the request, such as the content type of a patch.
+func (r *Request) SetHeader(key, value string) *Request {
+	if r.headers == nil {
+		r.headers = http.Header{}
+	}
+	r.headers.Set(key, value)
+	return r
+}
+
 // Body makes the request use obj as the body. Optional.
 // If obj is a string, try to read a file of that name.
 // If obj is a []byte, send it directly.
@@ -376,6 +389,9 @@ func (r *Request) Body(obj interface{}) *Request {
 			r.err = err
 			return r
 		}
+		if runtime.IsProtobuf(data) {
+			r.SetHeader("Content-Type", runtime.ProtobufContentType)
+		}
 		r.body = bytes.NewBuffer(data)
 	default:
 		r.err = fmt.Errorf("unknown type used for body: %+v", obj)
@@ -383,6 +399,15 @@ func (r *Request) Body(obj interface{}) *Request {
 	return r
 }
 
+// addHeaders sets the headers of the request on req.
+func (r *Request) addHeaders(req *http.Request) {
+	for key, values := range r.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
+
 func (r *Request) finalURL() string {
 	p := r.path
 	if r.namespaceSet && !r.namespaceInQuery && len(r.namespace) > 0 {
@@ -432,6 +457,7 @@ func (r *Request) Watch() (watch.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
+	r.addHeaders(req)
 	client := r.client
 	if client == nil {
 		client = http.DefaultClient
@@ -450,6 +476,9 @@ func (r *Request) Watch() (watch.Interface, error) {
 		}
 		return nil, fmt.Errorf("for request '%+v', got status: %v\nbody: %v", req.URL, resp.StatusCode, string(body))
 	}
+	if resp.Header.Get("Content-Type") == runtime.ProtobufContentType {
+		return watch.NewStreamWatcher(watchprotobuf.NewDecoder(resp.Body, r.codec)), nil
+	}
 	return watch.NewStreamWatcher(watchjson.NewDecoder(resp.Body, r.codec)), nil
 }
 
@@ -557,6 +586,7 @@ func (r *Request) DoRaw() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+		r.addHeaders(r.req)
 		r.resp, err = client.Do(r.req)
 		if err != nil {
 			return nil, err
@@ -38,12 +38,14 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta1"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta2"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/httpstream"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 	watchjson "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/json"
+	watchprotobuf "github.com/GoogleCloudPlatform/kubernetes/pkg/watch/protobuf"
 )
 
 func TestRequestWithErrorWontChange(t *testing.T) {
@@ -617,7 +619,7 @@ func TestRequestDo(t *testing.T) {
 
 func TestDoRequestNewWay(t *testing.T) {
 	reqBody := "request body"
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta2.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -651,7 +653,7 @@ func TestDoRequestNewWay(t *testing.T) {
 func TestDoRequestNewWayReader(t *testing.T) {
 	reqObj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	reqBodyExpected, _ := v1beta1.Codec.Encode(reqObj)
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -687,7 +689,7 @@ func TestDoRequestNewWayReader(t *testing.T) {
 func TestDoRequestNewWayObj(t *testing.T) {
 	reqObj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	reqBodyExpected, _ := v1beta2.Codec.Encode(reqObj)
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta2.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -737,7 +739,7 @@ func TestDoRequestNewWayFile(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   200,
@@ -778,7 +780,7 @@ func TestWasCreated(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 
-	expectedObj := &api.Service{Spec: api.ServiceSpec{Port: 12345}}
+	expectedObj := &api.Service{Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: 12345}}}}
 	expectedBody, _ := v1beta1.Codec.Encode(expectedObj)
 	fakeHandler := util.FakeHandler{
 		StatusCode:   201,
@@ -1006,6 +1008,51 @@ func TestWatch(t *testing.T) {
 	}
 }
 
+func TestWatchProtobuf(t *testing.T) {
+	table := []*api.Pod{
+		{ObjectMeta: api.ObjectMeta{Name: "first"}},
+		{ObjectMeta: api.ObjectMeta{Name: "second"}},
+	}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if e, a := runtime.ProtobufContentType+", application/json", r.Header.Get("Accept"); e != a {
+			t.Errorf("expected Accept %q, got %q", e, a)
+		}
+		w.Header().Set("Content-Type", runtime.ProtobufContentType)
+		w.WriteHeader(http.StatusOK)
+		encoder := watchprotobuf.NewEncoder(w, v1beta3.ProtobufCodec)
+		for _, pod := range table {
+			if err := encoder.Encode(&watch.Event{Type: watch.Added, Object: pod}); err != nil {
+				panic(err)
+			}
+		}
+	}))
+
+	s, err := New(&Config{
+		Host:        testServer.URL,
+		Version:     "v1beta3",
+		ContentType: runtime.ProtobufContentType,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	watching, err := s.Get().Prefix("path/to/watch/thing").Watch()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, pod := range table {
+		got, ok := <-watching.ResultChan()
+		if !ok {
+			t.Fatalf("unexpected early close")
+		}
+		if got.Type != watch.Added || !api.Semantic.DeepDerivative(pod, got.Object) {
+			t.Errorf("expected %v, got %#v", pod, got)
+		}
+	}
+	if _, ok := <-watching.ResultChan(); ok {
+		t.Fatal("unexpected non-close")
+	}
+}
+
 func TestStream(t *testing.T) {
 	auth := &Config{Username: "user", Password: "pass"}
 	expectedBody := "expected body"
@@ -45,6 +45,11 @@ type RESTClient struct {
 	// REST resources.
 	Codec runtime.Codec
 
+	// ContentType is the content type requested from the server in the Accept header
+	// of each request, if set. JSON is accepted as well, since errors and the versions
+	// without a protobuf encoding are returned in JSON.
+	ContentType string
+
 	// Set specific behavior of the client.  If not set http.DefaultClient will be
 	// used.
 	Client HTTPClient
@@ -92,7 +97,11 @@ func (c *RESTClient) Verb(verb string) *Request {
 	// if c.Client != nil {
 	// 	timeout = c.Client.Timeout
 	// }
-	return NewRequest(c.Client, verb, c.baseURL, c.apiVersion, c.Codec, c.LegacyBehavior, c.LegacyBehavior).Timeout(c.Timeout)
+	request := NewRequest(c.Client, verb, c.baseURL, c.apiVersion, c.Codec, c.LegacyBehavior, c.LegacyBehavior).Timeout(c.Timeout)
+	if len(c.ContentType) > 0 && c.ContentType != "application/json" {
+		request.SetHeader("Accept", c.ContentType+", application/json")
+	}
+	return request
 }
 
 // Post begins a POST request. Short for c.Verb("POST").
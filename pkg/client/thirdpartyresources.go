@@ -0,0 +1,109 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// ThirdPartyResourcesInterface has methods to work with ThirdPartyResource resources.
+type ThirdPartyResourcesInterface interface {
+	ThirdPartyResources() ThirdPartyResourceInterface
+}
+
+// ThirdPartyResourceInterface has methods to work with ThirdPartyResource resources.
+type ThirdPartyResourceInterface interface {
+	List(label labels.Selector, field fields.Selector) (*api.ThirdPartyResourceList, error)
+	Get(name string) (*api.ThirdPartyResource, error)
+	Create(thirdPartyResource *api.ThirdPartyResource) (*api.ThirdPartyResource, error)
+	Update(thirdPartyResource *api.ThirdPartyResource) (*api.ThirdPartyResource, error)
+	Delete(name string) error
+	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+}
+
+// thirdPartyResources implements ThirdPartyResourcesInterface
+type thirdPartyResources struct {
+	r *Client
+}
+
+// newThirdPartyResources returns a thirdPartyResources
+func newThirdPartyResources(c *Client) *thirdPartyResources {
+	return &thirdPartyResources{r: c}
+}
+
+// List takes label and field selectors, and returns the list of thirdPartyResources that match those selectors.
+func (c *thirdPartyResources) List(label labels.Selector, field fields.Selector) (result *api.ThirdPartyResourceList, err error) {
+	result = &api.ThirdPartyResourceList{}
+	err = c.r.Get().
+		Resource("thirdPartyResources").
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Do().
+		Into(result)
+	return
+}
+
+// Get takes the name of the thirdPartyResource, and returns the corresponding ThirdPartyResource object, and an error if it occurs
+func (c *thirdPartyResources) Get(name string) (result *api.ThirdPartyResource, err error) {
+	if len(name) == 0 {
+		return nil, errors.New("name is required parameter to Get")
+	}
+
+	result = &api.ThirdPartyResource{}
+	err = c.r.Get().Resource("thirdPartyResources").Name(name).Do().Into(result)
+	return
+}
+
+// Create takes the representation of a thirdPartyResource.  Returns the server's representation of the thirdPartyResource, and an error, if it occurs.
+func (c *thirdPartyResources) Create(thirdPartyResource *api.ThirdPartyResource) (result *api.ThirdPartyResource, err error) {
+	result = &api.ThirdPartyResource{}
+	err = c.r.Post().Resource("thirdPartyResources").Body(thirdPartyResource).Do().Into(result)
+	return
+}
+
+// Update takes the representation of a thirdPartyResource to update.  Returns the server's representation of the thirdPartyResource, and an error, if it occurs.
+func (c *thirdPartyResources) Update(thirdPartyResource *api.ThirdPartyResource) (result *api.ThirdPartyResource, err error) {
+	result = &api.ThirdPartyResource{}
+	if len(thirdPartyResource.ResourceVersion) == 0 {
+		err = fmt.Errorf("invalid update object, missing resource version: %v", thirdPartyResource)
+		return
+	}
+	err = c.r.Put().Resource("thirdPartyResources").Name(thirdPartyResource.Name).Body(thirdPartyResource).Do().Into(result)
+	return
+}
+
+// Delete takes the name of the thirdPartyResource, and returns an error if one occurs
+func (c *thirdPartyResources) Delete(name string) error {
+	return c.r.Delete().Resource("thirdPartyResources").Name(name).Do().Error()
+}
+
+// Watch returns a watch.Interface that watches the requested thirdPartyResources.
+func (c *thirdPartyResources) Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return c.r.Get().
+		Prefix("watch").
+		Resource("thirdPartyResources").
+		Param("resourceVersion", resourceVersion).
+		LabelsSelectorParam(api.LabelSelectorQueryParam(c.r.APIVersion()), label).
+		FieldsSelectorParam(api.FieldSelectorQueryParam(c.r.APIVersion()), field).
+		Watch()
+}
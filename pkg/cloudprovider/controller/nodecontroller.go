@@ -260,6 +260,9 @@ func (nc *NodeController) SyncProbedNodeStatus() error {
 			glog.Errorf("error updating node %s: %v", node.Name, err)
 		}
 	}
+	if err := nc.evictIntolerantPods(nodes.Items); err != nil {
+		glog.Errorf("error evicting pods from tainted nodes: %v", err)
+	}
 	return nil
 }
 
@@ -494,6 +497,9 @@ func (nc *NodeController) MonitorNodeStatus() error {
 			}
 		}
 	}
+	if err := nc.evictIntolerantPods(nodes.Items); err != nil {
+		glog.Errorf("error evicting pods from tainted nodes: %v", err)
+	}
 	return nil
 }
 
@@ -549,7 +555,9 @@ func (nc *NodeController) GetCloudNodesWithSpec() (*api.NodeList, error) {
 	return result, nil
 }
 
-// deletePods will delete all pods from master running on given node.
+// deletePods will delete all pods from master running on given node. The pods are evicted, so
+// that the disruption budgets they belong to are respected; the evictions refused because of
+// a budget are attempted again on the next call.
 func (nc *NodeController) deletePods(nodeID string) error {
 	glog.V(2).Infof("Delete all pods from %v", nodeID)
 	// TODO: We don't yet have field selectors from client, see issue #1362.
@@ -562,14 +570,68 @@ func (nc *NodeController) deletePods(nodeID string) error {
 			continue
 		}
 		glog.V(2).Infof("Delete pod %v", pod.Name)
-		if err := nc.kubeClient.Pods(pod.Namespace).Delete(pod.Name); err != nil {
-			glog.Errorf("Error deleting pod %v: %v", pod.Name, err)
+		eviction := &api.Eviction{
+			ObjectMeta:    api.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			DeleteOptions: api.NewDeleteOptions(0),
+		}
+		if err := nc.kubeClient.Pods(pod.Namespace).Evict(eviction); err != nil {
+			if apierrors.IsTooManyRequests(err) {
+				glog.V(2).Infof("Eviction of pod %v refused: %v", pod.Name, err)
+			} else {
+				glog.Errorf("Error deleting pod %v: %v", pod.Name, err)
+			}
 		}
 	}
 
 	return nil
 }
 
+// evictIntolerantPods evicts the pods bound to the given nodes which do not tolerate one of
+// the NoExecute taints of their node. The pods are listed once for all the nodes, and only if
+// one of them has such a taint. As in deletePods, the evictions refused because of a disruption
+// budget are attempted again on the next call.
+func (nc *NodeController) evictIntolerantPods(nodes []api.Node) error {
+	taints := map[string][]*api.Taint{}
+	for i := range nodes {
+		node := &nodes[i]
+		for j := range node.Spec.Taints {
+			if node.Spec.Taints[j].Effect == api.TaintEffectNoExecute {
+				taints[node.Name] = append(taints[node.Name], &node.Spec.Taints[j])
+			}
+		}
+	}
+	if len(taints) == 0 {
+		return nil
+	}
+	// TODO: We don't yet have field selectors from client, see issue #1362.
+	pods, err := nc.kubeClient.Pods(api.NamespaceAll).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, taint := range taints[pod.Spec.Host] {
+			if api.TolerationsTolerateTaint(pod.Spec.Tolerations, taint) {
+				continue
+			}
+			glog.V(2).Infof("Evict pod %v, it does not tolerate taint %v=%v:%v of node %v", pod.Name, taint.Key, taint.Value, taint.Effect, pod.Spec.Host)
+			eviction := &api.Eviction{
+				ObjectMeta:    api.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+				DeleteOptions: api.NewDeleteOptions(0),
+			}
+			if err := nc.kubeClient.Pods(pod.Namespace).Evict(eviction); err != nil {
+				if apierrors.IsTooManyRequests(err) {
+					glog.V(2).Infof("Eviction of pod %v refused: %v", pod.Name, err)
+				} else {
+					glog.Errorf("Error evicting pod %v: %v", pod.Name, err)
+				}
+			}
+			break
+		}
+	}
+	return nil
+}
+
 // isRunningCloudProvider checks if cluster is running with cloud provider.
 func (nc *NodeController) isRunningCloudProvider() bool {
 	return nc.cloud != nil && len(nc.matchRE) > 0
@@ -524,7 +524,7 @@ func TestSyncCloudNodesEvictPods(t *testing.T) {
 			matchRE:              ".*",
 			expectedRequestCount: 2, // List + Delete
 			expectedDeleted:      []string{"node1"},
-			expectedActions:      []client.FakeAction{{Action: "list-pods"}, {Action: "delete-pod", Value: "pod0"}},
+			expectedActions:      []client.FakeAction{{Action: "list-pods"}, {Action: "evict-pod", Value: "pod0"}},
 		},
 		{
 			// Delete node1, but pod0 is running on node0.
@@ -1018,7 +1018,7 @@ func TestSyncProbedNodeStatusEvictPods(t *testing.T) {
 				Err:    nil,
 			},
 			expectedRequestCount: 2, // List+Update
-			expectedActions:      []client.FakeAction{{Action: "list-pods"}, {Action: "delete-pod", Value: "pod0"}},
+			expectedActions:      []client.FakeAction{{Action: "list-pods"}, {Action: "evict-pod", Value: "pod0"}},
 		},
 	}
 
@@ -1188,7 +1188,7 @@ func TestMonitorNodeStatusEvictPods(t *testing.T) {
 		}
 		podEvicted := false
 		for _, action := range item.fakeNodeHandler.Actions {
-			if action.Action == "delete-pod" {
+			if action.Action == "evict-pod" {
 				podEvicted = true
 			}
 		}
@@ -1198,6 +1198,66 @@ func TestMonitorNodeStatusEvictPods(t *testing.T) {
 	}
 }
 
+func TestMonitorNodeStatusEvictIntolerantPods(t *testing.T) {
+	fakeNow := util.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+	newTaintedNode := func(name string) *api.Node {
+		return &api.Node{
+			ObjectMeta: api.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: util.Date(2012, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoExecute},
+				},
+			},
+			Status: api.NodeStatus{
+				Conditions: []api.NodeCondition{
+					{
+						Type:               api.NodeReady,
+						Status:             api.ConditionTrue,
+						LastProbeTime:      util.Date(2015, 1, 1, 11, 59, 0, 0, time.UTC),
+						LastTransitionTime: util.Date(2015, 1, 1, 11, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+		}
+	}
+	tolerantPod := newPod("pod1", "node0")
+	tolerantPod.Spec.Tolerations = []api.Toleration{{Key: "dedicated", Value: "gpu"}}
+	// pod3 is bound to node1 but the kubelet has not reported it yet.
+	unreportedPod := newPod("pod3", "node1")
+	unreportedPod.Status.Host = ""
+	fakeNodeHandler := &FakeNodeHandler{
+		Existing: []*api.Node{newTaintedNode("node0"), newTaintedNode("node1"), newNode("node2")},
+		Fake: client.Fake{
+			PodsList: api.PodList{Items: []api.Pod{*newPod("pod0", "node0"), *tolerantPod, *newPod("pod2", "node2"), *unreportedPod}},
+		},
+	}
+
+	nodeController := NewNodeController(nil, "", []string{"node0"}, nil, fakeNodeHandler, nil, 10, 5*time.Minute)
+	nodeController.now = func() util.Time { return fakeNow }
+	if err := nodeController.MonitorNodeStatus(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	evicted := []string{}
+	lists := 0
+	for _, action := range fakeNodeHandler.Actions {
+		switch action.Action {
+		case "evict-pod":
+			evicted = append(evicted, action.Value.(string))
+		case "list-pods":
+			lists++
+		}
+	}
+	if !reflect.DeepEqual([]string{"pod0", "pod3"}, evicted) {
+		t.Errorf("expected pod0 and pod3 to be evicted, got %v", evicted)
+	}
+	if lists != 1 {
+		t.Errorf("expected the pods to be listed once, got %d lists", lists)
+	}
+}
+
 func TestMonitorNodeStatusUpdateStatus(t *testing.T) {
 	fakeNow := util.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
 	table := []struct {
@@ -1361,7 +1421,7 @@ func newNode(name string) *api.Node {
 }
 
 func newPod(name, host string) *api.Pod {
-	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}, Status: api.PodStatus{Host: host}}
+	return &api.Pod{ObjectMeta: api.ObjectMeta{Name: name}, Spec: api.PodSpec{Host: host}, Status: api.PodStatus{Host: host}}
 }
 
 func sortedNodeNames(nodes []*api.Node) []string {
@@ -0,0 +1,264 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+const (
+	// AutoscalerTolerance is how far the measured CPU utilization may drift from
+	// the target, as a fraction of the target, before the replica count is changed.
+	AutoscalerTolerance = 0.1
+
+	// AutoscalerUpscaleForbiddenWindow is the minimum time between a rescale and
+	// a following scale up of the same replication controller.
+	AutoscalerUpscaleForbiddenWindow = 3 * time.Minute
+
+	// AutoscalerDownscaleForbiddenWindow is the minimum time between a rescale and
+	// a following scale down of the same replication controller.
+	AutoscalerDownscaleForbiddenWindow = 5 * time.Minute
+)
+
+// AutoscalerManager is responsible for adjusting the number of replicas of the
+// replication controller named by every HorizontalPodAutoscaler, so that the
+// CPU utilization of its pods stays close to the target.
+type AutoscalerManager struct {
+	kubeClient          client.Interface
+	containerInfoGetter client.ContainerInfoGetter
+	recorder            record.EventRecorder
+
+	// now returns the current time, to allow the cooldown windows to be tested.
+	now func() time.Time
+
+	// To allow injection of syncAutoscaler for testing.
+	syncHandler func(hpa api.HorizontalPodAutoscaler) error
+}
+
+// NewAutoscalerManager creates a new AutoscalerManager which reads the resource
+// usage of pods from the kubelets through containerInfoGetter.
+func NewAutoscalerManager(kubeClient client.Interface, containerInfoGetter client.ContainerInfoGetter) *AutoscalerManager {
+	am := &AutoscalerManager{
+		kubeClient:          kubeClient,
+		containerInfoGetter: containerInfoGetter,
+		recorder:            record.FromSource(api.EventSource{Component: "horizontal-pod-autoscaler"}),
+		now:                 time.Now,
+	}
+	am.syncHandler = am.syncAutoscaler
+	return am
+}
+
+// Run begins syncing horizontal pod autoscalers at the given period.
+func (am *AutoscalerManager) Run(period time.Duration) {
+	go util.Forever(func() { am.synchronize() }, period)
+}
+
+func (am *AutoscalerManager) synchronize() {
+	list, err := am.kubeClient.HorizontalPodAutoscalers(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	autoscalers := list.Items
+	wg := sync.WaitGroup{}
+	wg.Add(len(autoscalers))
+	for ix := range autoscalers {
+		go func(ix int) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of %v/%v", autoscalers[ix].Namespace, autoscalers[ix].Name)
+			if err := am.syncHandler(autoscalers[ix]); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing: %v", err))
+			}
+		}(ix)
+	}
+	wg.Wait()
+}
+
+func (am *AutoscalerManager) syncAutoscaler(hpa api.HorizontalPodAutoscaler) error {
+	rc, err := am.kubeClient.ReplicationControllers(hpa.Namespace).Get(hpa.Spec.ReplicationController)
+	if err != nil {
+		am.recorder.Eventf(&hpa, "failedGetReplicationController", "Unable to get replication controller %q: %v", hpa.Spec.ReplicationController, err)
+		return err
+	}
+	currentReplicas := rc.Spec.Replicas
+	status := api.HorizontalPodAutoscalerStatus{
+		CurrentReplicas: currentReplicas,
+		LastScaleTime:   hpa.Status.LastScaleTime,
+	}
+
+	desiredReplicas := currentReplicas
+	reason := ""
+	utilization, podCount, err := am.cpuUtilization(hpa.Namespace, rc.Spec.Selector)
+	if err != nil {
+		am.recorder.Eventf(&hpa, "failedGetMetrics", "Unable to compute CPU utilization: %v", err)
+	} else {
+		status.CurrentCPUUtilization = &utilization
+		ratio := float64(utilization) / float64(hpa.Spec.TargetCPUUtilization)
+		if math.Abs(1.0-ratio) > AutoscalerTolerance {
+			desiredReplicas = int(math.Ceil(ratio * float64(podCount)))
+			// Utilization is only measured on the running pods with metrics, so the
+			// desired count can fall on the wrong side of the current replicas when
+			// other pods are pending. Never scale against the direction of the load.
+			if (ratio > 1.0 && desiredReplicas < currentReplicas) || (ratio < 1.0 && desiredReplicas > currentReplicas) {
+				desiredReplicas = currentReplicas
+			}
+			reason = fmt.Sprintf("CPU utilization %d%% of request, target %d%%", utilization, hpa.Spec.TargetCPUUtilization)
+		}
+	}
+	if desiredReplicas < hpa.Spec.MinReplicas {
+		desiredReplicas = hpa.Spec.MinReplicas
+		reason = "below the minimum replica count"
+	}
+	if desiredReplicas > hpa.Spec.MaxReplicas {
+		desiredReplicas = hpa.Spec.MaxReplicas
+		reason = "above the maximum replica count"
+	}
+	status.DesiredReplicas = desiredReplicas
+
+	if am.shouldScale(hpa, currentReplicas, desiredReplicas) {
+		rc.Spec.Replicas = desiredReplicas
+		if _, err := am.kubeClient.ReplicationControllers(hpa.Namespace).Update(rc); err != nil {
+			am.recorder.Eventf(&hpa, "failedRescale", "New size: %d; error: %v", desiredReplicas, err)
+			return err
+		}
+		glog.V(2).Infof("Autoscaler \"%s\" rescaled %s from %d to %d replicas: %s", hpa.Name, rc.Name, currentReplicas, desiredReplicas, reason)
+		am.recorder.Eventf(&hpa, "successfulRescale", "New size: %d; reason: %s", desiredReplicas, reason)
+		now := util.NewTime(am.now())
+		status.CurrentReplicas = desiredReplicas
+		status.LastScaleTime = &now
+	}
+
+	if !api.Semantic.DeepEqual(hpa.Status, status) {
+		hpa.Status = status
+		if _, err := am.kubeClient.HorizontalPodAutoscalers(hpa.Namespace).UpdateStatus(&hpa); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shouldScale returns true when the replica count should change from current
+// to desired. Consecutive rescales are spaced by the cooldown windows, unless the
+// current count lies outside the bounds of the autoscaler.
+func (am *AutoscalerManager) shouldScale(hpa api.HorizontalPodAutoscaler, current, desired int) bool {
+	if desired == current {
+		return false
+	}
+	if current < hpa.Spec.MinReplicas || current > hpa.Spec.MaxReplicas || hpa.Status.LastScaleTime == nil {
+		return true
+	}
+	window := AutoscalerUpscaleForbiddenWindow
+	if desired < current {
+		window = AutoscalerDownscaleForbiddenWindow
+	}
+	return !hpa.Status.LastScaleTime.Add(window).After(am.now())
+}
+
+// cpuUtilization returns the average CPU usage of the running pods matching
+// selector, as a percentage of their CPU request, along with the number of pods
+// it was measured on. Pods whose stats cannot be read are left out.
+func (am *AutoscalerManager) cpuUtilization(namespace string, selector map[string]string) (int, int, error) {
+	podList, err := am.kubeClient.Pods(namespace).List(labels.Set(selector).AsSelector())
+	if err != nil {
+		return 0, 0, err
+	}
+	var usage, request int64
+	podCount := 0
+	for _, pod := range podList.Items {
+		if pod.Status.Phase != api.PodRunning || len(pod.Spec.Host) == 0 {
+			continue
+		}
+		podUsage, podRequest, err := am.podCPUUsage(&pod)
+		if err != nil {
+			// a missing CPU request affects every pod of the controller, so it is not skipped
+			if podRequest == 0 {
+				return 0, 0, err
+			}
+			glog.V(2).Infof("Skipping pod %s/%s in CPU utilization: %v", pod.Namespace, pod.Name, err)
+			continue
+		}
+		usage += podUsage
+		request += podRequest
+		podCount++
+	}
+	if podCount == 0 {
+		return 0, 0, fmt.Errorf("no running pods with metrics")
+	}
+	return int(usage * 100 / request), podCount, nil
+}
+
+// podCPUUsage returns the CPU usage and request of the containers of a pod in millicores.
+// The request is summed the way the scheduler does, and is zero if a container has no
+// CPU request.
+func (am *AutoscalerManager) podCPUUsage(pod *api.Pod) (int64, int64, error) {
+	var usage, request int64
+	for _, container := range pod.Spec.Containers {
+		containerRequest := container.Resources.Requests.Cpu().MilliValue()
+		if containerRequest == 0 {
+			return 0, 0, fmt.Errorf("container %s of pod %s has no CPU request", container.Name, pod.Name)
+		}
+		request += containerRequest
+	}
+	for _, container := range pod.Spec.Containers {
+		req := &cadvisorApi.ContainerInfoRequest{NumStats: 2}
+		info, err := am.containerInfoGetter.GetPodContainerInfo(pod.Spec.Host, pod.Namespace, pod.Name, pod.UID, container.Name, req)
+		if err != nil {
+			return 0, request, fmt.Errorf("unable to get stats of container %s of pod %s: %v", container.Name, pod.Name, err)
+		}
+		containerUsage, err := cpuUsageRate(info)
+		if err != nil {
+			return 0, request, fmt.Errorf("container %s of pod %s: %v", container.Name, pod.Name, err)
+		}
+		usage += containerUsage
+	}
+	return usage, request, nil
+}
+
+// cpuUsageRate returns the CPU usage of a container in millicores, averaged
+// between its oldest and newest samples.
+func cpuUsageRate(info *cadvisorApi.ContainerInfo) (int64, error) {
+	if len(info.Stats) < 2 {
+		return 0, fmt.Errorf("not enough samples")
+	}
+	oldest, newest := info.Stats[0], info.Stats[0]
+	for _, stats := range info.Stats {
+		if stats.Timestamp.Before(oldest.Timestamp) {
+			oldest = stats
+		}
+		if stats.Timestamp.After(newest.Timestamp) {
+			newest = stats
+		}
+	}
+	interval := newest.Timestamp.Sub(oldest.Timestamp)
+	if interval <= 0 || newest.Cpu.Usage.Total < oldest.Cpu.Usage.Total {
+		return 0, fmt.Errorf("invalid samples")
+	}
+	// CPU usage is reported in nanoseconds of CPU time.
+	return int64(newest.Cpu.Usage.Total-oldest.Cpu.Usage.Total) * 1000 / int64(interval), nil
+}
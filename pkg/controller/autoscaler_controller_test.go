@@ -0,0 +1,276 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/record"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	cadvisorApi "github.com/google/cadvisor/info/v1"
+)
+
+// autoscalerStatusClient records the autoscaler statuses written through UpdateStatus.
+type autoscalerStatusClient struct {
+	*client.Fake
+	statuses []api.HorizontalPodAutoscalerStatus
+}
+
+func (c *autoscalerStatusClient) HorizontalPodAutoscalers(namespace string) client.HorizontalPodAutoscalerInterface {
+	return &autoscalerStatusRecorder{&client.FakeHorizontalPodAutoscalers{Fake: c.Fake, Namespace: namespace}, c}
+}
+
+type autoscalerStatusRecorder struct {
+	*client.FakeHorizontalPodAutoscalers
+	client *autoscalerStatusClient
+}
+
+func (r *autoscalerStatusRecorder) UpdateStatus(hpa *api.HorizontalPodAutoscaler) (*api.HorizontalPodAutoscaler, error) {
+	r.client.statuses = append(r.client.statuses, hpa.Status)
+	return r.FakeHorizontalPodAutoscalers.UpdateStatus(hpa)
+}
+
+// fakeContainerInfoGetter reports every container as using the given number of
+// millicores over the last ten seconds.
+type fakeContainerInfoGetter struct {
+	milliCores map[string]int64
+}
+
+func (f *fakeContainerInfoGetter) GetContainerInfo(host, podID, containerID string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeContainerInfoGetter) GetPodContainerInfo(host, podNamespace, podName string, uid types.UID, containerName string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	milliCores, ok := f.milliCores[podName]
+	if !ok {
+		return nil, fmt.Errorf("no stats for pod %s", podName)
+	}
+	start := time.Unix(1000, 0)
+	interval := 10 * time.Second
+	return &cadvisorApi.ContainerInfo{
+		Stats: []*cadvisorApi.ContainerStats{
+			{Timestamp: start, Cpu: cadvisorApi.CpuStats{Usage: cadvisorApi.CpuUsage{Total: 5000}}},
+			{Timestamp: start.Add(interval), Cpu: cadvisorApi.CpuStats{Usage: cadvisorApi.CpuUsage{Total: 5000 + uint64(milliCores*int64(interval)/1000)}}},
+		},
+	}, nil
+}
+
+func (f *fakeContainerInfoGetter) GetRootInfo(host string, req *cadvisorApi.ContainerInfoRequest) (*cadvisorApi.ContainerInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeContainerInfoGetter) GetMachineInfo(host string) (*cadvisorApi.MachineInfo, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func newAutoscaler(min, max, target int) api.HorizontalPodAutoscaler {
+	return api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "foobar", Namespace: api.NamespaceDefault},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ReplicationController: "frontend",
+			MinReplicas:           min,
+			MaxReplicas:           max,
+			TargetCPUUtilization:  target,
+		},
+	}
+}
+
+// newAutoscaledPods returns running pods requesting 500 millicores without a CPU
+// limit, along with stats reporting the given usage for each of them. A negative
+// usage leaves the pod without stats.
+func newAutoscaledPods(milliCores ...int64) (api.PodList, *fakeContainerInfoGetter) {
+	pods := api.PodList{}
+	getter := &fakeContainerInfoGetter{milliCores: map[string]int64{}}
+	for i, usage := range milliCores {
+		name := fmt.Sprintf("pod%d", i)
+		pods.Items = append(pods.Items, api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:      name,
+				Namespace: api.NamespaceDefault,
+				Labels:    map[string]string{"name": "frontend"},
+			},
+			Spec: api.PodSpec{
+				Host: "node1",
+				Containers: []api.Container{{
+					Name: "web",
+					Resources: api.ResourceRequirements{
+						Requests: api.ResourceList{api.ResourceCPU: resource.MustParse("500m")},
+					},
+				}},
+			},
+			Status: api.PodStatus{Phase: api.PodRunning},
+		})
+		if usage >= 0 {
+			getter.milliCores[name] = usage
+		}
+	}
+	return pods, getter
+}
+
+func TestSyncAutoscaler(t *testing.T) {
+	now := time.Unix(10000, 0)
+	recently := util.NewTime(now.Add(-time.Minute))
+	tests := map[string]struct {
+		hpa              api.HorizontalPodAutoscaler
+		replicas         int
+		milliCores       []int64
+		expectedReplicas int
+		expectedStatus   api.HorizontalPodAutoscalerStatus
+	}{
+		"scales up above the target": {
+			hpa:              newAutoscaler(1, 10, 50),
+			replicas:         2,
+			milliCores:       []int64{400, 400},
+			expectedReplicas: 4,
+		},
+		"scales down below the target": {
+			hpa:              newAutoscaler(1, 10, 50),
+			replicas:         4,
+			milliCores:       []int64{50, 50, 50, 50},
+			expectedReplicas: 1,
+		},
+		"stays within the tolerance": {
+			hpa:              newAutoscaler(1, 10, 50),
+			replicas:         2,
+			milliCores:       []int64{260, 260},
+			expectedReplicas: 2,
+		},
+		"does not scale above the maximum": {
+			hpa:              newAutoscaler(1, 3, 50),
+			replicas:         2,
+			milliCores:       []int64{500, 500},
+			expectedReplicas: 3,
+		},
+		"scales up to the minimum without metrics": {
+			hpa:              newAutoscaler(3, 5, 50),
+			replicas:         1,
+			expectedReplicas: 3,
+		},
+		"does not scale down under load with pending pods": {
+			hpa:              newAutoscaler(1, 10, 50),
+			replicas:         5,
+			milliCores:       []int64{400, 400},
+			expectedReplicas: 5,
+		},
+		"skips pods without stats": {
+			hpa:              newAutoscaler(1, 10, 50),
+			replicas:         3,
+			milliCores:       []int64{400, 400, -1},
+			expectedReplicas: 4,
+		},
+		"waits for the upscale window": {
+			hpa: func() api.HorizontalPodAutoscaler {
+				hpa := newAutoscaler(1, 10, 50)
+				hpa.Status.LastScaleTime = &recently
+				return hpa
+			}(),
+			replicas:         2,
+			milliCores:       []int64{400, 400},
+			expectedReplicas: 2,
+		},
+	}
+
+	for name, test := range tests {
+		pods, getter := newAutoscaledPods(test.milliCores...)
+		kubeClient := &autoscalerStatusClient{Fake: &client.Fake{
+			PodsList: pods,
+			Ctrl: api.ReplicationController{
+				ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+				Spec: api.ReplicationControllerSpec{
+					Replicas: test.replicas,
+					Selector: map[string]string{"name": "frontend"},
+				},
+			},
+		}}
+		manager := NewAutoscalerManager(kubeClient, getter)
+		manager.recorder = &record.FakeRecorder{}
+		manager.now = func() time.Time { return now }
+
+		if err := manager.syncAutoscaler(test.hpa); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var updated *api.ReplicationController
+		for _, action := range kubeClient.Actions {
+			if action.Action == "update-controller" {
+				updated = action.Value.(*api.ReplicationController)
+			}
+		}
+		if test.expectedReplicas == test.replicas {
+			if updated != nil {
+				t.Errorf("%s: expected no rescale, got %d replicas", name, updated.Spec.Replicas)
+			}
+		} else if updated == nil {
+			t.Errorf("%s: expected a rescale to %d replicas, got none", name, test.expectedReplicas)
+		} else if updated.Spec.Replicas != test.expectedReplicas {
+			t.Errorf("%s: expected a rescale to %d replicas, got %d", name, test.expectedReplicas, updated.Spec.Replicas)
+		}
+		if len(kubeClient.statuses) != 1 {
+			t.Errorf("%s: expected 1 status update, got %#v", name, kubeClient.statuses)
+			continue
+		}
+		status := kubeClient.statuses[0]
+		if status.CurrentReplicas != test.expectedReplicas {
+			t.Errorf("%s: expected %d current replicas in status, got %d", name, test.expectedReplicas, status.CurrentReplicas)
+		}
+		if updated != nil && (status.LastScaleTime == nil || !status.LastScaleTime.Equal(now)) {
+			t.Errorf("%s: expected the last scale time to be recorded, got %v", name, status.LastScaleTime)
+		}
+	}
+}
+
+func TestCPUUsageRate(t *testing.T) {
+	start := time.Unix(1000, 0)
+	info := &cadvisorApi.ContainerInfo{
+		Stats: []*cadvisorApi.ContainerStats{
+			{Timestamp: start.Add(2 * time.Second), Cpu: cadvisorApi.CpuStats{Usage: cadvisorApi.CpuUsage{Total: 3000000000}}},
+			{Timestamp: start, Cpu: cadvisorApi.CpuStats{Usage: cadvisorApi.CpuUsage{Total: 2000000000}}},
+		},
+	}
+	usage, err := cpuUsageRate(info)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage != 500 {
+		t.Errorf("expected 500 millicores, got %d", usage)
+	}
+	if _, err := cpuUsageRate(&cadvisorApi.ContainerInfo{Stats: info.Stats[:1]}); err == nil {
+		t.Errorf("expected an error with a single sample")
+	}
+}
+
+func TestAutoscalerSynchronize(t *testing.T) {
+	kubeClient := &client.Fake{
+		HorizontalPodAutoscalersList: api.HorizontalPodAutoscalerList{Items: []api.HorizontalPodAutoscaler{newAutoscaler(1, 5, 80)}},
+	}
+	manager := NewAutoscalerManager(kubeClient, &fakeContainerInfoGetter{})
+	synced := []string{}
+	manager.syncHandler = func(hpa api.HorizontalPodAutoscaler) error {
+		synced = append(synced, hpa.Name)
+		return nil
+	}
+	manager.synchronize()
+	if len(synced) != 1 || synced[0] != "foobar" {
+		t.Errorf("expected foobar to be synced, got %v", synced)
+	}
+}
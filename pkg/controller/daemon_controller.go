@@ -0,0 +1,207 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+// DaemonManager is responsible for making sure that every node that matches the
+// node selector of a DaemonSet runs exactly one of its pods.
+type DaemonManager struct {
+	kubeClient client.Interface
+	podControl PodControlInterface
+
+	// nodeStore holds the nodes of the cluster, kept up to date by a reflector.
+	nodeStore cache.Store
+
+	// To allow injection of syncDaemonSet for testing.
+	syncHandler func(ds api.DaemonSet) error
+}
+
+// NewDaemonManager creates a new DaemonManager.
+func NewDaemonManager(kubeClient client.Interface) *DaemonManager {
+	dm := &DaemonManager{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+		},
+		nodeStore: cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	dm.syncHandler = dm.syncDaemonSet
+	return dm
+}
+
+// Run begins watching nodes and syncing daemon sets at the given period.
+func (dm *DaemonManager) Run(period time.Duration) {
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return dm.kubeClient.Nodes().List()
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return dm.kubeClient.Nodes().Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Node{},
+		dm.nodeStore,
+		0,
+	).Run()
+	go util.Forever(func() { dm.synchronize() }, period)
+}
+
+func (dm *DaemonManager) synchronize() {
+	if len(dm.nodeStore.List()) == 0 {
+		// Until the node cache is populated every daemon pod would look orphaned.
+		glog.V(4).Infof("No nodes known yet, skipping daemon set sync")
+		return
+	}
+	list, err := dm.kubeClient.DaemonSets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	daemonSets := list.Items
+	wg := sync.WaitGroup{}
+	wg.Add(len(daemonSets))
+	for ix := range daemonSets {
+		go func(ix int) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of %v/%v", daemonSets[ix].Namespace, daemonSets[ix].Name)
+			if err := dm.syncHandler(daemonSets[ix]); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing: %v", err))
+			}
+		}(ix)
+	}
+	wg.Wait()
+}
+
+func (dm *DaemonManager) syncDaemonSet(ds api.DaemonSet) error {
+	if ds.DeletionTimestamp != nil {
+		// the daemon set is being deleted, its pods are left to the garbage collector
+		return nil
+	}
+	s := labels.Set(ds.Spec.Selector).AsSelector()
+	podList, err := dm.kubeClient.Pods(ds.Namespace).List(s)
+	if err != nil {
+		return err
+	}
+	nodeToPods := map[string][]api.Pod{}
+	for _, pod := range FilterActivePods(podList.Items) {
+		if len(pod.Spec.Host) == 0 {
+			continue
+		}
+		nodeToPods[pod.Spec.Host] = append(nodeToPods[pod.Spec.Host], pod)
+	}
+
+	status := api.DaemonSetStatus{}
+	knownNodes := util.NewStringSet()
+	var hostsToCreate []string
+	var podsToDelete []api.Pod
+	for _, obj := range dm.nodeStore.List() {
+		node := obj.(*api.Node)
+		knownNodes.Insert(node.Name)
+		pods := nodeToPods[node.Name]
+		shouldRun, shouldContinueRunning := nodeShouldRunDaemonPod(node, &ds)
+		switch {
+		case shouldRun && len(pods) == 0:
+			status.DesiredNumberScheduled++
+			hostsToCreate = append(hostsToCreate, node.Name)
+		case shouldContinueRunning && len(pods) > 0:
+			status.DesiredNumberScheduled++
+			status.CurrentNumberScheduled++
+			// Only one daemon pod should run on each node.
+			podsToDelete = append(podsToDelete, pods[1:]...)
+		case len(pods) > 0:
+			status.NumberMisscheduled++
+			podsToDelete = append(podsToDelete, pods...)
+		}
+	}
+	// Pods bound to nodes which have left the cluster are no longer needed.
+	for host, pods := range nodeToPods {
+		if !knownNodes.Has(host) {
+			podsToDelete = append(podsToDelete, pods...)
+		}
+	}
+
+	if len(hostsToCreate) > 0 {
+		glog.V(2).Infof("Daemon set \"%s\" is missing from %d nodes, creating pods\n", ds.Name, len(hostsToCreate))
+	}
+	if len(podsToDelete) > 0 {
+		glog.V(2).Infof("Daemon set \"%s\" has %d extra pods, deleting them\n", ds.Name, len(podsToDelete))
+	}
+	wait := sync.WaitGroup{}
+	wait.Add(len(hostsToCreate) + len(podsToDelete))
+	for i := range hostsToCreate {
+		go func(ix int) {
+			defer wait.Done()
+			dm.podControl.createDaemonPod(ds.Namespace, ds, hostsToCreate[ix])
+		}(i)
+	}
+	for i := range podsToDelete {
+		go func(ix int) {
+			defer wait.Done()
+			if err := dm.podControl.deletePod(ds.Namespace, podsToDelete[ix].Name); err != nil {
+				util.HandleError(fmt.Errorf("unable to delete pod %s: %v", podsToDelete[ix].Name, err))
+			}
+		}(i)
+	}
+	wait.Wait()
+
+	if ds.Status != status {
+		ds.Status = status
+		if _, err := dm.kubeClient.DaemonSets(ds.Namespace).UpdateStatus(&ds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeShouldRunDaemonPod reports whether a pod of the daemon set should be created on the node,
+// and whether one already running there should be kept. As in the scheduler, a new pod must
+// match the node selector and tolerate the NoSchedule and NoExecute taints of the node; a
+// running pod is kept as long as it matches and tolerates the NoExecute taints.
+func nodeShouldRunDaemonPod(node *api.Node, ds *api.DaemonSet) (shouldRun, shouldContinueRunning bool) {
+	nodeSelector := labels.Set(ds.Spec.Template.Spec.NodeSelector).AsSelector()
+	if !nodeSelector.Matches(labels.Set(node.Labels)) {
+		return false, false
+	}
+	shouldRun, shouldContinueRunning = true, true
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == api.TaintEffectPreferNoSchedule || api.TolerationsTolerateTaint(ds.Spec.Template.Spec.Tolerations, taint) {
+			continue
+		}
+		shouldRun = false
+		if taint.Effect == api.TaintEffectNoExecute {
+			shouldContinueRunning = false
+		}
+	}
+	return shouldRun, shouldContinueRunning
+}
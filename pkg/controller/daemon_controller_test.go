@@ -0,0 +1,238 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+// daemonStatusClient records the daemon set statuses written through UpdateStatus.
+type daemonStatusClient struct {
+	*client.Fake
+	statuses []api.DaemonSetStatus
+}
+
+func (c *daemonStatusClient) DaemonSets(namespace string) client.DaemonSetInterface {
+	return &daemonStatusRecorder{&client.FakeDaemonSets{Fake: c.Fake, Namespace: namespace}, c}
+}
+
+type daemonStatusRecorder struct {
+	*client.FakeDaemonSets
+	client *daemonStatusClient
+}
+
+func (r *daemonStatusRecorder) UpdateStatus(ds *api.DaemonSet) (*api.DaemonSet, error) {
+	r.client.statuses = append(r.client.statuses, ds.Status)
+	return r.FakeDaemonSets.UpdateStatus(ds)
+}
+
+func newDaemonSet(nodeSelector map[string]string) api.DaemonSet {
+	selector := map[string]string{"daemon": "foobar"}
+	return api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{Name: "foobar", Namespace: api.NamespaceDefault},
+		Spec: api.DaemonSetSpec{
+			Selector: selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					NodeSelector:  nodeSelector,
+					RestartPolicy: api.RestartPolicyAlways,
+					Containers:    []api.Container{{Image: "foo/bar"}},
+				},
+			},
+		},
+	}
+}
+
+func newDaemonPod(name, host string) api.Pod {
+	return api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"daemon": "foobar"},
+		},
+		Spec:   api.PodSpec{Host: host},
+		Status: api.PodStatus{Phase: api.PodRunning},
+	}
+}
+
+func newDaemonManager(pods []api.Pod, nodes ...api.Node) (*DaemonManager, *daemonStatusClient, *FakePodControl) {
+	kubeClient := &daemonStatusClient{Fake: &client.Fake{PodsList: api.PodList{Items: pods}}}
+	fakePodControl := &FakePodControl{}
+	manager := NewDaemonManager(kubeClient)
+	manager.podControl = fakePodControl
+	for i := range nodes {
+		manager.nodeStore.Add(&nodes[i])
+	}
+	return manager, kubeClient, fakePodControl
+}
+
+func newNode(name string, nodeLabels map[string]string) api.Node {
+	return api.Node{ObjectMeta: api.ObjectMeta{Name: name, Labels: nodeLabels}}
+}
+
+func newTaintedNode(name string, effect api.TaintEffect) api.Node {
+	node := newNode(name, nil)
+	node.Spec.Taints = []api.Taint{{Key: "dedicated", Value: "infra", Effect: effect}}
+	return node
+}
+
+func newTolerantDaemonSet() api.DaemonSet {
+	ds := newDaemonSet(nil)
+	ds.Spec.Template.Spec.Tolerations = []api.Toleration{{Key: "dedicated", Value: "infra"}}
+	return ds
+}
+
+func TestSyncDaemonSet(t *testing.T) {
+	logging := map[string]string{"role": "logging"}
+	tests := map[string]struct {
+		ds              api.DaemonSet
+		pods            []api.Pod
+		nodes           []api.Node
+		expectedHosts   []string
+		expectedDeletes []string
+		expectedStatus  api.DaemonSetStatus
+	}{
+		"creates a pod on every node": {
+			ds:             newDaemonSet(nil),
+			nodes:          []api.Node{newNode("node1", nil), newNode("node2", nil)},
+			expectedHosts:  []string{"node1", "node2"},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 2},
+		},
+		"only creates pods on matching nodes": {
+			ds:             newDaemonSet(logging),
+			nodes:          []api.Node{newNode("node1", logging), newNode("node2", nil)},
+			expectedHosts:  []string{"node1"},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 1},
+		},
+		"skips nodes already running a pod": {
+			ds:             newDaemonSet(nil),
+			pods:           []api.Pod{newDaemonPod("pod1", "node1")},
+			nodes:          []api.Node{newNode("node1", nil), newNode("node2", nil)},
+			expectedHosts:  []string{"node2"},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 1},
+		},
+		"deletes extra pods on a node": {
+			ds:              newDaemonSet(nil),
+			pods:            []api.Pod{newDaemonPod("pod1", "node1"), newDaemonPod("pod2", "node1")},
+			nodes:           []api.Node{newNode("node1", nil)},
+			expectedDeletes: []string{"pod2"},
+			expectedStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+		"deletes pods from nodes that stopped matching": {
+			ds:              newDaemonSet(logging),
+			pods:            []api.Pod{newDaemonPod("pod1", "node1")},
+			nodes:           []api.Node{newNode("node1", nil)},
+			expectedDeletes: []string{"pod1"},
+			expectedStatus:  api.DaemonSetStatus{NumberMisscheduled: 1},
+		},
+		"does not create pods on nodes with untolerated taints": {
+			ds:             newDaemonSet(nil),
+			nodes:          []api.Node{newTaintedNode("node1", api.TaintEffectNoSchedule), newTaintedNode("node2", api.TaintEffectPreferNoSchedule)},
+			expectedHosts:  []string{"node2"},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 1},
+		},
+		"creates pods on nodes with tolerated taints": {
+			ds:             newTolerantDaemonSet(),
+			nodes:          []api.Node{newTaintedNode("node1", api.TaintEffectNoSchedule), newTaintedNode("node2", api.TaintEffectNoExecute)},
+			expectedHosts:  []string{"node1", "node2"},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 2},
+		},
+		"keeps pods on nodes tainted NoSchedule": {
+			ds:             newDaemonSet(nil),
+			pods:           []api.Pod{newDaemonPod("pod1", "node1")},
+			nodes:          []api.Node{newTaintedNode("node1", api.TaintEffectNoSchedule)},
+			expectedStatus: api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+		"deletes pods from nodes tainted NoExecute": {
+			ds:              newDaemonSet(nil),
+			pods:            []api.Pod{newDaemonPod("pod1", "node1")},
+			nodes:           []api.Node{newTaintedNode("node1", api.TaintEffectNoExecute)},
+			expectedDeletes: []string{"pod1"},
+			expectedStatus:  api.DaemonSetStatus{NumberMisscheduled: 1},
+		},
+		"deletes pods from nodes that left": {
+			ds:              newDaemonSet(nil),
+			pods:            []api.Pod{newDaemonPod("pod1", "node1"), newDaemonPod("pod2", "gone")},
+			nodes:           []api.Node{newNode("node1", nil)},
+			expectedDeletes: []string{"pod2"},
+			expectedStatus:  api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1},
+		},
+	}
+
+	for name, test := range tests {
+		manager, kubeClient, fakePodControl := newDaemonManager(test.pods, test.nodes...)
+		if err := manager.syncDaemonSet(test.ds); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		sort.Strings(fakePodControl.daemonHosts)
+		if e, a := fmt.Sprintf("%v", test.expectedHosts), fmt.Sprintf("%v", fakePodControl.daemonHosts); len(test.expectedHosts)+len(fakePodControl.daemonHosts) > 0 && e != a {
+			t.Errorf("%s: expected pods created on %s, got %s", name, e, a)
+		}
+		sort.Strings(fakePodControl.deletePodName)
+		if e, a := fmt.Sprintf("%v", test.expectedDeletes), fmt.Sprintf("%v", fakePodControl.deletePodName); len(test.expectedDeletes)+len(fakePodControl.deletePodName) > 0 && e != a {
+			t.Errorf("%s: expected pods %s deleted, got %s", name, e, a)
+		}
+		if len(kubeClient.statuses) != 1 {
+			t.Errorf("%s: expected 1 status update, got %#v", name, kubeClient.statuses)
+		} else if kubeClient.statuses[0] != test.expectedStatus {
+			t.Errorf("%s: expected status %#v, got %#v", name, test.expectedStatus, kubeClient.statuses[0])
+		}
+	}
+}
+
+func TestSyncDaemonSetUpdatesStatusOnlyWhenChanged(t *testing.T) {
+	ds := newDaemonSet(nil)
+	ds.Status = api.DaemonSetStatus{DesiredNumberScheduled: 1, CurrentNumberScheduled: 1}
+	manager, kubeClient, fakePodControl := newDaemonManager([]api.Pod{newDaemonPod("pod1", "node1")}, newNode("node1", nil))
+	if err := manager.syncDaemonSet(ds); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fakePodControl.daemonHosts) != 0 || len(fakePodControl.deletePodName) != 0 {
+		t.Errorf("expected no pods to be created or deleted, got %#v", fakePodControl)
+	}
+	if len(kubeClient.statuses) != 0 {
+		t.Errorf("expected no status update, got %#v", kubeClient.statuses)
+	}
+}
+
+func TestDaemonSynchronizeWaitsForNodes(t *testing.T) {
+	kubeClient := &client.Fake{
+		DaemonSetsList: api.DaemonSetList{Items: []api.DaemonSet{newDaemonSet(nil)}},
+	}
+	manager := NewDaemonManager(kubeClient)
+	synced := 0
+	manager.syncHandler = func(ds api.DaemonSet) error {
+		synced++
+		return nil
+	}
+	manager.synchronize()
+	if synced != 0 {
+		t.Errorf("expected no syncs before nodes are known, got %d", synced)
+	}
+	node := newNode("node1", nil)
+	manager.nodeStore.Add(&node)
+	manager.synchronize()
+	if synced != 1 {
+		t.Errorf("expected 1 sync, got %d", synced)
+	}
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+const (
+	// DeploymentPodTemplateHashLabel is added to the selector and pod template of
+	// every replication controller created for a deployment, so that the pods of
+	// each template are managed separately.
+	DeploymentPodTemplateHashLabel = "pod-template-hash"
+
+	// DeploymentRevisionAnnotation records on a replication controller the revision
+	// of the deployment template it rolls out.
+	DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+// DeploymentManager is responsible for rolling out the pod template of every
+// Deployment through replication controllers, one per distinct template.
+type DeploymentManager struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncDeployment for testing.
+	syncHandler func(deployment api.Deployment) error
+}
+
+// NewDeploymentManager creates a new DeploymentManager.
+func NewDeploymentManager(kubeClient client.Interface) *DeploymentManager {
+	dm := &DeploymentManager{
+		kubeClient: kubeClient,
+	}
+	dm.syncHandler = dm.syncDeployment
+	return dm
+}
+
+// Run begins syncing deployments at the given period.
+func (dm *DeploymentManager) Run(period time.Duration) {
+	go util.Forever(func() { dm.synchronize() }, period)
+}
+
+func (dm *DeploymentManager) synchronize() {
+	list, err := dm.kubeClient.Deployments(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	deployments := list.Items
+	wg := sync.WaitGroup{}
+	wg.Add(len(deployments))
+	for ix := range deployments {
+		go func(ix int) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of %v/%v", deployments[ix].Namespace, deployments[ix].Name)
+			if err := dm.syncHandler(deployments[ix]); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing: %v", err))
+			}
+		}(ix)
+	}
+	wg.Wait()
+}
+
+// podTemplateHash returns a stable hash of the given pod template. The hash is
+// computed over the JSON form of the template so that it is unaffected by a round
+// trip through the API.
+func podTemplateHash(template *api.PodTemplateSpec) string {
+	data, err := json.Marshal(template)
+	if err != nil {
+		// Pod templates always serialize; anything else is a programming error.
+		panic(err)
+	}
+	hasher := fnv.New32a()
+	hasher.Write(data)
+	return fmt.Sprintf("%d", hasher.Sum32())
+}
+
+// controllerRevision returns the deployment revision recorded on the controller,
+// or zero if there is none.
+func controllerRevision(rc *api.ReplicationController) int64 {
+	revision, err := strconv.ParseInt(rc.Annotations[DeploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// byRevision sorts replication controllers from the oldest revision to the newest.
+type byRevision []api.ReplicationController
+
+func (r byRevision) Len() int           { return len(r) }
+func (r byRevision) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r byRevision) Less(i, j int) bool { return controllerRevision(&r[i]) < controllerRevision(&r[j]) }
+
+// isPodAvailable returns true if the pod is running and ready to serve requests.
+func isPodAvailable(pod *api.Pod) bool {
+	if pod.Status.Phase != api.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == api.PodReady && c.Status == api.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveIntOrPercent returns the absolute value of a count that is given either
+// as an integer or as a percentage of total.
+func resolveIntOrPercent(value util.IntOrString, total int, roundUp bool) int {
+	if value.Kind == util.IntstrInt {
+		return value.IntVal
+	}
+	percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+	if err != nil {
+		return 0
+	}
+	if roundUp {
+		return (percent*total + 99) / 100
+	}
+	return percent * total / 100
+}
+
+func (dm *DeploymentManager) syncDeployment(deployment api.Deployment) error {
+	if deployment.DeletionTimestamp != nil {
+		// the deployment is being deleted, its controllers are left to the garbage collector
+		return nil
+	}
+	// Every controller whose pods match the deployment selector belongs to the
+	// deployment, including controllers it did not create itself.
+	rcList, err := dm.kubeClient.ReplicationControllers(deployment.Namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	selector := labels.Set(deployment.Spec.Selector).AsSelector()
+	hash := podTemplateHash(deployment.Spec.Template)
+	var newRC *api.ReplicationController
+	var oldRCs []api.ReplicationController
+	var maxOldRevision int64
+	for i := range rcList.Items {
+		rc := rcList.Items[i]
+		if rc.Spec.Template == nil || !selector.Matches(labels.Set(rc.Spec.Template.Labels)) {
+			continue
+		}
+		if rc.Spec.Template.Labels[DeploymentPodTemplateHashLabel] == hash {
+			newRC = &rc
+			continue
+		}
+		oldRCs = append(oldRCs, rc)
+		if revision := controllerRevision(&rc); revision > maxOldRevision {
+			maxOldRevision = revision
+		}
+	}
+	sort.Sort(byRevision(oldRCs))
+
+	if deployment.Spec.RollbackTo != nil {
+		return dm.rollback(deployment, newRC, oldRCs)
+	}
+
+	podList, err := dm.kubeClient.Pods(deployment.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+	status := api.DeploymentStatus{}
+	for _, pod := range FilterActivePods(podList.Items) {
+		status.Replicas++
+		if pod.Labels[DeploymentPodTemplateHashLabel] == hash {
+			status.UpdatedReplicas++
+		}
+		if isPodAvailable(&pod) {
+			status.AvailableReplicas++
+		}
+	}
+	status.UnavailableReplicas = status.Replicas - status.AvailableReplicas
+
+	created := newRC == nil
+	if created {
+		newRC = newControllerForDeployment(&deployment, hash)
+	}
+	var newReplicas int
+	switch deployment.Spec.Strategy.Type {
+	case api.RecreateDeploymentStrategyType:
+		newReplicas = dm.scaleRecreate(&deployment, newRC, oldRCs, status)
+	default:
+		newReplicas = dm.scaleRollingUpdate(&deployment, newRC, oldRCs, status)
+	}
+
+	// A template that was rolled back to becomes the newest revision again.
+	revision := controllerRevision(newRC)
+	if revision <= maxOldRevision {
+		revision = maxOldRevision + 1
+	}
+	if created {
+		glog.V(2).Infof("Deployment \"%s\" has a new template, creating controller %s\n", deployment.Name, newRC.Name)
+		newRC.Spec.Replicas = newReplicas
+		newRC.Annotations[DeploymentRevisionAnnotation] = strconv.FormatInt(revision, 10)
+		if _, err := dm.kubeClient.ReplicationControllers(deployment.Namespace).Create(newRC); err != nil {
+			return err
+		}
+	} else if newRC.Spec.Replicas != newReplicas || controllerRevision(newRC) != revision {
+		newRC.Spec.Replicas = newReplicas
+		if newRC.Annotations == nil {
+			newRC.Annotations = map[string]string{}
+		}
+		newRC.Annotations[DeploymentRevisionAnnotation] = strconv.FormatInt(revision, 10)
+		if _, err := dm.kubeClient.ReplicationControllers(deployment.Namespace).Update(newRC); err != nil {
+			return err
+		}
+	}
+
+	dm.cleanupOldControllers(&deployment, oldRCs)
+
+	if deployment.Status != status {
+		deployment.Status = status
+		if _, err := dm.kubeClient.Deployments(deployment.Namespace).UpdateStatus(&deployment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newControllerForDeployment returns a controller that rolls out the current
+// template of the deployment. It starts out with no replicas.
+func newControllerForDeployment(deployment *api.Deployment, hash string) *api.ReplicationController {
+	template := api.Scheme.CopyOrDie(deployment).(*api.Deployment).Spec.Template
+	if template.Labels == nil {
+		template.Labels = map[string]string{}
+	}
+	template.Labels[DeploymentPodTemplateHashLabel] = hash
+	selector := map[string]string{}
+	for k, v := range deployment.Spec.Selector {
+		selector[k] = v
+	}
+	selector[DeploymentPodTemplateHashLabel] = hash
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{
+			Name:        fmt.Sprintf("%s-%s", deployment.Name, hash),
+			Namespace:   deployment.Namespace,
+			Labels:      template.Labels,
+			Annotations: map[string]string{},
+			OwnerReferences: []api.OwnerReference{
+				{Kind: "Deployment", Name: deployment.Name, UID: deployment.UID},
+			},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Selector: selector,
+			Template: template,
+		},
+	}
+}
+
+// scaleRecreate scales every old controller down to zero and returns the number
+// of replicas for the new controller, which is only scaled once no old pods are left.
+func (dm *DeploymentManager) scaleRecreate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController, status api.DeploymentStatus) int {
+	for i := range oldRCs {
+		dm.scaleController(&oldRCs[i], 0)
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return newRC.Spec.Replicas
+	}
+	return deployment.Spec.Replicas
+}
+
+// scaleRollingUpdate scales the old controllers down and returns the number of
+// replicas for the new controller, keeping the total number of replicas within
+// maxSurge of the desired count and the number of available pods within
+// maxUnavailable of it.
+func (dm *DeploymentManager) scaleRollingUpdate(deployment *api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController, status api.DeploymentStatus) int {
+	replicas := deployment.Spec.Replicas
+	maxSurge, maxUnavailable := 0, 1
+	if params := deployment.Spec.Strategy.RollingUpdate; params != nil {
+		maxSurge = resolveIntOrPercent(params.MaxSurge, replicas, true)
+		maxUnavailable = resolveIntOrPercent(params.MaxUnavailable, replicas, false)
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		// Percentages may round down to nothing, which would stall the update.
+		maxUnavailable = 1
+	}
+
+	oldReplicas := 0
+	for _, rc := range oldRCs {
+		oldReplicas += rc.Spec.Replicas
+	}
+	newReplicas := newRC.Spec.Replicas
+	if newReplicas > replicas {
+		newReplicas = replicas
+	} else if room := replicas + maxSurge - oldReplicas - newReplicas; room > 0 {
+		newReplicas += room
+		if newReplicas > replicas {
+			newReplicas = replicas
+		}
+	}
+
+	// Only remove old pods while enough pods stay available.
+	toRemove := status.AvailableReplicas - (replicas - maxUnavailable)
+	if toRemove > oldReplicas {
+		toRemove = oldReplicas
+	}
+	for i := range oldRCs {
+		if toRemove <= 0 {
+			break
+		}
+		rc := &oldRCs[i]
+		remove := rc.Spec.Replicas
+		if remove > toRemove {
+			remove = toRemove
+		}
+		dm.scaleController(rc, rc.Spec.Replicas-remove)
+		toRemove -= remove
+	}
+	return newReplicas
+}
+
+// scaleController sets the replica count of the given controller if it differs.
+func (dm *DeploymentManager) scaleController(rc *api.ReplicationController, replicas int) {
+	if rc.Spec.Replicas == replicas {
+		return
+	}
+	glog.V(2).Infof("Scaling controller %s from %d to %d replicas\n", rc.Name, rc.Spec.Replicas, replicas)
+	rc.Spec.Replicas = replicas
+	if _, err := dm.kubeClient.ReplicationControllers(rc.Namespace).Update(rc); err != nil {
+		util.HandleError(fmt.Errorf("unable to scale controller %s: %v", rc.Name, err))
+	}
+}
+
+// cleanupOldControllers deletes the oldest controllers that no longer run any pods
+// once there are more of them than the revision history limit allows.
+func (dm *DeploymentManager) cleanupOldControllers(deployment *api.Deployment, oldRCs []api.ReplicationController) {
+	limit := deployment.Spec.RevisionHistoryLimit
+	if limit == 0 {
+		return
+	}
+	var idle []api.ReplicationController
+	for _, rc := range oldRCs {
+		if rc.Spec.Replicas == 0 && rc.Status.Replicas == 0 {
+			idle = append(idle, rc)
+		}
+	}
+	for i := 0; i < len(idle)-limit; i++ {
+		glog.V(2).Infof("Deployment \"%s\" exceeds its revision history limit, deleting controller %s\n", deployment.Name, idle[i].Name)
+		if err := dm.kubeClient.ReplicationControllers(deployment.Namespace).Delete(idle[i].Name); err != nil {
+			util.HandleError(fmt.Errorf("unable to delete controller %s: %v", idle[i].Name, err))
+		}
+	}
+}
+
+// rollback replaces the template of the deployment with the template of the
+// requested revision and clears the rollback request. The rollout itself happens
+// on the following syncs.
+func (dm *DeploymentManager) rollback(deployment api.Deployment, newRC *api.ReplicationController, oldRCs []api.ReplicationController) error {
+	revision := deployment.Spec.RollbackTo.Revision
+	if revision == 0 && len(oldRCs) > 0 {
+		// The revision preceding the current one is the newest of the old controllers
+		// that is older than the current controller.
+		current := int64(-1)
+		if newRC != nil {
+			current = controllerRevision(newRC)
+		}
+		for i := len(oldRCs) - 1; i >= 0; i-- {
+			if r := controllerRevision(&oldRCs[i]); current < 0 || r < current {
+				revision = r
+				break
+			}
+		}
+	}
+	deployment.Spec.RollbackTo = nil
+	found := false
+	for i := range oldRCs {
+		if revision != 0 && controllerRevision(&oldRCs[i]) == revision {
+			template := api.Scheme.CopyOrDie(&oldRCs[i]).(*api.ReplicationController).Spec.Template
+			delete(template.Labels, DeploymentPodTemplateHashLabel)
+			deployment.Spec.Template = template
+			found = true
+			break
+		}
+	}
+	if found {
+		glog.V(2).Infof("Rolling deployment \"%s\" back to revision %d\n", deployment.Name, revision)
+	} else {
+		glog.Warningf("Deployment \"%s\" has no revision %d to roll back to\n", deployment.Name, revision)
+	}
+	_, err := dm.kubeClient.Deployments(deployment.Namespace).Update(&deployment)
+	return err
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// deploymentClient records the deployments written through Update and UpdateStatus.
+type deploymentClient struct {
+	*client.Fake
+	updates  []api.Deployment
+	statuses []api.DeploymentStatus
+}
+
+func (c *deploymentClient) Deployments(namespace string) client.DeploymentInterface {
+	return &deploymentRecorder{&client.FakeDeployments{Fake: c.Fake, Namespace: namespace}, c}
+}
+
+type deploymentRecorder struct {
+	*client.FakeDeployments
+	client *deploymentClient
+}
+
+func (r *deploymentRecorder) Update(deployment *api.Deployment) (*api.Deployment, error) {
+	r.client.updates = append(r.client.updates, *deployment)
+	return r.FakeDeployments.Update(deployment)
+}
+
+func (r *deploymentRecorder) UpdateStatus(deployment *api.Deployment) (*api.Deployment, error) {
+	r.client.statuses = append(r.client.statuses, deployment.Status)
+	return r.FakeDeployments.UpdateStatus(deployment)
+}
+
+func newDeployment(replicas int, image string, strategy api.DeploymentStrategy) api.Deployment {
+	selector := map[string]string{"app": "foobar"}
+	return api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foobar", Namespace: api.NamespaceDefault},
+		Spec: api.DeploymentSpec{
+			Replicas: replicas,
+			Selector: selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "foo", Image: image, ImagePullPolicy: api.PullIfNotPresent}},
+				},
+			},
+			Strategy: strategy,
+		},
+	}
+}
+
+func rollingUpdateStrategy(maxUnavailable, maxSurge int) api.DeploymentStrategy {
+	return api.DeploymentStrategy{
+		Type: api.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &api.RollingUpdateDeployment{
+			MaxUnavailable: util.NewIntOrStringFromInt(maxUnavailable),
+			MaxSurge:       util.NewIntOrStringFromInt(maxSurge),
+		},
+	}
+}
+
+var recreateStrategy = api.DeploymentStrategy{Type: api.RecreateDeploymentStrategyType}
+
+// newDeploymentController returns the controller the deployment manager would
+// create for the given deployment at the given revision.
+func newDeploymentController(deployment api.Deployment, replicas int, revision int64) api.ReplicationController {
+	rc := newControllerForDeployment(&deployment, podTemplateHash(deployment.Spec.Template))
+	rc.Spec.Replicas = replicas
+	rc.Status.Replicas = replicas
+	rc.Annotations[DeploymentRevisionAnnotation] = strconv.FormatInt(revision, 10)
+	return *rc
+}
+
+// newDeploymentPods returns count available pods created by the given controller.
+func newDeploymentPods(rc api.ReplicationController, count int) []api.Pod {
+	pods := []api.Pod{}
+	for i := 0; i < count; i++ {
+		pods = append(pods, api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				Name:   fmt.Sprintf("%s-%d", rc.Name, i),
+				Labels: rc.Spec.Template.Labels,
+			},
+			Status: api.PodStatus{
+				Phase:      api.PodRunning,
+				Conditions: []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}},
+			},
+		})
+	}
+	return pods
+}
+
+// controllerActions returns the replica count written by each create or update
+// of a replication controller, keyed by controller name, and the deleted controllers.
+func controllerActions(fake *client.Fake) (created, updated map[string]int, deleted []string) {
+	created, updated = map[string]int{}, map[string]int{}
+	for _, action := range fake.Actions {
+		switch action.Action {
+		case "create-controller":
+			rc := action.Value.(*api.ReplicationController)
+			created[rc.Name] = rc.Spec.Replicas
+		case "update-controller":
+			rc := action.Value.(*api.ReplicationController)
+			updated[rc.Name] = rc.Spec.Replicas
+		case "delete-controller":
+			deleted = append(deleted, action.Value.(string))
+		}
+	}
+	return
+}
+
+func TestSyncDeploymentCreatesController(t *testing.T) {
+	deployment := newDeployment(3, "foo/bar:v1", rollingUpdateStrategy(1, 1))
+	kubeClient := &deploymentClient{Fake: &client.Fake{}}
+	manager := NewDeploymentManager(kubeClient)
+	if err := manager.syncDeployment(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash := podTemplateHash(deployment.Spec.Template)
+	var rc *api.ReplicationController
+	for _, action := range kubeClient.Actions {
+		if action.Action == "create-controller" {
+			rc = action.Value.(*api.ReplicationController)
+		}
+	}
+	if rc == nil {
+		t.Fatalf("expected a controller to be created, got %#v", kubeClient.Actions)
+	}
+	if e, a := "foobar-"+hash, rc.Name; e != a {
+		t.Errorf("expected controller %s, got %s", e, a)
+	}
+	if rc.Spec.Replicas != 3 {
+		t.Errorf("expected 3 replicas, got %d", rc.Spec.Replicas)
+	}
+	if rc.Spec.Selector[DeploymentPodTemplateHashLabel] != hash || rc.Spec.Template.Labels[DeploymentPodTemplateHashLabel] != hash {
+		t.Errorf("expected the selector and template to carry the template hash: %#v", rc.Spec)
+	}
+	if rc.Annotations[DeploymentRevisionAnnotation] != "1" {
+		t.Errorf("expected revision 1, got %#v", rc.Annotations)
+	}
+	if _, ok := deployment.Spec.Template.Labels[DeploymentPodTemplateHashLabel]; ok {
+		t.Errorf("expected the deployment template to be left unchanged")
+	}
+}
+
+func TestSyncDeploymentScaling(t *testing.T) {
+	v1 := newDeployment(3, "foo/bar:v1", rollingUpdateStrategy(1, 1))
+	v1RC := newDeploymentController(v1, 3, 1)
+	v2 := newDeployment(3, "foo/bar:v2", rollingUpdateStrategy(1, 1))
+	v2Name := newDeploymentController(v2, 0, 0).Name
+
+	tests := map[string]struct {
+		deployment      api.Deployment
+		controllers     []api.ReplicationController
+		pods            []api.Pod
+		expectedCreated map[string]int
+		expectedUpdated map[string]int
+		expectedStatus  api.DeploymentStatus
+	}{
+		"rolling update surges and removes unavailable budget": {
+			deployment:      v2,
+			controllers:     []api.ReplicationController{v1RC},
+			pods:            newDeploymentPods(v1RC, 3),
+			expectedCreated: map[string]int{v2Name: 1},
+			expectedUpdated: map[string]int{v1RC.Name: 2},
+			expectedStatus:  api.DeploymentStatus{Replicas: 3, AvailableReplicas: 3},
+		},
+		"rolling update only removes old pods while enough stay available": {
+			deployment: v2,
+			controllers: []api.ReplicationController{
+				newDeploymentController(v1, 2, 1),
+				newDeploymentController(v2, 2, 2),
+			},
+			pods: append(newDeploymentPods(v1RC, 2), func() []api.Pod {
+				pods := newDeploymentPods(newDeploymentController(v2, 2, 2), 2)
+				pods[1].Status.Conditions = nil
+				return pods
+			}()...),
+			expectedUpdated: map[string]int{v1RC.Name: 1},
+			expectedStatus:  api.DeploymentStatus{Replicas: 4, UpdatedReplicas: 2, AvailableReplicas: 3, UnavailableReplicas: 1},
+		},
+		"rolling update waits for new pods to become available": {
+			deployment: v2,
+			controllers: []api.ReplicationController{
+				newDeploymentController(v1, 2, 1),
+				newDeploymentController(v2, 2, 2),
+			},
+			pods: append(newDeploymentPods(v1RC, 2), func() []api.Pod {
+				pods := newDeploymentPods(newDeploymentController(v2, 2, 2), 2)
+				pods[0].Status.Phase = api.PodPending
+				pods[1].Status.Conditions = nil
+				return pods
+			}()...),
+			expectedStatus: api.DeploymentStatus{Replicas: 4, UpdatedReplicas: 2, AvailableReplicas: 2, UnavailableReplicas: 2},
+		},
+		"rolling update finishes": {
+			deployment: v2,
+			controllers: []api.ReplicationController{
+				newDeploymentController(v1, 1, 1),
+				newDeploymentController(v2, 3, 2),
+			},
+			pods:            append(newDeploymentPods(v1RC, 1), newDeploymentPods(newDeploymentController(v2, 3, 2), 3)...),
+			expectedUpdated: map[string]int{v1RC.Name: 0},
+			expectedStatus:  api.DeploymentStatus{Replicas: 4, UpdatedReplicas: 3, AvailableReplicas: 4},
+		},
+		"recreate scales old controllers down first": {
+			deployment: func() api.Deployment {
+				d := v2
+				d.Spec.Strategy = recreateStrategy
+				return d
+			}(),
+			controllers:     []api.ReplicationController{v1RC},
+			pods:            newDeploymentPods(v1RC, 3),
+			expectedCreated: map[string]int{v2Name: 0},
+			expectedUpdated: map[string]int{v1RC.Name: 0},
+			expectedStatus:  api.DeploymentStatus{Replicas: 3, AvailableReplicas: 3},
+		},
+		"recreate scales up once old pods are gone": {
+			deployment: func() api.Deployment {
+				d := v2
+				d.Spec.Strategy = recreateStrategy
+				return d
+			}(),
+			controllers: []api.ReplicationController{
+				newDeploymentController(v1, 0, 1),
+				newDeploymentController(v2, 0, 2),
+			},
+			expectedUpdated: map[string]int{v2Name: 3},
+		},
+		"scaling down the deployment scales the new controller": {
+			deployment:      newDeployment(1, "foo/bar:v1", rollingUpdateStrategy(1, 1)),
+			controllers:     []api.ReplicationController{v1RC},
+			pods:            newDeploymentPods(v1RC, 3),
+			expectedUpdated: map[string]int{v1RC.Name: 1},
+			expectedStatus:  api.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+		},
+		"does nothing when in sync": {
+			deployment: func() api.Deployment {
+				d := v1
+				d.Status = api.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3}
+				return d
+			}(),
+			controllers:    []api.ReplicationController{v1RC},
+			pods:           newDeploymentPods(v1RC, 3),
+			expectedStatus: api.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+		},
+	}
+
+	for name, test := range tests {
+		kubeClient := &deploymentClient{Fake: &client.Fake{
+			CtrlList: api.ReplicationControllerList{Items: test.controllers},
+			PodsList: api.PodList{Items: test.pods},
+		}}
+		manager := NewDeploymentManager(kubeClient)
+		if err := manager.syncDeployment(test.deployment); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		created, updated, _ := controllerActions(kubeClient.Fake)
+		if test.expectedCreated == nil {
+			test.expectedCreated = map[string]int{}
+		}
+		if test.expectedUpdated == nil {
+			test.expectedUpdated = map[string]int{}
+		}
+		if !api.Semantic.DeepEqual(test.expectedCreated, created) {
+			t.Errorf("%s: expected created controllers %v, got %v", name, test.expectedCreated, created)
+		}
+		if !api.Semantic.DeepEqual(test.expectedUpdated, updated) {
+			t.Errorf("%s: expected updated controllers %v, got %v", name, test.expectedUpdated, updated)
+		}
+		if test.deployment.Status == test.expectedStatus {
+			if len(kubeClient.statuses) != 0 {
+				t.Errorf("%s: expected no status update, got %#v", name, kubeClient.statuses)
+			}
+		} else if len(kubeClient.statuses) != 1 || kubeClient.statuses[0] != test.expectedStatus {
+			t.Errorf("%s: expected status %#v, got %#v", name, test.expectedStatus, kubeClient.statuses)
+		}
+	}
+}
+
+func TestSyncDeploymentRevisionHistoryLimit(t *testing.T) {
+	v1 := newDeployment(1, "foo/bar:v1", rollingUpdateStrategy(1, 1))
+	v2 := newDeployment(1, "foo/bar:v2", rollingUpdateStrategy(1, 1))
+	v3 := newDeployment(1, "foo/bar:v3", rollingUpdateStrategy(1, 1))
+	v3.Spec.RevisionHistoryLimit = 1
+	v1RC := newDeploymentController(v1, 0, 1)
+	v2RC := newDeploymentController(v2, 0, 2)
+	v3RC := newDeploymentController(v3, 1, 3)
+	kubeClient := &deploymentClient{Fake: &client.Fake{
+		CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{v2RC, v3RC, v1RC}},
+		PodsList: api.PodList{Items: newDeploymentPods(v3RC, 1)},
+	}}
+	manager := NewDeploymentManager(kubeClient)
+	if err := manager.syncDeployment(v3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, _, deleted := controllerActions(kubeClient.Fake)
+	if len(deleted) != 1 || deleted[0] != v1RC.Name {
+		t.Errorf("expected only %s to be deleted, got %v", v1RC.Name, deleted)
+	}
+}
+
+func TestSyncDeploymentRollback(t *testing.T) {
+	v1 := newDeployment(3, "foo/bar:v1", rollingUpdateStrategy(1, 1))
+	v2 := newDeployment(3, "foo/bar:v2", rollingUpdateStrategy(1, 1))
+	v3 := newDeployment(3, "foo/bar:v3", rollingUpdateStrategy(1, 1))
+	controllers := []api.ReplicationController{
+		newDeploymentController(v1, 0, 1),
+		newDeploymentController(v2, 0, 2),
+		newDeploymentController(v3, 3, 3),
+	}
+	tests := map[string]struct {
+		revision      int64
+		expectedImage string
+	}{
+		"previous revision": {revision: 0, expectedImage: "foo/bar:v2"},
+		"explicit revision": {revision: 1, expectedImage: "foo/bar:v1"},
+		"unknown revision":  {revision: 7, expectedImage: "foo/bar:v3"},
+	}
+	for name, test := range tests {
+		deployment := v3
+		deployment.Spec.RollbackTo = &api.RollbackConfig{Revision: test.revision}
+		kubeClient := &deploymentClient{Fake: &client.Fake{
+			CtrlList: api.ReplicationControllerList{Items: controllers},
+		}}
+		manager := NewDeploymentManager(kubeClient)
+		if err := manager.syncDeployment(deployment); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(kubeClient.updates) != 1 {
+			t.Errorf("%s: expected 1 deployment update, got %d", name, len(kubeClient.updates))
+			continue
+		}
+		updated := kubeClient.updates[0]
+		if updated.Spec.RollbackTo != nil {
+			t.Errorf("%s: expected the rollback request to be cleared", name)
+		}
+		if e, a := test.expectedImage, updated.Spec.Template.Spec.Containers[0].Image; e != a {
+			t.Errorf("%s: expected image %s, got %s", name, e, a)
+		}
+		if _, ok := updated.Spec.Template.Labels[DeploymentPodTemplateHashLabel]; ok {
+			t.Errorf("%s: expected the template hash label to be dropped: %#v", name, updated.Spec.Template.Labels)
+		}
+		if test.revision == 1 && podTemplateHash(updated.Spec.Template) != podTemplateHash(v1.Spec.Template) {
+			t.Errorf("%s: expected the template of revision 1 to be restored unchanged", name)
+		}
+		created, _, _ := controllerActions(kubeClient.Fake)
+		if len(created) != 0 {
+			t.Errorf("%s: expected no controllers to be created during a rollback, got %v", name, created)
+		}
+	}
+}
+
+func TestSyncDeploymentRolledBackTemplateBecomesNewestRevision(t *testing.T) {
+	v1 := newDeployment(1, "foo/bar:v1", rollingUpdateStrategy(1, 1))
+	v2 := newDeployment(1, "foo/bar:v2", rollingUpdateStrategy(1, 1))
+	v1RC := newDeploymentController(v1, 0, 1)
+	kubeClient := &deploymentClient{Fake: &client.Fake{
+		CtrlList: api.ReplicationControllerList{Items: []api.ReplicationController{v1RC, newDeploymentController(v2, 1, 2)}},
+	}}
+	manager := NewDeploymentManager(kubeClient)
+	if err := manager.syncDeployment(v1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range kubeClient.Actions {
+		if action.Action != "update-controller" {
+			continue
+		}
+		if rc := action.Value.(*api.ReplicationController); rc.Name == v1RC.Name {
+			if rc.Annotations[DeploymentRevisionAnnotation] != "3" {
+				t.Errorf("expected revision 3, got %#v", rc.Annotations)
+			}
+			return
+		}
+	}
+	t.Errorf("expected %s to be updated, got %#v", v1RC.Name, kubeClient.Actions)
+}
+
+func TestDeploymentSynchronize(t *testing.T) {
+	kubeClient := &client.Fake{
+		DeploymentsList: api.DeploymentList{Items: []api.Deployment{newDeployment(1, "foo/bar", recreateStrategy)}},
+	}
+	manager := NewDeploymentManager(kubeClient)
+	synced := []string{}
+	manager.syncHandler = func(deployment api.Deployment) error {
+		synced = append(synced, deployment.Name)
+		return nil
+	}
+	manager.synchronize()
+	if len(synced) != 1 || synced[0] != "foobar" {
+		t.Errorf("expected foobar to be synced, got %v", synced)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// DisruptedPodTimeout is how long a pod whose eviction was granted by a budget is kept
+// out of the healthy pods of the budget, waiting for its deletion to show up.
+const DisruptedPodTimeout = 2 * time.Minute
+
+// DisruptionManager is responsible for keeping the status of every PodDisruptionBudget
+// in sync with the pods it selects, so that the evictions it allows can be decided
+// from the status alone.
+type DisruptionManager struct {
+	kubeClient client.Interface
+
+	// To allow injection of syncPodDisruptionBudget for testing.
+	syncHandler func(pdb api.PodDisruptionBudget) error
+}
+
+// NewDisruptionManager creates a new DisruptionManager.
+func NewDisruptionManager(kubeClient client.Interface) *DisruptionManager {
+	dm := &DisruptionManager{
+		kubeClient: kubeClient,
+	}
+	dm.syncHandler = dm.syncPodDisruptionBudget
+	return dm
+}
+
+// Run begins syncing pod disruption budgets at the given period.
+func (dm *DisruptionManager) Run(period time.Duration) {
+	go util.Forever(func() { dm.synchronize() }, period)
+}
+
+func (dm *DisruptionManager) synchronize() {
+	list, err := dm.kubeClient.PodDisruptionBudgets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	pdbs := list.Items
+	wg := sync.WaitGroup{}
+	wg.Add(len(pdbs))
+	for ix := range pdbs {
+		go func(ix int) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of %v/%v", pdbs[ix].Namespace, pdbs[ix].Name)
+			if err := dm.syncHandler(pdbs[ix]); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing: %v", err))
+			}
+		}(ix)
+	}
+	wg.Wait()
+}
+
+func (dm *DisruptionManager) syncPodDisruptionBudget(pdb api.PodDisruptionBudget) error {
+	pods := []api.Pod{}
+	if len(pdb.Spec.Selector) > 0 {
+		podList, err := dm.kubeClient.Pods(pdb.Namespace).List(labels.Set(pdb.Spec.Selector).AsSelector())
+		if err != nil {
+			return err
+		}
+		pods = podList.Items
+	}
+
+	now := util.Now()
+	status := api.PodDisruptionBudgetStatus{
+		DesiredHealthy: pdb.Spec.MinAvailable,
+	}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == api.PodSucceeded || pod.Status.Phase == api.PodFailed {
+			continue
+		}
+		status.ExpectedPods++
+		// A pod whose eviction was granted does not count as healthy until it is gone, so
+		// that the same disruption is not allowed twice.
+		if evicted, found := pdb.Status.DisruptedPods[pod.Name]; found && now.Sub(evicted.Time) < DisruptedPodTimeout {
+			if status.DisruptedPods == nil {
+				status.DisruptedPods = map[string]util.Time{}
+			}
+			status.DisruptedPods[pod.Name] = evicted
+			continue
+		}
+		if isPodAvailable(pod) {
+			status.CurrentHealthy++
+		}
+	}
+	if status.CurrentHealthy > pdb.Spec.MinAvailable {
+		status.PodDisruptionsAllowed = status.CurrentHealthy - pdb.Spec.MinAvailable
+	}
+
+	if !api.Semantic.DeepEqual(pdb.Status, status) {
+		pdb.Status = status
+		if _, err := dm.kubeClient.PodDisruptionBudgets(pdb.Namespace).UpdateStatus(&pdb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
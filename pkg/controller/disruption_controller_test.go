@@ -0,0 +1,166 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// pdbStatusClient records the disruption budget statuses written through UpdateStatus.
+type pdbStatusClient struct {
+	*client.Fake
+	statuses []api.PodDisruptionBudgetStatus
+}
+
+func (c *pdbStatusClient) PodDisruptionBudgets(namespace string) client.PodDisruptionBudgetInterface {
+	return &pdbStatusRecorder{&client.FakePodDisruptionBudgets{Fake: c.Fake, Namespace: namespace}, c}
+}
+
+type pdbStatusRecorder struct {
+	*client.FakePodDisruptionBudgets
+	client *pdbStatusClient
+}
+
+func (r *pdbStatusRecorder) UpdateStatus(pdb *api.PodDisruptionBudget) (*api.PodDisruptionBudget, error) {
+	r.client.statuses = append(r.client.statuses, pdb.Status)
+	return r.FakePodDisruptionBudgets.UpdateStatus(pdb)
+}
+
+func newPodDisruptionBudget(minAvailable int) api.PodDisruptionBudget {
+	return api.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{Name: "foobar", Namespace: api.NamespaceDefault},
+		Spec: api.PodDisruptionBudgetSpec{
+			MinAvailable: minAvailable,
+			Selector:     map[string]string{"app": "web"},
+		},
+	}
+}
+
+// newBudgetPods returns ready running pods, followed by pods which are running but not ready.
+func newBudgetPods(ready, notReady int) []api.Pod {
+	pods := []api.Pod{}
+	for i := 0; i < ready+notReady; i++ {
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: fmt.Sprintf("pod%d", i), Namespace: api.NamespaceDefault, Labels: map[string]string{"app": "web"}},
+			Status:     api.PodStatus{Phase: api.PodRunning},
+		}
+		if i < ready {
+			pod.Status.Conditions = []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}}
+		}
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+func TestSyncPodDisruptionBudget(t *testing.T) {
+	recently := util.NewTime(time.Now().Add(-time.Minute))
+	longAgo := util.NewTime(time.Now().Add(-time.Hour))
+	tests := map[string]struct {
+		pdb            api.PodDisruptionBudget
+		pods           []api.Pod
+		expectedStatus *api.PodDisruptionBudgetStatus
+	}{
+		"healthy pods above the minimum": {
+			pdb:            newPodDisruptionBudget(2),
+			pods:           newBudgetPods(3, 0),
+			expectedStatus: &api.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3},
+		},
+		"pods which are not ready are not healthy": {
+			pdb:            newPodDisruptionBudget(2),
+			pods:           newBudgetPods(1, 2),
+			expectedStatus: &api.PodDisruptionBudgetStatus{CurrentHealthy: 1, DesiredHealthy: 2, ExpectedPods: 3},
+		},
+		"pods being deleted are not expected": {
+			pdb: newPodDisruptionBudget(1),
+			pods: func() []api.Pod {
+				pods := newBudgetPods(2, 0)
+				now := util.Now()
+				pods[1].DeletionTimestamp = &now
+				return pods
+			}(),
+			expectedStatus: &api.PodDisruptionBudgetStatus{CurrentHealthy: 1, DesiredHealthy: 1, ExpectedPods: 1},
+		},
+		"recently disrupted pods are not healthy": {
+			pdb: func() api.PodDisruptionBudget {
+				pdb := newPodDisruptionBudget(1)
+				pdb.Status.DisruptedPods = map[string]util.Time{"pod0": recently, "pod1": longAgo, "gone": recently}
+				return pdb
+			}(),
+			pods: newBudgetPods(3, 0),
+			expectedStatus: &api.PodDisruptionBudgetStatus{
+				PodDisruptionsAllowed: 1,
+				CurrentHealthy:        2,
+				DesiredHealthy:        1,
+				ExpectedPods:          3,
+				DisruptedPods:         map[string]util.Time{"pod0": recently},
+			},
+		},
+		"unchanged status": {
+			pdb: func() api.PodDisruptionBudget {
+				pdb := newPodDisruptionBudget(2)
+				pdb.Status = api.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3}
+				return pdb
+			}(),
+			pods: newBudgetPods(3, 0),
+		},
+	}
+
+	for name, test := range tests {
+		kubeClient := &pdbStatusClient{Fake: &client.Fake{PodsList: api.PodList{Items: test.pods}}}
+		manager := NewDisruptionManager(kubeClient)
+
+		if err := manager.syncPodDisruptionBudget(test.pdb); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if test.expectedStatus == nil {
+			if len(kubeClient.statuses) != 0 {
+				t.Errorf("%s: expected no status update, got %#v", name, kubeClient.statuses)
+			}
+			continue
+		}
+		if len(kubeClient.statuses) != 1 {
+			t.Errorf("%s: expected 1 status update, got %#v", name, kubeClient.statuses)
+			continue
+		}
+		if !api.Semantic.DeepEqual(*test.expectedStatus, kubeClient.statuses[0]) {
+			t.Errorf("%s: expected status %#v, got %#v", name, *test.expectedStatus, kubeClient.statuses[0])
+		}
+	}
+}
+
+func TestDisruptionSynchronize(t *testing.T) {
+	kubeClient := &client.Fake{
+		PodDisruptionBudgetsList: api.PodDisruptionBudgetList{Items: []api.PodDisruptionBudget{newPodDisruptionBudget(1)}},
+	}
+	manager := NewDisruptionManager(kubeClient)
+	synced := []string{}
+	manager.syncHandler = func(pdb api.PodDisruptionBudget) error {
+		synced = append(synced, pdb.Name)
+		return nil
+	}
+	manager.synchronize()
+	if len(synced) != 1 || synced[0] != "foobar" {
+		t.Errorf("expected foobar to be synced, got %v", synced)
+	}
+}
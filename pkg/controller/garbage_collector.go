@@ -0,0 +1,320 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/golang/glog"
+)
+
+// monitoredResource is a kind of object the garbage collector watches, along with the
+// calls it makes on the objects of that kind.
+type monitoredResource struct {
+	kind         string
+	store        cache.Store
+	listWatch    *cache.ListWatch
+	expectedType runtime.Object
+
+	get    func(namespace, name string) (runtime.Object, error)
+	update func(obj runtime.Object) error
+	delete func(namespace, name string) error
+}
+
+// gcNode is an object of the dependency graph.
+type gcNode struct {
+	resource *monitoredResource
+	obj      runtime.Object
+	meta     *api.ObjectMeta
+}
+
+// GarbageCollector is responsible for deleting the objects all of whose owners are
+// gone, and for removing the owner references to the objects deleted with the
+// orphan finalizer.
+type GarbageCollector struct {
+	kubeClient client.Interface
+	// resources maps the monitored kinds to their resources.
+	resources map[string]*monitoredResource
+}
+
+// NewGarbageCollector creates a new GarbageCollector.
+func NewGarbageCollector(kubeClient client.Interface) *GarbageCollector {
+	gc := &GarbageCollector{
+		kubeClient: kubeClient,
+		resources:  map[string]*monitoredResource{},
+	}
+	for _, resource := range []*monitoredResource{
+		{
+			kind: "Pod",
+			listWatch: &cache.ListWatch{
+				ListFunc: func() (runtime.Object, error) {
+					return kubeClient.Pods(api.NamespaceAll).List(labels.Everything())
+				},
+				WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+					return kubeClient.Pods(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+				},
+			},
+			expectedType: &api.Pod{},
+			get: func(namespace, name string) (runtime.Object, error) {
+				return kubeClient.Pods(namespace).Get(name)
+			},
+			update: func(obj runtime.Object) error {
+				pod := obj.(*api.Pod)
+				_, err := kubeClient.Pods(pod.Namespace).Update(pod)
+				return err
+			},
+			delete: func(namespace, name string) error {
+				return kubeClient.Pods(namespace).Delete(name, nil)
+			},
+		},
+		{
+			kind: "ReplicationController",
+			listWatch: &cache.ListWatch{
+				ListFunc: func() (runtime.Object, error) {
+					return kubeClient.ReplicationControllers(api.NamespaceAll).List(labels.Everything())
+				},
+				WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+					return kubeClient.ReplicationControllers(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+				},
+			},
+			expectedType: &api.ReplicationController{},
+			get: func(namespace, name string) (runtime.Object, error) {
+				return kubeClient.ReplicationControllers(namespace).Get(name)
+			},
+			update: func(obj runtime.Object) error {
+				controller := obj.(*api.ReplicationController)
+				_, err := kubeClient.ReplicationControllers(controller.Namespace).Update(controller)
+				return err
+			},
+			delete: func(namespace, name string) error {
+				return kubeClient.ReplicationControllers(namespace).Delete(name)
+			},
+		},
+		{
+			kind: "Job",
+			listWatch: &cache.ListWatch{
+				ListFunc: func() (runtime.Object, error) {
+					return kubeClient.Jobs(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+				},
+				WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+					return kubeClient.Jobs(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+				},
+			},
+			expectedType: &api.Job{},
+			get: func(namespace, name string) (runtime.Object, error) {
+				return kubeClient.Jobs(namespace).Get(name)
+			},
+			update: func(obj runtime.Object) error {
+				job := obj.(*api.Job)
+				_, err := kubeClient.Jobs(job.Namespace).Update(job)
+				return err
+			},
+			delete: func(namespace, name string) error {
+				return kubeClient.Jobs(namespace).Delete(name)
+			},
+		},
+		{
+			kind: "DaemonSet",
+			listWatch: &cache.ListWatch{
+				ListFunc: func() (runtime.Object, error) {
+					return kubeClient.DaemonSets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+				},
+				WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+					return kubeClient.DaemonSets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+				},
+			},
+			expectedType: &api.DaemonSet{},
+			get: func(namespace, name string) (runtime.Object, error) {
+				return kubeClient.DaemonSets(namespace).Get(name)
+			},
+			update: func(obj runtime.Object) error {
+				ds := obj.(*api.DaemonSet)
+				_, err := kubeClient.DaemonSets(ds.Namespace).Update(ds)
+				return err
+			},
+			delete: func(namespace, name string) error {
+				return kubeClient.DaemonSets(namespace).Delete(name)
+			},
+		},
+		{
+			kind: "Deployment",
+			listWatch: &cache.ListWatch{
+				ListFunc: func() (runtime.Object, error) {
+					return kubeClient.Deployments(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+				},
+				WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+					return kubeClient.Deployments(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+				},
+			},
+			expectedType: &api.Deployment{},
+			get: func(namespace, name string) (runtime.Object, error) {
+				return kubeClient.Deployments(namespace).Get(name)
+			},
+			update: func(obj runtime.Object) error {
+				deployment := obj.(*api.Deployment)
+				_, err := kubeClient.Deployments(deployment.Namespace).Update(deployment)
+				return err
+			},
+			delete: func(namespace, name string) error {
+				return kubeClient.Deployments(namespace).Delete(name)
+			},
+		},
+	} {
+		resource.store = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		gc.resources[resource.kind] = resource
+	}
+	return gc
+}
+
+// Run begins watching the monitored resources, and collects garbage at the given period.
+func (gc *GarbageCollector) Run(period time.Duration) {
+	for _, resource := range gc.resources {
+		cache.NewReflector(resource.listWatch, resource.expectedType, resource.store, 0).Run()
+	}
+	go util.Forever(func() { gc.synchronize() }, period)
+}
+
+// synchronize builds the dependency graph of the objects seen through the watches, then
+// orphans the dependents of the objects being deleted with the orphan finalizer, and
+// deletes the objects none of whose owners exist anymore.
+func (gc *GarbageCollector) synchronize() {
+	nodes := map[types.UID]*gcNode{}
+	dependents := map[types.UID][]*gcNode{}
+	for _, resource := range gc.resources {
+		for _, obj := range resource.store.List() {
+			meta, err := api.ObjectMetaFor(obj.(runtime.Object))
+			if err != nil {
+				util.HandleError(fmt.Errorf("unable to get the metadata of a %s: %v", resource.kind, err))
+				continue
+			}
+			node := &gcNode{resource: resource, obj: obj.(runtime.Object), meta: meta}
+			nodes[meta.UID] = node
+			for _, owner := range meta.OwnerReferences {
+				dependents[owner.UID] = append(dependents[owner.UID], node)
+			}
+		}
+	}
+
+	for uid, node := range nodes {
+		if node.meta.DeletionTimestamp != nil {
+			if hasFinalizer(node.meta, api.FinalizerOrphan) {
+				gc.orphanDependents(node, dependents[uid])
+			}
+			continue
+		}
+		if len(node.meta.OwnerReferences) == 0 || !gc.isOrphaned(node, nodes) {
+			continue
+		}
+		glog.V(2).Infof("Deleting %s %s/%s, its owners are gone", node.resource.kind, node.meta.Namespace, node.meta.Name)
+		if err := node.resource.delete(node.meta.Namespace, node.meta.Name); err != nil && !errors.IsNotFound(err) {
+			util.HandleError(fmt.Errorf("unable to delete %s %s/%s: %v", node.resource.kind, node.meta.Namespace, node.meta.Name, err))
+		}
+	}
+}
+
+// isOrphaned returns true if none of the owners of the object exist. Owners missing from
+// the graph are looked up, since the watches may not have caught up with them yet. An
+// object owned by a kind which is not monitored is never orphaned.
+func (gc *GarbageCollector) isOrphaned(node *gcNode, nodes map[types.UID]*gcNode) bool {
+	for _, owner := range node.meta.OwnerReferences {
+		if _, found := nodes[owner.UID]; found {
+			return false
+		}
+		resource, found := gc.resources[owner.Kind]
+		if !found {
+			return false
+		}
+		obj, err := resource.get(node.meta.Namespace, owner.Name)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			util.HandleError(fmt.Errorf("unable to get %s %s/%s: %v", owner.Kind, node.meta.Namespace, owner.Name, err))
+			return false
+		}
+		// an object of the same name may have replaced the owner
+		meta, err := api.ObjectMetaFor(obj)
+		if err != nil || meta.UID == owner.UID {
+			return false
+		}
+	}
+	return true
+}
+
+// orphanDependents removes the references to an object being deleted from its dependents,
+// then removes the orphan finalizer of the object so that the deletion completes. Failures
+// are retried on the next pass.
+func (gc *GarbageCollector) orphanDependents(owner *gcNode, dependents []*gcNode) {
+	failed := false
+	for _, dependent := range dependents {
+		obj, meta := copyNode(dependent)
+		references := []api.OwnerReference{}
+		for _, reference := range meta.OwnerReferences {
+			if reference.UID != owner.meta.UID {
+				references = append(references, reference)
+			}
+		}
+		meta.OwnerReferences = references
+		if err := dependent.resource.update(obj); err != nil {
+			util.HandleError(fmt.Errorf("unable to orphan %s %s/%s: %v", dependent.resource.kind, meta.Namespace, meta.Name, err))
+			failed = true
+		}
+	}
+	if failed {
+		return
+	}
+
+	obj, meta := copyNode(owner)
+	finalizers := []string{}
+	for _, finalizer := range meta.Finalizers {
+		if finalizer != api.FinalizerOrphan {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	meta.Finalizers = finalizers
+	if err := owner.resource.update(obj); err != nil {
+		util.HandleError(fmt.Errorf("unable to remove the orphan finalizer of %s %s/%s: %v", owner.resource.kind, meta.Namespace, meta.Name, err))
+	}
+}
+
+// copyNode returns a copy of the object of a node, which can be changed without altering
+// the store, along with its metadata.
+func copyNode(node *gcNode) (runtime.Object, *api.ObjectMeta) {
+	obj := api.Scheme.CopyOrDie(node.obj)
+	meta, _ := api.ObjectMetaFor(obj)
+	return obj, meta
+}
+
+func hasFinalizer(meta *api.ObjectMeta, finalizer string) bool {
+	for _, f := range meta.Finalizers {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
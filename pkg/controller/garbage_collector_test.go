@@ -0,0 +1,125 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func ownedPod(name string, owners ...api.OwnerReference) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:            name,
+			Namespace:       api.NamespaceDefault,
+			UID:             types.UID(name),
+			OwnerReferences: owners,
+		},
+	}
+}
+
+func gcController(name string, uid types.UID) *api.ReplicationController {
+	return &api.ReplicationController{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault, UID: uid},
+	}
+}
+
+func TestGarbageCollectorSynchronize(t *testing.T) {
+	deleted := util.Now()
+	beingOrphaned := gcController("orphaning", "orphaning-uid")
+	beingOrphaned.DeletionTimestamp = &deleted
+	beingOrphaned.Finalizers = []string{api.FinalizerOrphan}
+
+	tests := []struct {
+		controllers []*api.ReplicationController
+		// live is the controller returned by the API server, for owners missing from the store.
+		live     api.ReplicationController
+		pod      *api.Pod
+		expected []string
+		test     string
+	}{
+		{
+			controllers: []*api.ReplicationController{gcController("rc", "rc-uid")},
+			pod:         ownedPod("pod", api.OwnerReference{Kind: "ReplicationController", Name: "rc", UID: "rc-uid"}),
+			expected:    []string{},
+			test:        "owner in the graph",
+		},
+		{
+			live:     *gcController("rc", "rc-uid"),
+			pod:      ownedPod("pod", api.OwnerReference{Kind: "ReplicationController", Name: "rc", UID: "rc-uid"}),
+			expected: []string{"get-controller"},
+			test:     "owner not watched yet",
+		},
+		{
+			live:     *gcController("rc", "new-uid"),
+			pod:      ownedPod("pod", api.OwnerReference{Kind: "ReplicationController", Name: "rc", UID: "rc-uid"}),
+			expected: []string{"get-controller", "delete-pod"},
+			test:     "owner replaced by another object of the same name",
+		},
+		{
+			pod:      ownedPod("pod", api.OwnerReference{Kind: "Widget", Name: "w", UID: "w-uid"}),
+			expected: []string{},
+			test:     "owner of a kind which is not monitored",
+		},
+		{
+			pod:      ownedPod("pod"),
+			expected: []string{},
+			test:     "object without owner",
+		},
+		{
+			controllers: []*api.ReplicationController{beingOrphaned},
+			pod:         ownedPod("pod", api.OwnerReference{Kind: "ReplicationController", Name: "orphaning", UID: "orphaning-uid"}),
+			expected:    []string{"update-pod", "update-controller"},
+			test:        "owner deleted with the orphan finalizer",
+		},
+	}
+
+	for _, test := range tests {
+		kubeClient := &client.Fake{Ctrl: test.live}
+		gc := NewGarbageCollector(kubeClient)
+		for _, controller := range test.controllers {
+			gc.resources["ReplicationController"].store.Add(controller)
+		}
+		gc.resources["Pod"].store.Add(test.pod)
+		gc.synchronize()
+
+		actions := []string{}
+		for _, action := range kubeClient.Actions {
+			actions = append(actions, action.Action)
+		}
+		if !reflect.DeepEqual(test.expected, actions) {
+			t.Errorf("%s: expected actions %v, got %v", test.test, test.expected, actions)
+		}
+
+		for _, action := range kubeClient.Actions {
+			if action.Action != "update-controller" {
+				continue
+			}
+			if rc := action.Value.(*api.ReplicationController); len(rc.Finalizers) != 0 {
+				t.Errorf("%s: expected the orphan finalizer to be removed, got %v", test.test, rc.Finalizers)
+			}
+		}
+	}
+	if len(beingOrphaned.Finalizers) != 1 {
+		t.Errorf("expected the objects of the store to be left unchanged, got finalizers %v", beingOrphaned.Finalizers)
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// JobManager is responsible for synchronizing Job objects stored in the system
+// with the pods that execute them.
+type JobManager struct {
+	kubeClient client.Interface
+	podControl PodControlInterface
+
+	// To allow injection of syncJob for testing.
+	syncHandler func(job api.Job) error
+}
+
+// NewJobManager creates a new JobManager.
+func NewJobManager(kubeClient client.Interface) *JobManager {
+	jm := &JobManager{
+		kubeClient: kubeClient,
+		podControl: RealPodControl{
+			kubeClient: kubeClient,
+		},
+	}
+	jm.syncHandler = jm.syncJob
+	return jm
+}
+
+// Run begins syncing jobs at the given period.
+func (jm *JobManager) Run(period time.Duration) {
+	go util.Forever(func() { jm.synchronize() }, period)
+}
+
+func (jm *JobManager) synchronize() {
+	list, err := jm.kubeClient.Jobs(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("synchronization error: %v", err))
+		return
+	}
+	jobs := list.Items
+	wg := sync.WaitGroup{}
+	wg.Add(len(jobs))
+	for ix := range jobs {
+		go func(ix int) {
+			defer wg.Done()
+			glog.V(4).Infof("periodic sync of %v/%v", jobs[ix].Namespace, jobs[ix].Name)
+			if err := jm.syncHandler(jobs[ix]); err != nil {
+				util.HandleError(fmt.Errorf("error synchronizing: %v", err))
+			}
+		}(ix)
+	}
+	wg.Wait()
+}
+
+// countFinishedPods returns the number of pods which have succeeded and the number of
+// failures. A container restart counts as a failure, since with RestartPolicyOnFailure
+// the kubelet restarts a failed container in place and the pod rarely reaches PodFailed.
+func countFinishedPods(pods []api.Pod) (succeeded, failed int) {
+	for _, pod := range pods {
+		switch pod.Status.Phase {
+		case api.PodSucceeded:
+			succeeded++
+		case api.PodFailed:
+			failed++
+		}
+		for _, status := range pod.Status.Info {
+			failed += status.RestartCount
+		}
+	}
+	return
+}
+
+func (jm *JobManager) syncJob(job api.Job) error {
+	if job.DeletionTimestamp != nil {
+		// the job is being deleted, its pods are left to the garbage collector
+		return nil
+	}
+	if job.Status.Phase == api.JobComplete || job.Status.Phase == api.JobFailed {
+		// nothing left to do for a finished job
+		return nil
+	}
+	s := labels.Set(job.Spec.Selector).AsSelector()
+	podList, err := jm.kubeClient.Pods(job.Namespace).List(s)
+	if err != nil {
+		return err
+	}
+	activePods := FilterActivePods(podList.Items)
+	succeeded, failed := countFinishedPods(podList.Items)
+
+	status := api.JobStatus{
+		Phase:     api.JobRunning,
+		Active:    len(activePods),
+		Succeeded: succeeded,
+		Failed:    failed,
+	}
+	switch {
+	case succeeded >= job.Spec.Completions:
+		status.Phase = api.JobComplete
+	case failed > job.Spec.RetryLimit:
+		status.Phase = api.JobFailed
+	}
+
+	if status.Phase != api.JobRunning {
+		// The job is finished, so any pods still running are no longer needed.
+		glog.V(2).Infof("Job \"%s\" is %s, deleting %d active pods\n", job.Name, status.Phase, len(activePods))
+		jm.deletePods(job.Namespace, activePods, len(activePods))
+		status.Active = 0
+	} else {
+		wantActive := job.Spec.Completions - succeeded
+		if wantActive > job.Spec.Parallelism {
+			wantActive = job.Spec.Parallelism
+		}
+		diff := wantActive - len(activePods)
+		if diff > 0 {
+			glog.V(2).Infof("Too few \"%s\" job pods, creating %d\n", job.Name, diff)
+			wait := sync.WaitGroup{}
+			wait.Add(diff)
+			for i := 0; i < diff; i++ {
+				go func() {
+					defer wait.Done()
+					jm.podControl.createJobPod(job.Namespace, job)
+				}()
+			}
+			wait.Wait()
+		} else if diff < 0 {
+			glog.V(2).Infof("Too many \"%s\" job pods, deleting %d\n", job.Name, -diff)
+			jm.deletePods(job.Namespace, activePods, -diff)
+		}
+	}
+
+	if !api.Semantic.DeepEqual(job.Status, status) {
+		job.Status = status
+		if _, err := jm.kubeClient.Jobs(job.Namespace).UpdateStatus(&job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletePods deletes the first count pods of the given list.
+func (jm *JobManager) deletePods(namespace string, pods []api.Pod, count int) {
+	wait := sync.WaitGroup{}
+	wait.Add(count)
+	for i := 0; i < count; i++ {
+		go func(ix int) {
+			defer wait.Done()
+			if err := jm.podControl.deletePod(namespace, pods[ix].Name); err != nil {
+				util.HandleError(fmt.Errorf("unable to delete pod %s: %v", pods[ix].Name, err))
+			}
+		}(i)
+	}
+	wait.Wait()
+}
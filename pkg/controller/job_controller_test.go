@@ -0,0 +1,225 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// jobStatusClient records the job statuses written through UpdateStatus.
+type jobStatusClient struct {
+	*client.Fake
+	statuses []api.JobStatus
+}
+
+func (c *jobStatusClient) Jobs(namespace string) client.JobInterface {
+	return &jobStatusRecorder{&client.FakeJobs{Fake: c.Fake, Namespace: namespace}, c}
+}
+
+type jobStatusRecorder struct {
+	*client.FakeJobs
+	client *jobStatusClient
+}
+
+func (r *jobStatusRecorder) UpdateStatus(job *api.Job) (*api.Job, error) {
+	r.client.statuses = append(r.client.statuses, job.Status)
+	return r.FakeJobs.UpdateStatus(job)
+}
+
+func newJob(completions, parallelism, retryLimit int) api.Job {
+	selector := map[string]string{"job": "foobar"}
+	return api.Job{
+		ObjectMeta: api.ObjectMeta{Name: "foobar", Namespace: api.NamespaceDefault},
+		Spec: api.JobSpec{
+			Completions: completions,
+			Parallelism: parallelism,
+			RetryLimit:  retryLimit,
+			Selector:    selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{Labels: selector},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyNever,
+					Containers:    []api.Container{{Image: "foo/bar"}},
+				},
+			},
+		},
+	}
+}
+
+func newJobPodList(active, succeeded, failed int) api.PodList {
+	pods := []api.Pod{}
+	add := func(count int, phase api.PodPhase) {
+		for i := 0; i < count; i++ {
+			pods = append(pods, api.Pod{
+				ObjectMeta: api.ObjectMeta{
+					Name:   fmt.Sprintf("pod-%s-%d", phase, i),
+					Labels: map[string]string{"job": "foobar"},
+				},
+				Status: api.PodStatus{Phase: phase},
+			})
+		}
+	}
+	add(active, api.PodRunning)
+	add(succeeded, api.PodSucceeded)
+	add(failed, api.PodFailed)
+	return api.PodList{Items: pods}
+}
+
+func TestSyncJob(t *testing.T) {
+	tests := map[string]struct {
+		job                       api.Job
+		active, succeeded, failed int
+		// restarts is the container restart count of the first active pod
+		restarts int
+		// deleting is the number of active pods being deleted
+		deleting        int
+		expectedCreates int
+		expectedDeletes int
+		expectedStatus  *api.JobStatus
+	}{
+		"starts pods up to parallelism": {
+			job:             newJob(5, 2, 0),
+			expectedCreates: 2,
+			expectedStatus:  &api.JobStatus{Phase: api.JobRunning},
+		},
+		"only starts pods for remaining completions": {
+			job:             newJob(5, 3, 0),
+			succeeded:       4,
+			expectedCreates: 1,
+			expectedStatus:  &api.JobStatus{Phase: api.JobRunning, Succeeded: 4},
+		},
+		"replaces failed pods within retry limit": {
+			job:             newJob(2, 2, 1),
+			active:          1,
+			failed:          1,
+			expectedCreates: 1,
+			expectedStatus:  &api.JobStatus{Phase: api.JobRunning, Active: 1, Failed: 1},
+		},
+		"deletes pods above parallelism": {
+			job:             newJob(5, 1, 0),
+			active:          3,
+			expectedDeletes: 2,
+			expectedStatus:  &api.JobStatus{Phase: api.JobRunning, Active: 3},
+		},
+		"completes when enough pods succeed": {
+			job:            newJob(2, 2, 0),
+			succeeded:      2,
+			expectedStatus: &api.JobStatus{Phase: api.JobComplete, Succeeded: 2},
+		},
+		"fails when retry limit is exceeded": {
+			job:             newJob(3, 2, 1),
+			active:          1,
+			failed:          2,
+			expectedDeletes: 1,
+			expectedStatus:  &api.JobStatus{Phase: api.JobFailed, Failed: 2},
+		},
+		"counts container restarts as failures": {
+			job: func() api.Job {
+				job := newJob(3, 2, 1)
+				job.Spec.Template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+				return job
+			}(),
+			active:          2,
+			restarts:        2,
+			expectedDeletes: 2,
+			expectedStatus:  &api.JobStatus{Phase: api.JobFailed, Failed: 2},
+		},
+		"replaces pods being deleted": {
+			job:             newJob(3, 2, 0),
+			active:          2,
+			deleting:        1,
+			expectedCreates: 1,
+			expectedStatus:  &api.JobStatus{Phase: api.JobRunning, Active: 1},
+		},
+		"does nothing when in sync": {
+			job: func() api.Job {
+				job := newJob(3, 2, 0)
+				job.Status = api.JobStatus{Phase: api.JobRunning, Active: 2}
+				return job
+			}(),
+			active: 2,
+		},
+		"ignores finished jobs": {
+			job: func() api.Job {
+				job := newJob(1, 1, 0)
+				job.Status = api.JobStatus{Phase: api.JobComplete, Succeeded: 1}
+				return job
+			}(),
+			active: 1,
+		},
+	}
+
+	for name, test := range tests {
+		podList := newJobPodList(test.active, test.succeeded, test.failed)
+		if test.restarts > 0 {
+			podList.Items[0].Status.Info = api.PodInfo{"foo": {RestartCount: test.restarts}}
+		}
+		for i := 0; i < test.deleting; i++ {
+			now := util.Now()
+			podList.Items[i].DeletionTimestamp = &now
+		}
+		kubeClient := &jobStatusClient{Fake: &client.Fake{PodsList: podList}}
+		fakePodControl := FakePodControl{}
+		manager := NewJobManager(kubeClient)
+		manager.podControl = &fakePodControl
+
+		if err := manager.syncJob(test.job); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(fakePodControl.jobSpec) != test.expectedCreates {
+			t.Errorf("%s: expected %d creates, saw %d", name, test.expectedCreates, len(fakePodControl.jobSpec))
+		}
+		if len(fakePodControl.deletePodName) != test.expectedDeletes {
+			t.Errorf("%s: expected %d deletes, saw %d", name, test.expectedDeletes, len(fakePodControl.deletePodName))
+		}
+		if test.expectedStatus == nil {
+			if len(kubeClient.statuses) != 0 {
+				t.Errorf("%s: expected no status update, got %#v", name, kubeClient.statuses)
+			}
+			continue
+		}
+		if len(kubeClient.statuses) != 1 {
+			t.Errorf("%s: expected 1 status update, got %#v", name, kubeClient.statuses)
+			continue
+		}
+		if !api.Semantic.DeepEqual(*test.expectedStatus, kubeClient.statuses[0]) {
+			t.Errorf("%s: expected status %#v, got %#v", name, *test.expectedStatus, kubeClient.statuses[0])
+		}
+	}
+}
+
+func TestJobSynchronize(t *testing.T) {
+	kubeClient := &client.Fake{
+		JobsList: api.JobList{Items: []api.Job{newJob(1, 1, 0)}},
+	}
+	manager := NewJobManager(kubeClient)
+	synced := []string{}
+	manager.syncHandler = func(job api.Job) error {
+		synced = append(synced, job.Name)
+		return nil
+	}
+	manager.synchronize()
+	if len(synced) != 1 || synced[0] != "foobar" {
+		t.Errorf("expected foobar to be synced, got %v", synced)
+	}
+}
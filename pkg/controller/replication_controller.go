@@ -48,6 +48,10 @@ type ReplicationManager struct {
 type PodControlInterface interface {
 	// createReplica creates new replicated pods according to the spec.
 	createReplica(namespace string, controller api.ReplicationController)
+	// createJobPod creates a new pod for the job according to its template.
+	createJobPod(namespace string, job api.Job)
+	// createDaemonPod creates a new pod for the daemon set bound to the given host.
+	createDaemonPod(namespace string, ds api.DaemonSet, host string)
 	// deletePod deletes the pod identified by podID.
 	deletePod(namespace string, podID string) error
 }
@@ -61,43 +65,68 @@ type RealPodControl struct {
 const DefaultSyncPeriod = 5 * time.Second
 
 func (r RealPodControl) createReplica(namespace string, controller api.ReplicationController) {
+	owner := api.OwnerReference{Kind: "ReplicationController", Name: controller.Name, UID: controller.UID}
+	if err := r.createPodFromTemplate(namespace, owner, controller.Spec.Template, ""); err != nil {
+		util.HandleError(fmt.Errorf("unable to create pod replica: %v", err))
+	}
+}
+
+func (r RealPodControl) createJobPod(namespace string, job api.Job) {
+	owner := api.OwnerReference{Kind: "Job", Name: job.Name, UID: job.UID}
+	if err := r.createPodFromTemplate(namespace, owner, job.Spec.Template, ""); err != nil {
+		util.HandleError(fmt.Errorf("unable to create pod for job: %v", err))
+	}
+}
+
+func (r RealPodControl) createDaemonPod(namespace string, ds api.DaemonSet, host string) {
+	owner := api.OwnerReference{Kind: "DaemonSet", Name: ds.Name, UID: ds.UID}
+	if err := r.createPodFromTemplate(namespace, owner, ds.Spec.Template, host); err != nil {
+		util.HandleError(fmt.Errorf("unable to create daemon pod on %s: %v", host, err))
+	}
+}
+
+// createPodFromTemplate creates a pod in the given namespace from template, owned by the
+// given object and with a name generated from its name. If host is set the pod is bound
+// to it directly instead of going through the scheduler.
+func (r RealPodControl) createPodFromTemplate(namespace string, owner api.OwnerReference, template *api.PodTemplateSpec, host string) error {
 	desiredLabels := make(labels.Set)
-	for k, v := range controller.Spec.Template.Labels {
+	for k, v := range template.Labels {
 		desiredLabels[k] = v
 	}
 	desiredAnnotations := make(labels.Set)
-	for k, v := range controller.Spec.Template.Annotations {
+	for k, v := range template.Annotations {
 		desiredAnnotations[k] = v
 	}
 
 	// use the dash (if the name isn't too long) to make the pod name a bit prettier
-	prefix := fmt.Sprintf("%s-", controller.Name)
+	prefix := fmt.Sprintf("%s-", owner.Name)
 	if ok, _ := validation.ValidatePodName(prefix, true); !ok {
-		prefix = controller.Name
+		prefix = owner.Name
 	}
 
 	pod := &api.Pod{
 		ObjectMeta: api.ObjectMeta{
-			Labels:       desiredLabels,
-			Annotations:  desiredAnnotations,
-			GenerateName: prefix,
+			Labels:          desiredLabels,
+			Annotations:     desiredAnnotations,
+			GenerateName:    prefix,
+			OwnerReferences: []api.OwnerReference{owner},
 		},
 	}
-	if err := api.Scheme.Convert(&controller.Spec.Template.Spec, &pod.Spec); err != nil {
-		util.HandleError(fmt.Errorf("unable to convert pod template: %v", err))
-		return
+	if err := api.Scheme.Convert(&template.Spec, &pod.Spec); err != nil {
+		return fmt.Errorf("unable to convert pod template: %v", err)
 	}
-	if labels.Set(pod.Labels).AsSelector().Empty() {
-		util.HandleError(fmt.Errorf("unable to create pod replica, no labels"))
-		return
+	if len(host) != 0 {
+		pod.Spec.Host = host
 	}
-	if _, err := r.kubeClient.Pods(namespace).Create(pod); err != nil {
-		util.HandleError(fmt.Errorf("unable to create pod replica: %v", err))
+	if labels.Set(pod.Labels).AsSelector().Empty() {
+		return fmt.Errorf("unable to create pod, no labels")
 	}
+	_, err := r.kubeClient.Pods(namespace).Create(pod)
+	return err
 }
 
 func (r RealPodControl) deletePod(namespace, podID string) error {
-	return r.kubeClient.Pods(namespace).Delete(podID)
+	return r.kubeClient.Pods(namespace).Delete(podID, nil)
 }
 
 // NewReplicationManager creates a new ReplicationManager.
@@ -178,12 +207,14 @@ func (rm *ReplicationManager) watchControllers(resourceVersion *string) {
 	}
 }
 
-// Helper function. Also used in pkg/registry/controller, for now.
+// FilterActivePods returns the pods which have neither terminated nor are being
+// deleted. Also used in pkg/registry/controller, for now.
 func FilterActivePods(pods []api.Pod) []api.Pod {
 	var result []api.Pod
 	for _, value := range pods {
 		if api.PodSucceeded != value.Status.Phase &&
-			api.PodFailed != value.Status.Phase {
+			api.PodFailed != value.Status.Phase &&
+			value.DeletionTimestamp == nil {
 			result = append(result, value)
 		}
 	}
@@ -191,6 +222,10 @@ func FilterActivePods(pods []api.Pod) []api.Pod {
 }
 
 func (rm *ReplicationManager) syncReplicationController(controller api.ReplicationController) error {
+	if controller.DeletionTimestamp != nil {
+		// the controller is being deleted, its pods are left to the garbage collector
+		return nil
+	}
 	s := labels.Set(controller.Spec.Selector).AsSelector()
 	podList, err := rm.kubeClient.Pods(controller.Namespace).List(s)
 	if err != nil {
@@ -35,6 +35,8 @@ import (
 
 type FakePodControl struct {
 	controllerSpec []api.ReplicationController
+	jobSpec        []api.Job
+	daemonHosts    []string
 	deletePodName  []string
 	lock           sync.Mutex
 }
@@ -45,6 +47,18 @@ func (f *FakePodControl) createReplica(namespace string, spec api.ReplicationCon
 	f.controllerSpec = append(f.controllerSpec, spec)
 }
 
+func (f *FakePodControl) createJobPod(namespace string, job api.Job) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.jobSpec = append(f.jobSpec, job)
+}
+
+func (f *FakePodControl) createDaemonPod(namespace string, ds api.DaemonSet, host string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.daemonHosts = append(f.daemonHosts, host)
+}
+
 func (f *FakePodControl) deletePod(namespace string, podName string) error {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -190,6 +204,31 @@ func TestSyncReplicationControllerDeletes(t *testing.T) {
 	validateSyncReplication(t, &fakePodControl, 0, 1)
 }
 
+func TestSyncReplicationControllerReplacesDeletingPods(t *testing.T) {
+	podList := newPodList(2)
+	now := util.Now()
+	podList.Items[0].DeletionTimestamp = &now
+	body, _ := latest.Codec.Encode(podList)
+	fakeHandler := util.FakeHandler{
+		StatusCode:   200,
+		ResponseBody: string(body),
+	}
+	testServer := httptest.NewServer(&fakeHandler)
+	defer testServer.Close()
+	client := client.NewOrDie(&client.Config{Host: testServer.URL, Version: testapi.Version()})
+
+	fakePodControl := FakePodControl{}
+
+	manager := NewReplicationManager(client)
+	manager.podControl = &fakePodControl
+
+	controllerSpec := newReplicationController(2)
+
+	// The pod being deleted no longer counts as a replica.
+	manager.syncReplicationController(controllerSpec)
+	validateSyncReplication(t, &fakePodControl, 1, 0)
+}
+
 func TestSyncReplicationControllerCreates(t *testing.T) {
 	controller := newReplicationController(2)
 	testServer, fakeUpdateHandler := makeTestServer(t, api.NamespaceDefault, controller.Name,
@@ -238,8 +277,9 @@ func TestCreateReplica(t *testing.T) {
 
 	expectedPod := api.Pod{
 		ObjectMeta: api.ObjectMeta{
-			Labels:       controllerSpec.Spec.Template.Labels,
-			GenerateName: fmt.Sprintf("%s-", controllerSpec.Name),
+			Labels:          controllerSpec.Spec.Template.Labels,
+			GenerateName:    fmt.Sprintf("%s-", controllerSpec.Name),
+			OwnerReferences: []api.OwnerReference{{Kind: "ReplicationController", Name: controllerSpec.Name, UID: controllerSpec.UID}},
 		},
 		Spec: controllerSpec.Spec.Template.Spec,
 	}
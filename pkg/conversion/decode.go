@@ -17,12 +17,12 @@ limitations under the License.
 package conversion
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 )
 
-// Decode converts a JSON string back into a pointer to an api object.
+// Decode converts a JSON string, or the protobuf encoding of an object, back into a
+// pointer to an api object.
 // Deduces the type based upon the fields added by the MetaInsertionFactory
 // technique. The object will be converted, if necessary, into the
 // s.InternalVersion type before being returned. Decode will not decode
@@ -43,7 +43,7 @@ func (s *Scheme) Decode(data []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	if err := json.Unmarshal(data, obj); err != nil {
+	if err := unmarshal(data, obj); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +98,7 @@ func (s *Scheme) DecodeInto(data []byte, obj interface{}) error {
 	if err != nil {
 		return err
 	}
-	if err := json.Unmarshal(data, external); err != nil {
+	if err := unmarshal(data, external); err != nil {
 		return err
 	}
 	if err := s.converter.Convert(external, obj, 0, s.generateConvertMeta(dataVersion, objVersion)); err != nil {
@@ -25,8 +25,9 @@ limitations under the License.
 //
 // The second offering of this package is automated encoding/decoding. The version
 // and type of the object is recorded in the output, so it can be recreated upon
-// reading. Currently, conversion writes JSON output, and interprets both JSON
-// and YAML input.
+// reading. Currently, conversion writes JSON output, or protobuf output for the
+// types which implement ProtobufMarshaler, and interprets JSON, YAML and protobuf
+// input.
 //
 // In the future, we plan to more explicitly separate the above two mechanisms, and
 // add more serialization options, such as gob.
@@ -50,6 +50,21 @@ import (
 // config files.
 //
 func (s *Scheme) EncodeToVersion(obj interface{}, destVersion string) (data []byte, err error) {
+	return s.encodeToVersion(obj, destVersion, func(obj interface{}, version, kind string) ([]byte, error) {
+		return json.Marshal(obj)
+	})
+}
+
+// EncodeToVersionProtobuf is like EncodeToVersion, but returns the protobuf encoding of
+// the object, which is smaller and faster to encode and decode than JSON. The versioned
+// types must implement ProtobufMarshaler and ProtobufUnmarshaler, which ProtobufGenerator
+// writes from the protobuf tags of their fields. Decode and DecodeInto read both encodings.
+func (s *Scheme) EncodeToVersionProtobuf(obj interface{}, destVersion string) (data []byte, err error) {
+	return s.encodeToVersion(obj, destVersion, encodeProtobuf)
+}
+
+// encodeToVersion converts obj to destVersion, and encodes it with encode.
+func (s *Scheme) encodeToVersion(obj interface{}, destVersion string, encode func(obj interface{}, version, kind string) ([]byte, error)) (data []byte, err error) {
 	obj = maybeCopy(obj)
 	v, _ := EnforcePtr(obj) // maybeCopy guarantees a pointer
 	if _, registered := s.typeToVersion[v.Type()]; !registered {
@@ -86,8 +101,8 @@ func (s *Scheme) EncodeToVersion(obj interface{}, destVersion string) (data []by
 		return nil, err
 	}
 
-	// To add metadata, do some simple surgery on the JSON.
-	data, err = json.Marshal(obj)
+	// Encode the object, whose version and kind are now set.
+	data, err = encode(obj, destVersion, objKind)
 	if err != nil {
 		return nil, err
 	}
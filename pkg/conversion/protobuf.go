@@ -0,0 +1,138 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf"
+)
+
+// The protobuf encoding of a struct is a protobuf message holding each of its exported
+// fields under the field number of its protobuf tag:
+//
+//	Name string `json:"name,omitempty" protobuf:"1"`
+//
+// Strings, byte slices and nested structs are length delimited fields, numbers and
+// booleans are varints, or fixed length fields for floats. Lists are repeated fields,
+// and maps with string keys are repeated messages holding the key in field 1 and the
+// value in field 2. Zero values are omitted, and nil pointers are omitted, while the
+// zero values other pointers point to are not. Unknown fields are skipped when decoding.
+//
+// The versioned types encode themselves, through the ProtobufMarshaler and
+// ProtobufUnmarshaler methods ProtobufGenerator writes for them.
+
+// ProtobufMarshaler is implemented by the types which can be encoded in protobuf.
+type ProtobufMarshaler interface {
+	// AppendProtobuf appends the fields of the protobuf encoding of the object to buf.
+	AppendProtobuf(buf []byte) []byte
+}
+
+// ProtobufUnmarshaler is implemented by the types which can be decoded from protobuf.
+type ProtobufUnmarshaler interface {
+	// UnmarshalProtobuf decodes the fields of the protobuf encoding of an object, which
+	// are set in addition to the fields already set.
+	UnmarshalProtobuf(data []byte) error
+}
+
+// protobufPrefix starts the protobuf encoding of an object, to tell it apart from JSON
+// which cannot start with it.
+var protobufPrefix = []byte("k8s\x00")
+
+// protobufEnvelope holds the protobuf encoding of an object, along with its version and
+// kind, which are needed to find the type to decode it into. It is encoded as the fields
+// APIVersion (1), Kind (2) and Raw (3).
+type protobufEnvelope struct {
+	APIVersion string
+	Kind       string
+	Raw        []byte
+}
+
+// IsProtobuf returns true if data is the protobuf encoding of an object.
+func IsProtobuf(data []byte) bool {
+	if len(data) < len(protobufPrefix) {
+		return false
+	}
+	for i := range protobufPrefix {
+		if data[i] != protobufPrefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeProtobuf returns the protobuf encoding of obj, of the given version and kind.
+func encodeProtobuf(obj interface{}, version, kind string) ([]byte, error) {
+	marshaler, ok := obj.(ProtobufMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("unable to encode %T in protobuf: it does not implement ProtobufMarshaler", obj)
+	}
+	buf := append(make([]byte, 0, 512), protobufPrefix...)
+	buf = protobuf.AppendString(buf, 1, version)
+	buf = protobuf.AppendString(buf, 2, kind)
+	start := len(buf)
+	buf = protobuf.StartMessage(buf, 3)
+	buf = marshaler.AppendProtobuf(buf)
+	return protobuf.EndMessage(buf, start, false), nil
+}
+
+// readEnvelope reads the envelope of the protobuf encoding of an object. The Raw field of
+// the envelope is not copied from data.
+func readEnvelope(data []byte) (*protobufEnvelope, error) {
+	if !IsProtobuf(data) {
+		return nil, errors.New("not protobuf data")
+	}
+	envelope := &protobufEnvelope{}
+	data = data[len(protobufPrefix):]
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return nil, err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeString(&envelope.APIVersion)
+		case 2:
+			err = value.DecodeString(&envelope.Kind)
+		case 3:
+			envelope.Raw, err = value.Message()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode field %d of the protobuf envelope: %v", number, err)
+		}
+	}
+	return envelope, nil
+}
+
+// unmarshal decodes data, in JSON or protobuf, into obj.
+func unmarshal(data []byte, obj interface{}) error {
+	if !IsProtobuf(data) {
+		return json.Unmarshal(data, obj)
+	}
+	unmarshaler, ok := obj.(ProtobufUnmarshaler)
+	if !ok {
+		return fmt.Errorf("unable to decode %T from protobuf: it does not implement ProtobufUnmarshaler", obj)
+	}
+	envelope, err := readEnvelope(data)
+	if err != nil {
+		return err
+	}
+	return unmarshaler.UnmarshalProtobuf(envelope.Raw)
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protobuf implements the wire format of the protobuf encoding of the API
+// objects. It is used by the marshalers cmd/genprotobuf generates for the versioned
+// types, and by the few types which encode themselves by hand.
+//
+// Fields are appended to a buffer by the Append functions; a nested message is
+// started with StartMessage and its length is filled in by EndMessage once its
+// fields are appended, so that messages are encoded without copying. Fields are
+// read back one at a time by ReadField, and their values decoded by the methods of
+// Value, which check the wire type.
+package protobuf
@@ -0,0 +1,328 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// the wire types of the protobuf encoding
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("truncated protobuf data")
+
+// AppendVarint appends x to buf as a varint.
+func AppendVarint(buf []byte, x uint64) []byte {
+	for x >= 0x80 {
+		buf = append(buf, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(buf, byte(x))
+}
+
+func appendKey(buf []byte, number uint64, wireType uint64) []byte {
+	return AppendVarint(buf, number<<3|wireType)
+}
+
+// AppendBool appends the field of the given number holding x.
+func AppendBool(buf []byte, number uint64, x bool) []byte {
+	if x {
+		return append(appendKey(buf, number, wireVarint), 1)
+	}
+	return append(appendKey(buf, number, wireVarint), 0)
+}
+
+// AppendInt appends the field of the given number holding x.
+func AppendInt(buf []byte, number uint64, x int64) []byte {
+	return AppendVarint(appendKey(buf, number, wireVarint), uint64(x))
+}
+
+// AppendUint appends the field of the given number holding x.
+func AppendUint(buf []byte, number uint64, x uint64) []byte {
+	return AppendVarint(appendKey(buf, number, wireVarint), x)
+}
+
+// AppendFloat32 appends the field of the given number holding x.
+func AppendFloat32(buf []byte, number uint64, x float32) []byte {
+	bits := math.Float32bits(x)
+	return append(appendKey(buf, number, wireFixed32), byte(bits), byte(bits>>8), byte(bits>>16), byte(bits>>24))
+}
+
+// AppendFloat64 appends the field of the given number holding x.
+func AppendFloat64(buf []byte, number uint64, x float64) []byte {
+	bits := math.Float64bits(x)
+	buf = appendKey(buf, number, wireFixed64)
+	for i := uint(0); i < 64; i += 8 {
+		buf = append(buf, byte(bits>>i))
+	}
+	return buf
+}
+
+// AppendString appends the field of the given number holding s.
+func AppendString(buf []byte, number uint64, s string) []byte {
+	return append(AppendVarint(appendKey(buf, number, wireBytes), uint64(len(s))), s...)
+}
+
+// AppendBytes appends the field of the given number holding data.
+func AppendBytes(buf []byte, number uint64, data []byte) []byte {
+	return append(AppendVarint(appendKey(buf, number, wireBytes), uint64(len(data))), data...)
+}
+
+// StartMessage appends the start of the message field of the given number, whose fields
+// are to be appended next. The field must be ended by EndMessage, given the length of
+// buf before StartMessage.
+func StartMessage(buf []byte, number uint64) []byte {
+	// most messages are shorter than 128 bytes, whose length fits in a byte
+	return append(appendKey(buf, number, wireBytes), 0)
+}
+
+// EndMessage ends the message field started at start, by filling in its length. An empty
+// message is removed when omitEmpty is set.
+func EndMessage(buf []byte, start int, omitEmpty bool) []byte {
+	_, n := binary.Uvarint(buf[start:])
+	lengthAt := start + n
+	length := len(buf) - lengthAt - 1
+	switch {
+	case length == 0 && omitEmpty:
+		return buf[:start]
+	case length < 0x80:
+		buf[lengthAt] = byte(length)
+		return buf
+	}
+	// make room for the longer length
+	var encoded [binary.MaxVarintLen64]byte
+	size := binary.PutUvarint(encoded[:], uint64(length))
+	end := len(buf)
+	buf = append(buf, encoded[1:size]...)
+	copy(buf[lengthAt+size:], buf[lengthAt+1:end])
+	copy(buf[lengthAt:], encoded[:size])
+	return buf
+}
+
+// Value is the value of a field read from protobuf data. The zero Value is the value of
+// an absent field, which decodes to nothing.
+type Value struct {
+	present  bool
+	wireType uint64
+	// x holds the numbers, and bytes the length delimited values.
+	x     uint64
+	bytes []byte
+}
+
+// ReadField reads the field at the start of data, and returns its number and value along
+// with the data following it.
+func ReadField(data []byte) (number uint64, value Value, rest []byte, err error) {
+	key, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, Value{}, nil, errTruncated
+	}
+	data = data[n:]
+	value.present, value.wireType = true, key&7
+	switch value.wireType {
+	case wireVarint:
+		if value.x, n = binary.Uvarint(data); n <= 0 {
+			return 0, Value{}, nil, errTruncated
+		}
+		data = data[n:]
+	case wireFixed64:
+		if len(data) < 8 {
+			return 0, Value{}, nil, errTruncated
+		}
+		value.x, data = binary.LittleEndian.Uint64(data), data[8:]
+	case wireFixed32:
+		if len(data) < 4 {
+			return 0, Value{}, nil, errTruncated
+		}
+		value.x, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+	case wireBytes:
+		length, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < length {
+			return 0, Value{}, nil, errTruncated
+		}
+		value.bytes, data = data[n:n+int(length)], data[n+int(length):]
+	default:
+		return 0, Value{}, nil, fmt.Errorf("unsupported protobuf wire type %d", value.wireType)
+	}
+	return key >> 3, value, data, nil
+}
+
+// expect returns true if the value is present and of the given wire type, and an error
+// if it is of another wire type.
+func (v Value) expect(wireType uint64) (bool, error) {
+	if !v.present {
+		return false, nil
+	}
+	if v.wireType != wireType {
+		return false, fmt.Errorf("unexpected protobuf wire type %d, expected %d", v.wireType, wireType)
+	}
+	return true, nil
+}
+
+// DecodeBool decodes the value into x.
+func (v Value) DecodeBool(x *bool) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = v.x != 0
+	return nil
+}
+
+// DecodeInt decodes the value into x.
+func (v Value) DecodeInt(x *int) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = int(v.x)
+	return nil
+}
+
+// DecodeInt32 decodes the value into x.
+func (v Value) DecodeInt32(x *int32) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = int32(v.x)
+	return nil
+}
+
+// DecodeInt64 decodes the value into x.
+func (v Value) DecodeInt64(x *int64) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = int64(v.x)
+	return nil
+}
+
+// DecodeUint decodes the value into x.
+func (v Value) DecodeUint(x *uint) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = uint(v.x)
+	return nil
+}
+
+// DecodeUint32 decodes the value into x.
+func (v Value) DecodeUint32(x *uint32) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = uint32(v.x)
+	return nil
+}
+
+// DecodeUint64 decodes the value into x.
+func (v Value) DecodeUint64(x *uint64) error {
+	if ok, err := v.expect(wireVarint); !ok {
+		return err
+	}
+	*x = v.x
+	return nil
+}
+
+// DecodeFloat32 decodes the value into x.
+func (v Value) DecodeFloat32(x *float32) error {
+	if ok, err := v.expect(wireFixed32); !ok {
+		return err
+	}
+	*x = math.Float32frombits(uint32(v.x))
+	return nil
+}
+
+// DecodeFloat64 decodes the value into x.
+func (v Value) DecodeFloat64(x *float64) error {
+	if ok, err := v.expect(wireFixed64); !ok {
+		return err
+	}
+	*x = math.Float64frombits(v.x)
+	return nil
+}
+
+// DecodeString decodes the value into s.
+func (v Value) DecodeString(s *string) error {
+	if ok, err := v.expect(wireBytes); !ok {
+		return err
+	}
+	*s = string(v.bytes)
+	return nil
+}
+
+// DecodeBytes decodes a copy of the value into data.
+func (v Value) DecodeBytes(data *[]byte) error {
+	if ok, err := v.expect(wireBytes); !ok {
+		return err
+	}
+	*data = append([]byte{}, v.bytes...)
+	return nil
+}
+
+// Message returns the encoding of the message the value holds, or nil if the value is
+// absent. It is not a copy.
+func (v Value) Message() ([]byte, error) {
+	if ok, err := v.expect(wireBytes); !ok {
+		return nil, err
+	}
+	return v.bytes, nil
+}
+
+// Unmarshaler is implemented by the types whose protobuf encoding is a message.
+type Unmarshaler interface {
+	UnmarshalProtobuf(data []byte) error
+}
+
+// DecodeMessage decodes the message the value holds into m.
+func (v Value) DecodeMessage(m Unmarshaler) error {
+	if ok, err := v.expect(wireBytes); !ok {
+		return err
+	}
+	return m.UnmarshalProtobuf(v.bytes)
+}
+
+// Entry returns the key and the value of the map entry the value holds, which are the
+// fields 1 and 2 of a message.
+func (v Value) Entry() (key string, item Value, err error) {
+	data, err := v.Message()
+	if err != nil {
+		return "", Value{}, err
+	}
+	for len(data) > 0 {
+		number, value, rest, err := ReadField(data)
+		if err != nil {
+			return "", Value{}, err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeString(&key)
+		case 2:
+			item = value
+		}
+		if err != nil {
+			return "", Value{}, err
+		}
+	}
+	return key, item, nil
+}
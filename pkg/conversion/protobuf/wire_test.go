@@ -0,0 +1,164 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAppendRead(t *testing.T) {
+	long := strings.Repeat("x", 300)
+	buf := AppendBool(nil, 1, true)
+	buf = AppendInt(buf, 2, -5)
+	buf = AppendUint(buf, 3, 1<<40)
+	buf = AppendFloat32(buf, 4, 1.5)
+	buf = AppendFloat64(buf, 5, -2.25)
+	buf = AppendString(buf, 6, long)
+	buf = AppendBytes(buf, 7, []byte{1, 2})
+
+	var (
+		b   bool
+		i   int
+		u   uint64
+		f32 float32
+		f64 float64
+		s   string
+		d   []byte
+	)
+	for len(buf) > 0 {
+		number, value, rest, err := ReadField(buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		buf = rest
+		switch number {
+		case 1:
+			err = value.DecodeBool(&b)
+		case 2:
+			err = value.DecodeInt(&i)
+		case 3:
+			err = value.DecodeUint64(&u)
+		case 4:
+			err = value.DecodeFloat32(&f32)
+		case 5:
+			err = value.DecodeFloat64(&f64)
+		case 6:
+			err = value.DecodeString(&s)
+		case 7:
+			err = value.DecodeBytes(&d)
+		}
+		if err != nil {
+			t.Errorf("field %d: unexpected error: %v", number, err)
+		}
+	}
+	if !b || i != -5 || u != 1<<40 || f32 != 1.5 || f64 != -2.25 || s != long || !bytes.Equal(d, []byte{1, 2}) {
+		t.Errorf("unexpected values: %v %v %v %v %v %q %v", b, i, u, f32, f64, s, d)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	for _, length := range []int{0, 1, 127, 128, 300, 20000} {
+		content := strings.Repeat("x", length)
+		buf := AppendString(nil, 1, "before")
+		start := len(buf)
+		buf = StartMessage(buf, 2)
+		inner := len(buf)
+		buf = StartMessage(buf, 1)
+		buf = AppendString(buf, 1, content)
+		buf = EndMessage(buf, inner, false)
+		buf = EndMessage(buf, start, false)
+
+		expected := AppendString(nil, 1, "before")
+		expected = AppendBytes(expected, 2, AppendBytes(nil, 1, AppendString(nil, 1, content)))
+		if !bytes.Equal(expected, buf) {
+			t.Errorf("%d: expected %v, got %v", length, expected, buf)
+		}
+	}
+
+	buf := AppendString(nil, 1, "before")
+	start := len(buf)
+	if buf = EndMessage(StartMessage(buf, 2), start, true); !bytes.Equal(AppendString(nil, 1, "before"), buf) {
+		t.Errorf("expected the empty message to be omitted, got %v", buf)
+	}
+	if buf = EndMessage(StartMessage(buf, 2), start, false); !bytes.Equal(AppendBytes(AppendString(nil, 1, "before"), 2, nil), buf) {
+		t.Errorf("expected the empty message to be kept, got %v", buf)
+	}
+}
+
+func TestReadFieldErrors(t *testing.T) {
+	data := AppendString(nil, 1, "foo")
+	for i := 0; i < len(data); i++ {
+		if _, _, _, err := ReadField(data[:i]); err == nil {
+			t.Errorf("expected an error for %v", data[:i])
+		}
+	}
+	_, value, _, err := ReadField(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var i int
+	if err := value.DecodeInt(&i); err == nil {
+		t.Errorf("expected an error for an unexpected wire type")
+	}
+	if _, _, _, err := ReadField([]byte{0x0b}); err == nil {
+		t.Errorf("expected an error for an unsupported wire type")
+	}
+}
+
+type testMessage struct {
+	name string
+}
+
+func (m *testMessage) UnmarshalProtobuf(data []byte) error {
+	_, value, _, err := ReadField(data)
+	if err != nil {
+		return err
+	}
+	return value.DecodeString(&m.name)
+}
+
+func TestEntry(t *testing.T) {
+	item := AppendString(nil, 1, "bar")
+	entry := AppendBytes(AppendString(nil, 1, "foo"), 2, item)
+	_, value, _, err := ReadField(AppendBytes(nil, 1, entry))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key, itemValue, err := value.Entry()
+	if err != nil || key != "foo" {
+		t.Fatalf("unexpected entry: %q %v", key, err)
+	}
+	m := &testMessage{}
+	if err := itemValue.DecodeMessage(m); err != nil || m.name != "bar" {
+		t.Errorf("unexpected item: %#v %v", m, err)
+	}
+
+	// the item of an entry without one is absent, and decodes to nothing
+	_, value, _, err = ReadField(AppendBytes(nil, 1, AppendString(nil, 1, "foo")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, itemValue, err = value.Entry(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := "unchanged"
+	if err := itemValue.DecodeString(&s); err != nil || s != "unchanged" {
+		t.Errorf("expected an absent value to decode to nothing, got %q %v", s, err)
+	}
+}
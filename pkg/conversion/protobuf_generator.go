@@ -0,0 +1,480 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ProtobufGenerator writes the ProtobufMarshaler and ProtobufUnmarshaler methods of the
+// struct types of a package, which encode them as described in protobuf.go without
+// reflection. The types of other packages which appear in their fields must be encoded
+// by helper functions of the package, registered by AddHelper.
+type ProtobufGenerator struct {
+	pkgPath string
+	types   map[reflect.Type][]protobufGeneratedField
+	helpers map[reflect.Type]protobufHelper
+	// imports are the paths of the imported packages, by name.
+	imports map[string]string
+	// usesSort is set when maps are encoded, which sort their keys.
+	usesSort bool
+}
+
+type protobufGeneratedField struct {
+	name   string
+	number uint64
+	t      reflect.Type
+}
+
+type protobufHelper struct {
+	appendFunc    string
+	unmarshalFunc string
+}
+
+// NewProtobufGenerator creates a ProtobufGenerator for the types of the package of the
+// given import path.
+func NewProtobufGenerator(pkgPath string) *ProtobufGenerator {
+	return &ProtobufGenerator{
+		pkgPath: pkgPath,
+		types:   map[reflect.Type][]protobufGeneratedField{},
+		helpers: map[reflect.Type]protobufHelper{},
+		imports: map[string]string{"protobuf": "github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf"},
+	}
+}
+
+// AddHelper registers the functions of the package which encode the values of t, of
+// another package:
+//
+//	func appendFunc(buf []byte, number uint64, in *T, always bool) []byte
+//	func unmarshalFunc(value protobuf.Value, out *T) error
+//
+// appendFunc may omit the zero value unless always is set.
+func (g *ProtobufGenerator) AddHelper(t reflect.Type, appendFunc, unmarshalFunc string) {
+	g.helpers[t] = protobufHelper{appendFunc: appendFunc, unmarshalFunc: unmarshalFunc}
+}
+
+// Add adds t, a struct type of the package or a pointer to one, along with the struct
+// types of the package its fields hold.
+func (g *ProtobufGenerator) Add(t reflect.Type) error {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.PkgPath() != g.pkgPath {
+		return fmt.Errorf("%v is not a struct type of %s", t, g.pkgPath)
+	}
+	return g.addStruct(t)
+}
+
+func (g *ProtobufGenerator) addStruct(t reflect.Type) error {
+	if _, found := g.types[t]; found {
+		return nil
+	}
+	fields := []protobufGeneratedField{}
+	numbers := map[uint64]string{}
+	g.types[t] = fields
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if len(field.PkgPath) != 0 || field.Tag.Get("json") == "-" {
+			continue
+		}
+		tag := field.Tag.Get("protobuf")
+		if len(tag) == 0 {
+			return fmt.Errorf("field %s of %v has no protobuf tag", field.Name, t)
+		}
+		number, err := strconv.ParseUint(tag, 10, 29)
+		if err != nil || number == 0 {
+			return fmt.Errorf("field %s of %v has an invalid protobuf tag %q", field.Name, t, tag)
+		}
+		if other, found := numbers[number]; found {
+			return fmt.Errorf("fields %s and %s of %v have the same protobuf tag %d", other, field.Name, t, number)
+		}
+		numbers[number] = field.Name
+		if err := g.addType(field.Type, false); err != nil {
+			return fmt.Errorf("unable to encode field %s of %v: %v", field.Name, t, err)
+		}
+		fields = append(fields, protobufGeneratedField{name: field.Name, number: number, t: field.Type})
+	}
+	g.types[t] = fields
+	return nil
+}
+
+// addType checks that the values of t can be encoded, and adds the struct types of the
+// package they hold. repeated is set for the items of lists and maps, which cannot be
+// lists or maps themselves.
+func (g *ProtobufGenerator) addType(t reflect.Type, repeated bool) error {
+	if _, found := g.helpers[t]; found {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		// pointers are allocated by name
+		if err := g.addType(t.Elem(), repeated); err != nil {
+			return err
+		}
+		return g.addImport(t.Elem())
+	case reflect.Bool, reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		if repeated {
+			return fmt.Errorf("lists of lists and maps are not supported")
+		}
+		if err := g.addType(t.Elem(), true); err != nil {
+			return err
+		}
+		// structs are appended to lists by name
+		if t.Elem().Kind() == reflect.Struct {
+			return g.addImport(t.Elem())
+		}
+		return nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return fmt.Errorf("only maps with string keys are supported")
+		}
+		if repeated {
+			return fmt.Errorf("lists of maps and maps of maps are not supported")
+		}
+		if err := g.addType(t.Elem(), true); err != nil {
+			return err
+		}
+		g.usesSort = true
+		// entries are decoded into a variable of the type of the items
+		if err := g.addImport(t.Elem()); err != nil {
+			return err
+		}
+		return g.addImport(t)
+	case reflect.Struct:
+		if t.PkgPath() != g.pkgPath {
+			return fmt.Errorf("%v is not a type of %s and has no helper", t, g.pkgPath)
+		}
+		return g.addStruct(t)
+	}
+	return fmt.Errorf("%v is not supported", t)
+}
+
+// addImport imports the packages the name of t refers to.
+func (g *ProtobufGenerator) addImport(t reflect.Type) error {
+	if len(t.Name()) != 0 {
+		if len(t.PkgPath()) == 0 || t.PkgPath() == g.pkgPath {
+			return nil
+		}
+		name := path.Base(t.PkgPath())
+		if other, found := g.imports[name]; found && other != t.PkgPath() {
+			return fmt.Errorf("packages %s and %s have the same name", other, t.PkgPath())
+		}
+		g.imports[name] = t.PkgPath()
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice:
+		return g.addImport(t.Elem())
+	case reflect.Map:
+		if err := g.addImport(t.Key()); err != nil {
+			return err
+		}
+		return g.addImport(t.Elem())
+	}
+	return nil
+}
+
+// typeName returns the name of t in the generated code.
+func (g *ProtobufGenerator) typeName(t reflect.Type) string {
+	if len(t.Name()) != 0 {
+		if len(t.PkgPath()) == 0 || t.PkgPath() == g.pkgPath {
+			return t.Name()
+		}
+		return path.Base(t.PkgPath()) + "." + t.Name()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeName(t.Key()) + "]" + g.typeName(t.Elem())
+	}
+	return t.String()
+}
+
+// Write writes the methods of the added types, as a file of the package of the given
+// name.
+func (g *ProtobufGenerator) Write(w io.Writer, pkgName string) error {
+	types := []reflect.Type{}
+	for t := range g.types {
+		types = append(types, t)
+	}
+	sort.Sort(typesByName(types))
+
+	body := &bytes.Buffer{}
+	usesFmt := false
+	for _, t := range types {
+		g.writeAppend(body, t)
+		g.writeUnmarshal(body, t)
+		usesFmt = usesFmt || len(g.types[t]) != 0
+	}
+
+	imports := map[string]string{}
+	for name, pkgPath := range g.imports {
+		imports[name] = pkgPath
+	}
+	if usesFmt {
+		imports["fmt"] = "fmt"
+	}
+	if g.usesSort {
+		imports["sort"] = "sort"
+	}
+	names := []string{}
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "package %s\n\nimport (\n", pkgName)
+	// the standard packages come first
+	for _, std := range []bool{true, false} {
+		for _, name := range names {
+			if isStandard := !strings.Contains(imports[name], "."); isStandard == std {
+				fmt.Fprintf(buf, "%q\n", imports[name])
+			}
+		}
+		fmt.Fprintf(buf, "\n")
+	}
+	fmt.Fprintf(buf, ")\n")
+	buf.Write(body.Bytes())
+
+	data, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("unable to format the generated code: %v", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (g *ProtobufGenerator) writeAppend(w io.Writer, t reflect.Type) {
+	fmt.Fprintf(w, "\n// AppendProtobuf appends the fields of the protobuf encoding of the %s to buf.\n", t.Name())
+	fmt.Fprintf(w, "func (in *%s) AppendProtobuf(buf []byte) []byte {\n", t.Name())
+	for _, field := range g.types[t] {
+		g.writeAppendValue(w, field.t, field.number, "in."+field.name, false)
+	}
+	fmt.Fprintf(w, "return buf\n}\n")
+}
+
+// writeAppendValue writes the code which appends expr, a value of type t, as the field of
+// the given number. The zero value is omitted unless always is set.
+func (g *ProtobufGenerator) writeAppendValue(w io.Writer, t reflect.Type, number uint64, expr string, always bool) {
+	if helper, found := g.helpers[t]; found {
+		fmt.Fprintf(w, "buf = %s(buf, %d, %s, %t)\n", helper.appendFunc, number, addressOf(expr), always)
+		return
+	}
+	conversion := func(kind string) string {
+		if t.Name() == kind {
+			return expr
+		}
+		return kind + "(" + expr + ")"
+	}
+	check := func(condition, code string) {
+		if always {
+			fmt.Fprintf(w, "%s\n", code)
+			return
+		}
+		fmt.Fprintf(w, "if %s {\n%s\n}\n", condition, code)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		fmt.Fprintf(w, "if %s != nil {\n", expr)
+		g.writeAppendValue(w, t.Elem(), number, "(*"+expr+")", true)
+		fmt.Fprintf(w, "}\n")
+	case reflect.Bool:
+		check(expr, fmt.Sprintf("buf = protobuf.AppendBool(buf, %d, %s)", number, conversion("bool")))
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		check(expr+" != 0", fmt.Sprintf("buf = protobuf.AppendInt(buf, %d, %s)", number, conversion("int64")))
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		check(expr+" != 0", fmt.Sprintf("buf = protobuf.AppendUint(buf, %d, %s)", number, conversion("uint64")))
+	case reflect.Float32:
+		check(expr+" != 0", fmt.Sprintf("buf = protobuf.AppendFloat32(buf, %d, %s)", number, conversion("float32")))
+	case reflect.Float64:
+		check(expr+" != 0", fmt.Sprintf("buf = protobuf.AppendFloat64(buf, %d, %s)", number, conversion("float64")))
+	case reflect.String:
+		check("len("+expr+") != 0", fmt.Sprintf("buf = protobuf.AppendString(buf, %d, %s)", number, conversion("string")))
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if len(t.Name()) != 0 {
+				expr = "[]byte(" + expr + ")"
+			}
+			check("len("+expr+") != 0", fmt.Sprintf("buf = protobuf.AppendBytes(buf, %d, %s)", number, expr))
+			return
+		}
+		fmt.Fprintf(w, "for i := range %s {\n", expr)
+		g.writeAppendValue(w, t.Elem(), number, expr+"[i]", true)
+		fmt.Fprintf(w, "}\n")
+	case reflect.Map:
+		// the entries are sorted by key, for the encoding to be stable
+		fmt.Fprintf(w, "if len(%s) != 0 {\n", expr)
+		fmt.Fprintf(w, "keys := make([]string, 0, len(%s))\n", expr)
+		key, stringKey := "key", "key"
+		if t.Key().Name() != "string" {
+			key, stringKey = g.typeName(t.Key())+"(key)", "string(key)"
+		}
+		fmt.Fprintf(w, "for key := range %s {\nkeys = append(keys, %s)\n}\n", expr, stringKey)
+		fmt.Fprintf(w, "sort.Strings(keys)\n")
+		fmt.Fprintf(w, "for _, key := range keys {\n")
+		fmt.Fprintf(w, "item := %s[%s]\n", expr, key)
+		fmt.Fprintf(w, "entry := len(buf)\nbuf = protobuf.StartMessage(buf, %d)\n", number)
+		fmt.Fprintf(w, "buf = protobuf.AppendString(buf, 1, key)\n")
+		g.writeAppendValue(w, t.Elem(), 2, "item", true)
+		fmt.Fprintf(w, "buf = protobuf.EndMessage(buf, entry, false)\n}\n}\n")
+	case reflect.Struct:
+		// the values which are always encoded are in a block of their own
+		if !always {
+			fmt.Fprintf(w, "{\n")
+		}
+		fmt.Fprintf(w, "start := len(buf)\nbuf = protobuf.StartMessage(buf, %d)\n", number)
+		fmt.Fprintf(w, "buf = %s.AppendProtobuf(buf)\n", strings.TrimSuffix(strings.TrimPrefix(expr, "(*"), ")"))
+		fmt.Fprintf(w, "buf = protobuf.EndMessage(buf, start, %t)\n", !always)
+		if !always {
+			fmt.Fprintf(w, "}\n")
+		}
+	}
+}
+
+func (g *ProtobufGenerator) writeUnmarshal(w io.Writer, t reflect.Type) {
+	fields := g.types[t]
+	fmt.Fprintf(w, "\n// UnmarshalProtobuf decodes the fields of the protobuf encoding of the %s.\n", t.Name())
+	fmt.Fprintf(w, "func (out *%s) UnmarshalProtobuf(data []byte) error {\n", t.Name())
+	fmt.Fprintf(w, "for len(data) > 0 {\n")
+	if len(fields) == 0 {
+		fmt.Fprintf(w, "_, _, rest, err := protobuf.ReadField(data)\nif err != nil {\nreturn err\n}\ndata = rest\n}\nreturn nil\n}\n")
+		return
+	}
+	fmt.Fprintf(w, "number, value, rest, err := protobuf.ReadField(data)\nif err != nil {\nreturn err\n}\ndata = rest\n")
+	fmt.Fprintf(w, "switch number {\n")
+	for _, field := range fields {
+		fmt.Fprintf(w, "case %d:\n", field.number)
+		g.writeUnmarshalValue(w, field.t, "value", "out."+field.name)
+	}
+	fmt.Fprintf(w, "}\nif err != nil {\n")
+	fmt.Fprintf(w, "return fmt.Errorf(\"unable to decode field %%d of %s: %%v\", number, err)\n", t.Name())
+	fmt.Fprintf(w, "}\n}\nreturn nil\n}\n")
+}
+
+// writeUnmarshalValue writes the code which decodes value into expr, a value of type t,
+// and sets err. The items of lists and maps are added to them.
+func (g *ProtobufGenerator) writeUnmarshalValue(w io.Writer, t reflect.Type, value, expr string) {
+	if helper, found := g.helpers[t]; found {
+		fmt.Fprintf(w, "err = %s(%s, %s)\n", helper.unmarshalFunc, value, addressOf(expr))
+		return
+	}
+	decode := func(method, kind string) {
+		address := addressOf(expr)
+		if t.Name() != kind {
+			address = "(*" + kind + ")(" + address + ")"
+		}
+		fmt.Fprintf(w, "err = %s.%s(%s)\n", value, method, address)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		fmt.Fprintf(w, "if %s == nil {\n%s = new(%s)\n}\n", expr, expr, g.typeName(t.Elem()))
+		g.writeUnmarshalValue(w, t.Elem(), value, "(*"+expr+")")
+	case reflect.Bool:
+		decode("DecodeBool", "bool")
+	case reflect.Int:
+		decode("DecodeInt", "int")
+	case reflect.Int32:
+		decode("DecodeInt32", "int32")
+	case reflect.Int64:
+		decode("DecodeInt64", "int64")
+	case reflect.Uint:
+		decode("DecodeUint", "uint")
+	case reflect.Uint32:
+		decode("DecodeUint32", "uint32")
+	case reflect.Uint64:
+		decode("DecodeUint64", "uint64")
+	case reflect.Float32:
+		decode("DecodeFloat32", "float32")
+	case reflect.Float64:
+		decode("DecodeFloat64", "float64")
+	case reflect.String:
+		decode("DecodeString", "string")
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			address := addressOf(expr)
+			if len(t.Name()) != 0 {
+				address = "(*[]byte)(" + address + ")"
+			}
+			fmt.Fprintf(w, "err = %s.DecodeBytes(%s)\n", value, address)
+			return
+		}
+		fmt.Fprintf(w, "%s = append(%s, %s)\n", expr, expr, g.zeroValue(t.Elem()))
+		g.writeUnmarshalValue(w, t.Elem(), value, expr+"["+"len("+expr+")-1]")
+	case reflect.Map:
+		fmt.Fprintf(w, "var key string\nvar entry protobuf.Value\n")
+		fmt.Fprintf(w, "if key, entry, err = %s.Entry(); err != nil {\nbreak\n}\n", value)
+		fmt.Fprintf(w, "var item %s\n", g.typeName(t.Elem()))
+		g.writeUnmarshalValue(w, t.Elem(), "entry", "item")
+		fmt.Fprintf(w, "if %s == nil {\n%s = %s{}\n}\n", expr, expr, g.typeName(t))
+		key := "key"
+		if t.Key().Name() != "string" {
+			key = g.typeName(t.Key()) + "(key)"
+		}
+		fmt.Fprintf(w, "%s[%s] = item\n", expr, key)
+	case reflect.Struct:
+		fmt.Fprintf(w, "err = %s.DecodeMessage(%s)\n", value, addressOf(expr))
+	}
+}
+
+// zeroValue returns the zero value of t in the generated code.
+func (g *ProtobufGenerator) zeroValue(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		return "nil"
+	case reflect.Bool:
+		return "false"
+	case reflect.String:
+		return `""`
+	case reflect.Struct:
+		return g.typeName(t) + "{}"
+	}
+	return "0"
+}
+
+// addressOf returns the address of expr, an addressable expression.
+func addressOf(expr string) string {
+	if strings.HasPrefix(expr, "(*") && strings.HasSuffix(expr, ")") {
+		return expr[2 : len(expr)-1]
+	}
+	return "&" + expr
+}
+
+type typesByName []reflect.Type
+
+func (t typesByName) Len() int           { return len(t) }
+func (t typesByName) Less(i, j int) bool { return t[i].Name() < t[j].Name() }
+func (t typesByName) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
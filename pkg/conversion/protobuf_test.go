@@ -0,0 +1,270 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf"
+)
+
+type ProtobufTestMeta struct {
+	APIVersion string `json:"apiVersion,omitempty" protobuf:"1"`
+	Kind       string `json:"kind,omitempty" protobuf:"2"`
+}
+
+type protobufItem struct {
+	Name       string `json:"name" protobuf:"1"`
+	Port       int    `json:"port" protobuf:"2"`
+	Ignored    string `json:"-"`
+	unexported string
+}
+
+type protobufName string
+
+type protobufType struct {
+	ProtobufTestMeta `json:",inline" protobuf:"1"`
+	String           string                        `json:"string,omitempty" protobuf:"2"`
+	Name             protobufName                  `json:"name,omitempty" protobuf:"3"`
+	Float64          float64                       `json:"float64,omitempty" protobuf:"4"`
+	Bytes            []byte                        `json:"bytes,omitempty" protobuf:"5"`
+	Strings          []string                      `json:"strings,omitempty" protobuf:"6"`
+	Items            []protobufItem                `json:"items,omitempty" protobuf:"7"`
+	Map              map[protobufName]protobufItem `json:"map,omitempty" protobuf:"8"`
+	Pointer          *protobufItem                 `json:"pointer,omitempty" protobuf:"9"`
+	BoolPointer      *bool                         `json:"boolPointer,omitempty" protobuf:"10"`
+	Time             time.Time                     `json:"time,omitempty" protobuf:"11"`
+}
+
+func TestProtobufGenerator(t *testing.T) {
+	g := NewProtobufGenerator("github.com/GoogleCloudPlatform/kubernetes/pkg/conversion")
+	g.AddHelper(reflect.TypeOf(time.Time{}), "appendProtobufTime", "unmarshalProtobufTime")
+	if err := g.Add(reflect.TypeOf(&protobufType{})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := g.Write(buf, "conversion"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	generated := buf.String()
+	// indentation is ignored
+	flat := strings.Replace(generated, "\t", "", -1)
+	for _, expected := range []string{
+		"import (\n\"fmt\"\n\"sort\"\n\n\"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf\"\n)",
+		"func (in *ProtobufTestMeta) AppendProtobuf(buf []byte) []byte {",
+		"func (out *protobufItem) UnmarshalProtobuf(data []byte) error {",
+		// zero values are omitted, and fields of named types are converted
+		"if len(in.Name) != 0 {\nbuf = protobuf.AppendString(buf, 3, string(in.Name))\n}\n",
+		"err = value.DecodeString((*string)(&out.Name))",
+		// the values of pointers are always encoded
+		"if in.BoolPointer != nil {\nbuf = protobuf.AppendBool(buf, 10, (*in.BoolPointer))\n}\n",
+		"if out.BoolPointer == nil {\nout.BoolPointer = new(bool)\n}\nerr = value.DecodeBool(out.BoolPointer)\n",
+		// nested structs and maps
+		"buf = protobuf.EndMessage(buf, start, true)",
+		"out.Items = append(out.Items, protobufItem{})\nerr = value.DecodeMessage(&out.Items[len(out.Items)-1])",
+		"item := in.Map[protobufName(key)]",
+		"out.Map[protobufName(key)] = item",
+		// helpers
+		"buf = appendProtobufTime(buf, 11, &in.Time, false)",
+		"err = unmarshalProtobufTime(value, &out.Time)",
+	} {
+		if !strings.Contains(flat, expected) {
+			t.Errorf("expected the generated code to contain %q:\n%s", expected, generated)
+		}
+	}
+	if strings.Contains(generated, "Ignored") || strings.Contains(generated, "unexported") {
+		t.Errorf("expected ignored and unexported fields to be skipped:\n%s", generated)
+	}
+}
+
+func TestProtobufGeneratorErrors(t *testing.T) {
+	type untagged struct {
+		Name string `json:"name"`
+	}
+	type invalid struct {
+		Name string `protobuf:"name"`
+	}
+	type duplicate struct {
+		A string `protobuf:"1"`
+		B string `protobuf:"1"`
+	}
+	type lists struct {
+		Lists [][]string `protobuf:"1"`
+	}
+	type maps struct {
+		Map map[int]string `protobuf:"1"`
+	}
+	type foreign struct {
+		Time time.Time `protobuf:"1"`
+	}
+	type nested struct {
+		Item untagged `protobuf:"1"`
+	}
+	tests := map[string]interface{}{
+		"no protobuf tag":         untagged{},
+		"invalid protobuf tag":    invalid{},
+		"same protobuf tag":       duplicate{},
+		"lists of lists":          lists{},
+		"only maps with string":   maps{},
+		"has no helper":           foreign{},
+		"has no protobuf tag":     nested{},
+		"is not a struct type of": "",
+	}
+	for message, obj := range tests {
+		g := NewProtobufGenerator("github.com/GoogleCloudPlatform/kubernetes/pkg/conversion")
+		if err := g.Add(reflect.TypeOf(obj)); err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("%T: expected an error containing %q, got %v", obj, message, err)
+		}
+	}
+}
+
+type protobufExternalType struct {
+	ProtobufTestMeta `json:",inline" protobuf:"1"`
+	Name             string `json:"name,omitempty" protobuf:"2"`
+	Port             int    `json:"port,omitempty" protobuf:"3"`
+}
+
+// The methods of the types the scheme encodes are written as ProtobufGenerator would.
+func (in *ProtobufTestMeta) AppendProtobuf(buf []byte) []byte {
+	if len(in.APIVersion) != 0 {
+		buf = protobuf.AppendString(buf, 1, in.APIVersion)
+	}
+	if len(in.Kind) != 0 {
+		buf = protobuf.AppendString(buf, 2, in.Kind)
+	}
+	return buf
+}
+
+func (out *ProtobufTestMeta) UnmarshalProtobuf(data []byte) error {
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeString(&out.APIVersion)
+		case 2:
+			err = value.DecodeString(&out.Kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (in *protobufExternalType) AppendProtobuf(buf []byte) []byte {
+	start := len(buf)
+	buf = protobuf.StartMessage(buf, 1)
+	buf = in.ProtobufTestMeta.AppendProtobuf(buf)
+	buf = protobuf.EndMessage(buf, start, true)
+	if len(in.Name) != 0 {
+		buf = protobuf.AppendString(buf, 2, in.Name)
+	}
+	if in.Port != 0 {
+		buf = protobuf.AppendInt(buf, 3, int64(in.Port))
+	}
+	return buf
+}
+
+func (out *protobufExternalType) UnmarshalProtobuf(data []byte) error {
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeMessage(&out.ProtobufTestMeta)
+		case 2:
+			err = value.DecodeString(&out.Name)
+		case 3:
+			err = value.DecodeInt(&out.Port)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type protobufInternalType struct {
+	ProtobufTestMeta `json:",inline"`
+	Name             string
+	Port             int
+}
+
+// protobufUnencodableType has no protobuf methods.
+type protobufUnencodableType struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+func TestSchemeProtobuf(t *testing.T) {
+	s := NewScheme()
+	s.AddKnownTypeWithName("", "Type", &protobufInternalType{})
+	s.AddKnownTypeWithName("v1", "Type", &protobufExternalType{})
+	s.AddKnownTypeWithName("", "Other", &protobufUnencodableType{})
+	s.AddKnownTypeWithName("v1", "Other", &protobufUnencodableType{})
+	s.InternalVersion = ""
+
+	obj := &protobufInternalType{Name: "foo", Port: 80}
+	data, err := s.EncodeToVersionProtobuf(obj, "v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !IsProtobuf(data) {
+		t.Fatalf("expected protobuf data, got %q", string(data))
+	}
+	if version, kind, err := s.DataVersionAndKind(data); err != nil || version != "v1" || kind != "Type" {
+		t.Errorf("unexpected version and kind: %s %s %v", version, kind, err)
+	}
+
+	decoded, err := s.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(obj, decoded) {
+		t.Errorf("expected %#v, got %#v", obj, decoded)
+	}
+	into := &protobufInternalType{}
+	if err := s.DecodeInto(data, into); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(obj, into) {
+		t.Errorf("expected %#v, got %#v", obj, into)
+	}
+
+	if data, err := s.EncodeToVersion(obj, "v1"); err != nil || IsProtobuf(data) {
+		t.Errorf("expected JSON data, got %q %v", string(data), err)
+	}
+
+	if _, err := s.EncodeToVersionProtobuf(&protobufUnencodableType{}, "v1"); err == nil || !strings.Contains(err.Error(), "ProtobufMarshaler") {
+		t.Errorf("expected an error for a type without a protobuf marshaler, got %v", err)
+	}
+	other := append(append([]byte{}, protobufPrefix...), protobuf.AppendString(protobuf.AppendString(nil, 1, "v1"), 2, "Other")...)
+	if err := s.DecodeInto(other, &protobufUnencodableType{}); err == nil || !strings.Contains(err.Error(), "ProtobufUnmarshaler") {
+		t.Errorf("expected an error for a type without a protobuf unmarshaler, got %v", err)
+	}
+}
@@ -301,6 +301,13 @@ func (s *Scheme) generateConvertMeta(srcVersion, destVersion string) *Meta {
 // DataVersionAndKind will return the APIVersion and Kind of the given wire-format
 // encoding of an API Object, or an error.
 func (s *Scheme) DataVersionAndKind(data []byte) (version, kind string, err error) {
+	if IsProtobuf(data) {
+		envelope, err := readEnvelope(data)
+		if err != nil {
+			return "", "", fmt.Errorf("couldn't get version/kind: %v", err)
+		}
+		return envelope.APIVersion, envelope.Kind, nil
+	}
 	return s.MetaFactory.Interpret(data)
 }
 
@@ -0,0 +1,383 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+// IngressController keeps the routes of a Router in sync with the ingresses,
+// services, endpoints and secrets of the cluster, and publishes the address of
+// the router in the status of the ingresses.
+type IngressController struct {
+	kubeClient client.Interface
+	router     *Router
+	// address is written into the status of the ingresses, unless empty.
+	address string
+
+	ingressStore   cache.Store
+	serviceStore   cache.Store
+	endpointsStore cache.Store
+	secretStore    cache.Store
+
+	// changed receives a value when any of the stores changes.
+	changed chan struct{}
+	// certs caches the certificates parsed from secrets, by secret key.
+	certs map[string]cachedCertificate
+}
+
+// cachedCertificate is a certificate parsed from a version of a secret.
+type cachedCertificate struct {
+	resourceVersion string
+	cert            *tls.Certificate
+	err             error
+}
+
+// NewIngressController creates a new IngressController which sets the routes
+// of router, and publishes address as the address of the ingresses it serves.
+func NewIngressController(kubeClient client.Interface, router *Router, address string) *IngressController {
+	changed := make(chan struct{}, 1)
+	// notify wakes up Run, without blocking the reflectors when a rebuild of the
+	// routes is already pending.
+	notify := func([]interface{}) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+
+	ingressStore := cache.NewUndeltaStore(notify, cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Ingresses(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.Ingresses(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Ingress{},
+		ingressStore,
+		0,
+	).Run()
+
+	serviceStore := cache.NewUndeltaStore(notify, cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Services(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.Services(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Service{},
+		serviceStore,
+		0,
+	).Run()
+
+	endpointsStore := cache.NewUndeltaStore(notify, cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Endpoints(api.NamespaceAll).List(labels.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.Endpoints(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Endpoints{},
+		endpointsStore,
+		0,
+	).Run()
+
+	secretStore := cache.NewUndeltaStore(notify, cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.Secrets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.Secrets(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.Secret{},
+		secretStore,
+		0,
+	).Run()
+
+	return &IngressController{
+		kubeClient:     kubeClient,
+		router:         router,
+		address:        address,
+		ingressStore:   ingressStore,
+		serviceStore:   serviceStore,
+		endpointsStore: endpointsStore,
+		secretStore:    secretStore,
+		changed:        changed,
+		certs:          map[string]cachedCertificate{},
+	}
+}
+
+// Run begins syncing the routes whenever an ingress, service, endpoints or secret
+// changes, and at least at the given period.
+func (ic *IngressController) Run(period time.Duration) {
+	go util.Forever(func() {
+		select {
+		case <-ic.changed:
+		case <-time.After(period):
+		}
+		ic.synchronize()
+	}, 0)
+}
+
+// synchronize rebuilds the routes of the router from every ingress, and updates
+// the status of the ingresses.
+func (ic *IngressController) synchronize() {
+	ingresses := []*api.Ingress{}
+	for _, obj := range ic.ingressStore.List() {
+		ingresses = append(ingresses, obj.(*api.Ingress))
+	}
+	// Sort the ingresses, so that conflicting rules of a namespace are always
+	// resolved the same way.
+	sort.Sort(byNamespaceAndName(ingresses))
+
+	rejected := rejectedHosts(ingresses)
+	table := newRouteTable()
+	for _, ingress := range ingresses {
+		ic.addIngress(table, ingress, rejected)
+	}
+	ic.router.setTable(table)
+	ic.pruneCertificates()
+
+	if len(ic.address) == 0 {
+		return
+	}
+	for _, ingress := range ingresses {
+		if ingress.Status.Address == ic.address {
+			continue
+		}
+		ingress = api.Scheme.CopyOrDie(ingress).(*api.Ingress)
+		ingress.Status.Address = ic.address
+		if _, err := ic.kubeClient.Ingresses(ingress.Namespace).UpdateStatus(ingress); err != nil {
+			glog.Errorf("Error updating the status of ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
+		}
+	}
+}
+
+// rejectedHosts returns the host names claimed by the ingresses of more than one
+// namespace.  No namespace may take over the traffic of another one, so these
+// hosts are not routed at all; the empty host name stands for the default backend.
+func rejectedHosts(ingresses []*api.Ingress) util.StringSet {
+	namespaces := map[string]util.StringSet{}
+	claim := func(host, namespace string) {
+		if _, found := namespaces[host]; !found {
+			namespaces[host] = util.NewStringSet()
+		}
+		namespaces[host].Insert(namespace)
+	}
+	for _, ingress := range ingresses {
+		if ingress.Spec.Backend != nil {
+			claim("", ingress.Namespace)
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if len(rule.Host) != 0 {
+				claim(strings.ToLower(rule.Host), ingress.Namespace)
+			}
+		}
+		for _, ingressTLS := range ingress.Spec.TLS {
+			for _, host := range ingressTLS.Hosts {
+				claim(strings.ToLower(host), ingress.Namespace)
+			}
+		}
+	}
+
+	rejected := util.NewStringSet()
+	for host, claimed := range namespaces {
+		if claimed.Len() < 2 {
+			continue
+		}
+		rejected.Insert(host)
+		if len(host) == 0 {
+			util.HandleError(fmt.Errorf("the default backend is set by ingresses of several namespaces (%s), ignoring all of them", strings.Join(claimed.List(), ", ")))
+		} else {
+			util.HandleError(fmt.Errorf("host %q is claimed by ingresses of several namespaces (%s), refusing to route it", host, strings.Join(claimed.List(), ", ")))
+		}
+	}
+	return rejected
+}
+
+// addIngress adds the routes and certificates of an ingress to a route table,
+// except those of the rejected hosts.  Rules conflicting with the ones already in
+// the table are dropped.
+func (ic *IngressController) addIngress(table *routeTable, ingress *api.Ingress, rejected util.StringSet) {
+	if ingress.Spec.Backend != nil && !rejected.Has("") {
+		b, err := ic.resolveBackend(ingress.Namespace, ingress.Spec.Backend)
+		if err != nil {
+			util.HandleError(fmt.Errorf("ingress %s/%s: %v", ingress.Namespace, ingress.Name, err))
+		} else if table.defaultBackend != nil {
+			glog.Warningf("Ingress %s/%s: ignoring default backend, it is already set to %s", ingress.Namespace, ingress.Name, table.defaultBackend.name)
+		} else {
+			table.defaultBackend = b
+		}
+	}
+	for _, rule := range ingress.Spec.Rules {
+		host := strings.ToLower(rule.Host)
+		if len(host) != 0 && rejected.Has(host) {
+			continue
+		}
+		for _, path := range rule.Paths {
+			b, err := ic.resolveBackend(ingress.Namespace, &path.Backend)
+			if err != nil {
+				util.HandleError(fmt.Errorf("ingress %s/%s: %v", ingress.Namespace, ingress.Name, err))
+				continue
+			}
+			if !table.addRoute(host, path.Path, b) {
+				glog.Warningf("Ingress %s/%s: ignoring path %q of host %q, it is already routed", ingress.Namespace, ingress.Name, path.Path, rule.Host)
+			}
+		}
+	}
+	for _, ingressTLS := range ingress.Spec.TLS {
+		cert, err := ic.loadCertificate(ingress.Namespace, ingressTLS.SecretName)
+		if err != nil {
+			util.HandleError(fmt.Errorf("ingress %s/%s: %v", ingress.Namespace, ingress.Name, err))
+			continue
+		}
+		for _, host := range ingressTLS.Hosts {
+			host = strings.ToLower(host)
+			if rejected.Has(host) {
+				continue
+			}
+			if _, found := table.certs[host]; found {
+				glog.Warningf("Ingress %s/%s: ignoring certificate of host %q, it is already set", ingress.Namespace, ingress.Name, host)
+				continue
+			}
+			table.certs[host] = cert
+		}
+	}
+}
+
+// resolveBackend looks up the endpoints of the service port named by an ingress backend.
+func (ic *IngressController) resolveBackend(namespace string, ib *api.IngressBackend) (*backend, error) {
+	key := namespace + "/" + ib.ServiceName
+	obj, exists, err := ic.serviceStore.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("service %s not found", key)
+	}
+	service := obj.(*api.Service)
+
+	var servicePort *api.ServicePort
+	for i := range service.Spec.Ports {
+		port := &service.Spec.Ports[i]
+		if (ib.ServicePort.Kind == util.IntstrInt && port.Port == ib.ServicePort.IntVal) ||
+			(ib.ServicePort.Kind == util.IntstrString && port.Name == ib.ServicePort.StrVal) {
+			servicePort = port
+			break
+		}
+	}
+	if servicePort == nil {
+		return nil, fmt.Errorf("service %s has no port %s", key, ib.ServicePort.String())
+	}
+
+	b := &backend{name: fmt.Sprintf("%s:%s", key, ib.ServicePort.String())}
+	obj, exists, err = ic.endpointsStore.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return b, nil
+	}
+	for _, subset := range obj.(*api.Endpoints).Subsets {
+		for _, port := range subset.Ports {
+			if port.Name != servicePort.Name {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				b.endpoints = append(b.endpoints, net.JoinHostPort(address.IP, strconv.Itoa(port.Port)))
+			}
+		}
+	}
+	return b, nil
+}
+
+// loadCertificate returns the certificate and private key held by a secret.  A
+// certificate is only parsed again when its secret changes.
+func (ic *IngressController) loadCertificate(namespace, secretName string) (*tls.Certificate, error) {
+	key := namespace + "/" + secretName
+	obj, exists, err := ic.secretStore.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("secret %s not found", key)
+	}
+	secret := obj.(*api.Secret)
+
+	cached, found := ic.certs[key]
+	if !found || cached.resourceVersion != secret.ResourceVersion {
+		cached = cachedCertificate{resourceVersion: secret.ResourceVersion}
+		cert, err := tls.X509KeyPair(secret.Data[api.TLSCertKey], secret.Data[api.TLSPrivateKeyKey])
+		if err != nil {
+			cached.err = fmt.Errorf("secret %s does not hold a valid certificate: %v", key, err)
+		} else {
+			cached.cert = &cert
+		}
+		ic.certs[key] = cached
+	}
+	return cached.cert, cached.err
+}
+
+// pruneCertificates drops the cached certificates of deleted secrets.
+func (ic *IngressController) pruneCertificates() {
+	for key := range ic.certs {
+		if _, exists, _ := ic.secretStore.GetByKey(key); !exists {
+			delete(ic.certs, key)
+		}
+	}
+}
+
+// byNamespaceAndName sorts ingresses by namespace and name.
+type byNamespaceAndName []*api.Ingress
+
+func (i byNamespaceAndName) Len() int      { return len(i) }
+func (i byNamespaceAndName) Swap(a, b int) { i[a], i[b] = i[b], i[a] }
+func (i byNamespaceAndName) Less(a, b int) bool {
+	if i[a].Namespace != i[b].Namespace {
+		return i[a].Namespace < i[b].Namespace
+	}
+	return i[a].Name < i[b].Name
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func newTestController(kubeClient client.Interface, address string) *IngressController {
+	return &IngressController{
+		kubeClient:     kubeClient,
+		router:         NewRouter(),
+		address:        address,
+		ingressStore:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+		serviceStore:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+		endpointsStore: cache.NewStore(cache.MetaNamespaceKeyFunc),
+		secretStore:    cache.NewStore(cache.MetaNamespaceKeyFunc),
+		certs:          map[string]cachedCertificate{},
+	}
+}
+
+func addService(ic *IngressController, name string) {
+	ic.serviceStore.Add(&api.Service{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.ServiceSpec{
+			Ports: []api.ServicePort{
+				{Name: "http", Port: 80},
+				{Name: "metrics", Port: 9090},
+			},
+		},
+	})
+	ic.endpointsStore.Add(&api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Subsets: []api.EndpointSubset{
+			{
+				Addresses: []api.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				Ports:     []api.EndpointPort{{Name: "http", Port: 8080}, {Name: "metrics", Port: 9091}},
+			},
+		},
+	})
+}
+
+func TestResolveBackend(t *testing.T) {
+	ic := newTestController(&client.Fake{}, "")
+	addService(ic, "foo")
+	ic.serviceStore.Add(&api.Service{
+		ObjectMeta: api.ObjectMeta{Name: "noendpoints", Namespace: api.NamespaceDefault},
+		Spec:       api.ServiceSpec{Ports: []api.ServicePort{{Port: 80}}},
+	})
+
+	testCases := []struct {
+		name      string
+		backend   api.IngressBackend
+		endpoints []string
+		err       bool
+	}{
+		{
+			name:      "by port number",
+			backend:   api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromInt(80)},
+			endpoints: []string{"10.0.0.1:8080", "10.0.0.2:8080"},
+		},
+		{
+			name:      "by port name",
+			backend:   api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromString("metrics")},
+			endpoints: []string{"10.0.0.1:9091", "10.0.0.2:9091"},
+		},
+		{
+			name:    "no endpoints",
+			backend: api.IngressBackend{ServiceName: "noendpoints", ServicePort: util.NewIntOrStringFromInt(80)},
+		},
+		{
+			name:    "missing port",
+			backend: api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromInt(81)},
+			err:     true,
+		},
+		{
+			name:    "missing service",
+			backend: api.IngressBackend{ServiceName: "bar", ServicePort: util.NewIntOrStringFromInt(80)},
+			err:     true,
+		},
+	}
+	for _, tc := range testCases {
+		b, err := ic.resolveBackend(api.NamespaceDefault, &tc.backend)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(b.endpoints, tc.endpoints) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.endpoints, b.endpoints)
+		}
+	}
+}
+
+func TestSynchronize(t *testing.T) {
+	fakeClient := &client.Fake{}
+	ic := newTestController(fakeClient, "1.2.3.4")
+	addService(ic, "foo")
+	addService(ic, "bar")
+	ic.ingressStore.Add(&api.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "a", Namespace: api.NamespaceDefault},
+		Spec: api.IngressSpec{
+			Backend: &api.IngressBackend{ServiceName: "bar", ServicePort: util.NewIntOrStringFromInt(80)},
+			Rules: []api.IngressRule{
+				{
+					Host: "foo.com",
+					Paths: []api.IngressPath{
+						{Path: "/", Backend: api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromInt(80)}},
+					},
+				},
+			},
+		},
+	})
+	// Conflicts with the rule of "a", which sorts first.
+	ic.ingressStore.Add(&api.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "b", Namespace: api.NamespaceDefault},
+		Spec: api.IngressSpec{
+			Rules: []api.IngressRule{
+				{
+					Host: "foo.com",
+					Paths: []api.IngressPath{
+						{Path: "/", Backend: api.IngressBackend{ServiceName: "bar", ServicePort: util.NewIntOrStringFromInt(80)}},
+						{Path: "/bar", Backend: api.IngressBackend{ServiceName: "bar", ServicePort: util.NewIntOrStringFromInt(80)}},
+					},
+				},
+			},
+		},
+		Status: api.IngressStatus{Address: "1.2.3.4"},
+	})
+
+	ic.synchronize()
+
+	table := ic.router.getTable()
+	if b := table.lookup("foo.com", "/"); b == nil || b.name != "default/foo:80" {
+		t.Errorf("expected foo.com/ to be routed to foo, got %v", b)
+	}
+	if b := table.lookup("foo.com", "/bar"); b == nil || b.name != "default/bar:80" {
+		t.Errorf("expected foo.com/bar to be routed to bar, got %v", b)
+	}
+	if b := table.lookup("other.com", "/"); b == nil || b.name != "default/bar:80" {
+		t.Errorf("expected other.com/ to be routed to the default backend, got %v", b)
+	}
+
+	expected := []client.FakeAction{{Action: "update-status-ingress", Value: "a"}}
+	if !reflect.DeepEqual(fakeClient.Actions, expected) {
+		t.Errorf("expected %v, got %v", expected, fakeClient.Actions)
+	}
+}
+
+func TestSynchronizeWithoutAddress(t *testing.T) {
+	fakeClient := &client.Fake{}
+	ic := newTestController(fakeClient, "")
+	ic.ingressStore.Add(&api.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "a", Namespace: api.NamespaceDefault},
+	})
+
+	ic.synchronize()
+
+	if len(fakeClient.Actions) != 0 {
+		t.Errorf("unexpected actions: %v", fakeClient.Actions)
+	}
+}
+
+func TestSynchronizeTLS(t *testing.T) {
+	fakeClient := &client.Fake{}
+	ic := newTestController(fakeClient, "")
+	ic.ingressStore.Add(&api.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "a", Namespace: api.NamespaceDefault},
+		Spec: api.IngressSpec{
+			TLS: []api.IngressTLS{{Hosts: []string{"foo.com"}, SecretName: "cert"}},
+		},
+	})
+
+	// The secret holds no certificate yet.
+	ic.secretStore.Add(&api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "cert", Namespace: api.NamespaceDefault, ResourceVersion: "1"},
+	})
+	ic.synchronize()
+
+	if len(ic.router.getTable().certs) != 0 {
+		t.Errorf("expected no certificate, got %v", ic.router.getTable().certs)
+	}
+
+	dir, err := ioutil.TempDir("", "ingress")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	certPath, keyPath := path.Join(dir, "tls.crt"), path.Join(dir, "tls.key")
+	if err := util.GenerateSelfSignedCert("foo.com", certPath, keyPath); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certData, _ := ioutil.ReadFile(certPath)
+	keyData, _ := ioutil.ReadFile(keyPath)
+	ic.secretStore.Update(&api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "cert", Namespace: api.NamespaceDefault, ResourceVersion: "2"},
+		Data: map[string][]byte{
+			api.TLSCertKey:       certData,
+			api.TLSPrivateKeyKey: keyData,
+		},
+	})
+	ic.synchronize()
+
+	cert, found := ic.router.getTable().certs["foo.com"]
+	if !found {
+		t.Fatalf("expected a certificate for foo.com, got %v", ic.router.getTable().certs)
+	}
+	// The certificate is only parsed again when the secret changes.
+	ic.synchronize()
+	if ic.router.getTable().certs["foo.com"] != cert {
+		t.Errorf("expected the certificate of foo.com to be reused")
+	}
+	if len(fakeClient.Actions) != 0 {
+		t.Errorf("unexpected actions: %v", fakeClient.Actions)
+	}
+
+	ic.secretStore.Delete(&api.Secret{ObjectMeta: api.ObjectMeta{Name: "cert", Namespace: api.NamespaceDefault}})
+	ic.synchronize()
+	if len(ic.router.getTable().certs) != 0 || len(ic.certs) != 0 {
+		t.Errorf("expected the certificate of a deleted secret to be dropped")
+	}
+}
+
+func TestSynchronizeRejectsHostsOfSeveralNamespaces(t *testing.T) {
+	ic := newTestController(&client.Fake{}, "")
+	addService(ic, "foo")
+	for _, namespace := range []string{api.NamespaceDefault, "other"} {
+		ic.ingressStore.Add(&api.Ingress{
+			ObjectMeta: api.ObjectMeta{Name: "a", Namespace: namespace},
+			Spec: api.IngressSpec{
+				Rules: []api.IngressRule{
+					{
+						Host: "foo.com",
+						Paths: []api.IngressPath{
+							{Path: "/", Backend: api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromInt(80)}},
+						},
+					},
+				},
+			},
+		})
+	}
+	ic.ingressStore.Add(&api.Ingress{
+		ObjectMeta: api.ObjectMeta{Name: "b", Namespace: api.NamespaceDefault},
+		Spec: api.IngressSpec{
+			Rules: []api.IngressRule{
+				{
+					Host: "bar.com",
+					Paths: []api.IngressPath{
+						{Path: "/", Backend: api.IngressBackend{ServiceName: "foo", ServicePort: util.NewIntOrStringFromInt(80)}},
+					},
+				},
+			},
+		},
+	})
+
+	ic.synchronize()
+
+	table := ic.router.getTable()
+	if b := table.lookup("foo.com", "/"); b != nil {
+		t.Errorf("expected foo.com, claimed by two namespaces, not to be routed, got %v", b)
+	}
+	if b := table.lookup("bar.com", "/"); b == nil || b.name != "default/foo:80" {
+		t.Errorf("expected bar.com/ to be routed to foo, got %v", b)
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// backend is a port of a service, along with the endpoints serving it.
+type backend struct {
+	// name identifies the service port in logs.
+	name string
+	// endpoints are the "host:port" addresses requests are balanced across.
+	endpoints []string
+	// next is the index of the endpoint receiving the next request.  It is shared
+	// with the backend of the same name in the next route table, so that rebuilding
+	// the routes does not restart the round robin.
+	next *uint32
+}
+
+// nextEndpoint returns the endpoint which should receive the next request, in
+// round robin order, or false if the backend has no endpoint.
+func (b *backend) nextEndpoint() (string, bool) {
+	if len(b.endpoints) == 0 {
+		return "", false
+	}
+	i := atomic.AddUint32(b.next, 1) - 1
+	return b.endpoints[int(i%uint32(len(b.endpoints)))], true
+}
+
+// route sends the requests under a URL path to a backend.
+type route struct {
+	path    string
+	backend *backend
+}
+
+// matches returns true if the path of the route is made of the leading segments
+// of the given URL path.
+func (r *route) matches(path string) bool {
+	prefix := strings.TrimSuffix(r.path, "/")
+	if len(prefix) == 0 {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// byPathLength sorts routes from the longest path to the shortest.
+type byPathLength []route
+
+func (r byPathLength) Len() int           { return len(r) }
+func (r byPathLength) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r byPathLength) Less(i, j int) bool { return len(r[i].path) > len(r[j].path) }
+
+// routeTable holds the routes of every host name, and the certificates served
+// for them.
+type routeTable struct {
+	// hosts maps host names to their routes, sorted by byPathLength. The routes
+	// of the rules without a host are under the empty host name.
+	hosts map[string][]route
+	// defaultBackend receives the requests matching no route, if set.
+	defaultBackend *backend
+	// certs maps host names to the certificates served for them.
+	certs map[string]*tls.Certificate
+}
+
+func newRouteTable() *routeTable {
+	return &routeTable{
+		hosts: map[string][]route{},
+		certs: map[string]*tls.Certificate{},
+	}
+}
+
+// addRoute adds a route for a host name and path. It returns false if the host
+// name and path are already routed.
+func (t *routeTable) addRoute(host, path string, b *backend) bool {
+	for _, r := range t.hosts[host] {
+		if r.path == path {
+			return false
+		}
+	}
+	t.hosts[host] = append(t.hosts[host], route{path: path, backend: b})
+	sort.Stable(byPathLength(t.hosts[host]))
+	return true
+}
+
+// lookup returns the backend which should serve a request for the given host
+// name and URL path, or nil if there is none.  The routes without a host name
+// apply to the paths the routes of the host do not match.
+func (t *routeTable) lookup(host, path string) *backend {
+	if b := matchRoute(t.hosts[host], path); b != nil {
+		return b
+	}
+	if b := matchRoute(t.hosts[""], path); b != nil {
+		return b
+	}
+	return t.defaultBackend
+}
+
+// matchRoute returns the backend of the first route matching path, or nil.
+func matchRoute(routes []route, path string) *backend {
+	for i := range routes {
+		if routes[i].matches(path) {
+			return routes[i].backend
+		}
+	}
+	return nil
+}
+
+// backends returns every backend of the table.
+func (t *routeTable) backends() []*backend {
+	backends := []*backend{}
+	for _, routes := range t.hosts {
+		for i := range routes {
+			backends = append(backends, routes[i].backend)
+		}
+	}
+	if t.defaultBackend != nil {
+		backends = append(backends, t.defaultBackend)
+	}
+	return backends
+}
+
+// Router is an http.Handler which proxies requests to the endpoints of services,
+// by host name and URL path.
+type Router struct {
+	lock  sync.RWMutex // protects 'table' and 'counters'
+	table *routeTable
+	// counters holds the round robin position of the backends of table, by name.
+	counters map[string]*uint32
+
+	// transport is used to reach the endpoints.
+	transport http.RoundTripper
+}
+
+// NewRouter creates a Router which routes no request until its routes are set
+// by an IngressController.
+func NewRouter() *Router {
+	return &Router{
+		table:     newRouteTable(),
+		counters:  map[string]*uint32{},
+		transport: http.DefaultTransport,
+	}
+}
+
+// setTable replaces the routes of the router.  The backends of table carry on
+// the round robin of the backends of the same name in the previous table.
+func (r *Router) setTable(table *routeTable) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	counters := map[string]*uint32{}
+	for _, b := range table.backends() {
+		counter, found := r.counters[b.name]
+		if !found {
+			counter = new(uint32)
+		}
+		b.next = counter
+		counters[b.name] = counter
+	}
+	r.counters = counters
+	r.table = table
+}
+
+func (r *Router) getTable() *routeTable {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.table
+}
+
+// GetCertificate returns the certificate served for the host name requested by
+// a TLS client. It is meant to be set as the GetCertificate field of a tls.Config.
+func (r *Router) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, found := r.getTable().certs[strings.ToLower(hello.ServerName)]
+	if !found {
+		return nil, fmt.Errorf("no certificate for host %q", hello.ServerName)
+	}
+	return cert, nil
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	host := req.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	b := r.getTable().lookup(host, req.URL.Path)
+	if b == nil {
+		http.NotFound(w, req)
+		return
+	}
+	endpoint, ok := b.nextEndpoint()
+	if !ok {
+		http.Error(w, fmt.Sprintf("no endpoints available for %s", b.name), http.StatusServiceUnavailable)
+		return
+	}
+
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	req.Header.Set("X-Forwarded-Host", req.Host)
+	req.Header.Set("X-Forwarded-Proto", scheme)
+
+	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: endpoint})
+	proxy.Transport = r.transport
+	proxy.FlushInterval = 200 * time.Millisecond
+	proxy.ServeHTTP(w, req)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestRouteMatches(t *testing.T) {
+	testCases := []struct {
+		route string
+		path  string
+		match bool
+	}{
+		{"", "/", true},
+		{"", "/foo", true},
+		{"/", "/foo", true},
+		{"/foo", "/foo", true},
+		{"/foo", "/foo/", true},
+		{"/foo", "/foo/bar", true},
+		{"/foo/", "/foo", true},
+		{"/foo", "/foobar", false},
+		{"/foo", "/", false},
+		{"/foo/bar", "/foo", false},
+	}
+	for _, tc := range testCases {
+		r := route{path: tc.route}
+		if r.matches(tc.path) != tc.match {
+			t.Errorf("expected route %q matching %q to be %v", tc.route, tc.path, tc.match)
+		}
+	}
+}
+
+func TestRouteTableLookup(t *testing.T) {
+	foo := &backend{name: "foo"}
+	bar := &backend{name: "bar"}
+	baz := &backend{name: "baz"}
+	def := &backend{name: "default"}
+
+	table := newRouteTable()
+	table.addRoute("foo.com", "/", foo)
+	table.addRoute("foo.com", "/bar", bar)
+	table.addRoute("", "/baz", baz)
+	table.addRoute("bar.com", "/bar", bar)
+	if table.addRoute("foo.com", "/bar", baz) {
+		t.Errorf("expected a duplicate route to be rejected")
+	}
+
+	testCases := []struct {
+		host     string
+		path     string
+		expected *backend
+	}{
+		{"foo.com", "/", foo},
+		{"foo.com", "/bar/baz", bar},
+		{"foo.com", "/baz", foo},
+		{"other.com", "/baz", baz},
+		{"other.com", "/foo", nil},
+		{"bar.com", "/bar", bar},
+		{"bar.com", "/baz", baz},
+		{"bar.com", "/foo", nil},
+	}
+	for _, tc := range testCases {
+		if b := table.lookup(tc.host, tc.path); b != tc.expected {
+			t.Errorf("%s%s: expected %v, got %v", tc.host, tc.path, tc.expected, b)
+		}
+	}
+
+	table.defaultBackend = def
+	if b := table.lookup("other.com", "/foo"); b != def {
+		t.Errorf("expected the default backend, got %v", b)
+	}
+}
+
+func TestBackendNextEndpoint(t *testing.T) {
+	b := &backend{next: new(uint32)}
+	if _, ok := b.nextEndpoint(); ok {
+		t.Errorf("expected no endpoint")
+	}
+	b.endpoints = []string{"1.2.3.4:80", "1.2.3.5:80"}
+	for _, expected := range []string{"1.2.3.4:80", "1.2.3.5:80", "1.2.3.4:80"} {
+		if endpoint, _ := b.nextEndpoint(); endpoint != expected {
+			t.Errorf("expected %s, got %s", expected, endpoint)
+		}
+	}
+}
+
+func TestRouterSetTableKeepsRoundRobin(t *testing.T) {
+	router := NewRouter()
+	endpoints := []string{"1.2.3.4:80", "1.2.3.5:80"}
+
+	table := newRouteTable()
+	table.addRoute("foo.com", "/", &backend{name: "foo", endpoints: endpoints})
+	router.setTable(table)
+	if endpoint, _ := router.getTable().lookup("foo.com", "/").nextEndpoint(); endpoint != "1.2.3.4:80" {
+		t.Errorf("expected 1.2.3.4:80, got %s", endpoint)
+	}
+
+	// Rebuilding the routes carries on with the next endpoint.
+	table = newRouteTable()
+	table.addRoute("foo.com", "/", &backend{name: "foo", endpoints: endpoints})
+	router.setTable(table)
+	if endpoint, _ := router.getTable().lookup("foo.com", "/").nextEndpoint(); endpoint != "1.2.3.5:80" {
+		t.Errorf("expected 1.2.3.5:80, got %s", endpoint)
+	}
+}
+
+func TestRouterServeHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "%s %s %s", req.Host, req.URL.Path, req.Header.Get("X-Forwarded-Host"))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	router := NewRouter()
+	table := newRouteTable()
+	table.addRoute("foo.com", "/foo", &backend{name: "foo", endpoints: []string{serverURL.Host}})
+	table.addRoute("foo.com", "/empty", &backend{name: "empty"})
+	router.setTable(table)
+
+	testCases := []struct {
+		host   string
+		path   string
+		code   int
+		output string
+	}{
+		{"foo.com", "/foo/bar", http.StatusOK, "foo.com /foo/bar foo.com"},
+		{"FOO.com:8080", "/foo", http.StatusOK, "FOO.com:8080 /foo FOO.com:8080"},
+		{"foo.com", "/bar", http.StatusNotFound, ""},
+		{"bar.com", "/foo", http.StatusNotFound, ""},
+		{"foo.com", "/empty", http.StatusServiceUnavailable, ""},
+	}
+	for _, tc := range testCases {
+		req, err := http.NewRequest("GET", "http://"+tc.host+tc.path, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != tc.code {
+			t.Errorf("%s%s: expected code %d, got %d", tc.host, tc.path, tc.code, w.Code)
+			continue
+		}
+		if tc.code != http.StatusOK {
+			continue
+		}
+		body, _ := ioutil.ReadAll(w.Body)
+		if string(body) != tc.output {
+			t.Errorf("%s%s: expected %q, got %q", tc.host, tc.path, tc.output, string(body))
+		}
+	}
+}
+
+func TestRouterGetCertificate(t *testing.T) {
+	cert := &tls.Certificate{}
+	router := NewRouter()
+	table := newRouteTable()
+	table.certs["foo.com"] = cert
+	router.setTable(table)
+
+	if c, err := router.GetCertificate(&tls.ClientHelloInfo{ServerName: "Foo.com"}); err != nil || c != cert {
+		t.Errorf("expected the certificate of foo.com, got %v, %v", c, err)
+	}
+	if _, err := router.GetCertificate(&tls.ClientHelloInfo{ServerName: "bar.com"}); err == nil {
+		t.Errorf("expected an error")
+	}
+}
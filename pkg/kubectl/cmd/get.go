@@ -71,6 +71,7 @@ func (f *Factory) NewCmdGet(out io.Writer) *cobra.Command {
 	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on")
 	cmd.Flags().BoolP("watch", "w", false, "After listing/getting the requested object, watch for changes.")
 	cmd.Flags().Bool("watch-only", false, "Watch for changes to the requested object(s), without listing/getting first.")
+	cmd.Flags().Int("chunk-size", 500, "Number of objects requested from the server at a time when listing large collections, or 0 to request them all at once.")
 	return cmd
 }
 
@@ -78,6 +79,7 @@ func (f *Factory) NewCmdGet(out io.Writer) *cobra.Command {
 // TODO: convert all direct flag accessors to a struct and pass that instead of cmd
 func RunGet(f *Factory, out io.Writer, cmd *cobra.Command, args []string) error {
 	selector := util.GetFlagString(cmd, "selector")
+	chunkSize := util.GetFlagInt(cmd, "chunk-size")
 	mapper, typer := f.Object()
 
 	cmdNamespace, err := f.DefaultNamespace()
@@ -91,6 +93,7 @@ func RunGet(f *Factory, out io.Writer, cmd *cobra.Command, args []string) error
 		r := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand(cmd)).
 			NamespaceParam(cmdNamespace).DefaultNamespace().
 			SelectorParam(selector).
+			RequestChunksOf(chunkSize).
 			ResourceTypeOrNameArgs(true, args...).
 			SingleResourceType().
 			Do()
@@ -140,6 +143,7 @@ func RunGet(f *Factory, out io.Writer, cmd *cobra.Command, args []string) error
 	b := resource.NewBuilder(mapper, typer, f.ClientMapperForCommand(cmd)).
 		NamespaceParam(cmdNamespace).DefaultNamespace().
 		SelectorParam(selector).
+		RequestChunksOf(chunkSize).
 		ResourceTypeOrNameArgs(true, args...).
 		Latest()
 	printer, generic, err := util.PrinterForCommand(cmd)
@@ -17,9 +17,11 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	cmdutil "github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/cmd/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubectl/resource"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -36,8 +38,9 @@ $ kubectl update -f pod.json
 // Update a pod based on the JSON passed into stdin.
 $ cat pod.json | kubectl update -f -
 
-// Update a pod by downloading it, applying the patch, then updating. Requires apiVersion be specified.
-$ kubectl update pods my-pod --patch='{ "apiVersion": "v1beta1", "desiredState": { "manifest": [{ "cpu": 100 }]}}'`
+// Update the image of the container named nginx of a pod with a strategic merge patch. The
+// containers of the pod are merged by name, the other containers are left unchanged.
+$ kubectl update pods my-pod --patch='{ "apiVersion": "v1beta3", "spec": { "containers": [{ "name": "nginx", "image": "nginx:1.7.9" }]}}'`
 )
 
 func (f *Factory) NewCmdUpdate(out io.Writer) *cobra.Command {
@@ -53,7 +56,7 @@ func (f *Factory) NewCmdUpdate(out io.Writer) *cobra.Command {
 		},
 	}
 	cmd.Flags().VarP(&filenames, "filename", "f", "Filename, directory, or URL to file to use to update the resource.")
-	cmd.Flags().String("patch", "", "A JSON document to override the existing resource. The resource is downloaded, patched with the JSON, then updated.")
+	cmd.Flags().String("patch", "", "A JSON document to patch the existing resource with, as a strategic merge patch. Lists of the resource such as containers are merged by key, unless the patch holds a $patch directive.")
 	return cmd
 }
 
@@ -129,27 +132,22 @@ func updateWithPatch(cmd *cobra.Command, args []string, f *Factory, patch string
 	if err != nil {
 		return "", err
 	}
-	client, err := f.RESTClient(mapping)
-	if err != nil {
-		return "", err
-	}
 
-	helper := resource.NewHelper(client, mapping)
-	obj, err := helper.Get(namespace, name)
-	if err != nil {
-		return "", err
+	// the patch is applied by the server to the resource in the version of the patch
+	var meta api.TypeMeta
+	if err := json.Unmarshal([]byte(patch), &meta); err != nil {
+		return "", fmt.Errorf("unable to decode the patch: %v", err)
 	}
-
-	patchedObj, err := cmdutil.Merge(obj, patch, mapping.Kind)
-	if err != nil {
-		return "", err
+	if len(meta.APIVersion) != 0 && meta.APIVersion != mapping.APIVersion {
+		if mapping, err = mapper.RESTMapping(mapping.Kind, meta.APIVersion); err != nil {
+			return "", err
+		}
 	}
-
-	data, err := helper.Codec.Encode(patchedObj)
+	client, err := f.RESTClient(mapping)
 	if err != nil {
 		return "", err
 	}
 
-	_, err = helper.Update(namespace, name, true, data)
+	_, err = resource.NewHelper(client, mapping).Patch(namespace, name, api.StrategicMergePatchType, []byte(patch))
 	return name, err
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/golang/glog"
 )
 
@@ -65,6 +66,16 @@ func DescriberFor(kind string, c *client.Client) (Describer, bool) {
 		return &PodDescriber{c}, true
 	case "ReplicationController":
 		return &ReplicationControllerDescriber{c}, true
+	case "Job":
+		return &JobDescriber{c}, true
+	case "DaemonSet":
+		return &DaemonSetDescriber{c}, true
+	case "Deployment":
+		return &DeploymentDescriber{c}, true
+	case "HorizontalPodAutoscaler":
+		return &HorizontalPodAutoscalerDescriber{c}, true
+	case "Ingress":
+		return &IngressDescriber{c}, true
 	case "Service":
 		return &ServiceDescriber{c}, true
 	case "Minion", "Node":
@@ -238,6 +249,12 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 		fmt.Fprintf(out, "Name:\t%s\n", pod.Name)
 		fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&pod.Spec))
 		fmt.Fprintf(out, "Host:\t%s\n", pod.Status.Host+"/"+pod.Status.HostIP)
+		if len(pod.Status.NominatedHost) > 0 {
+			fmt.Fprintf(out, "Nominated Host:\t%s\n", pod.Status.NominatedHost)
+		}
+		if len(pod.Spec.PriorityClassName) > 0 || pod.Spec.Priority != nil {
+			fmt.Fprintf(out, "Priority:\t%d (%s)\n", api.PodPriority(pod), pod.Spec.PriorityClassName)
+		}
 		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(pod.Labels))
 		fmt.Fprintf(out, "Status:\t%s\n", string(pod.Status.Phase))
 		fmt.Fprintf(out, "Replication Controllers:\t%s\n", printReplicationControllersByLabels(rcs))
@@ -249,6 +266,17 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 					c.Status)
 			}
 		}
+		if len(pod.Spec.InitContainers) > 0 {
+			fmt.Fprint(out, "Init Containers:\n  Name\tImage\tState\tRestarts\n")
+			for _, c := range pod.Spec.InitContainers {
+				status := pod.Status.InitContainerInfo[c.Name]
+				fmt.Fprintf(out, "  %v \t%v \t%v \t%d \n",
+					c.Name,
+					c.Image,
+					describeContainerState(status.State),
+					status.RestartCount)
+			}
+		}
 		if events != nil {
 			describeEvents(events, out)
 		}
@@ -256,6 +284,20 @@ func describePod(pod *api.Pod, rcs []api.ReplicationController, events *api.Even
 	})
 }
 
+// describeContainerState returns a short description of the state of a container.
+func describeContainerState(state api.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "Running"
+	case state.Termination != nil:
+		return fmt.Sprintf("Terminated (exit code %d)", state.Termination.ExitCode)
+	case state.Waiting != nil && len(state.Waiting.Reason) > 0:
+		return fmt.Sprintf("Waiting (%s)", state.Waiting.Reason)
+	default:
+		return "Waiting"
+	}
+}
+
 // ReplicationControllerDescriber generates information about a replication controller
 // and the pods it has created.
 type ReplicationControllerDescriber struct {
@@ -300,6 +342,218 @@ func describeReplicationController(controller *api.ReplicationController, events
 	})
 }
 
+// JobDescriber generates information about a job and the pods it has created.
+type JobDescriber struct {
+	client.Interface
+}
+
+func (d *JobDescriber) Describe(namespace, name string) (string, error) {
+	job, err := d.Jobs(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(job)
+
+	return describeJob(job, events)
+}
+
+func describeJob(job *api.Job, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", job.Name)
+		if job.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&job.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(job.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(job.Labels))
+		fmt.Fprintf(out, "Parallelism:\t%d\n", job.Spec.Parallelism)
+		fmt.Fprintf(out, "Completions:\t%d succeeded / %d desired\n", job.Status.Succeeded, job.Spec.Completions)
+		fmt.Fprintf(out, "Retry Limit:\t%d\n", job.Spec.RetryLimit)
+		fmt.Fprintf(out, "Status:\t%s\n", job.Status.Phase)
+		fmt.Fprintf(out, "Pods Status:\t%d Active / %d Succeeded / %d Failed\n", job.Status.Active, job.Status.Succeeded, job.Status.Failed)
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// DaemonSetDescriber generates information about a daemon set and the nodes it runs on.
+type DaemonSetDescriber struct {
+	client.Interface
+}
+
+func (d *DaemonSetDescriber) Describe(namespace, name string) (string, error) {
+	ds, err := d.DaemonSets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(ds)
+
+	return describeDaemonSet(ds, events)
+}
+
+func describeDaemonSet(ds *api.DaemonSet, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", ds.Name)
+		if ds.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&ds.Spec.Template.Spec))
+			fmt.Fprintf(out, "Node-Selector:\t%s\n", formatLabels(ds.Spec.Template.Spec.NodeSelector))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(ds.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(ds.Labels))
+		fmt.Fprintf(out, "Desired Number of Nodes Scheduled:\t%d\n", ds.Status.DesiredNumberScheduled)
+		fmt.Fprintf(out, "Current Number of Nodes Scheduled:\t%d\n", ds.Status.CurrentNumberScheduled)
+		fmt.Fprintf(out, "Number of Nodes Misscheduled:\t%d\n", ds.Status.NumberMisscheduled)
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// DeploymentDescriber generates information about a deployment and the progress of its rollout.
+type DeploymentDescriber struct {
+	client.Interface
+}
+
+func (d *DeploymentDescriber) Describe(namespace, name string) (string, error) {
+	deployment, err := d.Deployments(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(deployment)
+
+	return describeDeployment(deployment, events)
+}
+
+func describeDeployment(deployment *api.Deployment, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", deployment.Name)
+		if deployment.Spec.Template != nil {
+			fmt.Fprintf(out, "Image(s):\t%s\n", makeImageList(&deployment.Spec.Template.Spec))
+		} else {
+			fmt.Fprintf(out, "Image(s):\t%s\n", "<no template>")
+		}
+		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(deployment.Spec.Selector))
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(deployment.Labels))
+		fmt.Fprintf(out, "Strategy:\t%s\n", deployment.Spec.Strategy.Type)
+		if ru := deployment.Spec.Strategy.RollingUpdate; ru != nil {
+			fmt.Fprintf(out, "Rolling Update:\tmax unavailable %s, max surge %s\n", ru.MaxUnavailable.String(), ru.MaxSurge.String())
+		}
+		fmt.Fprintf(out, "Replicas:\t%d desired | %d updated | %d total | %d available | %d unavailable\n",
+			deployment.Spec.Replicas,
+			deployment.Status.UpdatedReplicas,
+			deployment.Status.Replicas,
+			deployment.Status.AvailableReplicas,
+			deployment.Status.UnavailableReplicas)
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// HorizontalPodAutoscalerDescriber generates information about a horizontal pod autoscaler
+// and the rescales it made.
+type HorizontalPodAutoscalerDescriber struct {
+	client.Interface
+}
+
+func (d *HorizontalPodAutoscalerDescriber) Describe(namespace, name string) (string, error) {
+	hpa, err := d.HorizontalPodAutoscalers(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(hpa)
+
+	return describeHorizontalPodAutoscaler(hpa, events)
+}
+
+func describeHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", hpa.Name)
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(hpa.Labels))
+		fmt.Fprintf(out, "Replication Controller:\t%s\n", hpa.Spec.ReplicationController)
+		fmt.Fprintf(out, "Target CPU utilization:\t%d%%\n", hpa.Spec.TargetCPUUtilization)
+		if hpa.Status.CurrentCPUUtilization != nil {
+			fmt.Fprintf(out, "Current CPU utilization:\t%d%%\n", *hpa.Status.CurrentCPUUtilization)
+		} else {
+			fmt.Fprintf(out, "Current CPU utilization:\t<waiting>\n")
+		}
+		fmt.Fprintf(out, "Min replicas:\t%d\n", hpa.Spec.MinReplicas)
+		fmt.Fprintf(out, "Max replicas:\t%d\n", hpa.Spec.MaxReplicas)
+		fmt.Fprintf(out, "Replicas:\t%d current / %d desired\n", hpa.Status.CurrentReplicas, hpa.Status.DesiredReplicas)
+		if hpa.Status.LastScaleTime != nil {
+			fmt.Fprintf(out, "Last scale time:\t%s\n", hpa.Status.LastScaleTime.Time.Format(time.RFC1123Z))
+		}
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+// IngressDescriber generates information about an ingress.
+type IngressDescriber struct {
+	client.Interface
+}
+
+func (d *IngressDescriber) Describe(namespace, name string) (string, error) {
+	ingress, err := d.Ingresses(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+
+	events, _ := d.Events(namespace).Search(ingress)
+
+	return describeIngress(ingress, events)
+}
+
+func describeIngress(ingress *api.Ingress, events *api.EventList) (string, error) {
+	return tabbedString(func(out io.Writer) error {
+		fmt.Fprintf(out, "Name:\t%s\n", ingress.Name)
+		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(ingress.Labels))
+		fmt.Fprintf(out, "Address:\t%s\n", ingress.Status.Address)
+		if ingress.Spec.Backend != nil {
+			fmt.Fprintf(out, "Default backend:\t%s\n", formatIngressBackend(ingress.Spec.Backend))
+		} else {
+			fmt.Fprintf(out, "Default backend:\t<none>\n")
+		}
+		for _, tls := range ingress.Spec.TLS {
+			fmt.Fprintf(out, "TLS:\t%s terminates %s\n", tls.SecretName, strings.Join(tls.Hosts, ","))
+		}
+		if len(ingress.Spec.Rules) > 0 {
+			fmt.Fprint(out, "Rules:\n  Host\tPath\tBackend\n")
+			fmt.Fprint(out, "  ----\t----\t-------\n")
+			for _, rule := range ingress.Spec.Rules {
+				host := rule.Host
+				if len(host) == 0 {
+					host = "*"
+				}
+				for _, path := range rule.Paths {
+					fmt.Fprintf(out, "  %s\t%s\t%s\n", host, path.Path, formatIngressBackend(&path.Backend))
+				}
+			}
+		}
+		if events != nil {
+			describeEvents(events, out)
+		}
+		return nil
+	})
+}
+
+func formatIngressBackend(backend *api.IngressBackend) string {
+	return fmt.Sprintf("%s:%s", backend.ServiceName, backend.ServicePort.String())
+}
+
 // ServiceDescriber generates information about a service.
 type ServiceDescriber struct {
 	client.Interface
@@ -327,13 +581,25 @@ func describeService(service *api.Service, endpoints *api.Endpoints, events *api
 		fmt.Fprintf(out, "Name:\t%s\n", service.Name)
 		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(service.Labels))
 		fmt.Fprintf(out, "Selector:\t%s\n", formatLabels(service.Spec.Selector))
+		fmt.Fprintf(out, "Type:\t%s\n", service.Spec.Type)
 		fmt.Fprintf(out, "IP:\t%s\n", service.Spec.PortalIP)
 		if len(service.Spec.PublicIPs) > 0 {
 			list := strings.Join(service.Spec.PublicIPs, ", ")
 			fmt.Fprintf(out, "Public IPs:\t%s\n", list)
 		}
-		fmt.Fprintf(out, "Port:\t%d\n", service.Spec.Port)
-		fmt.Fprintf(out, "Endpoints:\t%s\n", formatEndpoints(endpoints.Endpoints))
+		for i := range service.Spec.Ports {
+			sp := &service.Spec.Ports[i]
+
+			name := sp.Name
+			if name == "" {
+				name = "<unnamed>"
+			}
+			fmt.Fprintf(out, "Port:\t%s\t%d/%s\n", name, sp.Port, sp.Protocol)
+			if sp.NodePort != 0 {
+				fmt.Fprintf(out, "NodePort:\t%s\t%d/%s\n", name, sp.NodePort, sp.Protocol)
+			}
+			fmt.Fprintf(out, "Endpoints:\t%s\n", formatEndpoints(endpoints, util.NewStringSet(sp.Name)))
+		}
 		fmt.Fprintf(out, "Session Affinity:\t%s\n", service.Spec.SessionAffinity)
 		if events != nil {
 			describeEvents(events, out)
@@ -375,6 +641,7 @@ func describeNode(node *api.Node, pods []api.Pod, events *api.EventList) (string
 	return tabbedString(func(out io.Writer) error {
 		fmt.Fprintf(out, "Name:\t%s\n", node.Name)
 		fmt.Fprintf(out, "Labels:\t%s\n", formatLabels(node.Labels))
+		fmt.Fprintf(out, "Taints:\t%s\n", formatTaints(node.Spec.Taints))
 		fmt.Fprintf(out, "CreationTimestamp:\t%s\n", node.CreationTimestamp.Time.Format(time.RFC1123Z))
 		if len(node.Status.Conditions) > 0 {
 			fmt.Fprint(out, "Conditions:\n  Type\tStatus\tLastProbeTime\tLastTransitionTime\tReason\tMessage\n")
@@ -52,6 +52,60 @@ func TestDescribePod(t *testing.T) {
 	}
 }
 
+func TestDescribePodInitContainers(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "bar"},
+		Spec: api.PodSpec{
+			InitContainers: []api.Container{
+				{Name: "setup", Image: "busybox"},
+				{Name: "migrate", Image: "migrations"},
+			},
+			Containers: []api.Container{{Name: "app", Image: "app"}},
+		},
+		Status: api.PodStatus{
+			Phase: api.PodPending,
+			InitContainerInfo: api.PodInfo{
+				"setup": {
+					State: api.ContainerState{
+						Termination: &api.ContainerStateTerminated{ExitCode: 0},
+					},
+				},
+				"migrate": {
+					State: api.ContainerState{
+						Running: &api.ContainerStateRunning{},
+					},
+					RestartCount: 2,
+				},
+			},
+		},
+	}
+	out, err := describePod(pod, nil, nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "Init Containers:") ||
+		!strings.Contains(out, "Terminated (exit code 0)") ||
+		!strings.Contains(out, "Running") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
+func TestDescribeNodeTaints(t *testing.T) {
+	node := &api.Node{
+		ObjectMeta: api.ObjectMeta{Name: "bar"},
+		Spec: api.NodeSpec{
+			Taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}},
+		},
+	}
+	out, err := describeNode(node, nil, nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "dedicated=gpu:NoSchedule") {
+		t.Errorf("unexpected out: %s", out)
+	}
+}
+
 func TestDescribeService(t *testing.T) {
 	fake := &client.Fake{}
 	c := &describeClient{T: t, Namespace: "foo", Fake: fake}
@@ -18,6 +18,7 @@ limitations under the License.
 package kubectl
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
@@ -42,6 +43,17 @@ func formatLabels(labelMap map[string]string) string {
 	return l
 }
 
+func formatTaints(taints []api.Taint) string {
+	if len(taints) == 0 {
+		return "<none>"
+	}
+	var t []string
+	for _, taint := range taints {
+		t = append(t, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+	}
+	return strings.Join(t, ",")
+}
+
 func listOfImages(spec *api.PodSpec) []string {
 	var images []string
 	for _, container := range spec.Containers {
@@ -107,6 +119,13 @@ func expandResourceShortcut(resource string) string {
 		"ev":     "events",
 		"limits": "limitRanges",
 		"quota":  "resourceQuotas",
+		"pv":     "persistentVolumes",
+		"pvc":    "persistentVolumeClaims",
+		"ds":     "daemonSets",
+		"hpa":    "horizontalPodAutoscalers",
+		"ing":    "ingresses",
+		"pc":     "priorityClasses",
+		"pdb":    "podDisruptionBudgets",
 	}
 	if expanded, ok := shortForms[resource]; ok {
 		return expanded
@@ -44,6 +44,7 @@ type Builder struct {
 
 	selector  labels.Selector
 	selectAll bool
+	chunkSize int
 
 	resources []string
 
@@ -256,6 +257,13 @@ func (b *Builder) ResourceTypeAndNameArgs(args ...string) *Builder {
 	return b
 }
 
+// RequestChunksOf makes the lists of resources by selector request chunkSize objects at a
+// time from the server, instead of all the objects at once. 0 disables the chunking.
+func (b *Builder) RequestChunksOf(chunkSize int) *Builder {
+	b.chunkSize = chunkSize
+	return b
+}
+
 // Flatten will convert any objects with a field named "Items" that is an array of runtime.Object
 // compatible types into individual entries and give them their own items. The original object
 // is not passed to any visitors.
@@ -344,7 +352,9 @@ func (b *Builder) visitorResult() *Result {
 			if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
 				selectorNamespace = ""
 			}
-			visitors = append(visitors, NewSelector(client, mapping, selectorNamespace, b.selector))
+			selector := NewSelector(client, mapping, selectorNamespace, b.selector)
+			selector.ChunkSize = b.chunkSize
+			visitors = append(visitors, selector)
 		}
 		if b.continueOnError {
 			return &Result{visitor: EagerVisitorList(visitors), sources: visitors}
@@ -393,6 +393,33 @@ func TestSelector(t *testing.T) {
 	}
 }
 
+func TestSelectorChunks(t *testing.T) {
+	pods, _ := testData()
+	first, second := *pods, *pods
+	first.Items, first.Continue = pods.Items[:1], "next"
+	second.Items = pods.Items[1:]
+	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClientWith(t, map[string]string{
+		"/namespaces/test/pods?labels=a%3Db&limit=1":               runtime.EncodeOrDie(latest.Codec, &first),
+		"/namespaces/test/pods?continue=next&labels=a%3Db&limit=1": runtime.EncodeOrDie(latest.Codec, &second),
+	})).
+		SelectorParam("a=b").
+		NamespaceParam("test").
+		RequestChunksOf(1).
+		ResourceTypeOrNameArgs(true, "pods")
+
+	obj, err := b.Do().Object()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list, ok := obj.(*api.PodList)
+	if !ok || list.ResourceVersion != "15" || len(list.Continue) != 0 {
+		t.Fatalf("unexpected list: %#v", obj)
+	}
+	if !api.Semantic.DeepDerivative(pods.Items, list.Items) {
+		t.Errorf("unexpected items: %#v", list.Items)
+	}
+}
+
 func TestSelectorRequiresKnownTypes(t *testing.T) {
 	b := NewBuilder(latest.RESTMapper, api.Scheme, fakeClient()).
 		SelectorParam("a=b").
@@ -17,6 +17,8 @@ limitations under the License.
 package resource
 
 import (
+	"strconv"
+
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
@@ -63,12 +65,23 @@ func (m *Helper) Get(namespace, name string) (runtime.Object, error) {
 }
 
 func (m *Helper) List(namespace, apiVersion string, selector labels.Selector) (runtime.Object, error) {
-	return m.RESTClient.Get().
+	return m.ListPage(namespace, apiVersion, selector, 0, "")
+}
+
+// ListPage lists at most limit objects, or all of them if limit is 0, following the page
+// which returned continueToken if it is not empty.
+func (m *Helper) ListPage(namespace, apiVersion string, selector labels.Selector, limit int, continueToken string) (runtime.Object, error) {
+	req := m.RESTClient.Get().
 		NamespaceIfScoped(namespace, m.NamespaceScoped).
 		Resource(m.Resource).
-		LabelsSelectorParam(api.LabelSelectorQueryParam(apiVersion), selector).
-		Do().
-		Get()
+		LabelsSelectorParam(api.LabelSelectorQueryParam(apiVersion), selector)
+	if limit > 0 {
+		req.Param("limit", strconv.Itoa(limit))
+	}
+	if len(continueToken) > 0 {
+		req.Param("continue", continueToken)
+	}
+	return req.Do().Get()
 }
 
 func (m *Helper) Watch(namespace, resourceVersion, apiVersion string, labelSelector labels.Selector, fieldSelector fields.Selector) (watch.Interface, error) {
@@ -173,6 +186,18 @@ func (m *Helper) Update(namespace, name string, overwrite bool, data []byte) (ru
 	return m.updateResource(c, m.Resource, namespace, name, data)
 }
 
+// Patch applies a patch of the given type to the named resource, and returns the patched object.
+func (m *Helper) Patch(namespace, name string, pt api.PatchType, data []byte) (runtime.Object, error) {
+	return m.RESTClient.Patch().
+		NamespaceIfScoped(namespace, m.NamespaceScoped).
+		Resource(m.Resource).
+		Name(name).
+		SetHeader("Content-Type", string(pt)).
+		Body(data).
+		Do().
+		Get()
+}
+
 func (m *Helper) updateResource(c RESTClient, resource, namespace, name string, data []byte) (runtime.Object, error) {
 	return c.Put().NamespaceIfScoped(namespace, m.NamespaceScoped).Resource(resource).Name(name).Body(data).Do().Get()
 }
@@ -481,3 +481,43 @@ func TestHelperUpdate(t *testing.T) {
 		}
 	}
 }
+
+func TestHelperPatch(t *testing.T) {
+	client := &client.FakeRESTClient{
+		Codec: testapi.Codec(),
+		Resp: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       objBody(&api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}),
+		},
+	}
+	modifier := &Helper{
+		RESTClient:      client,
+		NamespaceScoped: true,
+	}
+	patch := `{"spec": {"containers": [{"name": "web", "image": "nginx"}]}}`
+	obj, err := modifier.Patch("bar", "foo", api.StrategicMergePatchType, []byte(patch))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.(*api.Pod).Name != "foo" {
+		t.Errorf("unexpected object: %#v", obj)
+	}
+	req := client.Req
+	if req.Method != "PATCH" {
+		t.Errorf("unexpected method: %#v", req)
+	}
+	parts := splitPath(req.URL.Path)
+	if parts[1] != "bar" || parts[2] != "foo" {
+		t.Errorf("url doesn't contain namespace and name: %#v", req.URL)
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != string(api.StrategicMergePatchType) {
+		t.Errorf("unexpected content type: %s", contentType)
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != patch {
+		t.Errorf("unexpected body: %s", string(body))
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 )
 
@@ -32,6 +33,9 @@ type Selector struct {
 	Mapping   *meta.RESTMapping
 	Namespace string
 	Selector  labels.Selector
+	// ChunkSize is the number of objects requested at a time, or 0 to request all the
+	// objects at once.
+	ChunkSize int
 }
 
 // NewSelector creates a resource selector which hides details of getting items by their label selector.
@@ -46,7 +50,7 @@ func NewSelector(client RESTClient, mapping *meta.RESTMapping, namespace string,
 
 // Visit implements Visitor
 func (r *Selector) Visit(fn VisitorFunc) error {
-	list, err := NewHelper(r.Client, r.Mapping).List(r.Namespace, r.ResourceMapping().APIVersion, r.Selector)
+	list, err := r.list()
 	if err != nil {
 		if errors.IsBadRequest(err) || errors.IsNotFound(err) {
 			if r.Selector.Empty() {
@@ -71,6 +75,48 @@ func (r *Selector) Visit(fn VisitorFunc) error {
 	return fn(info)
 }
 
+// list returns the list of the objects, requested ChunkSize objects at a time. The pages
+// are merged into the first one, which carries the resource version of the list.
+func (r *Selector) list() (runtime.Object, error) {
+	helper := NewHelper(r.Client, r.Mapping)
+	apiVersion := r.ResourceMapping().APIVersion
+	if r.ChunkSize == 0 {
+		return helper.List(r.Namespace, apiVersion, r.Selector)
+	}
+	list, err := helper.ListPage(r.Namespace, apiVersion, r.Selector, r.ChunkSize, "")
+	if err != nil {
+		return nil, err
+	}
+	accessor := r.Mapping.MetadataAccessor
+	continueToken, err := accessor.Continue(list)
+	if err != nil {
+		// the pages of the versions whose list metadata is not understood cannot be followed
+		return helper.List(r.Namespace, apiVersion, r.Selector)
+	}
+	items, err := runtime.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	for len(continueToken) > 0 {
+		page, err := helper.ListPage(r.Namespace, apiVersion, r.Selector, r.ChunkSize, continueToken)
+		if err != nil {
+			return nil, err
+		}
+		pageItems, err := runtime.ExtractList(page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, pageItems...)
+		if continueToken, err = accessor.Continue(page); err != nil {
+			return nil, err
+		}
+	}
+	if err := runtime.SetList(list, items); err != nil {
+		return nil, err
+	}
+	return list, accessor.SetContinue(list, "")
+}
+
 func (r *Selector) Watch(resourceVersion string) (watch.Interface, error) {
 	return NewHelper(r.Client, r.Mapping).Watch(r.Namespace, resourceVersion, r.ResourceMapping().APIVersion, r.Selector, fields.Everything())
 }
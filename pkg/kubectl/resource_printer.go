@@ -34,6 +34,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/docker/docker/pkg/units"
 	"github.com/ghodss/yaml"
 	"github.com/golang/glog"
@@ -230,7 +231,12 @@ func (h *HumanReadablePrinter) validatePrintHandlerFunc(printFunc reflect.Value)
 
 var podColumns = []string{"POD", "IP", "CONTAINER(S)", "IMAGE(S)", "HOST", "LABELS", "STATUS", "CREATED"}
 var replicationControllerColumns = []string{"CONTROLLER", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "REPLICAS"}
-var serviceColumns = []string{"NAME", "LABELS", "SELECTOR", "IP", "PORT"}
+var jobColumns = []string{"JOB", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "COMPLETIONS", "STATUS"}
+var daemonSetColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "NODE-SELECTOR", "DESIRED", "CURRENT", "MISSCHEDULED"}
+var deploymentColumns = []string{"NAME", "CONTAINER(S)", "IMAGE(S)", "SELECTOR", "DESIRED", "CURRENT", "UPDATED", "AVAILABLE"}
+var horizontalPodAutoscalerColumns = []string{"NAME", "REFERENCE", "TARGET", "CURRENT", "MINPODS", "MAXPODS", "REPLICAS"}
+var ingressColumns = []string{"NAME", "HOSTS", "ADDRESS"}
+var serviceColumns = []string{"NAME", "LABELS", "SELECTOR", "IP", "PORT(S)"}
 var endpointColumns = []string{"NAME", "ENDPOINTS"}
 var nodeColumns = []string{"NAME", "LABELS", "STATUS"}
 var statusColumns = []string{"STATUS"}
@@ -239,6 +245,13 @@ var limitRangeColumns = []string{"NAME"}
 var resourceQuotaColumns = []string{"NAME"}
 var namespaceColumns = []string{"NAME", "LABELS", "STATUS"}
 var secretColumns = []string{"NAME", "DATA"}
+var serviceAccountColumns = []string{"NAME", "SECRETS"}
+var configMapColumns = []string{"NAME", "DATA"}
+var persistentVolumeColumns = []string{"NAME", "LABELS", "CAPACITY", "ACCESSMODES", "STATUS", "CLAIM"}
+var persistentVolumeClaimColumns = []string{"NAME", "LABELS", "STATUS", "VOLUME"}
+var priorityClassColumns = []string{"NAME", "VALUE", "GLOBAL-DEFAULT"}
+var podDisruptionBudgetColumns = []string{"NAME", "SELECTOR", "MIN-AVAILABLE", "ALLOWED-DISRUPTIONS"}
+var thirdPartyResourceColumns = []string{"NAME", "DESCRIPTION", "VERSIONS"}
 
 // addDefaultHandlers adds print handlers for default Kubernetes types.
 func (h *HumanReadablePrinter) addDefaultHandlers() {
@@ -246,6 +259,16 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(podColumns, printPodList)
 	h.Handler(replicationControllerColumns, printReplicationController)
 	h.Handler(replicationControllerColumns, printReplicationControllerList)
+	h.Handler(jobColumns, printJob)
+	h.Handler(jobColumns, printJobList)
+	h.Handler(daemonSetColumns, printDaemonSet)
+	h.Handler(daemonSetColumns, printDaemonSetList)
+	h.Handler(deploymentColumns, printDeployment)
+	h.Handler(deploymentColumns, printDeploymentList)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscaler)
+	h.Handler(horizontalPodAutoscalerColumns, printHorizontalPodAutoscalerList)
+	h.Handler(ingressColumns, printIngress)
+	h.Handler(ingressColumns, printIngressList)
 	h.Handler(serviceColumns, printService)
 	h.Handler(serviceColumns, printServiceList)
 	h.Handler(endpointColumns, printEndpoints)
@@ -263,6 +286,20 @@ func (h *HumanReadablePrinter) addDefaultHandlers() {
 	h.Handler(namespaceColumns, printNamespaceList)
 	h.Handler(secretColumns, printSecret)
 	h.Handler(secretColumns, printSecretList)
+	h.Handler(serviceAccountColumns, printServiceAccount)
+	h.Handler(serviceAccountColumns, printServiceAccountList)
+	h.Handler(configMapColumns, printConfigMap)
+	h.Handler(configMapColumns, printConfigMapList)
+	h.Handler(persistentVolumeColumns, printPersistentVolume)
+	h.Handler(persistentVolumeColumns, printPersistentVolumeList)
+	h.Handler(persistentVolumeClaimColumns, printPersistentVolumeClaim)
+	h.Handler(persistentVolumeClaimColumns, printPersistentVolumeClaimList)
+	h.Handler(priorityClassColumns, printPriorityClass)
+	h.Handler(priorityClassColumns, printPriorityClassList)
+	h.Handler(podDisruptionBudgetColumns, printPodDisruptionBudget)
+	h.Handler(podDisruptionBudgetColumns, printPodDisruptionBudgetList)
+	h.Handler(thirdPartyResourceColumns, printThirdPartyResource)
+	h.Handler(thirdPartyResourceColumns, printThirdPartyResourceList)
 }
 
 func (h *HumanReadablePrinter) unknown(data []byte, w io.Writer) error {
@@ -277,14 +314,24 @@ func (h *HumanReadablePrinter) printHeader(columnNames []string, w io.Writer) er
 	return nil
 }
 
-func formatEndpoints(endpoints []api.Endpoint) string {
-	if len(endpoints) == 0 {
-		return "<none>"
-	}
+// formatEndpoints lists the ip:port pairs of endpoints. If ports is not nil,
+// only the ports with those names are listed.
+func formatEndpoints(endpoints *api.Endpoints, ports util.StringSet) string {
 	list := []string{}
-	for i := range endpoints {
-		ep := &endpoints[i]
-		list = append(list, net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port)))
+	for i := range endpoints.Subsets {
+		ss := &endpoints.Subsets[i]
+		for i := range ss.Ports {
+			port := &ss.Ports[i]
+			if ports == nil || ports.Has(port.Name) {
+				for i := range ss.Addresses {
+					addr := &ss.Addresses[i]
+					list = append(list, net.JoinHostPort(addr.IP, strconv.Itoa(port.Port)))
+				}
+			}
+		}
+	}
+	if len(list) == 0 {
+		return "<none>"
 	}
 	return strings.Join(list, ",")
 }
@@ -372,12 +419,186 @@ func printReplicationControllerList(list *api.ReplicationControllerList, w io.Wr
 	return nil
 }
 
-func printService(svc *api.Service, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", svc.Name, formatLabels(svc.Labels),
-		formatLabels(svc.Spec.Selector), svc.Spec.PortalIP, svc.Spec.Port)
+func printJob(job *api.Job, w io.Writer) error {
+	containers := job.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d/%d\t%s\n",
+		job.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(job.Spec.Selector),
+		job.Status.Succeeded,
+		job.Spec.Completions,
+		job.Status.Phase)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printJobList(list *api.JobList, w io.Writer) error {
+	for _, job := range list.Items {
+		if err := printJob(&job, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSet(ds *api.DaemonSet, w io.Writer) error {
+	containers := ds.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\n",
+		ds.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(ds.Spec.Selector),
+		formatLabels(ds.Spec.Template.Spec.NodeSelector),
+		ds.Status.DesiredNumberScheduled,
+		ds.Status.CurrentNumberScheduled,
+		ds.Status.NumberMisscheduled)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "", "", "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDaemonSetList(list *api.DaemonSetList, w io.Writer) error {
+	for _, ds := range list.Items {
+		if err := printDaemonSet(&ds, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeployment(deployment *api.Deployment, w io.Writer) error {
+	containers := deployment.Spec.Template.Spec.Containers
+	var firstContainer api.Container
+	if len(containers) > 0 {
+		firstContainer, containers = containers[0], containers[1:]
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+		deployment.Name,
+		firstContainer.Name,
+		firstContainer.Image,
+		formatLabels(deployment.Spec.Selector),
+		deployment.Spec.Replicas,
+		deployment.Status.Replicas,
+		deployment.Status.UpdatedReplicas,
+		deployment.Status.AvailableReplicas)
+	if err != nil {
+		return err
+	}
+	// Lay out all the other containers on separate lines.
+	for _, container := range containers {
+		_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", "", container.Name, container.Image, "", "", "", "", "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printDeploymentList(list *api.DeploymentList, w io.Writer) error {
+	for _, deployment := range list.Items {
+		if err := printDeployment(&deployment, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printHorizontalPodAutoscaler(hpa *api.HorizontalPodAutoscaler, w io.Writer) error {
+	current := "<waiting>"
+	if hpa.Status.CurrentCPUUtilization != nil {
+		current = fmt.Sprintf("%d%%", *hpa.Status.CurrentCPUUtilization)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%d%%\t%s\t%d\t%d\t%d\n",
+		hpa.Name,
+		hpa.Spec.ReplicationController,
+		hpa.Spec.TargetCPUUtilization,
+		current,
+		hpa.Spec.MinReplicas,
+		hpa.Spec.MaxReplicas,
+		hpa.Status.CurrentReplicas)
+	return err
+}
+
+func printHorizontalPodAutoscalerList(list *api.HorizontalPodAutoscalerList, w io.Writer) error {
+	for _, hpa := range list.Items {
+		if err := printHorizontalPodAutoscaler(&hpa, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printIngress(ingress *api.Ingress, w io.Writer) error {
+	hosts := []string{}
+	for _, rule := range ingress.Spec.Rules {
+		if len(rule.Host) == 0 {
+			hosts = append(hosts, "*")
+		} else {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n",
+		ingress.Name,
+		strings.Join(hosts, ","),
+		ingress.Status.Address)
 	return err
 }
 
+func printIngressList(list *api.IngressList, w io.Writer) error {
+	for _, ingress := range list.Items {
+		if err := printIngress(&ingress, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printService(svc *api.Service, w io.Writer) error {
+	ports := "<none>"
+	if len(svc.Spec.Ports) > 0 {
+		ports = fmt.Sprintf("%d/%s", svc.Spec.Ports[0].Port, svc.Spec.Ports[0].Protocol)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", svc.Name, formatLabels(svc.Labels),
+		formatLabels(svc.Spec.Selector), svc.Spec.PortalIP, ports)
+	if err != nil {
+		return err
+	}
+	// Any remaining ports go on their own lines.
+	for i := 1; i < len(svc.Spec.Ports); i++ {
+		port := &svc.Spec.Ports[i]
+		if _, err := fmt.Fprintf(w, "\t\t\t\t%d/%s\n", port.Port, port.Protocol); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printServiceList(list *api.ServiceList, w io.Writer) error {
 	for _, svc := range list.Items {
 		if err := printService(&svc, w); err != nil {
@@ -388,7 +609,7 @@ func printServiceList(list *api.ServiceList, w io.Writer) error {
 }
 
 func printEndpoints(endpoint *api.Endpoints, w io.Writer) error {
-	_, err := fmt.Fprintf(w, "%s\t%s\n", endpoint.Name, formatEndpoints(endpoint.Endpoints))
+	_, err := fmt.Fprintf(w, "%s\t%s\n", endpoint.Name, formatEndpoints(endpoint, nil))
 	return err
 }
 
@@ -430,6 +651,119 @@ func printSecretList(list *api.SecretList, w io.Writer) error {
 	return nil
 }
 
+func printServiceAccount(item *api.ServiceAccount, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%d\n", item.Name, len(item.Secrets))
+	return err
+}
+
+func printServiceAccountList(list *api.ServiceAccountList, w io.Writer) error {
+	for _, item := range list.Items {
+		if err := printServiceAccount(&item, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printConfigMap(item *api.ConfigMap, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%d\n", item.Name, len(item.Data))
+	return err
+}
+
+func printConfigMapList(list *api.ConfigMapList, w io.Writer) error {
+	for _, item := range list.Items {
+		if err := printConfigMap(&item, w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printPersistentVolume(pv *api.PersistentVolume, w io.Writer) error {
+	claim := ""
+	if pv.Spec.ClaimRef != nil {
+		claim = pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+	}
+	modes := []string{}
+	for _, mode := range pv.Spec.AccessModes {
+		modes = append(modes, string(mode))
+	}
+	capacity := pv.Spec.Capacity[api.ResourceStorage]
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", pv.Name, formatLabels(pv.Labels), capacity.String(), strings.Join(modes, ","), pv.Status.Phase, claim)
+	return err
+}
+
+func printPersistentVolumeList(list *api.PersistentVolumeList, w io.Writer) error {
+	for _, pv := range list.Items {
+		if err := printPersistentVolume(&pv, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPersistentVolumeClaim(pvc *api.PersistentVolumeClaim, w io.Writer) error {
+	volumeName := ""
+	if pvc.Status.VolumeRef != nil {
+		volumeName = pvc.Status.VolumeRef.Name
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", pvc.Name, formatLabels(pvc.Labels), pvc.Status.Phase, volumeName)
+	return err
+}
+
+func printPersistentVolumeClaimList(list *api.PersistentVolumeClaimList, w io.Writer) error {
+	for _, pvc := range list.Items {
+		if err := printPersistentVolumeClaim(&pvc, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPriorityClass(pc *api.PriorityClass, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%d\t%t\n", pc.Name, pc.Value, pc.GlobalDefault)
+	return err
+}
+
+func printPriorityClassList(list *api.PriorityClassList, w io.Writer) error {
+	for _, pc := range list.Items {
+		if err := printPriorityClass(&pc, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPodDisruptionBudget(pdb *api.PodDisruptionBudget, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", pdb.Name, formatLabels(pdb.Spec.Selector), pdb.Spec.MinAvailable, pdb.Status.PodDisruptionsAllowed)
+	return err
+}
+
+func printPodDisruptionBudgetList(list *api.PodDisruptionBudgetList, w io.Writer) error {
+	for _, pdb := range list.Items {
+		if err := printPodDisruptionBudget(&pdb, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printThirdPartyResource(rsrc *api.ThirdPartyResource, w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\n", rsrc.Name, rsrc.Description, strings.Join(rsrc.Versions, ","))
+	return err
+}
+
+func printThirdPartyResourceList(list *api.ThirdPartyResourceList, w io.Writer) error {
+	for _, rsrc := range list.Items {
+		if err := printThirdPartyResource(&rsrc, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printNode(node *api.Node, w io.Writer) error {
 	conditionMap := make(map[api.NodeConditionType]*api.NodeCondition)
 	NodeAllConditions := []api.NodeConditionType{api.NodeSchedulable, api.NodeReady, api.NodeReachable}
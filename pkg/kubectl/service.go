@@ -34,6 +34,7 @@ func (ServiceGenerator) ParamNames() []GeneratorParam {
 		{"port", true},
 		{"public-ip", false},
 		{"create-external-load-balancer", false},
+		{"type", false},
 		{"protocol", false},
 		{"container-port", false}, // alias of target-port
 		{"target-port", false},
@@ -71,9 +72,13 @@ func (ServiceGenerator) Generate(params map[string]string) (runtime.Object, erro
 			Labels: labels,
 		},
 		Spec: api.ServiceSpec{
-			Port:     port,
-			Protocol: api.Protocol(params["protocol"]),
 			Selector: selector,
+			Ports: []api.ServicePort{
+				{
+					Port:     port,
+					Protocol: api.Protocol(params["protocol"]),
+				},
+			},
 		},
 	}
 	targetPort, found := params["target-port"]
@@ -82,15 +87,18 @@ func (ServiceGenerator) Generate(params map[string]string) (runtime.Object, erro
 	}
 	if found && len(targetPort) > 0 {
 		if portNum, err := strconv.Atoi(targetPort); err != nil {
-			service.Spec.TargetPort = util.NewIntOrStringFromString(targetPort)
+			service.Spec.Ports[0].TargetPort = util.NewIntOrStringFromString(targetPort)
 		} else {
-			service.Spec.TargetPort = util.NewIntOrStringFromInt(portNum)
+			service.Spec.Ports[0].TargetPort = util.NewIntOrStringFromInt(portNum)
 		}
 	} else {
-		service.Spec.TargetPort = util.NewIntOrStringFromInt(port)
+		service.Spec.Ports[0].TargetPort = util.NewIntOrStringFromInt(port)
 	}
 	if params["create-external-load-balancer"] == "true" {
-		service.Spec.CreateExternalLoadBalancer = true
+		service.Spec.Type = api.ServiceTypeLoadBalancer
+	}
+	if len(params["type"]) != 0 {
+		service.Spec.Type = api.ServiceType(params["type"])
 	}
 	if len(params["public-ip"]) != 0 {
 		service.Spec.PublicIPs = []string{params["public-ip"]}
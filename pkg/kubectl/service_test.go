@@ -46,9 +46,7 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:       80,
-					Protocol:   "TCP",
-					TargetPort: util.NewIntOrStringFromInt(1234),
+					Ports: []api.ServicePort{{Port: 80, Protocol: "TCP", TargetPort: util.NewIntOrStringFromInt(1234)}},
 				},
 			},
 		},
@@ -69,9 +67,7 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:       80,
-					Protocol:   "UDP",
-					TargetPort: util.NewIntOrStringFromString("foobar"),
+					Ports: []api.ServicePort{{Port: 80, Protocol: "UDP", TargetPort: util.NewIntOrStringFromString("foobar")}},
 				},
 			},
 		},
@@ -97,9 +93,7 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:       80,
-					Protocol:   "TCP",
-					TargetPort: util.NewIntOrStringFromInt(1234),
+					Ports: []api.ServicePort{{Port: 80, Protocol: "TCP", TargetPort: util.NewIntOrStringFromInt(1234)}},
 				},
 			},
 		},
@@ -121,10 +115,8 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:       80,
-					Protocol:   "UDP",
-					PublicIPs:  []string{"1.2.3.4"},
-					TargetPort: util.NewIntOrStringFromString("foobar"),
+					Ports:     []api.ServicePort{{Port: 80, Protocol: "UDP", TargetPort: util.NewIntOrStringFromString("foobar")}},
+					PublicIPs: []string{"1.2.3.4"},
 				},
 			},
 		},
@@ -147,11 +139,30 @@ func TestGenerateService(t *testing.T) {
 						"foo": "bar",
 						"baz": "blah",
 					},
-					Port:                       80,
-					Protocol:                   "UDP",
-					PublicIPs:                  []string{"1.2.3.4"},
-					TargetPort:                 util.NewIntOrStringFromString("foobar"),
-					CreateExternalLoadBalancer: true,
+					Ports:     []api.ServicePort{{Port: 80, Protocol: "UDP", TargetPort: util.NewIntOrStringFromString("foobar")}},
+					PublicIPs: []string{"1.2.3.4"},
+					Type:      api.ServiceTypeLoadBalancer,
+				},
+			},
+		},
+		{
+			params: map[string]string{
+				"selector": "foo=bar,baz=blah",
+				"name":     "test",
+				"port":     "80",
+				"type":     "NodePort",
+			},
+			expected: api.Service{
+				ObjectMeta: api.ObjectMeta{
+					Name: "test",
+				},
+				Spec: api.ServiceSpec{
+					Selector: map[string]string{
+						"foo": "bar",
+						"baz": "blah",
+					},
+					Ports: []api.ServicePort{{Port: 80, TargetPort: util.NewIntOrStringFromInt(80)}},
+					Type:  api.ServiceTypeNodePort,
 				},
 			},
 		},
@@ -46,6 +46,19 @@ const (
 	DockerPrefix          = "docker://"
 )
 
+const (
+	// Taken from lmctfy https://github.com/google/lmctfy/blob/master/lmctfy/controllers/cpu_controller.cc
+	minShares     = 2
+	sharesPerCPU  = 1024
+	milliCPUToCPU = 1000
+
+	// The default CFS period of docker, which the CPU quota of containers is
+	// relative to, in microseconds.
+	quotaPeriod = 100000
+	// The smallest CPU quota the kernel accepts, in microseconds.
+	minQuotaPeriod = 1000
+)
+
 // DockerInterface is an abstract interface for testability.  It abstracts the interface of docker.Client.
 type DockerInterface interface {
 	ListContainers(options docker.ListContainersOptions) ([]docker.APIContainers, error)
@@ -64,6 +77,34 @@ type DockerInterface interface {
 	StartExec(string, docker.StartExecOptions) error
 }
 
+// MilliCPUToShares converts a CPU request to the CPU shares of a container.
+func MilliCPUToShares(milliCPU int64) int64 {
+	if milliCPU == 0 {
+		// zero milliCPU means unset. Use kernel default.
+		return 0
+	}
+	// Conceptually (milliCPU / milliCPUToCPU) * sharesPerCPU, but factored to improve rounding.
+	shares := (milliCPU * sharesPerCPU) / milliCPUToCPU
+	if shares < minShares {
+		return minShares
+	}
+	return shares
+}
+
+// MilliCPUToQuota converts a CPU limit to the CFS quota of a container over the
+// default CFS period of docker.
+func MilliCPUToQuota(milliCPU int64) int64 {
+	if milliCPU == 0 {
+		// zero milliCPU means unset. Don't limit the CPU usage.
+		return 0
+	}
+	quota := (milliCPU * quotaPeriod) / milliCPUToCPU
+	if quota < minQuotaPeriod {
+		return minQuotaPeriod
+	}
+	return quota
+}
+
 // DockerID is an ID of docker container. It is a type to make it clear when we're working with docker container Ids
 type DockerID string
 
@@ -625,6 +666,14 @@ func GetDockerPodStatus(client DockerInterface, manifest api.PodSpec, podFullNam
 		expectedContainers[container.Name] = container
 	}
 	expectedContainers[PodInfraContainerName] = api.Container{}
+	initContainers := make(map[string]bool)
+	for _, container := range manifest.InitContainers {
+		expectedContainers[container.Name] = container
+		initContainers[container.Name] = true
+	}
+	if len(initContainers) > 0 {
+		podStatus.InitContainerInfo = api.PodInfo{}
+	}
 
 	containers, err := client.ListContainers(docker.ListContainersOptions{All: true})
 	if err != nil {
@@ -654,10 +703,14 @@ func GetDockerPodStatus(client DockerInterface, manifest api.PodSpec, podFullNam
 		} else {
 			terminationMessagePath = c.TerminationMessagePath
 		}
+		info := podStatus.Info
+		if initContainers[dockerContainerName] {
+			info = podStatus.InitContainerInfo
+		}
 		// We assume docker return us a list of containers in time order
-		if containerStatus, found := podStatus.Info[dockerContainerName]; found {
+		if containerStatus, found := info[dockerContainerName]; found {
 			containerStatus.RestartCount += 1
-			podStatus.Info[dockerContainerName] = containerStatus
+			info[dockerContainerName] = containerStatus
 			continue
 		}
 
@@ -670,11 +723,11 @@ func GetDockerPodStatus(client DockerInterface, manifest api.PodSpec, podFullNam
 			// Found network container
 			podStatus.PodIP = result.ip
 		} else {
-			podStatus.Info[dockerContainerName] = result.status
+			info[dockerContainerName] = result.status
 		}
 	}
 
-	if len(podStatus.Info) == 0 && podStatus.PodIP == "" {
+	if len(podStatus.Info) == 0 && len(podStatus.InitContainerInfo) == 0 && podStatus.PodIP == "" {
 		return nil, ErrNoContainersInPod
 	}
 
@@ -637,3 +637,25 @@ func TestFindContainersByPod(t *testing.T) {
 		}
 	}
 }
+
+func TestMilliCPUToSharesAndQuota(t *testing.T) {
+	tests := []struct {
+		milliCPU int64
+		shares   int64
+		quota    int64
+	}{
+		{milliCPU: 0, shares: 0, quota: 0},
+		{milliCPU: 1, shares: minShares, quota: minQuotaPeriod},
+		{milliCPU: 250, shares: 256, quota: 25000},
+		{milliCPU: 1000, shares: 1024, quota: 100000},
+		{milliCPU: 2500, shares: 2560, quota: 250000},
+	}
+	for _, test := range tests {
+		if shares := MilliCPUToShares(test.milliCPU); shares != test.shares {
+			t.Errorf("%dm: expected %d shares, got %d", test.milliCPU, test.shares, shares)
+		}
+		if quota := MilliCPUToQuota(test.milliCPU); quota != test.quota {
+			t.Errorf("%dm: expected a quota of %d, got %d", test.milliCPU, test.quota, quota)
+		}
+	}
+}
@@ -30,45 +30,51 @@ func TestFromServices(t *testing.T) {
 			{
 				ObjectMeta: api.ObjectMeta{Name: "foo-bar"},
 				Spec: api.ServiceSpec{
-					Port:     8080,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports: []api.ServicePort{
+						{Port: 8080, Protocol: "TCP"},
+					},
 					PortalIP: "1.2.3.4",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "abc-123"},
 				Spec: api.ServiceSpec{
-					Port:     8081,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "UDP",
+					Ports: []api.ServicePort{
+						{Port: 8081, Protocol: "UDP"},
+					},
 					PortalIP: "5.6.7.8",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "q-u-u-x"},
 				Spec: api.ServiceSpec{
-					Port:     8082,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports: []api.ServicePort{
+						{Name: "u-d-p", Port: 8083, Protocol: "UDP"},
+						{Name: "t-c-p", Port: 8084, Protocol: "TCP"},
+					},
 					PortalIP: "9.8.7.6",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "svrc-portalip-none"},
 				Spec: api.ServiceSpec{
-					Port:     8082,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports: []api.ServicePort{
+						{Port: 8082, Protocol: "TCP"},
+					},
 					PortalIP: "None",
 				},
 			},
 			{
 				ObjectMeta: api.ObjectMeta{Name: "svrc-portalip-empty"},
 				Spec: api.ServiceSpec{
-					Port:     8082,
 					Selector: map[string]string{"bar": "baz"},
-					Protocol: "TCP",
+					Ports: []api.ServicePort{
+						{Port: 8082, Protocol: "TCP"},
+					},
 					PortalIP: "",
 				},
 			},
@@ -91,12 +97,18 @@ func TestFromServices(t *testing.T) {
 		{Name: "ABC_123_PORT_8081_UDP_PORT", Value: "8081"},
 		{Name: "ABC_123_PORT_8081_UDP_ADDR", Value: "5.6.7.8"},
 		{Name: "Q_U_U_X_SERVICE_HOST", Value: "9.8.7.6"},
-		{Name: "Q_U_U_X_SERVICE_PORT", Value: "8082"},
-		{Name: "Q_U_U_X_PORT", Value: "tcp://9.8.7.6:8082"},
-		{Name: "Q_U_U_X_PORT_8082_TCP", Value: "tcp://9.8.7.6:8082"},
-		{Name: "Q_U_U_X_PORT_8082_TCP_PROTO", Value: "tcp"},
-		{Name: "Q_U_U_X_PORT_8082_TCP_PORT", Value: "8082"},
-		{Name: "Q_U_U_X_PORT_8082_TCP_ADDR", Value: "9.8.7.6"},
+		{Name: "Q_U_U_X_SERVICE_PORT", Value: "8083"},
+		{Name: "Q_U_U_X_SERVICE_PORT_U_D_P", Value: "8083"},
+		{Name: "Q_U_U_X_SERVICE_PORT_T_C_P", Value: "8084"},
+		{Name: "Q_U_U_X_PORT", Value: "udp://9.8.7.6:8083"},
+		{Name: "Q_U_U_X_PORT_8083_UDP", Value: "udp://9.8.7.6:8083"},
+		{Name: "Q_U_U_X_PORT_8083_UDP_PROTO", Value: "udp"},
+		{Name: "Q_U_U_X_PORT_8083_UDP_PORT", Value: "8083"},
+		{Name: "Q_U_U_X_PORT_8083_UDP_ADDR", Value: "9.8.7.6"},
+		{Name: "Q_U_U_X_PORT_8084_TCP", Value: "tcp://9.8.7.6:8084"},
+		{Name: "Q_U_U_X_PORT_8084_TCP_PROTO", Value: "tcp"},
+		{Name: "Q_U_U_X_PORT_8084_TCP_PORT", Value: "8084"},
+		{Name: "Q_U_U_X_PORT_8084_TCP_ADDR", Value: "9.8.7.6"},
 	}
 	if len(vars) != len(expected) {
 		t.Errorf("Expected %d env vars, got: %+v", len(expected), vars)
@@ -108,3 +120,36 @@ func TestFromServices(t *testing.T) {
 		}
 	}
 }
+
+func TestPodFieldValue(t *testing.T) {
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "foo",
+			Namespace:   "bar",
+			Labels:      map[string]string{"zone": "us-east", "app": "web"},
+			Annotations: map[string]string{"note": `say "hi"`},
+		},
+		Status: api.PodStatus{PodIP: "1.2.3.4"},
+	}
+	expected := map[string]string{
+		"metadata.name":        "foo",
+		"metadata.namespace":   "bar",
+		"metadata.labels":      "app=\"web\"\nzone=\"us-east\"\n",
+		"metadata.annotations": "note=\"say \\\"hi\\\"\"\n",
+	}
+	for fieldPath, value := range expected {
+		actual, err := envvars.PodFieldValue(pod, fieldPath)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", fieldPath, err)
+			continue
+		}
+		if actual != value {
+			t.Errorf("%s: expected %q, got %q", fieldPath, value, actual)
+		}
+	}
+	for _, fieldPath := range []string{"spec.host", "status.podIP"} {
+		if _, err := envvars.PodFieldValue(pod, fieldPath); err == nil {
+			t.Errorf("%s: expected an error for an unsupported field", fieldPath)
+		}
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/capabilities"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
@@ -60,11 +61,6 @@ import (
 )
 
 const (
-	// Taken from lmctfy https://github.com/google/lmctfy/blob/master/lmctfy/controllers/cpu_controller.cc
-	minShares     = 2
-	sharesPerCPU  = 1024
-	milliCPUToCPU = 1000
-
 	// The oom_score_adj of the POD infrastructure container. The default is 0, so
 	// any value below that makes it *less* likely to get OOM killed.
 	podOomScoreAdj = -100
@@ -617,19 +613,6 @@ func makePortsAndBindings(container *api.Container) (map[docker.Port]struct{}, m
 	return exposedPorts, portBindings
 }
 
-func milliCPUToShares(milliCPU int64) int64 {
-	if milliCPU == 0 {
-		// zero milliCPU means unset. Use kernel default.
-		return 0
-	}
-	// Conceptually (milliCPU / milliCPUToCPU) * sharesPerCPU, but factored to improve rounding.
-	shares := (milliCPU * sharesPerCPU) / milliCPUToCPU
-	if shares < minShares {
-		return minShares
-	}
-	return shares
-}
-
 func makeCapabilites(capAdd []api.CapabilityType, capDrop []api.CapabilityType) ([]string, []string) {
 	var (
 		addCaps  []string
@@ -731,13 +714,13 @@ func (kl *Kubelet) getRef(id string) (ref *api.ObjectReference, ok bool) {
 }
 
 // Run a single container from a pod. Returns the docker container ID
-func (kl *Kubelet) runContainer(pod *api.Pod, container *api.Container, podVolumes volumeMap, netMode, ipcMode string) (id dockertools.DockerID, err error) {
+func (kl *Kubelet) runContainer(pod *api.Pod, container *api.Container, podVolumes volumeMap, netMode, ipcMode, podIP string) (id dockertools.DockerID, err error) {
 	ref, err := containerRef(pod, container)
 	if err != nil {
 		glog.Errorf("Couldn't make a ref to pod %v, container %v: '%v'", pod.Name, container.Name, err)
 	}
 
-	envVariables, err := kl.makeEnvironmentVariables(pod.Namespace, container)
+	envVariables, err := kl.makeEnvironmentVariables(pod, container, podIP)
 	if err != nil {
 		return "", err
 	}
@@ -760,7 +743,7 @@ func (kl *Kubelet) runContainer(pod *api.Pod, container *api.Container, podVolum
 			Hostname:     containerHostname,
 			Image:        container.Image,
 			Memory:       container.Resources.Limits.Memory().Value(),
-			CPUShares:    milliCPUToShares(container.Resources.Limits.Cpu().MilliValue()),
+			CPUShares:    dockertools.MilliCPUToShares(container.Resources.Requests.Cpu().MilliValue()),
 			WorkingDir:   container.WorkingDir,
 		},
 	}
@@ -802,6 +785,9 @@ func (kl *Kubelet) runContainer(pod *api.Pod, container *api.Container, podVolum
 	}
 
 	capAdd, capDrop := makeCapabilites(container.Capabilities.Add, container.Capabilities.Drop)
+	// TODO: enforce the CPU limit by setting CPUQuota to
+	// dockertools.MilliCPUToQuota(container.Resources.Limits.Cpu().MilliValue()) once
+	// the vendored go-dockerclient has HostConfig.CPUQuota.
 	hc := &docker.HostConfig{
 		PortBindings: portBindings,
 		Binds:        binds,
@@ -894,7 +880,7 @@ func (kl *Kubelet) getServiceEnvVarMap(ns string) (map[string]string, error) {
 }
 
 // Make the service environment variables for a pod in the given namespace.
-func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container) ([]string, error) {
+func (kl *Kubelet) makeEnvironmentVariables(pod *api.Pod, container *api.Container, podIP string) ([]string, error) {
 	var result []string
 	// Note:  These are added to the docker.Config, but are not included in the checksum computed
 	// by dockertools.BuildDockerName(...).  That way, we can still determine whether an
@@ -905,11 +891,13 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 	// To avoid this users can: (1) wait between starting a service and starting; or (2) detect
 	// missing service env var and exit and be restarted; or (3) use DNS instead of env vars
 	// and keep trying to resolve the DNS name of the service (recommended).
+	ns := pod.Namespace
 	serviceEnv, err := kl.getServiceEnvVarMap(ns)
 	if err != nil {
 		return result, err
 	}
 
+	configMaps := map[string]*api.ConfigMap{}
 	for _, value := range container.Env {
 		// Accesses apiserver+Pods.
 		// So, the master may set service env vars, or kubelet may.  In case both are doing
@@ -917,7 +905,19 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 		// env vars.
 		// TODO: remove this net line once all platforms use apiserver+Pods.
 		delete(serviceEnv, value.Name)
-		result = append(result, fmt.Sprintf("%s=%s", value.Name, value.Value))
+		runtimeValue := value.Value
+		if value.ValueFrom != nil {
+			switch {
+			case value.ValueFrom.ConfigMapKeyRef != nil:
+				runtimeValue, err = kl.configMapKeyValue(ns, value.ValueFrom.ConfigMapKeyRef, configMaps)
+			case value.ValueFrom.FieldRef != nil:
+				runtimeValue, err = podFieldValue(pod, podIP, value.ValueFrom.FieldRef.FieldPath)
+			}
+			if err != nil {
+				return result, err
+			}
+		}
+		result = append(result, fmt.Sprintf("%s=%s", value.Name, runtimeValue))
 	}
 
 	// Append remaining service env vars.
@@ -927,6 +927,39 @@ func (kl *Kubelet) makeEnvironmentVariables(ns string, container *api.Container)
 	return result, nil
 }
 
+// podFieldValue returns the value of the selected field of pod. The IP of the
+// pod is passed separately, since the status of a pod being started does not
+// carry it yet.
+func podFieldValue(pod *api.Pod, podIP, fieldPath string) (string, error) {
+	if fieldPath == "status.podIP" {
+		return podIP, nil
+	}
+	return envvars.PodFieldValue(pod, fieldPath)
+}
+
+// configMapKeyValue returns the value of the config map key selected by ref.
+// Config maps are fetched once and remembered in configMaps, so that a container
+// referring to several keys of the same map sees a consistent view of it.
+func (kl *Kubelet) configMapKeyValue(ns string, ref *api.ConfigMapKeySelector, configMaps map[string]*api.ConfigMap) (string, error) {
+	configMap, ok := configMaps[ref.Name]
+	if !ok {
+		if kl.kubeClient == nil {
+			return "", fmt.Errorf("cannot read config map %q because kube client is not configured", ref.Name)
+		}
+		var err error
+		configMap, err = kl.kubeClient.ConfigMaps(ns).Get(ref.Name)
+		if err != nil {
+			return "", err
+		}
+		configMaps[ref.Name] = configMap
+	}
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("couldn't find key %q in config map %s/%s", ref.Key, ns, ref.Name)
+	}
+	return value, nil
+}
+
 func (kl *Kubelet) applyClusterDNS(hc *docker.HostConfig, pod *api.Pod) error {
 	// Get host DNS settings and append them to cluster DNS settings.
 	f, err := os.Open("/etc/resolv.conf")
@@ -989,9 +1022,72 @@ func (kl *Kubelet) killContainer(c *kubecontainer.Container) error {
 }
 
 func (kl *Kubelet) killContainerByID(ID string) error {
+	return kl.stopContainer(ID, 10)
+}
+
+// minimumGracePeriodInSeconds is the shortest time containers are given to stop after
+// receiving the termination signal.
+const minimumGracePeriodInSeconds = 2
+
+// podTerminationGracePeriod returns the number of seconds the containers of a pod are
+// given to terminate gracefully. For a pod being deleted, this is the time left until its
+// DeletionTimestamp, which is set to the end of the grace period: the kubelet may only
+// notice the deletion some time after it was requested.
+func podTerminationGracePeriod(pod *api.Pod) int64 {
+	switch {
+	case pod.DeletionGracePeriodSeconds != nil:
+		gracePeriod := *pod.DeletionGracePeriodSeconds
+		if pod.DeletionTimestamp != nil {
+			remaining := int64(pod.DeletionTimestamp.Sub(util.Now().Time).Seconds())
+			if remaining < gracePeriod {
+				gracePeriod = remaining
+			}
+		}
+		if gracePeriod < 0 {
+			gracePeriod = 0
+		}
+		return gracePeriod
+	case pod.Spec.TerminationGracePeriodSeconds != nil:
+		return *pod.Spec.TerminationGracePeriodSeconds
+	}
+	return api.DefaultTerminationGracePeriodSeconds
+}
+
+// killContainerInPod gracefully stops a container of a pod: the PreStop handler of the
+// container is run, then the container is sent the termination signal, and is killed if it
+// is still running once the grace period of the pod is over.
+func (kl *Kubelet) killContainerInPod(c *kubecontainer.Container, container *api.Container, pod *api.Pod) error {
+	gracePeriod := podTerminationGracePeriod(pod)
+	if container.Lifecycle != nil && container.Lifecycle.PreStop != nil {
+		podFullName := kubecontainer.GetPodFullName(pod)
+		start := time.Now()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer util.HandleCrash()
+			if err := kl.runHandler(podFullName, pod.UID, container, container.Lifecycle.PreStop); err != nil {
+				glog.Errorf("PreStop handler for container %q of pod %q failed: %v", container.Name, podFullName, err)
+			}
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Duration(gracePeriod) * time.Second):
+			glog.Warningf("PreStop handler for container %q of pod %q did not complete within %d seconds", container.Name, podFullName, gracePeriod)
+		}
+		gracePeriod -= int64(time.Since(start).Seconds())
+	}
+	if gracePeriod < minimumGracePeriodInSeconds {
+		gracePeriod = minimumGracePeriodInSeconds
+	}
+	return kl.stopContainer(string(c.ID), uint(gracePeriod))
+}
+
+// stopContainer sends the termination signal to a container, and kills it if it is still
+// running after timeout seconds.
+func (kl *Kubelet) stopContainer(ID string, timeout uint) error {
 	glog.V(2).Infof("Killing container with id %q", ID)
 	kl.readiness.remove(ID)
-	err := kl.dockerClient.StopContainer(ID, 10)
+	err := kl.dockerClient.StopContainer(ID, timeout)
 
 	ref, ok := kl.getRef(ID)
 	if !ok {
@@ -1040,7 +1136,7 @@ func (kl *Kubelet) createPodInfraContainer(pod *api.Pod) (dockertools.DockerID,
 	if ref != nil {
 		kl.recorder.Eventf(ref, "pulled", "Successfully pulled image %q", container.Image)
 	}
-	id, err := kl.runContainer(pod, container, nil, "", "")
+	id, err := kl.runContainer(pod, container, nil, "", "", "")
 	if err != nil {
 		return "", err
 	}
@@ -1085,7 +1181,8 @@ func (kl *Kubelet) killContainersInPod(pod *api.Pod, runningPod kubecontainer.Po
 	count := 0
 	errs := make(chan error, len(pod.Spec.Containers))
 	wg := sync.WaitGroup{}
-	for _, container := range pod.Spec.Containers {
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
 		// TODO: Consider being more aggressive: kill all containers with this pod UID, period.
 		c := runningPod.FindContainerByName(container.Name)
 		if c != nil {
@@ -1093,7 +1190,7 @@ func (kl *Kubelet) killContainersInPod(pod *api.Pod, runningPod kubecontainer.Po
 			wg.Add(1)
 			go func() {
 				defer util.HandleCrash()
-				err := kl.killContainer(c)
+				err := kl.killContainerInPod(c, container, pod)
 				if err != nil {
 					glog.Errorf("Failed to delete container: %v; Skipping pod %q", err, podFullName)
 					errs <- err
@@ -1172,6 +1269,30 @@ func (kl *Kubelet) getPodInfraContainer(podFullName string, uid types.UID,
 	return "", false
 }
 
+// requiresPodIP returns true if an environment variable of container refers to
+// the IP of its pod.
+func requiresPodIP(container *api.Container) bool {
+	for _, env := range container.Env {
+		if env.ValueFrom != nil && env.ValueFrom.FieldRef != nil && env.ValueFrom.FieldRef.FieldPath == "status.podIP" {
+			return true
+		}
+	}
+	return false
+}
+
+// getPodInfraContainerIP returns the IP address of the pod whose network
+// namespace is held by the given infra container.
+func (kl *Kubelet) getPodInfraContainerIP(podInfraContainerID dockertools.DockerID) (string, error) {
+	inspectResult, err := kl.dockerClient.InspectContainer(string(podInfraContainerID))
+	if err != nil {
+		return "", err
+	}
+	if inspectResult.NetworkSettings == nil {
+		return "", fmt.Errorf("no network settings for infra container %q", podInfraContainerID)
+	}
+	return inspectResult.NetworkSettings.IPAddress, nil
+}
+
 // Attempts to start a container pulling the image before that if necessary. It returns DockerID of a started container
 // if it was successful, and a non-nil error otherwise.
 func (kl *Kubelet) pullImageAndRunContainer(pod *api.Pod, container *api.Container, podVolumes *volumeMap,
@@ -1197,9 +1318,17 @@ func (kl *Kubelet) pullImageAndRunContainer(pod *api.Pod, container *api.Contain
 			}
 		}
 	}
+	podIP := ""
+	if requiresPodIP(container) {
+		podIP, err = kl.getPodInfraContainerIP(podInfraContainerID)
+		if err != nil {
+			glog.Errorf("Couldn't get the IP of pod %q for container %q: %v", podFullName, container.Name, err)
+			return "", err
+		}
+	}
 	// TODO(dawnchen): Check RestartPolicy.DelaySeconds before restart a container
 	namespaceMode := fmt.Sprintf("container:%v", podInfraContainerID)
-	containerID, err := kl.runContainer(pod, container, *podVolumes, namespaceMode, namespaceMode)
+	containerID, err := kl.runContainer(pod, container, *podVolumes, namespaceMode, namespaceMode, podIP)
 	if err != nil {
 		// TODO(bburns) : Perhaps blacklist a container after N failures?
 		glog.Errorf("Error running pod %q container %q: %v", podFullName, container.Name, err)
@@ -1349,6 +1478,10 @@ func (kl *Kubelet) syncPod(pod *api.Pod, hasMirrorPod bool, runningPod kubeconta
 		}
 	}()
 
+	if pod.DeletionTimestamp != nil {
+		return kl.terminatePod(pod, runningPod)
+	}
+
 	containerChanges, err := kl.computePodContainerChanges(pod, hasMirrorPod, runningPod)
 	glog.V(3).Infof("Got container changes for pod %q: %+v", podFullName, containerChanges)
 	if err != nil {
@@ -1376,9 +1509,10 @@ func (kl *Kubelet) syncPod(pod *api.Pod, hasMirrorPod bool, runningPod kubeconta
 		}
 	} else {
 		// Otherwise kill any containers in this pod which are not specified as ones to keep.
+		// Running init containers are left to complete.
 		for _, container := range runningPod.Containers {
 			_, keep := containerChanges.containersToKeep[dockertools.DockerID(container.ID)]
-			if !keep {
+			if !keep && !isInitContainer(pod, container.Name) {
 				glog.V(3).Infof("Killing unwanted container %+v", container)
 				err = kl.killContainer(container)
 				if err != nil {
@@ -1392,6 +1526,10 @@ func (kl *Kubelet) syncPod(pod *api.Pod, hasMirrorPod bool, runningPod kubeconta
 	var ref *api.ObjectReference
 	var podVolumes volumeMap
 	podInfraContainerID := containerChanges.infraContainerId
+	podInfraContainerCreated := time.Now().Unix()
+	if podInfraContainer := runningPod.FindContainerByName(dockertools.PodInfraContainerName); podInfraContainer != nil && !containerChanges.startInfraContainer {
+		podInfraContainerCreated = podInfraContainer.Created
+	}
 	if containerChanges.startInfraContainer && (len(containerChanges.containersToStart) > 0) {
 		ref, err = api.GetReference(pod)
 		if err != nil {
@@ -1421,6 +1559,18 @@ func (kl *Kubelet) syncPod(pod *api.Pod, hasMirrorPod bool, runningPod kubeconta
 		return err
 	}
 
+	// Run the init containers to completion before starting the containers of the pod.
+	if shouldRunInitContainers(pod, containerChanges) {
+		if containerChanges.startInfraContainer {
+			// Everything was killed along with the old infra container.
+			runningPod = kubecontainer.Pod{}
+		}
+		done, err := kl.runInitContainers(pod, runningPod, &podVolumes, podInfraContainerID, podInfraContainerCreated)
+		if err != nil || !done {
+			return err
+		}
+	}
+
 	// Start everything
 	for container := range containerChanges.containersToStart {
 		glog.V(4).Infof("Creating container %+v", pod.Spec.Containers[container])
@@ -1437,6 +1587,102 @@ func (kl *Kubelet) syncPod(pod *api.Pod, hasMirrorPod bool, runningPod kubeconta
 	return nil
 }
 
+// isInitContainer returns true if name is the name of an init container of pod.
+func isInitContainer(pod *api.Pod, name string) bool {
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldRunInitContainers returns true if the init containers of pod have to
+// complete before its containers are started, which is the case until one of
+// the containers is running in the current pod infra container.
+func shouldRunInitContainers(pod *api.Pod, containerChanges podContainerChangesSpec) bool {
+	if len(pod.Spec.InitContainers) == 0 || len(containerChanges.containersToStart) == 0 {
+		return false
+	}
+	for _, index := range containerChanges.containersToKeep {
+		if index >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// runInitContainers runs the init containers of a pod one at a time, and returns true once
+// all of them completed successfully since the pod infra container was created. A failed
+// init container is re-run unless the restart policy of the pod is Never.
+func (kl *Kubelet) runInitContainers(pod *api.Pod, runningPod kubecontainer.Pod, podVolumes *volumeMap,
+	podInfraContainerID dockertools.DockerID, podInfraContainerCreated int64) (bool, error) {
+	podFullName := kubecontainer.GetPodFullName(pod)
+	for i := range pod.Spec.InitContainers {
+		container := &pod.Spec.InitContainers[i]
+		if runningPod.FindContainerByName(container.Name) != nil {
+			glog.V(4).Infof("Waiting for init container %q of pod %q to complete", container.Name, podFullName)
+			return false, nil
+		}
+
+		recentContainers, err := dockertools.GetRecentDockerContainersWithNameAndUUID(kl.dockerClient, podFullName, pod.UID, container.Name)
+		if err != nil {
+			return false, err
+		}
+		var lastRun *docker.Container
+		for _, c := range recentContainers {
+			if c.Created.Unix() < podInfraContainerCreated {
+				continue
+			}
+			if lastRun == nil || c.Created.After(lastRun.Created) {
+				lastRun = c
+			}
+		}
+		if lastRun != nil {
+			if lastRun.State.ExitCode == 0 {
+				continue
+			}
+			if pod.Spec.RestartPolicy == api.RestartPolicyNever {
+				glog.Infof("Init container %q of pod %q failed, do nothing", container.Name, podFullName)
+				return false, nil
+			}
+		}
+
+		glog.V(4).Infof("Creating init container %+v", container)
+		_, err = kl.pullImageAndRunContainer(pod, container, podVolumes, podInfraContainerID)
+		return false, err
+	}
+	return true, nil
+}
+
+// terminatePod stops the containers of a pod which is being deleted, then removes the pod
+// from the apiserver, which keeps it until the kubelet is done or the grace period is over.
+func (kl *Kubelet) terminatePod(pod *api.Pod, runningPod kubecontainer.Pod) error {
+	podFullName := kubecontainer.GetPodFullName(pod)
+	glog.V(2).Infof("Terminating pod %q within %d seconds", podFullName, podTerminationGracePeriod(pod))
+
+	if _, err := kl.killContainersInPod(pod, runningPod); err != nil {
+		return err
+	}
+	if podInfraContainer := runningPod.FindContainerByName(dockertools.PodInfraContainerName); podInfraContainer != nil {
+		if err := kl.networkPlugin.TearDownPod(pod.Namespace, pod.Name, dockertools.DockerID(podInfraContainer.ID)); err != nil {
+			glog.Errorf("Network plugin pre-delete method returned an error: %v", err)
+		}
+		if err := kl.killContainer(podInfraContainer); err != nil {
+			return err
+		}
+	}
+
+	if kl.kubeClient == nil || isStaticPod(pod) {
+		return nil
+	}
+	// The containers are gone, the pod can be removed right away.
+	if err := kl.kubeClient.Pods(pod.Namespace).Delete(pod.Name, api.NewDeleteOptions(0)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 // Stores all volumes defined by the set of pods into a map.
 // Keys for each entry are in the format (POD_ID)/(VOLUME_NAME)
 func getDesiredVolumes(pods []api.Pod) map[string]api.Volume {
@@ -1550,7 +1796,15 @@ func (kl *Kubelet) SyncPods(allPods []api.Pod, podSyncTypes map[types.UID]metric
 		desiredPods[uid] = empty{}
 
 		// Run the sync in an async manifest worker.
-		_, hasMirrorPod := mirrorPods[podFullName]
+		mirrorPod, hasMirrorPod := mirrorPods[podFullName]
+		if hasMirrorPod && mirrorPod.DeletionTimestamp != nil {
+			// Static pods are not deleted through the apiserver: remove the mirror pod right
+			// away, so that it gets recreated.
+			if err := kl.podManager.DeleteMirrorPod(podFullName); err != nil {
+				glog.Errorf("Failed deleting mirror pod %q: %v", podFullName, err)
+			}
+			hasMirrorPod = false
+		}
 		kl.podWorkers.UpdatePod(pod, hasMirrorPod, func() {
 			metrics.SyncPodLatency.WithLabelValues(podSyncTypes[pod.UID].String()).Observe(metrics.SinceInMicroseconds(start))
 		})
@@ -1942,6 +2196,22 @@ func getPhase(spec *api.PodSpec, info api.PodInfo) api.PodPhase {
 	}
 }
 
+// initContainerFailed returns true if an init container of a pod failed and will not
+// be re-run, given the info of the init containers.
+func initContainerFailed(spec *api.PodSpec, initInfo api.PodInfo) bool {
+	if spec.RestartPolicy != api.RestartPolicyNever {
+		return false
+	}
+	for _, container := range spec.InitContainers {
+		if containerStatus, ok := initInfo[container.Name]; ok {
+			if containerStatus.State.Termination != nil && containerStatus.State.Termination.ExitCode != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getPodReadyCondition returns ready condition if all containers in a pod are ready, else it returns an unready condition.
 func getPodReadyCondition(spec *api.PodSpec, info api.PodInfo) []api.PodCondition {
 	ready := []api.PodCondition{{
@@ -2012,6 +2282,9 @@ func (kl *Kubelet) generatePodStatusByPod(pod *api.Pod) (api.PodStatus, error) {
 
 	// Assume info is ready to process
 	podStatus.Phase = getPhase(spec, podStatus.Info)
+	if initContainerFailed(spec, podStatus.InitContainerInfo) {
+		podStatus.Phase = api.PodFailed
+	}
 	for _, c := range spec.Containers {
 		containerStatus := podStatus.Info[c.Name]
 		containerStatus.Ready = kl.readiness.IsReady(containerStatus)
@@ -335,10 +335,11 @@ func dockerContainersToPod(containers dockertools.DockerContainers) container.Po
 			continue
 		}
 		pod.Containers = append(pod.Containers, &container.Container{
-			ID:    types.UID(c.ID),
-			Name:  dockerName.ContainerName,
-			Hash:  hash,
-			Image: c.Image,
+			ID:      types.UID(c.ID),
+			Name:    dockerName.ContainerName,
+			Hash:    hash,
+			Image:   c.Image,
+			Created: c.Created,
 		})
 		// TODO(yifan): Only one evaluation is enough.
 		pod.ID = dockerName.PodUID
@@ -1017,6 +1018,97 @@ func TestSyncPodBadHash(t *testing.T) {
 	}
 }
 
+func TestSyncPodTerminatesDeletedPod(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kubelet := testKubelet.kubelet
+	fakeDocker := testKubelet.fakeDocker
+	fakeHttp := fakeHTTP{}
+	kubelet.httpClient = &fakeHttp
+	dockerContainers := dockertools.DockerContainers{
+		"1234": &docker.APIContainers{
+			// the k8s prefix is required for the kubelet to manage the container
+			Names: []string{"/k8s_bar_foo_new_12345678_42"},
+			ID:    "1234",
+		},
+		"9876": &docker.APIContainers{
+			// pod infra container
+			Names: []string{"/k8s_POD_foo_new_12345678_42"},
+			ID:    "9876",
+		},
+	}
+	// the deletion timestamp is set to the end of the grace period
+	gracePeriod := int64(5)
+	deletionTimestamp := util.NewTime(util.Now().Add(time.Duration(gracePeriod) * time.Second))
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:                        "12345678",
+			Name:                       "foo",
+			Namespace:                  "new",
+			DeletionTimestamp:          &deletionTimestamp,
+			DeletionGracePeriodSeconds: &gracePeriod,
+		},
+		Spec: api.PodSpec{
+			Containers: []api.Container{
+				{
+					Name: "bar",
+					Lifecycle: &api.Lifecycle{
+						PreStop: &api.Handler{
+							HTTPGet: &api.HTTPGetAction{
+								Host: "foo",
+								Port: util.IntOrString{IntVal: 8080, Kind: util.IntstrInt},
+								Path: "stop",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	kubelet.podManager.SetPods([]api.Pod{pod})
+	err := kubelet.syncPod(&pod, false, dockerContainersToPod(dockerContainers))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if fakeHttp.url != "http://foo:8080/stop" {
+		t.Errorf("Unexpected handler: %s", fakeHttp.url)
+	}
+	// The containers are stopped before the pod infra container, and none is started.
+	if len(fakeDocker.Stopped) != 2 || fakeDocker.Stopped[0] != "1234" || fakeDocker.Stopped[1] != "9876" {
+		t.Errorf("Wrong containers were stopped: %v", fakeDocker.Stopped)
+	}
+	if len(fakeDocker.Created) != 0 {
+		t.Errorf("Unexpected containers created %v", fakeDocker.Created)
+	}
+	expectedActions := []client.FakeAction{{Action: "delete-pod", Value: "foo"}}
+	if !reflect.DeepEqual(testKubelet.fakeKubeClient.Actions, expectedActions) {
+		t.Errorf("expected %v, got %v", expectedActions, testKubelet.fakeKubeClient.Actions)
+	}
+}
+
+func TestPodTerminationGracePeriod(t *testing.T) {
+	short, long := int64(5), int64(60)
+	// deleted 40 seconds ago with a grace period of 60 seconds
+	endsSoon := util.NewTime(util.Now().Add(20 * time.Second))
+	// deleted longer ago than its grace period
+	ended := util.NewTime(util.Now().Add(-time.Minute))
+	testCases := []struct {
+		pod           api.Pod
+		min, expected int64
+	}{
+		{api.Pod{}, api.DefaultTerminationGracePeriodSeconds, api.DefaultTerminationGracePeriodSeconds},
+		{api.Pod{Spec: api.PodSpec{TerminationGracePeriodSeconds: &long}}, long, long},
+		{api.Pod{ObjectMeta: api.ObjectMeta{DeletionGracePeriodSeconds: &short}, Spec: api.PodSpec{TerminationGracePeriodSeconds: &long}}, short, short},
+		{api.Pod{ObjectMeta: api.ObjectMeta{DeletionTimestamp: &endsSoon, DeletionGracePeriodSeconds: &long}}, 19, 20},
+		{api.Pod{ObjectMeta: api.ObjectMeta{DeletionTimestamp: &ended, DeletionGracePeriodSeconds: &long}}, 0, 0},
+	}
+	for i, tc := range testCases {
+		if actual := podTerminationGracePeriod(&tc.pod); actual < tc.min || actual > tc.expected {
+			t.Errorf("%d: expected %d, got %d", i, tc.expected, actual)
+		}
+	}
+}
+
 func TestSyncPodUnhealthy(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
@@ -1069,6 +1161,124 @@ func TestSyncPodUnhealthy(t *testing.T) {
 	}
 }
 
+func TestSyncPodInitContainers(t *testing.T) {
+	podInfraCreated := time.Unix(1000, 0)
+	infra := docker.APIContainers{
+		// pod infra container
+		Names:   []string{"/k8s_POD_foo_new_12345678_42"},
+		ID:      "9876",
+		Created: podInfraCreated.Unix(),
+	}
+	initRun := func(id string, created time.Time, exitCode int) (docker.APIContainers, *docker.Container) {
+		return docker.APIContainers{Names: []string{"/k8s_init_foo_new_12345678_42"}, ID: id},
+			&docker.Container{
+				ID:      id,
+				Config:  &docker.Config{},
+				Created: created,
+				State:   docker.State{ExitCode: exitCode, FinishedAt: created.Add(time.Second)},
+			}
+	}
+	tests := []struct {
+		name          string
+		restartPolicy api.RestartPolicy
+		runs          []time.Time
+		exitCodes     []int
+		initRunning   bool
+		expectCreated string
+	}{
+		{
+			name:          "init container never ran",
+			restartPolicy: api.RestartPolicyAlways,
+			expectCreated: "k8s_init\\.[a-f0-9]+_foo_new_",
+		},
+		{
+			name:          "init container completed",
+			restartPolicy: api.RestartPolicyAlways,
+			runs:          []time.Time{podInfraCreated.Add(time.Second)},
+			exitCodes:     []int{0},
+			expectCreated: "k8s_bar\\.[a-f0-9]+_foo_new_",
+		},
+		{
+			name:          "init container completed in an older pod infra container",
+			restartPolicy: api.RestartPolicyAlways,
+			runs:          []time.Time{podInfraCreated.Add(-time.Minute)},
+			exitCodes:     []int{0},
+			expectCreated: "k8s_init\\.[a-f0-9]+_foo_new_",
+		},
+		{
+			name:          "init container failed",
+			restartPolicy: api.RestartPolicyOnFailure,
+			runs:          []time.Time{podInfraCreated.Add(2 * time.Second), podInfraCreated.Add(time.Second)},
+			exitCodes:     []int{1, 0},
+			expectCreated: "k8s_init\\.[a-f0-9]+_foo_new_",
+		},
+		{
+			name:          "init container failed and is not restarted",
+			restartPolicy: api.RestartPolicyNever,
+			runs:          []time.Time{podInfraCreated.Add(time.Second)},
+			exitCodes:     []int{1},
+		},
+		{
+			name:          "init container is running",
+			restartPolicy: api.RestartPolicyAlways,
+			initRunning:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testKubelet := newTestKubelet(t)
+		kubelet := testKubelet.kubelet
+		fakeDocker := testKubelet.fakeDocker
+		dockerContainers := dockertools.DockerContainers{"9876": &infra}
+		fakeDocker.ContainerList = []docker.APIContainers{infra}
+		fakeDocker.ContainerMap = map[string]*docker.Container{
+			"9876": {ID: "9876", Config: &docker.Config{}, State: docker.State{Running: true}},
+		}
+		for i, created := range test.runs {
+			id := fmt.Sprintf("init%d", i)
+			apiContainer, container := initRun(id, created, test.exitCodes[i])
+			fakeDocker.ContainerList = append(fakeDocker.ContainerList, apiContainer)
+			fakeDocker.ContainerMap[id] = container
+		}
+		if test.initRunning {
+			dockerContainers["1234"] = &docker.APIContainers{
+				Names: []string{"/k8s_init_foo_new_12345678_42"},
+				ID:    "1234",
+			}
+		}
+		pod := api.Pod{
+			ObjectMeta: api.ObjectMeta{
+				UID:       "12345678",
+				Name:      "foo",
+				Namespace: "new",
+			},
+			Spec: api.PodSpec{
+				InitContainers: []api.Container{{Name: "init"}},
+				Containers:     []api.Container{{Name: "bar"}},
+				RestartPolicy:  test.restartPolicy,
+			},
+		}
+		kubelet.podManager.SetPods([]api.Pod{pod})
+		err := kubelet.syncPod(&pod, false, dockerContainersToPod(dockerContainers))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+
+		fakeDocker.Lock()
+		if len(fakeDocker.Stopped) != 0 {
+			t.Errorf("%s: unexpected containers stopped %v", test.name, fakeDocker.Stopped)
+		}
+		if len(test.expectCreated) == 0 {
+			if len(fakeDocker.Created) != 0 {
+				t.Errorf("%s: unexpected containers created %v", test.name, fakeDocker.Created)
+			}
+		} else if len(fakeDocker.Created) != 1 || !matchString(t, test.expectCreated, fakeDocker.Created[0]) {
+			t.Errorf("%s: unexpected containers created %v", test.name, fakeDocker.Created)
+		}
+		fakeDocker.Unlock()
+	}
+}
+
 func TestMountExternalVolumes(t *testing.T) {
 	testKubelet := newTestKubelet(t)
 	kubelet := testKubelet.kubelet
@@ -1882,97 +2092,97 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8081,
+				Ports:    []api.ServicePort{{Port: 8081}},
 				PortalIP: "1.2.3.1",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8082,
+				Ports:    []api.ServicePort{{Port: 8082}},
 				PortalIP: "1.2.3.2",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8082,
+				Ports:    []api.ServicePort{{Port: 8082}},
 				PortalIP: "None",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: api.NamespaceDefault},
 			Spec: api.ServiceSpec{
-				Port:     8082,
+				Ports:    []api.ServicePort{{Port: 8082}},
 				PortalIP: "",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test1"},
 			Spec: api.ServiceSpec{
-				Port:     8083,
+				Ports:    []api.ServicePort{{Port: 8083}},
 				PortalIP: "1.2.3.3",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port:     8084,
+				Ports:    []api.ServicePort{{Port: 8084}},
 				PortalIP: "1.2.3.4",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port:     8085,
+				Ports:    []api.ServicePort{{Port: 8085}},
 				PortalIP: "1.2.3.5",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port:     8085,
+				Ports:    []api.ServicePort{{Port: 8085}},
 				PortalIP: "None",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "test", Namespace: "test2"},
 			Spec: api.ServiceSpec{
-				Port: 8085,
+				Ports: []api.ServicePort{{Port: 8085}},
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8086,
+				Ports:    []api.ServicePort{{Port: 8086}},
 				PortalIP: "1.2.3.6",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "kubernetes-ro", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8087,
+				Ports:    []api.ServicePort{{Port: 8087}},
 				PortalIP: "1.2.3.7",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "not-special", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8088,
+				Ports:    []api.ServicePort{{Port: 8088}},
 				PortalIP: "1.2.3.8",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "not-special", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8088,
+				Ports:    []api.ServicePort{{Port: 8088}},
 				PortalIP: "None",
 			},
 		},
 		{
 			ObjectMeta: api.ObjectMeta{Name: "not-special", Namespace: "kubernetes"},
 			Spec: api.ServiceSpec{
-				Port:     8088,
+				Ports:    []api.ServicePort{{Port: 8088}},
 				PortalIP: "",
 			},
 		},
@@ -2165,7 +2375,8 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 			kl.serviceLister = testServiceLister{services}
 		}
 
-		result, err := kl.makeEnvironmentVariables(tc.ns, tc.container)
+		pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: tc.ns}}
+		result, err := kl.makeEnvironmentVariables(pod, tc.container, "")
 		if err != nil {
 			t.Errorf("[%v] Unexpected error: %v", tc.name, err)
 		}
@@ -2181,6 +2392,68 @@ func TestMakeEnvironmentVariables(t *testing.T) {
 	}
 }
 
+func TestMakeEnvironmentVariablesFromConfigMap(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kl := testKubelet.kubelet
+	kl.serviceLister = testServiceLister{}
+	kubeClient := testKubelet.fakeKubeClient
+	kubeClient.ConfigMap = api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "config", Namespace: "test1"},
+		Data:       map[string]string{"mode": "fast"},
+	}
+
+	container := &api.Container{
+		Env: []api.EnvVar{
+			{Name: "FOO", Value: "BAR"},
+			{Name: "MODE", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "config", Key: "mode"}}},
+			{Name: "MODE_AGAIN", ValueFrom: &api.EnvVarSource{ConfigMapKeyRef: &api.ConfigMapKeySelector{Name: "config", Key: "mode"}}},
+		},
+	}
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "test1"}}
+	result, err := kl.makeEnvironmentVariables(pod, container, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := util.NewStringSet("FOO=BAR", "MODE=fast", "MODE_AGAIN=fast")
+	if resultSet := util.NewStringSet(result...); !resultSet.IsSuperset(expected) || len(resultSet) != len(expected) {
+		t.Errorf("Unexpected env entries; expected {%v}, got {%v}", expected, resultSet)
+	}
+	if len(kubeClient.Actions) != 1 || kubeClient.Actions[0].Action != "get-configMap" {
+		t.Errorf("Expected the config map to be fetched once, got %#v", kubeClient.Actions)
+	}
+
+	container.Env[1].ValueFrom.ConfigMapKeyRef.Key = "missing"
+	if _, err := kl.makeEnvironmentVariables(pod, container, ""); err == nil {
+		t.Errorf("Expected an error for a missing config map key")
+	}
+}
+
+func TestMakeEnvironmentVariablesFromFieldRef(t *testing.T) {
+	testKubelet := newTestKubelet(t)
+	kl := testKubelet.kubelet
+	kl.serviceLister = testServiceLister{}
+
+	pod := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: "test1"}}
+	container := &api.Container{
+		Env: []api.EnvVar{
+			{Name: "POD_NAME", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			{Name: "POD_NAMESPACE", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+			{Name: "POD_IP", ValueFrom: &api.EnvVarSource{FieldRef: &api.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+		},
+	}
+	if !requiresPodIP(container) {
+		t.Errorf("Expected the container to require the pod IP")
+	}
+	result, err := kl.makeEnvironmentVariables(pod, container, "1.2.3.4")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := util.NewStringSet("POD_NAME=foo", "POD_NAMESPACE=test1", "POD_IP=1.2.3.4")
+	if resultSet := util.NewStringSet(result...); !resultSet.IsSuperset(expected) || len(resultSet) != len(expected) {
+		t.Errorf("Unexpected env entries; expected {%v}, got {%v}", expected, resultSet)
+	}
+}
+
 func TestPodPhaseWithRestartAlways(t *testing.T) {
 	desiredState := api.PodSpec{
 		Containers: []api.Container{
@@ -2500,6 +2773,45 @@ func TestPodPhaseWithRestartOnFailure(t *testing.T) {
 	}
 }
 
+func TestInitContainerFailed(t *testing.T) {
+	succeededState := api.ContainerStatus{
+		State: api.ContainerState{
+			Termination: &api.ContainerStateTerminated{
+				ExitCode: 0,
+			},
+		},
+	}
+	failedState := api.ContainerStatus{
+		State: api.ContainerState{
+			Termination: &api.ContainerStateTerminated{
+				ExitCode: -1,
+			},
+		},
+	}
+	tests := []struct {
+		restartPolicy api.RestartPolicy
+		initInfo      api.PodInfo
+		expected      bool
+		test          string
+	}{
+		{api.RestartPolicyNever, nil, false, "no init container status"},
+		{api.RestartPolicyNever, api.PodInfo{"init": succeededState}, false, "init container succeeded"},
+		{api.RestartPolicyNever, api.PodInfo{"init": failedState}, true, "init container failed"},
+		{api.RestartPolicyOnFailure, api.PodInfo{"init": failedState}, false, "init container failed with restart onfailure"},
+		{api.RestartPolicyAlways, api.PodInfo{"init": failedState}, false, "init container failed with restart always"},
+	}
+	for _, test := range tests {
+		spec := &api.PodSpec{
+			InitContainers: []api.Container{{Name: "init"}},
+			Containers:     []api.Container{{Name: "containerA"}},
+			RestartPolicy:  test.restartPolicy,
+		}
+		if failed := initContainerFailed(spec, test.initInfo); failed != test.expected {
+			t.Errorf("In test %s, expected %v, got %v", test.test, test.expected, failed)
+		}
+	}
+}
+
 func TestGetPodReadyCondition(t *testing.T) {
 	ready := []api.PodCondition{{
 		Type:   api.PodReady,
@@ -2981,7 +3293,7 @@ func TestHandleMemExceeded(t *testing.T) {
 	testKubelet.fakeCadvisor.On("MachineInfo").Return(&cadvisorApi.MachineInfo{MemoryCapacity: 100}, nil)
 
 	spec := api.PodSpec{Containers: []api.Container{{Resources: api.ResourceRequirements{
-		Limits: api.ResourceList{
+		Requests: api.ResourceList{
 			"memory": resource.MustParse("90"),
 		},
 	}}}}
@@ -54,8 +54,8 @@ func (vh *volumeHost) GetKubeClient() client.Interface {
 	return vh.kubelet.kubeClient
 }
 
-func (vh *volumeHost) NewWrapperBuilder(spec *api.Volume, podRef *api.ObjectReference) (volume.Builder, error) {
-	b, err := vh.kubelet.newVolumeBuilderFromPlugins(spec, podRef)
+func (vh *volumeHost) NewWrapperBuilder(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
+	b, err := vh.kubelet.newVolumeBuilderFromPlugins(spec, pod)
 	if err == nil && b == nil {
 		return nil, errUnsupportedVolumeType
 	}
@@ -78,7 +78,7 @@ func (vh *volumeHost) NewWrapperCleaner(spec *api.Volume, podUID types.UID) (vol
 	return c, nil
 }
 
-func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, podRef *api.ObjectReference) (volume.Builder, error) {
+func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
 	plugin, err := kl.volumePluginMgr.FindPluginBySpec(spec)
 	if err != nil {
 		return nil, fmt.Errorf("can't use volume plugins for %s: %v", spew.Sprintf("%#v", *spec), err)
@@ -87,7 +87,7 @@ func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, podRef *api.Obj
 		// Not found but not an error
 		return nil, nil
 	}
-	builder, err := plugin.NewBuilder(spec, podRef)
+	builder, err := plugin.NewBuilder(spec, pod)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate volume plugin for %s: %v", spew.Sprintf("%#v", *spec), err)
 	}
@@ -95,19 +95,63 @@ func (kl *Kubelet) newVolumeBuilderFromPlugins(spec *api.Volume, podRef *api.Obj
 	return builder, nil
 }
 
+// resolvePersistentVolumeClaim returns a copy of spec whose source is the PersistentVolume
+// bound to the claim spec refers to.  The claim must be in namespace and already bound.
+func (kl *Kubelet) resolvePersistentVolumeClaim(namespace string, spec *api.Volume) (*api.Volume, error) {
+	if kl.kubeClient == nil {
+		return nil, fmt.Errorf("cannot resolve persistent volume claim %q without an API client", spec.PersistentVolumeClaim.ClaimName)
+	}
+	source := spec.PersistentVolumeClaim
+	claim, err := kl.kubeClient.PersistentVolumeClaims(namespace).Get(source.ClaimName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting persistent volume claim %q: %v", source.ClaimName, err)
+	}
+	if claim.Status.Phase != api.ClaimBound || claim.Status.VolumeRef == nil {
+		return nil, fmt.Errorf("persistent volume claim %q is not bound", source.ClaimName)
+	}
+	pv, err := kl.kubeClient.PersistentVolumes().Get(claim.Status.VolumeRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting persistent volume %q for claim %q: %v", claim.Status.VolumeRef.Name, source.ClaimName, err)
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.UID != claim.UID {
+		return nil, fmt.Errorf("persistent volume %q is not bound to claim %q", pv.Name, source.ClaimName)
+	}
+
+	resolved := &api.Volume{
+		Name: spec.Name,
+		VolumeSource: api.VolumeSource{
+			GCEPersistentDisk: pv.Spec.GCEPersistentDisk,
+			HostPath:          pv.Spec.HostPath,
+			NFS:               pv.Spec.NFS,
+		},
+	}
+	if source.ReadOnly {
+		if resolved.GCEPersistentDisk != nil {
+			resolved.GCEPersistentDisk.ReadOnly = true
+		}
+		if resolved.NFS != nil {
+			resolved.NFS.ReadOnly = true
+		}
+	}
+	return resolved, nil
+}
+
 func (kl *Kubelet) mountExternalVolumes(pod *api.Pod) (volumeMap, error) {
 	podVolumes := make(volumeMap)
 	for i := range pod.Spec.Volumes {
 		volSpec := &pod.Spec.Volumes[i]
 
-		podRef, err := api.GetReference(pod)
-		if err != nil {
-			glog.Errorf("Error getting object reference for pod: %v", pod, err)
-			return nil, err
+		if volSpec.PersistentVolumeClaim != nil {
+			var err error
+			volSpec, err = kl.resolvePersistentVolumeClaim(pod.Namespace, volSpec)
+			if err != nil {
+				glog.Errorf("Could not resolve persistent volume claim for pod %s: %v", pod.UID, err)
+				return nil, err
+			}
 		}
 
 		// Try to use a plugin for this volume.
-		builder, err := kl.newVolumeBuilderFromPlugins(volSpec, podRef)
+		builder, err := kl.newVolumeBuilderFromPlugins(volSpec, pod)
 		if err != nil {
 			glog.Errorf("Could not create volume builder for pod %s: %v", pod.UID, err)
 			return nil, err
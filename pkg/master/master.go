@@ -42,19 +42,31 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/cloudprovider"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/master/ports"
+	configmapetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/configmap/etcd"
 	controlleretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/controller/etcd"
+	daemonsetetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/daemonset/etcd"
+	deploymentetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/deployment/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/endpoint"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/event"
+	autoscaleretcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler/etcd"
+	ingressetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/ingress/etcd"
+	jobetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/job/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/limitrange"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/minion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/namespace"
 	namespaceetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/namespace/etcd"
+	pvetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolume/etcd"
+	pvcetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolumeclaim/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod"
 	podetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod/etcd"
+	pdbetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/poddisruptionbudget/etcd"
+	priorityclassetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/priorityclass/etcd"
 	resourcequotaetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/resourcequota/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/secret"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/service"
+	serviceaccountetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/serviceaccount/etcd"
+	thirdpartyresourceetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/ui"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
@@ -115,15 +127,27 @@ type Config struct {
 	// The name of the cluster.
 	ClusterName string
 
+	// The range of ports from which to allocate the node ports of services.
+	// Defaults to 30000-32767 if not set.
+	ServiceNodePortRange util.PortRange
+
 	// If true we will periodically probe pods statuses.
 	SyncPodStatus bool
+
+	// The number of changes of each resource kept by the watch cache, which serves the
+	// watches of the resources watched by every node from memory. 0 disables the cache.
+	WatchCacheSize int
 }
 
+// watchCacheKeys are the etcd keys of the resources served by the watch cache.
+var watchCacheKeys = []string{"/registry/pods", etcd.NodePath, etcd.ServicePath, etcd.ServiceEndpointPath, etcd.ControllerPath}
+
 // Master contains state for a Kubernetes cluster master/api server.
 type Master struct {
 	// "Inputs", Copied from Config
-	portalNet    *net.IPNet
-	cacheTimeout time.Duration
+	portalNet            *net.IPNet
+	serviceNodePortRange util.PortRange
+	cacheTimeout         time.Duration
 
 	mux                   apiserver.Mux
 	muxHelper             *apiserver.MuxHelper
@@ -180,6 +204,23 @@ func NewEtcdHelper(client tools.EtcdGetSet, version string) (helper tools.EtcdHe
 	return tools.NewEtcdHelper(client, versionInterfaces.Codec), nil
 }
 
+// NewProtobufEtcdHelper is like NewEtcdHelper, but the EtcdHelper stores objects in protobuf,
+// encoded in base64 for etcd. Objects stored in JSON are still read. Returns an error if the
+// version has no protobuf encoding.
+func NewProtobufEtcdHelper(client tools.EtcdGetSet, version string) (helper tools.EtcdHelper, err error) {
+	if version == "" {
+		version = latest.Version
+	}
+	versionInterfaces, err := latest.InterfacesFor(version)
+	if err != nil {
+		return helper, err
+	}
+	if versionInterfaces.ProtobufCodec == nil {
+		return helper, fmt.Errorf("storage version %s has no protobuf encoding", version)
+	}
+	return tools.NewEtcdHelper(client, tools.NewBase64Codec(versionInterfaces.ProtobufCodec)), nil
+}
+
 // setDefaults fills in any fields not set that are required to have valid data.
 func setDefaults(c *Config) {
 	if c.PortalNet == nil {
@@ -191,6 +232,15 @@ func setDefaults(c *Config) {
 		}
 		c.PortalNet = portalNet
 	}
+	if c.ServiceNodePortRange.Size == 0 {
+		// TODO: Currently no way to specify an empty range (do we need to allow this?)
+		// We should probably allow this for clouds that don't require NodePort to do load-balancing (GCE)
+		// but then that breaks the strict nestedness of ServiceType.
+		// Review post-v1
+		defaultServiceNodePortRange := util.PortRange{Base: 30000, Size: 2768}
+		c.ServiceNodePortRange = defaultServiceNodePortRange
+		glog.Infof("Node port range unspecified. Defaulting to %v.", c.ServiceNodePortRange)
+	}
 	if c.MasterCount == 0 {
 		// Clearly, there will be at least one master.
 		c.MasterCount = 1
@@ -223,6 +273,7 @@ func setDefaults(c *Config) {
 // Certain config fields will be set to a default value if unset,
 // including:
 //   PortalNet
+//   ServiceNodePortRange
 //   MasterCount
 //   ReadOnlyPort
 //   ReadWritePort
@@ -262,6 +313,7 @@ func New(c *Config) *Master {
 
 	m := &Master{
 		portalNet:             c.PortalNet,
+		serviceNodePortRange:  c.ServiceNodePortRange,
 		rootWebService:        new(restful.WebService),
 		enableLogsSupport:     c.EnableLogsSupport,
 		enableUISupport:       c.EnableUISupport,
@@ -349,6 +401,12 @@ func logStackOnRecover(panicReason interface{}, httpWriter http.ResponseWriter)
 
 // init initializes master.
 func (m *Master) init(c *Config) {
+	if c.WatchCacheSize > 0 {
+		watchCache := tools.NewWatchCache(c.EtcdHelper.Client, c.WatchCacheSize, watchCacheKeys...)
+		watchCache.Run()
+		c.EtcdHelper.WatchCache = watchCache
+	}
+
 	podStorage, bindingStorage, podStatusStorage := podetcd.NewStorage(c.EtcdHelper)
 	podRegistry := pod.NewRegistry(podStorage)
 
@@ -357,6 +415,8 @@ func (m *Master) init(c *Config) {
 
 	resourceQuotaStorage, resourceQuotaStatusStorage := resourcequotaetcd.NewStorage(c.EtcdHelper)
 	secretRegistry := secret.NewEtcdRegistry(c.EtcdHelper)
+	persistentVolumeStorage, persistentVolumeStatusStorage := pvetcd.NewStorage(c.EtcdHelper)
+	persistentVolumeClaimStorage, persistentVolumeClaimStatusStorage := pvcetcd.NewStorage(c.EtcdHelper)
 
 	namespaceStorage, namespaceStatusStorage, namespaceFinalizeStorage := namespaceetcd.NewStorage(c.EtcdHelper)
 	m.namespaceRegistry = namespace.NewRegistry(namespaceStorage)
@@ -385,21 +445,57 @@ func (m *Master) init(c *Config) {
 	}
 
 	controllerStorage := controlleretcd.NewREST(c.EtcdHelper)
+	jobStorage, jobStatusStorage := jobetcd.NewStorage(c.EtcdHelper)
+	daemonSetStorage, daemonSetStatusStorage := daemonsetetcd.NewStorage(c.EtcdHelper)
+	deploymentStorage, deploymentStatusStorage := deploymentetcd.NewStorage(c.EtcdHelper)
+	autoscalerStorage, autoscalerStatusStorage := autoscaleretcd.NewStorage(c.EtcdHelper)
+	ingressStorage, ingressStatusStorage := ingressetcd.NewStorage(c.EtcdHelper)
+	serviceAccountStorage := serviceaccountetcd.NewStorage(c.EtcdHelper)
+	configMapStorage := configmapetcd.NewStorage(c.EtcdHelper)
+	priorityClassStorage := priorityclassetcd.NewStorage(c.EtcdHelper)
+	podDisruptionBudgetStorage, podDisruptionBudgetStatusStorage := pdbetcd.NewStorage(c.EtcdHelper)
+	thirdPartyResourceStorage := thirdpartyresourceetcd.NewStorage(c.EtcdHelper)
+
+	nodePorts, err := service.NewEtcdPortAllocator(&m.serviceNodePortRange, c.EtcdHelper)
+	if err != nil {
+		glog.Fatalf("Failed to create a node port allocator: %v", err)
+	}
+	// Initialize the node port allocations before services are served, then keep
+	// repairing them from the services.
+	nodePortRepair := service.NewPortAllocatorRepair(m.serviceRegistry, &m.serviceNodePortRange, c.EtcdHelper)
+	if err := nodePortRepair.RunOnce(); err != nil {
+		glog.Errorf("Unable to initialize the node port allocations: %v", err)
+	}
+	nodePortRepair.Run(time.Minute)
 
 	// TODO: Factor out the core API registration
 	m.storage = map[string]rest.Storage{
-		"pods":         podStorage,
-		"pods/status":  podStatusStorage,
-		"pods/binding": bindingStorage,
-		"bindings":     bindingStorage,
+		"pods":          podStorage,
+		"pods/status":   podStatusStorage,
+		"pods/binding":  bindingStorage,
+		"pods/eviction": podetcd.NewEvictionREST(podStorage, podDisruptionBudgetStorage, podDisruptionBudgetStatusStorage),
+		"bindings":      bindingStorage,
 
 		"replicationControllers": controllerStorage,
-		"services":               service.NewStorage(m.serviceRegistry, c.Cloud, m.nodeRegistry, m.portalNet, c.ClusterName),
+		"services":               service.NewStorage(m.serviceRegistry, c.Cloud, m.nodeRegistry, m.portalNet, nodePorts, c.ClusterName),
 		"endpoints":              endpoint.NewStorage(m.endpointRegistry),
 		"minions":                nodeStorage,
 		"nodes":                  nodeStorage,
 		"events":                 event.NewStorage(eventRegistry),
 
+		"jobs":               jobStorage,
+		"jobs/status":        jobStatusStorage,
+		"daemonSets":         daemonSetStorage,
+		"daemonSets/status":  daemonSetStatusStorage,
+		"deployments":        deploymentStorage,
+		"deployments/status": deploymentStatusStorage,
+
+		"horizontalPodAutoscalers":        autoscalerStorage,
+		"horizontalPodAutoscalers/status": autoscalerStatusStorage,
+
+		"ingresses":        ingressStorage,
+		"ingresses/status": ingressStatusStorage,
+
 		"limitRanges":           limitrange.NewStorage(limitRangeRegistry),
 		"resourceQuotas":        resourceQuotaStorage,
 		"resourceQuotas/status": resourceQuotaStatusStorage,
@@ -407,6 +503,20 @@ func (m *Master) init(c *Config) {
 		"namespaces/status":     namespaceStatusStorage,
 		"namespaces/finalize":   namespaceFinalizeStorage,
 		"secrets":               secret.NewStorage(secretRegistry),
+		"serviceAccounts":       serviceAccountStorage,
+		"configMaps":            configMapStorage,
+
+		"persistentVolumes":             persistentVolumeStorage,
+		"persistentVolumes/status":      persistentVolumeStatusStorage,
+		"persistentVolumeClaims":        persistentVolumeClaimStorage,
+		"persistentVolumeClaims/status": persistentVolumeClaimStatusStorage,
+
+		"priorityClasses": priorityClassStorage,
+
+		"podDisruptionBudgets":        podDisruptionBudgetStorage,
+		"podDisruptionBudgets/status": podDisruptionBudgetStatusStorage,
+
+		"thirdPartyResources": thirdPartyResourceStorage,
 	}
 
 	apiVersions := []string{"v1beta1", "v1beta2"}
@@ -426,6 +536,11 @@ func (m *Master) init(c *Config) {
 	apiserver.InstallSupport(m.muxHelper, m.rootWebService)
 	apiserver.AddApiWebService(m.handlerContainer, c.APIPrefix, apiVersions)
 
+	// Serve the kinds registered by third party resources.
+	thirdPartyAPIs := newThirdPartyAPIs(c.EtcdHelper, thirdPartyResourceStorage, m.admissionControl, m.requestContextMapper)
+	go util.Forever(thirdPartyAPIs.sync, 10*time.Second)
+	m.muxHelper.Handle(thirdPartyPrefix+"/", thirdPartyAPIs)
+
 	// Register root handler.
 	// We do not register this using restful Webservice since we do not want to surface this in api docs.
 	// Allow master to be embedded in contexts which already have something registered at the root
@@ -464,7 +579,11 @@ func (m *Master) init(c *Config) {
 
 	m.InsecureHandler = handler
 
-	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, latest.RESTMapper, "api")
+	attributeGetter := apiserver.NewRequestAttributeGetter(m.requestContextMapper, &apiserver.APIRequestInfoResolver{
+		APIPrefixes:      util.NewStringSet("api"),
+		APIGroupPrefixes: util.NewStringSet(thirdPartyPrefix[1:]),
+		RestMapper:       latest.RESTMapper,
+	})
 	handler = apiserver.WithAuthorizationCheck(handler, attributeGetter, m.authorizer)
 
 	// Install Authenticator
@@ -618,5 +737,6 @@ func (m *Master) api_v1beta3() *apiserver.APIGroupVersion {
 	version.Storage = storage
 	version.Version = "v1beta3"
 	version.Codec = v1beta3.Codec
+	version.ProtobufCodec = v1beta3.ProtobufCodec
 	return version
 }
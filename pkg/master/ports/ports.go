@@ -29,4 +29,7 @@ const (
 	// ProxyPort is the default port for the proxy status server.
 	// May be overriden by a flag at startup.
 	ProxyPort = 10249
+	// IngressRouterPort is the default port for the ingress router status server.
+	// May be overridden by a flag at startup.
+	IngressRouterPort = 10253
 )
@@ -113,12 +113,12 @@ func (m *Master) createMasterServiceIfNeeded(serviceName string, serviceIP net.I
 			Labels:    map[string]string{"provider": "kubernetes", "component": "apiserver"},
 		},
 		Spec: api.ServiceSpec{
-			Port: servicePort,
+			Ports: []api.ServicePort{{Port: servicePort, Protocol: api.ProtocolTCP}},
 			// maintained by this code, not by the pod selector
 			Selector:        nil,
 			PortalIP:        serviceIP.String(),
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	_, err := m.storage["services"].(rest.Creater).Create(ctx, svc)
@@ -135,29 +135,41 @@ func (m *Master) createMasterServiceIfNeeded(serviceName string, serviceIP net.I
 func (m *Master) ensureEndpointsContain(serviceName string, ip net.IP, port int) error {
 	ctx := api.NewDefaultContext()
 	e, err := m.endpointRegistry.GetEndpoints(ctx, serviceName)
-	if err != nil || e.Protocol != api.ProtocolTCP {
+	if err != nil {
 		e = &api.Endpoints{
 			ObjectMeta: api.ObjectMeta{
 				Name:      serviceName,
 				Namespace: api.NamespaceDefault,
 			},
-			Protocol: api.ProtocolTCP,
 		}
 	}
+	// Every master is kept in a subset of its own, so that the order in which
+	// they were added is preserved.
 	found := false
-	for i := range e.Endpoints {
-		ep := &e.Endpoints[i]
-		if ep.IP == ip.String() && ep.Port == port {
-			found = true
-			break
+FindEndpointLoop:
+	for i := range e.Subsets {
+		ss := &e.Subsets[i]
+		for j := range ss.Ports {
+			if ss.Ports[j].Port != port || ss.Ports[j].Protocol != api.ProtocolTCP {
+				continue
+			}
+			for k := range ss.Addresses {
+				if ss.Addresses[k].IP == ip.String() {
+					found = true
+					break FindEndpointLoop
+				}
+			}
 		}
 	}
 	if !found {
-		e.Endpoints = append(e.Endpoints, api.Endpoint{IP: ip.String(), Port: port})
-		if len(e.Endpoints) > m.masterCount {
+		e.Subsets = append(e.Subsets, api.EndpointSubset{
+			Addresses: []api.EndpointAddress{{IP: ip.String()}},
+			Ports:     []api.EndpointPort{{Port: port, Protocol: api.ProtocolTCP}},
+		})
+		if len(e.Subsets) > m.masterCount {
 			// We append to the end and remove from the beginning, so this should
 			// converge rapidly with all masters performing this operation.
-			e.Endpoints = e.Endpoints[len(e.Endpoints)-m.masterCount:]
+			e.Subsets = e.Subsets[len(e.Subsets)-m.masterCount:]
 		}
 		return m.endpointRegistry.UpdateEndpoints(ctx, e)
 	}
@@ -28,15 +28,15 @@ import (
 
 func TestEnsureEndpointsContain(t *testing.T) {
 	tests := []struct {
-		serviceName       string
-		ip                string
-		port              int
-		expectError       bool
-		expectUpdate      bool
-		endpoints         *api.EndpointsList
-		expectedEndpoints []api.Endpoint
-		err               error
-		masterCount       int
+		serviceName     string
+		ip              string
+		port            int
+		expectError     bool
+		expectUpdate    bool
+		endpoints       *api.EndpointsList
+		expectedSubsets []api.EndpointSubset
+		err             error
+		masterCount     int
 	}{
 		{
 			serviceName:  "foo",
@@ -58,18 +58,17 @@ func TestEnsureEndpointsContain(t *testing.T) {
 						ObjectMeta: api.ObjectMeta{
 							Name: "foo",
 						},
-						Endpoints: []api.Endpoint{
+						Subsets: []api.EndpointSubset{
 							{
-								IP:   "1.2.3.4",
-								Port: 8080,
+								Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+								Ports:     []api.EndpointPort{{Port: 8080, Protocol: api.ProtocolTCP}},
 							},
 						},
-						Protocol: api.ProtocolTCP,
 					},
 				},
 			},
-			masterCount:       1,
-			expectedEndpoints: []api.Endpoint{{"1.2.3.4", 8080, nil}},
+			masterCount:     1,
+			expectedSubsets: []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}}, Ports: []api.EndpointPort{{Port: 8080, Protocol: "TCP"}}}},
 		},
 		{
 			serviceName:  "foo",
@@ -84,13 +83,12 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Subsets: []api.EndpointSubset{
 							{
-								IP:   "4.3.2.1",
-								Port: 8080,
+								Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}},
+								Ports:     []api.EndpointPort{{Port: 8080, Protocol: api.ProtocolTCP}},
 							},
 						},
-						Protocol: api.ProtocolTCP,
 					},
 				},
 			},
@@ -109,18 +107,17 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Subsets: []api.EndpointSubset{
 							{
-								IP:   "4.3.2.1",
-								Port: 9090,
+								Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}},
+								Ports:     []api.EndpointPort{{Port: 9090, Protocol: api.ProtocolTCP}},
 							},
 						},
-						Protocol: api.ProtocolTCP,
 					},
 				},
 			},
-			masterCount:       2,
-			expectedEndpoints: []api.Endpoint{{"4.3.2.1", 9090, nil}, {"1.2.3.4", 8080, nil}},
+			masterCount:     2,
+			expectedSubsets: []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}}, Ports: []api.EndpointPort{{Port: 9090, Protocol: "TCP"}}}, {Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}}, Ports: []api.EndpointPort{{Port: 8080, Protocol: "TCP"}}}},
 		},
 		{
 			serviceName:  "foo",
@@ -135,22 +132,21 @@ func TestEnsureEndpointsContain(t *testing.T) {
 							Name:      "foo",
 							Namespace: api.NamespaceDefault,
 						},
-						Endpoints: []api.Endpoint{
+						Subsets: []api.EndpointSubset{
 							{
-								IP:   "4.3.2.1",
-								Port: 9090,
+								Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}},
+								Ports:     []api.EndpointPort{{Port: 9090, Protocol: api.ProtocolTCP}},
 							},
 							{
-								IP:   "1.2.3.4",
-								Port: 8000,
+								Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+								Ports:     []api.EndpointPort{{Port: 8000, Protocol: api.ProtocolTCP}},
 							},
 						},
-						Protocol: api.ProtocolTCP,
 					},
 				},
 			},
-			masterCount:       2,
-			expectedEndpoints: []api.Endpoint{{"1.2.3.4", 8000, nil}, {"1.2.3.4", 8080, nil}},
+			masterCount:     2,
+			expectedSubsets: []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}}, Ports: []api.EndpointPort{{Port: 8000, Protocol: "TCP"}}}, {Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}}, Ports: []api.EndpointPort{{Port: 8080, Protocol: "TCP"}}}},
 		},
 	}
 	for _, test := range tests {
@@ -169,16 +165,18 @@ func TestEnsureEndpointsContain(t *testing.T) {
 			t.Errorf("unexpected error: %v", err)
 		}
 		if test.expectUpdate {
-			if test.expectedEndpoints == nil {
-				test.expectedEndpoints = []api.Endpoint{{test.ip, test.port, nil}}
+			if test.expectedSubsets == nil {
+				test.expectedSubsets = []api.EndpointSubset{{
+					Addresses: []api.EndpointAddress{{IP: test.ip}},
+					Ports:     []api.EndpointPort{{Port: test.port, Protocol: "TCP"}},
+				}}
 			}
 			expectedUpdate := api.Endpoints{
 				ObjectMeta: api.ObjectMeta{
 					Name:      test.serviceName,
 					Namespace: "default",
 				},
-				Endpoints: test.expectedEndpoints,
-				Protocol:  "TCP",
+				Subsets: test.expectedSubsets,
 			}
 			if len(registry.Updates) != 1 {
 				t.Errorf("unexpected updates: %v", registry.Updates)
@@ -202,17 +200,16 @@ func TestEnsureEndpointsContainConverges(t *testing.T) {
 						Name:      "foo",
 						Namespace: api.NamespaceDefault,
 					},
-					Endpoints: []api.Endpoint{
+					Subsets: []api.EndpointSubset{
 						{
-							IP:   "4.3.2.1",
-							Port: 9000,
+							Addresses: []api.EndpointAddress{{IP: "4.3.2.1"}},
+							Ports:     []api.EndpointPort{{Port: 9000, Protocol: api.ProtocolTCP}},
 						},
 						{
-							IP:   "1.2.3.4",
-							Port: 8000,
+							Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+							Ports:     []api.EndpointPort{{Port: 8000, Protocol: api.ProtocolTCP}},
 						},
 					},
-					Protocol: api.ProtocolTCP,
 				},
 			},
 		},
@@ -249,15 +246,19 @@ func TestEnsureEndpointsContainConverges(t *testing.T) {
 	}
 	// Pick up the last update and validate.
 	endpoints := registry.Updates[len(registry.Updates)-1]
-	if len(endpoints.Endpoints) != 2 {
+	if len(endpoints.Subsets) != 2 {
 		t.Errorf("unexpected update: %v", endpoints)
 	}
-	for _, endpoint := range endpoints.Endpoints {
-		if endpoint.IP == "4.3.2.1" && endpoint.Port != 9090 {
-			t.Errorf("unexpected endpoint state: %v", endpoint)
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) != 1 || len(subset.Ports) != 1 {
+			t.Errorf("unexpected subset: %v", subset)
+			continue
 		}
-		if endpoint.IP == "1.2.3.4" && endpoint.Port != 8080 {
-			t.Errorf("unexpected endpoint state: %v", endpoint)
+		if subset.Addresses[0].IP == "4.3.2.1" && subset.Ports[0].Port != 9090 {
+			t.Errorf("unexpected endpoint state: %v", subset)
+		}
+		if subset.Addresses[0].IP == "1.2.3.4" && subset.Ports[0].Port != 8080 {
+			t.Errorf("unexpected endpoint state: %v", subset)
 		}
 	}
 }
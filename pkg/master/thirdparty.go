@@ -0,0 +1,176 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/apiserver"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata"
+	thirdpartyresourcedataetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/glog"
+)
+
+// thirdPartyPrefix is the path under which the kinds registered by third party resources
+// are served, as /thirdparty/{group}/{version}.
+const thirdPartyPrefix = "/thirdparty"
+
+// thirdPartyAPIs serves the kinds registered by third party resources. Each kind, in each
+// of its versions, is installed in its own container, since the codec and the mapper of
+// an APIGroupVersion are shared by all of its resources.
+type thirdPartyAPIs struct {
+	helper    tools.EtcdHelper
+	resources rest.Lister
+	admit     admission.Interface
+	context   api.RequestContextMapper
+	info      *apiserver.APIRequestInfoResolver
+
+	lock sync.RWMutex
+	// containers maps the {group}/{version}/{resource} paths to the containers serving them.
+	containers map[string]*restful.Container
+}
+
+// newThirdPartyAPIs returns the thirdPartyAPIs serving the kinds registered by the third
+// party resources listed from resources, and storing their objects through helper.
+func newThirdPartyAPIs(helper tools.EtcdHelper, resources rest.Lister, admit admission.Interface, context api.RequestContextMapper) *thirdPartyAPIs {
+	return &thirdPartyAPIs{
+		helper:    helper,
+		resources: resources,
+		admit:     admit,
+		context:   context,
+		info: &apiserver.APIRequestInfoResolver{
+			APIGroupPrefixes: util.NewStringSet(thirdPartyPrefix[1:]),
+			RestMapper:       latest.RESTMapper,
+		},
+		containers: map[string]*restful.Container{},
+	}
+}
+
+func (t *thirdPartyAPIs) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	requestInfo, err := t.info.GetAPIRequestInfo(req)
+	if err != nil {
+		http.NotFound(w, req)
+		return
+	}
+	t.lock.RLock()
+	container, found := t.containers[path.Join(requestInfo.APIGroup, requestInfo.APIVersion, requestInfo.Resource)]
+	t.lock.RUnlock()
+	if !found {
+		http.NotFound(w, req)
+		return
+	}
+	container.ServeHTTP(w, req)
+}
+
+// sync installs the kinds of the third party resources which are not served yet, and
+// stops serving the kinds whose third party resource was deleted. The objects of those
+// kinds are kept in etcd, and served again if the resource is created again.
+func (t *thirdPartyAPIs) sync() {
+	obj, err := t.resources.List(api.NewContext(), labels.Everything(), fields.Everything())
+	if err != nil {
+		glog.Errorf("Unable to list the third party resources: %v", err)
+		return
+	}
+
+	current := map[string]bool{}
+	for _, rsrc := range obj.(*api.ThirdPartyResourceList).Items {
+		group, kind, err := thirdpartyresourcedata.ExtractGroupAndKind(&rsrc)
+		if err != nil {
+			util.HandleError(err)
+			continue
+		}
+		for _, version := range rsrc.Versions {
+			if err := t.install(group, kind, version, current); err != nil {
+				util.HandleError(fmt.Errorf("unable to serve %s in %s/%s: %v", kind, group, version, err))
+			}
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key := range t.containers {
+		if !current[key] {
+			glog.Infof("Third party resource %s was removed, no longer serving it", key)
+			delete(t.containers, key)
+		}
+	}
+}
+
+// install serves the given kind in the given version of its group, unless it is served
+// already, and records its path in current.
+func (t *thirdPartyAPIs) install(group, kind, version string, current map[string]bool) error {
+	codec := thirdpartyresourcedata.NewCodec(v1beta3.Codec, kind, group+"/"+version)
+	mapper := thirdpartyresourcedata.NewMapper(kind, version, codec)
+	mapping, err := mapper.RESTMapping(kind, version)
+	if err != nil {
+		return err
+	}
+	key := path.Join(group, version, mapping.Resource)
+	current[key] = true
+
+	t.lock.RLock()
+	_, found := t.containers[key]
+	t.lock.RUnlock()
+	if found {
+		return nil
+	}
+
+	apiGroupVersion := &apiserver.APIGroupVersion{
+		Storage: map[string]rest.Storage{
+			mapping.Resource: thirdpartyresourcedataetcd.NewStorage(t.helper, group, mapping.Resource),
+		},
+
+		Root:    thirdPartyPrefix,
+		Group:   group,
+		Version: version,
+
+		Mapper: mapper,
+
+		Codec:   codec,
+		Creater: thirdpartyresourcedata.NewObjectCreater(kind, "v1beta3"),
+		Typer:   thirdpartyresourcedata.NewObjectTyper(kind, version),
+		Linker:  latest.SelfLinker,
+
+		Admit:   t.admit,
+		Context: t.context,
+	}
+	container := NewHandlerContainer(http.NewServeMux())
+	container.Router(restful.CurlyRouter{})
+	if err := apiGroupVersion.InstallREST(container); err != nil {
+		return err
+	}
+
+	glog.Infof("Serving third party resource %s at %s", kind, path.Join(thirdPartyPrefix, key))
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.containers[key] = container
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package master
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	thirdpartyresourceetcd "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+// setDir makes the objects stored at the given keys the content of dir, since the fake
+// client only lists the directories defined by the test.
+func setDir(fakeEtcdClient *tools.FakeEtcdClient, dir string, keys ...string) {
+	nodes := []*etcd.Node{}
+	for _, key := range keys {
+		nodes = append(nodes, fakeEtcdClient.Data[key].R.Node)
+	}
+	fakeEtcdClient.Data[dir] = tools.EtcdResponseWithError{
+		R: &etcd.Response{Node: &etcd.Node{Key: dir, Dir: true, Nodes: nodes}},
+	}
+}
+
+func request(t *testing.T, method, url string, body string) (int, map[string]interface{}) {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content := map[string]interface{}{}
+	json.Unmarshal(data, &content)
+	return resp.StatusCode, content
+}
+
+func TestThirdPartyAPIs(t *testing.T) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	setDir(fakeEtcdClient, "/registry/thirdpartyresources")
+	resources := thirdpartyresourceetcd.NewStorage(helper)
+	contextMapper := api.NewRequestContextMapper()
+	thirdPartyAPIs := newThirdPartyAPIs(helper, resources, admission.NewFromPlugins(nil, nil, ""), contextMapper)
+	handler, err := api.NewRequestContextFilter(contextMapper, thirdPartyAPIs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	url := server.URL + "/thirdparty/example.com/v1/namespaces/default/databaseclusters"
+	if code, _ := request(t, "GET", url, ""); code != http.StatusNotFound {
+		t.Errorf("expected the kind not to be served before its third party resource exists, got %d", code)
+	}
+
+	rsrc := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+		Versions:   []string{"v1"},
+	}
+	if _, err := resources.Create(api.NewContext(), rsrc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	setDir(fakeEtcdClient, "/registry/thirdpartyresources", "/registry/thirdpartyresources/database-cluster.example.com")
+	thirdPartyAPIs.sync()
+
+	body := `{"kind": "DatabaseCluster", "apiVersion": "example.com/v1", "metadata": {"name": "db", "labels": {"app": "db"}}, "replicas": 3}`
+	code, created := request(t, "POST", url, body)
+	if code != http.StatusCreated {
+		t.Fatalf("unexpected response: %d %v", code, created)
+	}
+	metadata := created["metadata"].(map[string]interface{})
+	if metadata["namespace"] != "default" || len(metadata["resourceVersion"].(string)) == 0 || created["replicas"] != float64(3) {
+		t.Errorf("unexpected object: %v", created)
+	}
+	if metadata["selfLink"] != "/thirdparty/example.com/v1/namespaces/default/databaseclusters/db" {
+		t.Errorf("unexpected self link: %v", metadata["selfLink"])
+	}
+
+	code, obj := request(t, "GET", url+"/db", "")
+	if code != http.StatusOK || obj["kind"] != "DatabaseCluster" || obj["apiVersion"] != "example.com/v1" || obj["replicas"] != float64(3) {
+		t.Errorf("unexpected response: %d %v", code, obj)
+	}
+
+	setDir(fakeEtcdClient, "/registry/thirdparty/example.com/databaseclusters/default", "/registry/thirdparty/example.com/databaseclusters/default/db")
+	code, list := request(t, "GET", url+"?label-selector=app%3Ddb", "")
+	if code != http.StatusOK || list["kind"] != "DatabaseClusterList" {
+		t.Fatalf("unexpected response: %d %v", code, list)
+	}
+	if items := list["items"].([]interface{}); len(items) != 1 {
+		t.Errorf("unexpected items: %v", items)
+	}
+	if code, list := request(t, "GET", url+"?label-selector=app%3Dother", ""); code != http.StatusOK || len(list["items"].([]interface{})) != 0 {
+		t.Errorf("unexpected response: %d %v", code, list)
+	}
+
+	if code, obj := request(t, "POST", url, `{"kind": "Certificate", "apiVersion": "example.com/v1", "metadata": {"name": "cert"}}`); code == http.StatusCreated {
+		t.Errorf("expected an object of another kind to be rejected, got %v", obj)
+	}
+
+	setDir(fakeEtcdClient, "/registry/thirdpartyresources")
+	thirdPartyAPIs.sync()
+	if code, _ := request(t, "GET", url+"/db", ""); code != http.StatusNotFound {
+		t.Errorf("expected the kind not to be served after its third party resource was deleted, got %d", code)
+	}
+}
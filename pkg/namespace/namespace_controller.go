@@ -125,18 +125,50 @@ func deleteAllContent(kubeClient client.Interface, namespace string) (err error)
 	if err != nil {
 		return err
 	}
+	err = deleteHorizontalPodAutoscalers(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+	err = deleteIngresses(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+	err = deleteDeployments(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
 	err = deleteReplicationControllers(kubeClient, namespace)
 	if err != nil {
 		return err
 	}
+	err = deleteJobs(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+	err = deleteDaemonSets(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
+	err = deletePodDisruptionBudgets(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
 	err = deletePods(kubeClient, namespace)
 	if err != nil {
 		return err
 	}
+	err = deleteServiceAccounts(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
 	err = deleteSecrets(kubeClient, namespace)
 	if err != nil {
 		return err
 	}
+	err = deleteConfigMaps(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
 	err = deleteLimitRanges(kubeClient, namespace)
 	if err != nil {
 		return err
@@ -145,6 +177,10 @@ func deleteAllContent(kubeClient client.Interface, namespace string) (err error)
 	if err != nil {
 		return err
 	}
+	err = deletePersistentVolumeClaims(kubeClient, namespace)
+	if err != nil {
+		return err
+	}
 	err = deleteEvents(kubeClient, namespace)
 	if err != nil {
 		return err
@@ -255,13 +291,97 @@ func deleteReplicationControllers(kubeClient client.Interface, ns string) error
 	return nil
 }
 
+func deleteJobs(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.Jobs(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.Jobs(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteDaemonSets(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.DaemonSets(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.DaemonSets(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deletePodDisruptionBudgets(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.PodDisruptionBudgets(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.PodDisruptionBudgets(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteDeployments(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.Deployments(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.Deployments(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteHorizontalPodAutoscalers(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.HorizontalPodAutoscalers(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.HorizontalPodAutoscalers(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteIngresses(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.Ingresses(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.Ingresses(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func deletePods(kubeClient client.Interface, ns string) error {
 	items, err := kubeClient.Pods(ns).List(labels.Everything())
 	if err != nil {
 		return err
 	}
 	for i := range items.Items {
-		err := kubeClient.Pods(ns).Delete(items.Items[i].Name)
+		err := kubeClient.Pods(ns).Delete(items.Items[i].Name, nil)
 		if err != nil {
 			return err
 		}
@@ -283,6 +403,20 @@ func deleteEvents(kubeClient client.Interface, ns string) error {
 	return nil
 }
 
+func deleteServiceAccounts(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.ServiceAccounts(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.ServiceAccounts(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func deleteSecrets(kubeClient client.Interface, ns string) error {
 	items, err := kubeClient.Secrets(ns).List(labels.Everything(), fields.Everything())
 	if err != nil {
@@ -296,3 +430,31 @@ func deleteSecrets(kubeClient client.Interface, ns string) error {
 	}
 	return nil
 }
+
+func deleteConfigMaps(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.ConfigMaps(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.ConfigMaps(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deletePersistentVolumeClaims(kubeClient client.Interface, ns string) error {
+	items, err := kubeClient.PersistentVolumeClaims(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for i := range items.Items {
+		err := kubeClient.PersistentVolumeClaims(ns).Delete(items.Items[i].Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
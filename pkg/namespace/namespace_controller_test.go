@@ -86,8 +86,17 @@ func TestSyncNamespaceThatIsTerminating(t *testing.T) {
 		"list-pods",
 		"list-resourceQuotas",
 		"list-controllers",
+		"list-jobs",
+		"list-daemonSets",
+		"list-podDisruptionBudgets",
+		"list-deployments",
+		"list-horizontalPodAutoscalers",
+		"list-ingresses",
+		"list-serviceAccounts",
 		"list-secrets",
+		"list-configMaps",
 		"list-limitRanges",
+		"list-persistentVolumeClaims",
 		"list-events",
 		"finalize-namespace",
 		"delete-namespace")
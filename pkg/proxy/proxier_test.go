@@ -24,6 +24,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -195,11 +196,11 @@ func waitForNumProxyLoops(t *testing.T, p *Proxier, want int32) {
 
 func TestTCPProxy(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -216,11 +217,11 @@ func TestTCPProxy(t *testing.T) {
 
 func TestUDPProxy(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: udpServerPort}}}},
 		},
 	})
 
@@ -236,7 +237,7 @@ func TestUDPProxy(t *testing.T) {
 }
 
 // Helper: Stops the proxy for the named service.
-func stopProxyByName(proxier *Proxier, service types.NamespacedName) error {
+func stopProxyByName(proxier *Proxier, service ServicePortName) error {
 	info, found := proxier.getServiceInfo(service)
 	if !found {
 		return fmt.Errorf("unknown service: %s", service)
@@ -246,11 +247,11 @@ func stopProxyByName(proxier *Proxier, service types.NamespacedName) error {
 
 func TestTCPProxyStop(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -278,11 +279,11 @@ func TestTCPProxyStop(t *testing.T) {
 
 func TestUDPProxyStop(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: udpServerPort}}}},
 		},
 	})
 
@@ -310,11 +311,11 @@ func TestUDPProxyStop(t *testing.T) {
 
 func TestTCPProxyUpdateDelete(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -341,11 +342,11 @@ func TestTCPProxyUpdateDelete(t *testing.T) {
 
 func TestUDPProxyUpdateDelete(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Namespace: service.Namespace, Name: service.Name},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: udpServerPort}}}},
 		},
 	})
 
@@ -372,11 +373,11 @@ func TestUDPProxyUpdateDelete(t *testing.T) {
 
 func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -400,7 +401,7 @@ func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
 	}
 	waitForNumProxyLoops(t, p, 0)
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP", PortalIP: "1.2.3.4"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
@@ -412,11 +413,11 @@ func TestTCPProxyUpdateDeleteUpdate(t *testing.T) {
 
 func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: udpServerPort}}}},
 		},
 	})
 
@@ -440,7 +441,7 @@ func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
 	}
 	waitForNumProxyLoops(t, p, 0)
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "UDP", PortalIP: "1.2.3.4"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "UDP"}}}, Status: api.ServiceStatus{}},
 	})
 	svcInfo, exists := p.getServiceInfo(service)
 	if !exists {
@@ -452,11 +453,11 @@ func TestUDPProxyUpdateDeleteUpdate(t *testing.T) {
 
 func TestTCPProxyUpdatePort(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -471,7 +472,7 @@ func TestTCPProxyUpdatePort(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: 99, Protocol: "TCP", PortalIP: "1.2.3.4"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Ports: []api.ServicePort{{Port: 99, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	// Wait for the socket to actually get free.
 	if err := waitForClosedPortTCP(p, svcInfo.proxyPort); err != nil {
@@ -489,11 +490,11 @@ func TestTCPProxyUpdatePort(t *testing.T) {
 
 func TestUDPProxyUpdatePort(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: udpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: udpServerPort}}}},
 		},
 	})
 
@@ -507,7 +508,7 @@ func TestUDPProxyUpdatePort(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: 99, Protocol: "UDP", PortalIP: "1.2.3.4"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Ports: []api.ServicePort{{Port: 99, Protocol: "UDP"}}}, Status: api.ServiceStatus{}},
 	})
 	// Wait for the socket to actually get free.
 	if err := waitForClosedPortUDP(p, svcInfo.proxyPort); err != nil {
@@ -523,11 +524,11 @@ func TestUDPProxyUpdatePort(t *testing.T) {
 
 func TestProxyUpdatePortal(t *testing.T) {
 	lb := NewLoadBalancerRR()
-	service := types.NewNamespacedNameOrDie("testnamespace", "echo")
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
 	lb.OnUpdate([]api.Endpoints{
 		{
 			ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: tcpServerPort}},
+			Subsets:    []api.EndpointSubset{{Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}}, Ports: []api.EndpointPort{{Port: tcpServerPort}}}},
 		},
 	})
 
@@ -542,7 +543,7 @@ func TestProxyUpdatePortal(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	_, exists := p.getServiceInfo(service)
 	if exists {
@@ -550,7 +551,7 @@ func TestProxyUpdatePortal(t *testing.T) {
 	}
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP", PortalIP: ""}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "", Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	_, exists = p.getServiceInfo(service)
 	if exists {
@@ -558,7 +559,7 @@ func TestProxyUpdatePortal(t *testing.T) {
 	}
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP", PortalIP: "None"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "None", Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	_, exists = p.getServiceInfo(service)
 	if exists {
@@ -566,7 +567,7 @@ func TestProxyUpdatePortal(t *testing.T) {
 	}
 
 	p.OnUpdate([]api.Service{
-		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{Port: svcInfo.proxyPort, Protocol: "TCP", PortalIP: "1.2.3.4"}, Status: api.ServiceStatus{}},
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Ports: []api.ServicePort{{Port: svcInfo.proxyPort, Protocol: "TCP"}}}, Status: api.ServiceStatus{}},
 	})
 	svcInfo, exists = p.getServiceInfo(service)
 	if !exists {
@@ -576,4 +577,72 @@ func TestProxyUpdatePortal(t *testing.T) {
 	waitForNumProxyLoops(t, p, 1)
 }
 
+// recordingIptables remembers the rules which are currently installed.
+type recordingIptables struct {
+	fakeIptables
+	rules map[iptables.Chain][]string
+}
+
+func (r *recordingIptables) EnsureRule(table iptables.Table, chain iptables.Chain, args ...string) (bool, error) {
+	rule := strings.Join(args, " ")
+	for _, existing := range r.rules[chain] {
+		if existing == rule {
+			return true, nil
+		}
+	}
+	r.rules[chain] = append(r.rules[chain], rule)
+	return false, nil
+}
+
+func (r *recordingIptables) DeleteRule(table iptables.Table, chain iptables.Chain, args ...string) error {
+	rule := strings.Join(args, " ")
+	kept := []string{}
+	for _, existing := range r.rules[chain] {
+		if existing != rule {
+			kept = append(kept, existing)
+		}
+	}
+	r.rules[chain] = kept
+	return nil
+}
+
+func TestProxyNodePort(t *testing.T) {
+	lb := NewLoadBalancerRR()
+	service := ServicePortName{types.NewNamespacedNameOrDie("testnamespace", "echo"), ""}
+	ipt := &recordingIptables{rules: map[iptables.Chain][]string{}}
+	p := CreateProxier(lb, net.ParseIP("0.0.0.0"), ipt, net.ParseIP("127.0.0.1"))
+	waitForNumProxyLoops(t, p, 0)
+
+	p.OnUpdate([]api.Service{
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Type: api.ServiceTypeNodePort, Ports: []api.ServicePort{{Port: 80, NodePort: 30080, Protocol: "TCP"}}}},
+	})
+	svcInfo, exists := p.getServiceInfo(service)
+	if !exists {
+		t.Fatalf("service with a node port not found in the proxy")
+	}
+	waitForNumProxyLoops(t, p, 1)
+	expectedContainer := fmt.Sprintf("-m comment --comment %s -p tcp -m tcp --dport 30080 -j REDIRECT --to-ports %d", service, svcInfo.proxyPort)
+	if rules := ipt.rules[iptablesContainerNodePortChain]; len(rules) != 1 || rules[0] != expectedContainer {
+		t.Errorf("expected container node port rule %q, got %v", expectedContainer, rules)
+	}
+	expectedHost := fmt.Sprintf("-m comment --comment %s -p tcp -m tcp --dport 30080 -j DNAT --to-destination 127.0.0.1:%d", service, svcInfo.proxyPort)
+	if rules := ipt.rules[iptablesHostNodePortChain]; len(rules) != 1 || rules[0] != expectedHost {
+		t.Errorf("expected host node port rule %q, got %v", expectedHost, rules)
+	}
+
+	p.OnUpdate([]api.Service{
+		{ObjectMeta: api.ObjectMeta{Name: service.Name, Namespace: service.Namespace}, Spec: api.ServiceSpec{PortalIP: "1.2.3.4", Type: api.ServiceTypeClusterIP, Ports: []api.ServicePort{{Port: 80, Protocol: "TCP"}}}},
+	})
+	if rules := ipt.rules[iptablesContainerNodePortChain]; len(rules) != 0 {
+		t.Errorf("expected no container node port rules, got %v", rules)
+	}
+	if rules := ipt.rules[iptablesHostNodePortChain]; len(rules) != 0 {
+		t.Errorf("expected no host node port rules, got %v", rules)
+	}
+	if rules := ipt.rules[iptablesContainerPortalChain]; len(rules) != 1 {
+		t.Errorf("expected 1 container portal rule, got %v", rules)
+	}
+	waitForNumProxyLoops(t, p, 1)
+}
+
 // TODO: Test UDP timeouts.
@@ -0,0 +1,161 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/configmap"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage := NewStorage(h)
+	return storage, fakeEtcdClient, h
+}
+
+func validNewConfigMap(name, ns string) *api.ConfigMap {
+	return &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Data: map[string]string{"log-level": "debug"},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _ := newStorage(t)
+	configmap.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	configMap := validNewConfigMap("foo", api.NamespaceDefault)
+	configMap.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		configMap,
+		// invalid
+		&api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestEtcdListConfigMaps(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewConfigMap("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewConfigMap("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	configMapObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	configMaps := configMapObj.(*api.ConfigMapList)
+	if len(configMaps.Items) != 2 || configMaps.Items[0].Name != "foo" || configMaps.Items[1].Name != "bar" {
+		t.Errorf("Unexpected config map list: %#v", configMaps)
+	}
+}
+
+func TestEtcdGetConfigMap(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	configMap := validNewConfigMap("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, configMap), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.ConfigMap)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(configMap.Data, actual.Data) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(configMap, actual))
+	}
+}
+
+func TestEtcdUpdateConfigMap(t *testing.T) {
+	registry, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewConfigMap("foo", api.NamespaceDefault)), 1)
+
+	configMapIn := validNewConfigMap("foo", api.NamespaceDefault)
+	configMapIn.ResourceVersion = "1"
+	configMapIn.Data["log-level"] = "info"
+	if _, _, err := registry.Update(ctx, configMapIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var configMapOut api.ConfigMap
+	if err := helper.ExtractObj(key, &configMapOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(configMapIn.Data, configMapOut.Data) {
+		t.Errorf("unexpected data: %s", util.ObjectDiff(configMapIn.Data, configMapOut.Data))
+	}
+}
+
+func TestEtcdDeleteConfigMap(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewConfigMap("foo", api.NamespaceDefault)), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
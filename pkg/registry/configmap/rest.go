@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// configMapStrategy implements behavior for ConfigMap objects
+type configMapStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ConfigMap
+// objects via the REST API.
+var Strategy = configMapStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for config maps.
+func (configMapStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate is a no-op, config maps have no status.
+func (configMapStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new config map.
+func (configMapStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateConfigMap(obj.(*api.ConfigMap))
+}
+
+// AllowCreateOnUpdate is false for config maps.
+func (configMapStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (configMapStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateConfigMapUpdate(obj.(*api.ConfigMap), old.(*api.ConfigMap))
+}
+
+// MatchConfigMap returns a generic matcher for a given label and field selector.
+func MatchConfigMap(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		configMapObj, ok := obj.(*api.ConfigMap)
+		if !ok {
+			return false, fmt.Errorf("not a config map")
+		}
+		fields := ConfigMapToSelectableFields(configMapObj)
+		return label.Matches(labels.Set(configMapObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ConfigMapToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ConfigMapToSelectableFields(configMap *api.ConfigMap) labels.Set {
+	return labels.Set{
+		"name": configMap.Name,
+	}
+}
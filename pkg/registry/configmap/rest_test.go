@@ -0,0 +1,54 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestConfigMapStrategy(t *testing.T) {
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("ConfigMap should be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("ConfigMap should not allow create on update")
+	}
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "my-config", Namespace: api.NamespaceDefault},
+	}
+	if errs := Strategy.Validate(configMap); len(errs) != 0 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
+
+func TestMatchConfigMap(t *testing.T) {
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Name: "my-config", Labels: map[string]string{"team": "a"}},
+	}
+	matcher := MatchConfigMap(labels.Everything(), fields.SelectorFromSet(fields.Set{"name": "my-config"}))
+	if ok, err := matcher.Matches(configMap); !ok || err != nil {
+		t.Errorf("expected a match on the name field, got %v %v", ok, err)
+	}
+	matcher = MatchConfigMap(labels.SelectorFromSet(labels.Set{"team": "b"}), fields.Everything())
+	if ok, err := matcher.Matches(configMap); ok || err != nil {
+		t.Errorf("expected no match on another label, got %v %v", ok, err)
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/daemonset"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewDaemonSet(name, ns string) *api.DaemonSet {
+	selector := map[string]string{"daemon": name}
+	return &api.DaemonSet{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.DaemonSetSpec{
+			Selector: selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: selector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "test", Image: "test_image", ImagePullPolicy: api.PullIfNotPresent}},
+				},
+			},
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	daemonset.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewDaemonSet("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.DaemonSet{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewDaemonSet("foo", api.NamespaceDefault)
+	j.Status.CurrentNumberScheduled = 2
+	j.Status.DesiredNumberScheduled = 2
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.DaemonSet{}
+	if err := helper.ExtractObj("/registry/daemonsets/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected daemon set: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected daemon set UID to be set: %#v", actual)
+	}
+	if actual.Status.CurrentNumberScheduled != 0 || actual.Status.DesiredNumberScheduled != 0 {
+		t.Errorf("expected new daemon set to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListDaemonSets(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewDaemonSet("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewDaemonSet("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	dsObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	daemonSets := dsObj.(*api.DaemonSetList)
+	if len(daemonSets.Items) != 2 || daemonSets.Items[0].Name != "foo" || daemonSets.Items[1].Name != "bar" {
+		t.Errorf("Unexpected daemon set list: %#v", daemonSets)
+	}
+}
+
+func TestEtcdGetDaemonSet(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewDaemonSet("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.DaemonSet)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec.Selector, actual.Spec.Selector) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeleteDaemonSet(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewDaemonSet("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	dsStart := validNewDaemonSet("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, dsStart), 1)
+
+	dsIn := validNewDaemonSet("foo", api.NamespaceDefault)
+	dsIn.ResourceVersion = "1"
+	dsIn.Spec.Selector = map[string]string{"daemon": "bar"}
+	dsIn.Status = api.DaemonSetStatus{
+		CurrentNumberScheduled: 1,
+		NumberMisscheduled:     1,
+		DesiredNumberScheduled: 2,
+	}
+
+	if _, _, err := status.Update(ctx, dsIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var dsOut api.DaemonSet
+	if err := helper.ExtractObj(key, &dsOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(dsOut.Spec.Selector, dsStart.Spec.Selector) {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", dsOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(dsIn.Status, dsOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(dsIn.Status, dsOut.Status))
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/deployment"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewDeployment(name, ns string) *api.Deployment {
+	selector := map[string]string{"deployment": name}
+	return &api.Deployment{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.DeploymentSpec{
+			Replicas: 2,
+			Selector: selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: selector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyAlways,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "test", Image: "test_image", ImagePullPolicy: api.PullIfNotPresent}},
+				},
+			},
+			Strategy: api.DeploymentStrategy{
+				Type: api.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &api.RollingUpdateDeployment{
+					MaxUnavailable: util.NewIntOrStringFromInt(1),
+					MaxSurge:       util.NewIntOrStringFromInt(1),
+				},
+			},
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	deployment.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewDeployment("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.Deployment{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewDeployment("foo", api.NamespaceDefault)
+	j.Status.Replicas = 2
+	j.Status.UpdatedReplicas = 2
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.Deployment{}
+	if err := helper.ExtractObj("/registry/deployments/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected deployment: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected deployment UID to be set: %#v", actual)
+	}
+	if actual.Status.Replicas != 0 || actual.Status.UpdatedReplicas != 0 {
+		t.Errorf("expected new deployment to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListDeployments(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewDeployment("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewDeployment("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	deploymentObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deployments := deploymentObj.(*api.DeploymentList)
+	if len(deployments.Items) != 2 || deployments.Items[0].Name != "foo" || deployments.Items[1].Name != "bar" {
+		t.Errorf("Unexpected deployment list: %#v", deployments)
+	}
+}
+
+func TestEtcdGetDeployment(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewDeployment("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.Deployment)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec.Selector, actual.Spec.Selector) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeleteDeployment(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewDeployment("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	deploymentStart := validNewDeployment("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, deploymentStart), 1)
+
+	deploymentIn := validNewDeployment("foo", api.NamespaceDefault)
+	deploymentIn.ResourceVersion = "1"
+	deploymentIn.Spec.Selector = map[string]string{"deployment": "bar"}
+	deploymentIn.Status = api.DeploymentStatus{
+		Replicas:            3,
+		UpdatedReplicas:     1,
+		AvailableReplicas:   2,
+		UnavailableReplicas: 1,
+	}
+
+	if _, _, err := status.Update(ctx, deploymentIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var deploymentOut api.Deployment
+	if err := helper.ExtractObj(key, &deploymentOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(deploymentOut.Spec.Selector, deploymentStart.Spec.Selector) {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", deploymentOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(deploymentIn.Status, deploymentOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(deploymentIn.Status, deploymentOut.Status))
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store Deployment objects.
+type Registry interface {
+	// ListDeployments obtains a list of deployments having labels which match selector.
+	ListDeployments(ctx api.Context, selector labels.Selector) (*api.DeploymentList, error)
+	// Watch for new/changed/deleted deployments
+	WatchDeployments(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific deployment
+	GetDeployment(ctx api.Context, name string) (*api.Deployment, error)
+	// Create a deployment based on a specification.
+	CreateDeployment(ctx api.Context, deployment *api.Deployment) error
+	// Update an existing deployment
+	UpdateDeployment(ctx api.Context, deployment *api.Deployment) error
+	// Delete an existing deployment
+	DeleteDeployment(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListDeployments(ctx api.Context, label labels.Selector) (*api.DeploymentList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.DeploymentList), nil
+}
+
+func (s *storage) WatchDeployments(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetDeployment(ctx api.Context, name string) (*api.Deployment, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.Deployment), nil
+}
+
+func (s *storage) CreateDeployment(ctx api.Context, deployment *api.Deployment) error {
+	_, err := s.Create(ctx, deployment)
+	return err
+}
+
+func (s *storage) UpdateDeployment(ctx api.Context, deployment *api.Deployment) error {
+	_, _, err := s.Update(ctx, deployment)
+	return err
+}
+
+func (s *storage) DeleteDeployment(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// deploymentStrategy implements behavior for Deployment objects
+type deploymentStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Deployment
+// objects via the REST API.
+var Strategy = deploymentStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for deployments.
+func (deploymentStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears the Status field which is not allowed to be set by end users on creation.
+func (deploymentStrategy) ResetBeforeCreate(obj runtime.Object) {
+	deployment := obj.(*api.Deployment)
+	deployment.Status = api.DeploymentStatus{}
+}
+
+// Validate validates a new deployment.
+func (deploymentStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	deployment := obj.(*api.Deployment)
+	return validation.ValidateDeployment(deployment)
+}
+
+// AllowCreateOnUpdate is false for deployments.
+func (deploymentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (deploymentStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateDeploymentUpdate(old.(*api.Deployment), obj.(*api.Deployment))
+}
+
+type deploymentStatusStrategy struct {
+	deploymentStrategy
+}
+
+var StatusStrategy = deploymentStatusStrategy{Strategy}
+
+func (deploymentStatusStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateDeploymentStatusUpdate(old.(*api.Deployment), obj.(*api.Deployment))
+}
+
+// MatchDeployment returns a generic matcher for a given label and field selector.
+func MatchDeployment(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		deploymentObj, ok := obj.(*api.Deployment)
+		if !ok {
+			return false, fmt.Errorf("not a deployment")
+		}
+		fields := DeploymentToSelectableFields(deploymentObj)
+		return label.Matches(labels.Set(deploymentObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// DeploymentToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func DeploymentToSelectableFields(deployment *api.Deployment) labels.Set {
+	return labels.Set{
+		"name": deployment.Name,
+	}
+}
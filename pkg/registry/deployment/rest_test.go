@@ -0,0 +1,43 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestDeploymentStrategy(t *testing.T) {
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("Deployment should be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("Deployment should not allow create on update")
+	}
+	deployment := &api.Deployment{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Status: api.DeploymentStatus{
+			Replicas:        3,
+			UpdatedReplicas: 2,
+		},
+	}
+	Strategy.ResetBeforeCreate(deployment)
+	if deployment.Status.Replicas != 0 || deployment.Status.UpdatedReplicas != 0 {
+		t.Errorf("Deployment does not allow setting status on create")
+	}
+}
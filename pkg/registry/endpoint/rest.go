@@ -21,6 +21,7 @@ import (
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
@@ -71,6 +72,9 @@ func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, err
 		return nil, errors.NewConflict("endpoints", endpoints.Namespace, fmt.Errorf("Endpoints.Namespace does not match the provided context"))
 	}
 	api.FillObjectMetaSystemFields(ctx, &endpoints.ObjectMeta)
+	if errs := validation.ValidateEndpoints(endpoints); len(errs) > 0 {
+		return nil, errors.NewInvalid("endpoints", endpoints.Name, errs)
+	}
 
 	err := rs.registry.UpdateEndpoints(ctx, endpoints)
 	if err != nil {
@@ -85,6 +89,12 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 	if !ok {
 		return nil, false, fmt.Errorf("not an endpoints: %#v", obj)
 	}
+	if !api.ValidNamespace(ctx, &endpoints.ObjectMeta) {
+		return nil, false, errors.NewConflict("endpoints", endpoints.Namespace, fmt.Errorf("Endpoints.Namespace does not match the provided context"))
+	}
+	if errs := validation.ValidateEndpoints(endpoints); len(errs) > 0 {
+		return nil, false, errors.NewInvalid("endpoints", endpoints.Name, errs)
+	}
 	err := rs.registry.UpdateEndpoints(ctx, endpoints)
 	if err != nil {
 		return nil, false, err
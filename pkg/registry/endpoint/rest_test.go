@@ -31,7 +31,10 @@ func TestGetEndpoints(t *testing.T) {
 	registry := &registrytest.ServiceRegistry{
 		Endpoints: api.Endpoints{
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
-			Endpoints:  []api.Endpoint{{IP: "127.0.0.1", Port: 9000}},
+			Subsets: []api.EndpointSubset{{
+				Addresses: []api.EndpointAddress{{IP: "127.0.0.1"}},
+				Ports:     []api.EndpointPort{{Port: 9000}},
+			}},
 		},
 	}
 	storage := NewStorage(registry)
@@ -40,7 +43,7 @@ func TestGetEndpoints(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %#v", err)
 	}
-	if !reflect.DeepEqual([]api.Endpoint{{IP: "127.0.0.1", Port: 9000}}, obj.(*api.Endpoints).Endpoints) {
+	if !reflect.DeepEqual(registry.Endpoints.Subsets, obj.(*api.Endpoints).Subsets) {
 		t.Errorf("unexpected endpoints: %#v", obj)
 	}
 }
@@ -66,7 +69,7 @@ func TestGetEndpointsMissingService(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if obj.(*api.Endpoints).Endpoints != nil {
+	if obj.(*api.Endpoints).Subsets != nil {
 		t.Errorf("unexpected endpoints: %#v", obj)
 	}
 }
@@ -97,3 +100,24 @@ func TestEndpointsRegistryList(t *testing.T) {
 		t.Errorf("Unexpected resource version: %#v", sl)
 	}
 }
+
+func TestEndpointsRegistryValidatesCreate(t *testing.T) {
+	registry := registrytest.NewServiceRegistry()
+	storage := NewStorage(registry)
+	ctx := api.NewDefaultContext()
+	endpoints := &api.Endpoints{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Subsets: []api.EndpointSubset{{
+			Addresses: []api.EndpointAddress{{IP: "not-an-ip"}},
+			Ports:     []api.EndpointPort{{Port: 80, Protocol: api.ProtocolTCP}},
+		}},
+	}
+	if _, err := storage.Create(ctx, endpoints); !errors.IsInvalid(err) {
+		t.Errorf("expected an invalid error, got %v", err)
+	}
+
+	endpoints.Subsets[0].Addresses[0].IP = "1.2.3.4"
+	if _, err := storage.Create(ctx, endpoints); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
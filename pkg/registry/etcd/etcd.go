@@ -85,11 +85,35 @@ func MakeEtcdItemKey(ctx api.Context, prefix string, id string) (string, error)
 	return key, nil
 }
 
+// extractToList lists the objects under key into listObj, from the watch cache if the
+// context requests the resource version "0", and a page at a time if the context holds
+// a limit or a continue token.
+func (r *Registry) extractToList(ctx api.Context, key string, listObj runtime.Object) error {
+	limit, _ := api.LimitFrom(ctx)
+	continueToken, _ := api.ContinueFrom(ctx)
+	var next string
+	var err error
+	if resourceVersion, _ := api.ResourceVersionFrom(ctx); resourceVersion == "0" {
+		next, err = r.ExtractCachedToListPage(key, listObj, tools.Everything, limit, continueToken)
+	} else {
+		next, err = r.ExtractToListPage(key, listObj, tools.Everything, limit, continueToken)
+	}
+	if err != nil {
+		return err
+	}
+	listMeta, err := api.ListMetaFor(listObj)
+	if err != nil {
+		return err
+	}
+	listMeta.Continue = next
+	return nil
+}
+
 // ListControllers obtains a list of ReplicationControllers.
 func (r *Registry) ListControllers(ctx api.Context) (*api.ReplicationControllerList, error) {
 	controllers := &api.ReplicationControllerList{}
 	key := makeControllerListKey(ctx)
-	err := r.ExtractToList(key, controllers)
+	err := r.extractToList(ctx, key, controllers)
 	return controllers, err
 }
 
@@ -197,7 +221,7 @@ func makeServiceKey(ctx api.Context, name string) (string, error) {
 // ListServices obtains a list of Services.
 func (r *Registry) ListServices(ctx api.Context) (*api.ServiceList, error) {
 	list := &api.ServiceList{}
-	err := r.ExtractToList(makeServiceListKey(ctx), list)
+	err := r.extractToList(ctx, makeServiceListKey(ctx), list)
 	return list, err
 }
 
@@ -310,7 +334,7 @@ func (r *Registry) WatchServices(ctx api.Context, label labels.Selector, field f
 func (r *Registry) ListEndpoints(ctx api.Context) (*api.EndpointsList, error) {
 	list := &api.EndpointsList{}
 	key := makeServiceEndpointsListKey(ctx)
-	err := r.ExtractToList(key, list)
+	err := r.extractToList(ctx, key, list)
 	return list, err
 }
 
@@ -361,7 +385,7 @@ func makeNodeListKey() string {
 
 func (r *Registry) ListMinions(ctx api.Context) (*api.NodeList, error) {
 	minions := &api.NodeList{}
-	err := r.ExtractToList(makeNodeListKey(), minions)
+	err := r.extractToList(ctx, makeNodeListKey(), minions)
 	return minions, err
 }
 
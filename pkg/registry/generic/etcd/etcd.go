@@ -28,6 +28,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
 
 	"github.com/golang/glog"
@@ -141,14 +142,55 @@ func (e *Etcd) List(ctx api.Context, label labels.Selector, field fields.Selecto
 	return e.ListPredicate(ctx, e.PredicateFunc(label, field))
 }
 
-// ListPredicate returns a list of all the items matching m.
+// ListPredicate returns a list of all the items matching m. The items come from the
+// watch cache of the helper if the context requests the resource version "0". If the
+// context holds a limit or a continue token, the list is a page of the items matching m.
 func (e *Etcd) ListPredicate(ctx api.Context, m generic.Matcher) (runtime.Object, error) {
 	list := e.NewListFunc()
-	err := e.Helper.ExtractToList(e.KeyRootFunc(ctx), list)
+	limit, _ := api.LimitFrom(ctx)
+	continueToken, _ := api.ContinueFrom(ctx)
+	var matchErr error
+	filter := func(obj runtime.Object) bool {
+		if matchErr != nil {
+			return false
+		}
+		matches, err := m.Matches(obj)
+		if err != nil {
+			matchErr = err
+			return false
+		}
+		return matches
+	}
+	var next string
+	var err error
+	if resourceVersion, _ := api.ResourceVersionFrom(ctx); resourceVersion == "0" {
+		next, err = e.Helper.ExtractCachedToListPage(e.KeyRootFunc(ctx), list, filter, limit, continueToken)
+	} else {
+		next, err = e.Helper.ExtractToListPage(e.KeyRootFunc(ctx), list, filter, limit, continueToken)
+	}
+	if err == nil {
+		err = matchErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	listMeta, err := api.ListMetaFor(list)
 	if err != nil {
 		return nil, err
 	}
-	return generic.FilterList(list, m, generic.DecoratorFunc(e.Decorator))
+	listMeta.Continue = next
+	if e.Decorator != nil {
+		items, err := runtime.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if err := e.Decorator(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return list, nil
 }
 
 // CreateWithName inserts a new item with the provided name
@@ -231,6 +273,9 @@ func (e *Etcd) UpdateWithName(ctx api.Context, name string, obj runtime.Object)
 			return err
 		}
 	}
+	if deletion := deletionTTL(obj); deletion > ttl {
+		ttl = deletion
+	}
 	err = e.Helper.SetObj(key, obj, nil, ttl)
 	err = etcderr.InterpretUpdateError(err, e.EndpointName, name)
 	if err == nil && e.Decorator != nil {
@@ -296,6 +341,10 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 				return nil, 0, err
 			}
 		}
+		// keep the backstop of an object being gracefully deleted
+		if deletion := deletionTTL(existing); deletion > ttl {
+			ttl = deletion
+		}
 		return obj, ttl, nil
 	})
 
@@ -320,6 +369,15 @@ func (e *Etcd) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool
 				return nil, false, err
 			}
 		}
+		if isFinalized(out) {
+			deleted := e.NewFunc()
+			if err := e.Helper.DeleteObj(key, deleted); err != nil {
+				return nil, false, etcderr.InterpretDeleteError(err, e.EndpointName, name)
+			}
+			if _, err := e.finalizeDelete(deleted, true); err != nil {
+				return nil, false, err
+			}
+		}
 	}
 	if e.Decorator != nil {
 		if err := e.Decorator(obj); err != nil {
@@ -347,7 +405,38 @@ func (e *Etcd) Get(ctx api.Context, name string) (runtime.Object, error) {
 	return obj, nil
 }
 
-// Delete removes the item from etcd.
+var (
+	errAlreadyDeleting = fmt.Errorf("object is already being deleted")
+	errDeleteNow       = fmt.Errorf("object should be deleted immediately")
+)
+
+// gracefulDeletionSlackSeconds is how long a gracefully deleted object is kept past
+// its grace period before etcd expires it.
+const gracefulDeletionSlackSeconds = 30
+
+// deletionTTL returns the TTL of the key of an object being gracefully deleted: the
+// time left in its grace period plus some slack, after which etcd removes the object
+// if nobody else did, e.g. because the node running a pod is gone. It returns zero
+// for other objects, and for objects awaiting finalizers.
+func deletionTTL(obj runtime.Object) uint64 {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil || objectMeta.DeletionTimestamp == nil || len(objectMeta.Finalizers) > 0 {
+		return 0
+	}
+	if objectMeta.DeletionGracePeriodSeconds == nil || *objectMeta.DeletionGracePeriodSeconds == 0 {
+		return 0
+	}
+	remaining := int64(objectMeta.DeletionTimestamp.Sub(util.Now().Time).Seconds())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return uint64(remaining + gracefulDeletionSlackSeconds)
+}
+
+// Delete removes the item from etcd. Objects whose DeleteStrategy allows it are
+// deleted gracefully: their DeletionTimestamp and DeletionGracePeriodSeconds are
+// set, and another party is responsible for removing them once the grace period
+// is over. Their key expires a little later, in case nobody does.
 func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions) (runtime.Object, error) {
 	key, err := e.KeyFunc(ctx, name)
 	if err != nil {
@@ -372,10 +461,68 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	}
 	if graceful && *options.GracePeriodSeconds != 0 {
 		out := e.NewFunc()
-		if err := e.Helper.SetObj(key, obj, out, uint64(*options.GracePeriodSeconds)); err != nil {
+		err := e.Helper.AtomicUpdate(key, out, false, func(existing runtime.Object) (runtime.Object, uint64, error) {
+			// the object may have changed since it was read, check it again
+			graceful, pendingGraceful, err := rest.BeforeDelete(e.DeleteStrategy, ctx, existing, options)
+			if err != nil {
+				return nil, 0, err
+			}
+			if pendingGraceful {
+				return nil, 0, errAlreadyDeleting
+			}
+			if !graceful || *options.GracePeriodSeconds == 0 {
+				return nil, 0, errDeleteNow
+			}
+			return existing, deletionTTL(existing), nil
+		})
+		switch err {
+		case nil:
+			// the object is removed once the grace period is over
+			return e.finalizeDelete(out, false)
+		case errAlreadyDeleting:
+			return e.finalizeDelete(obj, false)
+		case errDeleteNow:
+			// fall through and delete the object immediately
+		default:
+			return nil, etcderr.InterpretUpdateError(err, e.EndpointName, name)
+		}
+	}
+
+	// objects with finalizers are only marked as deleted, they are removed by the update
+	// clearing their last finalizer
+	orphan := options.OrphanDependents != nil && *options.OrphanDependents
+	if !orphan && isAwaitingFinalizers(obj) {
+		return e.finalizeDelete(obj, false)
+	}
+	if orphan || hasFinalizers(obj) {
+		out := e.NewFunc()
+		err := e.Helper.AtomicUpdate(key, out, false, func(existing runtime.Object) (runtime.Object, uint64, error) {
+			objectMeta, err := api.ObjectMetaFor(existing)
+			if err != nil {
+				return nil, 0, err
+			}
+			if orphan && !util.NewStringSet(objectMeta.Finalizers...).Has(api.FinalizerOrphan) {
+				objectMeta.Finalizers = append(objectMeta.Finalizers, api.FinalizerOrphan)
+			}
+			if len(objectMeta.Finalizers) == 0 {
+				return nil, 0, errDeleteNow
+			}
+			now := util.Now()
+			if objectMeta.DeletionTimestamp == nil || objectMeta.DeletionTimestamp.After(now.Time) {
+				gracePeriod := int64(0)
+				objectMeta.DeletionTimestamp = &now
+				objectMeta.DeletionGracePeriodSeconds = &gracePeriod
+			}
+			return existing, 0, nil
+		})
+		switch err {
+		case nil:
+			return e.finalizeDelete(out, false)
+		case errDeleteNow:
+			// the finalizers were cleared meanwhile, delete the object immediately
+		default:
 			return nil, etcderr.InterpretUpdateError(err, e.EndpointName, name)
 		}
-		return e.finalizeDelete(out, true)
 	}
 
 	// delete immediately, or no graceful deletion supported
@@ -386,6 +533,33 @@ func (e *Etcd) Delete(ctx api.Context, name string, options *api.DeleteOptions)
 	return e.finalizeDelete(out, true)
 }
 
+// hasFinalizers returns true if the object has finalizers which must be cleared before it
+// is removed.
+func hasFinalizers(obj runtime.Object) bool {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	return err == nil && len(objectMeta.Finalizers) > 0
+}
+
+// isAwaitingFinalizers returns true if the object was already deleted, and is only kept
+// until its finalizers are cleared.
+func isAwaitingFinalizers(obj runtime.Object) bool {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil || objectMeta.DeletionTimestamp == nil || len(objectMeta.Finalizers) == 0 {
+		return false
+	}
+	return objectMeta.DeletionGracePeriodSeconds != nil && *objectMeta.DeletionGracePeriodSeconds == 0
+}
+
+// isFinalized returns true if the object was deleted while it had finalizers, and they
+// have all been cleared since.
+func isFinalized(obj runtime.Object) bool {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil || objectMeta.DeletionTimestamp == nil || len(objectMeta.Finalizers) > 0 {
+		return false
+	}
+	return objectMeta.DeletionGracePeriodSeconds != nil && *objectMeta.DeletionGracePeriodSeconds == 0
+}
+
 func (e *Etcd) finalizeDelete(obj runtime.Object, runHooks bool) (runtime.Object, error) {
 	if runHooks && e.AfterDelete != nil {
 		if err := e.AfterDelete(obj); err != nil {
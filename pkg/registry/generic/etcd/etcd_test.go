@@ -19,7 +19,9 @@ package etcd
 import (
 	"fmt"
 	"path"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -646,6 +648,63 @@ func TestEtcdDelete(t *testing.T) {
 	}
 }
 
+func TestEtcdDeleteWithFinalizers(t *testing.T) {
+	fakeClient, registry := NewTestGenericEtcdRegistry(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.Set("/registry/pods/foo", runtime.EncodeOrDie(testapi.Codec(), &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault}}), 0)
+
+	orphan := true
+	if _, err := registry.Delete(ctx, "foo", &api.DeleteOptions{OrphanDependents: &orphan}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("expected the pod to be kept until it is finalized, got %v", err)
+	}
+	pod := obj.(*api.Pod)
+	if pod.DeletionTimestamp == nil || !reflect.DeepEqual(pod.Finalizers, []string{api.FinalizerOrphan}) {
+		t.Fatalf("expected the pod to be marked as deleted with the orphan finalizer, got %#v", pod.ObjectMeta)
+	}
+
+	// a delete without orphaning still waits for the finalizers
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(ctx, "foo"); err != nil {
+		t.Fatalf("expected the pod to be kept until it is finalized, got %v", err)
+	}
+
+	pod.Finalizers = nil
+	if _, _, err := registry.Update(ctx, pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := registry.Get(ctx, "foo"); !errors.IsNotFound(err) {
+		t.Errorf("expected the pod to be removed once finalized, got %v", err)
+	}
+}
+
+func TestDeletionTTL(t *testing.T) {
+	inMinute := util.NewTime(util.Now().Add(time.Minute))
+	longAgo := util.NewTime(util.Now().Add(-time.Hour))
+	gracePeriod, noGracePeriod := int64(60), int64(0)
+
+	testCases := []struct {
+		name     string
+		meta     api.ObjectMeta
+		min, max uint64
+	}{
+		{"not deleted", api.ObjectMeta{}, 0, 0},
+		{"pending deletion", api.ObjectMeta{DeletionTimestamp: &inMinute, DeletionGracePeriodSeconds: &gracePeriod}, 59, 60 + gracefulDeletionSlackSeconds},
+		{"grace period over", api.ObjectMeta{DeletionTimestamp: &longAgo, DeletionGracePeriodSeconds: &gracePeriod}, gracefulDeletionSlackSeconds, gracefulDeletionSlackSeconds},
+		{"awaiting finalizers", api.ObjectMeta{DeletionTimestamp: &longAgo, DeletionGracePeriodSeconds: &noGracePeriod, Finalizers: []string{"foo"}}, 0, 0},
+	}
+	for _, tc := range testCases {
+		if ttl := deletionTTL(&api.Pod{ObjectMeta: tc.meta}); ttl < tc.min || ttl > tc.max {
+			t.Errorf("%s: expected a TTL between %d and %d, got %d", tc.name, tc.min, tc.max, ttl)
+		}
+	}
+}
+
 func TestEtcdWatch(t *testing.T) {
 	podA := &api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "foo", ResourceVersion: "1"},
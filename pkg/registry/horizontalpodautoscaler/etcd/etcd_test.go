@@ -0,0 +1,205 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/horizontalpodautoscaler"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewHorizontalPodAutoscaler(name, ns string) *api.HorizontalPodAutoscaler {
+	return &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.HorizontalPodAutoscalerSpec{
+			ReplicationController: "frontend",
+			MinReplicas:           1,
+			MaxReplicas:           5,
+			TargetCPUUtilization:  80,
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	horizontalpodautoscaler.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.HorizontalPodAutoscaler{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	j.Status.CurrentReplicas = 2
+	j.Status.DesiredReplicas = 2
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.HorizontalPodAutoscaler{}
+	if err := helper.ExtractObj("/registry/horizontalpodautoscalers/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected autoscaler: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected autoscaler UID to be set: %#v", actual)
+	}
+	if actual.Status.CurrentReplicas != 0 || actual.Status.DesiredReplicas != 0 {
+		t.Errorf("expected new autoscaler to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListHorizontalPodAutoscalers(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewHorizontalPodAutoscaler("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	autoscalerObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	autoscalers := autoscalerObj.(*api.HorizontalPodAutoscalerList)
+	if len(autoscalers.Items) != 2 || autoscalers.Items[0].Name != "foo" || autoscalers.Items[1].Name != "bar" {
+		t.Errorf("Unexpected autoscaler list: %#v", autoscalers)
+	}
+}
+
+func TestEtcdGetHorizontalPodAutoscaler(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.HorizontalPodAutoscaler)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec, actual.Spec) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeleteHorizontalPodAutoscaler(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	autoscalerStart := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, autoscalerStart), 1)
+
+	autoscalerIn := validNewHorizontalPodAutoscaler("foo", api.NamespaceDefault)
+	autoscalerIn.ResourceVersion = "1"
+	autoscalerIn.Spec.MaxReplicas = 10
+	utilization := 95
+	autoscalerIn.Status = api.HorizontalPodAutoscalerStatus{
+		CurrentReplicas:       3,
+		DesiredReplicas:       4,
+		CurrentCPUUtilization: &utilization,
+	}
+
+	if _, _, err := status.Update(ctx, autoscalerIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var autoscalerOut api.HorizontalPodAutoscaler
+	if err := helper.ExtractObj(key, &autoscalerOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(autoscalerOut.Spec, autoscalerStart.Spec) {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", autoscalerOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(autoscalerIn.Status, autoscalerOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(autoscalerIn.Status, autoscalerOut.Status))
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horizontalpodautoscaler
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store HorizontalPodAutoscaler objects.
+type Registry interface {
+	// ListHorizontalPodAutoscalers obtains a list of autoscalers having labels which match selector.
+	ListHorizontalPodAutoscalers(ctx api.Context, selector labels.Selector) (*api.HorizontalPodAutoscalerList, error)
+	// Watch for new/changed/deleted autoscalers
+	WatchHorizontalPodAutoscalers(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific autoscaler
+	GetHorizontalPodAutoscaler(ctx api.Context, name string) (*api.HorizontalPodAutoscaler, error)
+	// Create an autoscaler based on a specification.
+	CreateHorizontalPodAutoscaler(ctx api.Context, autoscaler *api.HorizontalPodAutoscaler) error
+	// Update an existing autoscaler
+	UpdateHorizontalPodAutoscaler(ctx api.Context, autoscaler *api.HorizontalPodAutoscaler) error
+	// Delete an existing autoscaler
+	DeleteHorizontalPodAutoscaler(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListHorizontalPodAutoscalers(ctx api.Context, label labels.Selector) (*api.HorizontalPodAutoscalerList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.HorizontalPodAutoscalerList), nil
+}
+
+func (s *storage) WatchHorizontalPodAutoscalers(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetHorizontalPodAutoscaler(ctx api.Context, name string) (*api.HorizontalPodAutoscaler, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.HorizontalPodAutoscaler), nil
+}
+
+func (s *storage) CreateHorizontalPodAutoscaler(ctx api.Context, autoscaler *api.HorizontalPodAutoscaler) error {
+	_, err := s.Create(ctx, autoscaler)
+	return err
+}
+
+func (s *storage) UpdateHorizontalPodAutoscaler(ctx api.Context, autoscaler *api.HorizontalPodAutoscaler) error {
+	_, _, err := s.Update(ctx, autoscaler)
+	return err
+}
+
+func (s *storage) DeleteHorizontalPodAutoscaler(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
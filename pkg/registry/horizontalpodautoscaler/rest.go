@@ -0,0 +1,96 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horizontalpodautoscaler
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// autoscalerStrategy implements behavior for HorizontalPodAutoscaler objects
+type autoscalerStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating HorizontalPodAutoscaler
+// objects via the REST API.
+var Strategy = autoscalerStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for autoscalers.
+func (autoscalerStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears the Status field which is not allowed to be set by end users on creation.
+func (autoscalerStrategy) ResetBeforeCreate(obj runtime.Object) {
+	autoscaler := obj.(*api.HorizontalPodAutoscaler)
+	autoscaler.Status = api.HorizontalPodAutoscalerStatus{}
+}
+
+// Validate validates a new autoscaler.
+func (autoscalerStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	autoscaler := obj.(*api.HorizontalPodAutoscaler)
+	return validation.ValidateHorizontalPodAutoscaler(autoscaler)
+}
+
+// AllowCreateOnUpdate is false for autoscalers.
+func (autoscalerStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (autoscalerStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateHorizontalPodAutoscalerUpdate(old.(*api.HorizontalPodAutoscaler), obj.(*api.HorizontalPodAutoscaler))
+}
+
+type autoscalerStatusStrategy struct {
+	autoscalerStrategy
+}
+
+var StatusStrategy = autoscalerStatusStrategy{Strategy}
+
+func (autoscalerStatusStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateHorizontalPodAutoscalerStatusUpdate(old.(*api.HorizontalPodAutoscaler), obj.(*api.HorizontalPodAutoscaler))
+}
+
+// MatchHorizontalPodAutoscaler returns a generic matcher for a given label and field selector.
+func MatchHorizontalPodAutoscaler(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		autoscalerObj, ok := obj.(*api.HorizontalPodAutoscaler)
+		if !ok {
+			return false, fmt.Errorf("not an autoscaler")
+		}
+		fields := HorizontalPodAutoscalerToSelectableFields(autoscalerObj)
+		return label.Matches(labels.Set(autoscalerObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// HorizontalPodAutoscalerToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func HorizontalPodAutoscalerToSelectableFields(autoscaler *api.HorizontalPodAutoscaler) labels.Set {
+	return labels.Set{
+		"name": autoscaler.Name,
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package horizontalpodautoscaler
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func TestHorizontalPodAutoscalerStrategy(t *testing.T) {
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("HorizontalPodAutoscaler should be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("HorizontalPodAutoscaler should not allow create on update")
+	}
+	autoscaler := &api.HorizontalPodAutoscaler{
+		ObjectMeta: api.ObjectMeta{Name: "foo"},
+		Status: api.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 3,
+			DesiredReplicas: 2,
+		},
+	}
+	Strategy.ResetBeforeCreate(autoscaler)
+	if autoscaler.Status.CurrentReplicas != 0 || autoscaler.Status.DesiredReplicas != 0 {
+		t.Errorf("HorizontalPodAutoscaler does not allow setting status on create")
+	}
+}
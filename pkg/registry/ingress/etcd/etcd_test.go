@@ -0,0 +1,201 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/ingress"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewIngress(name, ns string) *api.Ingress {
+	return &api.Ingress{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.IngressSpec{
+			Rules: []api.IngressRule{{
+				Host:  "foo.example.com",
+				Paths: []api.IngressPath{{Path: "/", Backend: api.IngressBackend{ServiceName: "frontend", ServicePort: util.NewIntOrStringFromInt(80)}}},
+			}},
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	ingress.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewIngress("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.Ingress{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewIngress("foo", api.NamespaceDefault)
+	j.Status.Address = "10.0.0.1"
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.Ingress{}
+	if err := helper.ExtractObj("/registry/ingresses/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected ingress: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected ingress UID to be set: %#v", actual)
+	}
+	if actual.Status.Address != "" {
+		t.Errorf("expected new ingress to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListIngresses(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewIngress("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewIngress("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	ingressObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ingresses := ingressObj.(*api.IngressList)
+	if len(ingresses.Items) != 2 || ingresses.Items[0].Name != "foo" || ingresses.Items[1].Name != "bar" {
+		t.Errorf("Unexpected ingress list: %#v", ingresses)
+	}
+}
+
+func TestEtcdGetIngress(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewIngress("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.Ingress)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec, actual.Spec) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeleteIngress(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewIngress("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	ingressStart := validNewIngress("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, ingressStart), 1)
+
+	ingressIn := validNewIngress("foo", api.NamespaceDefault)
+	ingressIn.ResourceVersion = "1"
+	ingressIn.Spec.Rules[0].Host = "bar.example.com"
+	ingressIn.Status = api.IngressStatus{
+		Address: "10.0.0.1",
+	}
+
+	if _, _, err := status.Update(ctx, ingressIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var ingressOut api.Ingress
+	if err := helper.ExtractObj(key, &ingressOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(ingressOut.Spec, ingressStart.Spec) {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", ingressOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(ingressIn.Status, ingressOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(ingressIn.Status, ingressOut.Status))
+	}
+}
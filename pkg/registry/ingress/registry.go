@@ -0,0 +1,87 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store Ingress objects.
+type Registry interface {
+	// ListIngresses obtains a list of ingresses having labels which match selector.
+	ListIngresses(ctx api.Context, selector labels.Selector) (*api.IngressList, error)
+	// Watch for new/changed/deleted ingresses
+	WatchIngresses(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific ingress
+	GetIngress(ctx api.Context, name string) (*api.Ingress, error)
+	// Create an ingress based on a specification.
+	CreateIngress(ctx api.Context, ingress *api.Ingress) error
+	// Update an existing ingress
+	UpdateIngress(ctx api.Context, ingress *api.Ingress) error
+	// Delete an existing ingress
+	DeleteIngress(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListIngresses(ctx api.Context, label labels.Selector) (*api.IngressList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.IngressList), nil
+}
+
+func (s *storage) WatchIngresses(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetIngress(ctx api.Context, name string) (*api.Ingress, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.Ingress), nil
+}
+
+func (s *storage) CreateIngress(ctx api.Context, ingress *api.Ingress) error {
+	_, err := s.Create(ctx, ingress)
+	return err
+}
+
+func (s *storage) UpdateIngress(ctx api.Context, ingress *api.Ingress) error {
+	_, _, err := s.Update(ctx, ingress)
+	return err
+}
+
+func (s *storage) DeleteIngress(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// ingressStrategy implements behavior for Ingress objects
+type ingressStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating Ingress
+// objects via the REST API.
+var Strategy = ingressStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for ingresses.
+func (ingressStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears the Status field which is not allowed to be set by end users on creation.
+func (ingressStrategy) ResetBeforeCreate(obj runtime.Object) {
+	ingress := obj.(*api.Ingress)
+	ingress.Status = api.IngressStatus{}
+}
+
+// Validate validates a new ingress.
+func (ingressStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	ingress := obj.(*api.Ingress)
+	return validation.ValidateIngress(ingress)
+}
+
+// AllowCreateOnUpdate is false for ingresses.
+func (ingressStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (ingressStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateIngressUpdate(old.(*api.Ingress), obj.(*api.Ingress))
+}
+
+type ingressStatusStrategy struct {
+	ingressStrategy
+}
+
+var StatusStrategy = ingressStatusStrategy{Strategy}
+
+func (ingressStatusStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateIngressStatusUpdate(old.(*api.Ingress), obj.(*api.Ingress))
+}
+
+// MatchIngress returns a generic matcher for a given label and field selector.
+func MatchIngress(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		ingressObj, ok := obj.(*api.Ingress)
+		if !ok {
+			return false, fmt.Errorf("not an ingress")
+		}
+		fields := IngressToSelectableFields(ingressObj)
+		return label.Matches(labels.Set(ingressObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// IngressToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func IngressToSelectableFields(ingress *api.Ingress) labels.Set {
+	return labels.Set{
+		"name": ingress.Name,
+	}
+}
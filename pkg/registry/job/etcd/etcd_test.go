@@ -0,0 +1,214 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/job"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewJob(name, ns string) *api.Job {
+	selector := map[string]string{"job": name}
+	return &api.Job{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.JobSpec{
+			Completions: 2,
+			Parallelism: 1,
+			Selector:    selector,
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels: selector,
+				},
+				Spec: api.PodSpec{
+					RestartPolicy: api.RestartPolicyNever,
+					DNSPolicy:     api.DNSClusterFirst,
+					Containers:    []api.Container{{Name: "test", Image: "test_image", ImagePullPolicy: api.PullIfNotPresent}},
+				},
+			},
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	job.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewJob("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.Job{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewJob("foo", api.NamespaceDefault)
+	j.Status.Phase = api.JobComplete
+	j.Status.Succeeded = 2
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.Job{}
+	if err := helper.ExtractObj("/registry/jobs/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected job: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected job UID to be set: %#v", actual)
+	}
+	if actual.Status.Phase != "" || actual.Status.Succeeded != 0 {
+		t.Errorf("expected new job to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListJobs(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewJob("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewJob("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	jobObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	jobs := jobObj.(*api.JobList)
+	if len(jobs.Items) != 2 || jobs.Items[0].Name != "foo" || jobs.Items[1].Name != "bar" {
+		t.Errorf("Unexpected job list: %#v", jobs)
+	}
+}
+
+func TestEtcdGetJob(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewJob("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.Job)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec.Selector, actual.Spec.Selector) || actual.Spec.Completions != j.Spec.Completions {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeleteJob(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewJob("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	jobStart := validNewJob("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, jobStart), 1)
+
+	jobIn := validNewJob("foo", api.NamespaceDefault)
+	jobIn.ResourceVersion = "1"
+	jobIn.Spec.Completions = 10
+	jobIn.Status = api.JobStatus{
+		Phase:     api.JobRunning,
+		Active:    1,
+		Succeeded: 1,
+	}
+
+	if _, _, err := status.Update(ctx, jobIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var jobOut api.Job
+	if err := helper.ExtractObj(key, &jobOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if jobOut.Spec.Completions != jobStart.Spec.Completions {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", jobOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(jobIn.Status, jobOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(jobIn.Status, jobOut.Status))
+	}
+}
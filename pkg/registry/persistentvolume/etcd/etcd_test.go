@@ -0,0 +1,214 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewPersistentVolume(name string) *api.PersistentVolume {
+	return &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10G"),
+			},
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/foo"},
+			},
+		},
+		Status: api.PersistentVolumeStatus{
+			Phase: api.VolumeAvailable,
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	persistentvolume.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	pv := validNewPersistentVolume("foo")
+	pv.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		pv,
+		// invalid
+		&api.PersistentVolume{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	pv := validNewPersistentVolume("foo")
+	pv.Status.Phase = api.VolumeBound
+	if _, err := storage.Create(api.NewContext(), pv); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.PersistentVolume{}
+	if err := helper.ExtractObj("/registry/persistentvolumes/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != pv.Name {
+		t.Errorf("unexpected persistentvolume: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected persistentvolume UID to be set: %#v", actual)
+	}
+	if actual.Status.Phase != api.VolumeAvailable {
+		t.Errorf("expected new persistentvolume to be pending: %#v", actual)
+	}
+}
+
+func TestEtcdListPersistentVolumes(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPersistentVolume("foo")),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPersistentVolume("bar")),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	pvObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pvs := pvObj.(*api.PersistentVolumeList)
+	if len(pvs.Items) != 2 || pvs.Items[0].Name != "foo" || pvs.Items[1].Name != "bar" {
+		t.Errorf("Unexpected persistentvolume list: %#v", pvs)
+	}
+}
+
+func TestEtcdGetPersistentVolume(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	pv := validNewPersistentVolume("foo")
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pv), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.PersistentVolume)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(pv.Spec, actual.Spec) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(pv, actual))
+	}
+}
+
+func TestEtcdDeletePersistentVolume(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	pv := validNewPersistentVolume("foo")
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pv), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	pvStart := validNewPersistentVolume("foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pvStart), 1)
+
+	pvIn := &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "foo",
+			ResourceVersion: "1",
+		},
+		Spec: api.PersistentVolumeSpec{
+			AccessModes: []api.AccessModeType{api.ReadOnlyMany},
+		},
+		Status: api.PersistentVolumeStatus{
+			Phase: api.VolumeBound,
+		},
+	}
+
+	expected := *pvStart
+	expected.ResourceVersion = "2"
+	expected.Status = pvIn.Status
+
+	if _, _, err := status.Update(ctx, pvIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var pvOut api.PersistentVolume
+	if err := helper.ExtractObj(key, &pvOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, pvOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, pvOut))
+	}
+}
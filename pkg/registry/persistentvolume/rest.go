@@ -0,0 +1,98 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// persistentvolumeStrategy implements behavior for PersistentVolume objects
+type persistentvolumeStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PersistentVolume
+// objects via the REST API.
+var Strategy = persistentvolumeStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for persistentvolumes.
+func (persistentvolumeStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate clears the Status field which is not allowed to be set by end users on creation.
+func (persistentvolumeStrategy) ResetBeforeCreate(obj runtime.Object) {
+	pv := obj.(*api.PersistentVolume)
+	pv.Status = api.PersistentVolumeStatus{
+		Phase: api.VolumeAvailable,
+	}
+}
+
+// Validate validates a new persistentvolume.
+func (persistentvolumeStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	pv := obj.(*api.PersistentVolume)
+	return validation.ValidatePersistentVolume(pv)
+}
+
+// AllowCreateOnUpdate is false for persistentvolumes.
+func (persistentvolumeStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (persistentvolumeStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePersistentVolumeUpdate(obj.(*api.PersistentVolume), old.(*api.PersistentVolume))
+}
+
+type persistentvolumeStatusStrategy struct {
+	persistentvolumeStrategy
+}
+
+var StatusStrategy = persistentvolumeStatusStrategy{Strategy}
+
+func (persistentvolumeStatusStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePersistentVolumeStatusUpdate(obj.(*api.PersistentVolume), old.(*api.PersistentVolume))
+}
+
+// MatchPersistentVolume returns a generic matcher for a given label and field selector.
+func MatchPersistentVolume(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		pvObj, ok := obj.(*api.PersistentVolume)
+		if !ok {
+			return false, fmt.Errorf("not a persistentvolume")
+		}
+		fields := PersistentVolumeToSelectableFields(pvObj)
+		return label.Matches(labels.Set(pvObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// PersistentVolumeToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func PersistentVolumeToSelectableFields(pv *api.PersistentVolume) labels.Set {
+	return labels.Set{
+		"name": pv.Name,
+	}
+}
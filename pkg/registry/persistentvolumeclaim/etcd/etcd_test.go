@@ -0,0 +1,220 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/persistentvolumeclaim"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewPersistentVolumeClaim(name, ns string) *api.PersistentVolumeClaim {
+	return &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+			Resources: api.ResourceRequirements{
+				Limits: api.ResourceList{
+					api.ResourceStorage: resource.MustParse("10G"),
+				},
+			},
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase: api.ClaimPending,
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	persistentvolumeclaim.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	pvc := validNewPersistentVolumeClaim("foo", api.NamespaceDefault)
+	pvc.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		pvc,
+		// invalid
+		&api.PersistentVolumeClaim{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	pvc := validNewPersistentVolumeClaim("foo", api.NamespaceDefault)
+	pvc.Status.Phase = api.ClaimBound
+	if _, err := storage.Create(api.NewDefaultContext(), pvc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.PersistentVolumeClaim{}
+	if err := helper.ExtractObj("/registry/persistentvolumeclaims/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != pvc.Name {
+		t.Errorf("unexpected persistentvolumeclaim: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected persistentvolumeclaim UID to be set: %#v", actual)
+	}
+	if actual.Status.Phase != api.ClaimPending {
+		t.Errorf("expected new persistentvolumeclaim to be pending: %#v", actual)
+	}
+}
+
+func TestEtcdListPersistentVolumeClaims(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPersistentVolumeClaim("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPersistentVolumeClaim("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	pvcObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pvcs := pvcObj.(*api.PersistentVolumeClaimList)
+	if len(pvcs.Items) != 2 || pvcs.Items[0].Name != "foo" || pvcs.Items[1].Name != "bar" {
+		t.Errorf("Unexpected persistentvolumeclaim list: %#v", pvcs)
+	}
+}
+
+func TestEtcdGetPersistentVolumeClaim(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	pvc := validNewPersistentVolumeClaim("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pvc), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.PersistentVolumeClaim)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(pvc.Spec, actual.Spec) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(pvc, actual))
+	}
+}
+
+func TestEtcdDeletePersistentVolumeClaim(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	pvc := validNewPersistentVolumeClaim("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pvc), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	pvcStart := validNewPersistentVolumeClaim("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pvcStart), 1)
+
+	pvcIn := &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{
+			Name:            "foo",
+			Namespace:       api.NamespaceDefault,
+			ResourceVersion: "1",
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.AccessModeType{api.ReadOnlyMany},
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase:       api.ClaimBound,
+			AccessModes: []api.AccessModeType{api.ReadWriteOnce},
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse("10G"),
+			},
+			VolumeRef: &api.ObjectReference{Kind: "PersistentVolume", Name: "bar"},
+		},
+	}
+
+	expected := *pvcStart
+	expected.ResourceVersion = "2"
+	expected.Status = pvcIn.Status
+
+	if _, _, err := status.Update(ctx, pvcIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var pvcOut api.PersistentVolumeClaim
+	if err := helper.ExtractObj(key, &pvcOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(expected, pvcOut) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(expected, pvcOut))
+	}
+}
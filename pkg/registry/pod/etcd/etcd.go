@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	etcderr "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors/etcd"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
@@ -32,6 +33,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/pod"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
 )
 
@@ -160,6 +162,109 @@ func (r *BindingREST) assignPod(ctx api.Context, podID string, machine string, a
 	return
 }
 
+// EvictionREST implements the REST endpoint for evicting pods. Unlike a plain delete, an
+// eviction of a running pod is refused when it would break one of the disruption budgets
+// of its namespace.
+type EvictionREST struct {
+	store                     *etcdgeneric.Etcd
+	podDisruptionBudgets      rest.Lister
+	podDisruptionBudgetStatus rest.Updater
+}
+
+// NewEvictionREST returns the eviction endpoint of the pods of r, checking the disruption
+// budgets listed by podDisruptionBudgets and charging them through podDisruptionBudgetStatus.
+func NewEvictionREST(r *REST, podDisruptionBudgets rest.Lister, podDisruptionBudgetStatus rest.Updater) *EvictionREST {
+	return &EvictionREST{
+		store:                     &r.Etcd,
+		podDisruptionBudgets:      podDisruptionBudgets,
+		podDisruptionBudgetStatus: podDisruptionBudgetStatus,
+	}
+}
+
+func (r *EvictionREST) New() runtime.Object {
+	return &api.Eviction{}
+}
+
+// Create deletes the pod named by the eviction, unless that would break a disruption budget.
+// The apiserver names the eviction after the pod on the URL, and refuses the evictions
+// naming another pod.
+func (r *EvictionREST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
+	eviction := obj.(*api.Eviction)
+	if errs := validation.ValidateEviction(eviction); len(errs) > 0 {
+		return nil, errors.NewInvalid("eviction", eviction.Name, errs)
+	}
+	obj, err := r.store.Get(ctx, eviction.Name)
+	if err != nil {
+		return nil, err
+	}
+	pod := obj.(*api.Pod)
+	// Only the pods which are running count towards the budgets, the others can go freely.
+	if pod.Status.Phase == api.PodRunning && pod.DeletionTimestamp == nil {
+		if err := r.chargeBudgets(ctx, pod); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := r.store.Delete(ctx, eviction.Name, eviction.DeleteOptions); err != nil {
+		return nil, err
+	}
+	return &api.Status{Status: api.StatusSuccess}, nil
+}
+
+// chargeBudgets takes one disruption from each budget selecting the pod, and records the
+// pod as disrupted so that the budget controller does not count it as healthy anymore.
+// It fails without charging any budget if one of them allows no disruption, and gives back
+// the disruptions already taken if one of the budgets cannot be charged.
+func (r *EvictionREST) chargeBudgets(ctx api.Context, pod *api.Pod) error {
+	obj, err := r.podDisruptionBudgets.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	budgets := []*api.PodDisruptionBudget{}
+	for i := range obj.(*api.PodDisruptionBudgetList).Items {
+		pdb := &obj.(*api.PodDisruptionBudgetList).Items[i]
+		if len(pdb.Spec.Selector) == 0 || !labels.SelectorFromSet(pdb.Spec.Selector).Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		if _, found := pdb.Status.DisruptedPods[pod.Name]; found {
+			// the pod was already charged by an eviction which did not go through
+			continue
+		}
+		if pdb.Status.PodDisruptionsAllowed <= 0 {
+			return errors.NewTooManyRequests(fmt.Sprintf("Cannot evict pod %s, as it would violate the disruption budget %s.", pod.Name, pdb.Name))
+		}
+		budgets = append(budgets, pdb)
+	}
+	charged := []*api.PodDisruptionBudget{}
+	for _, pdb := range budgets {
+		pdb.Status.PodDisruptionsAllowed--
+		if pdb.Status.DisruptedPods == nil {
+			pdb.Status.DisruptedPods = map[string]util.Time{}
+		}
+		pdb.Status.DisruptedPods[pod.Name] = util.Now()
+		// the resource version of the budget makes concurrent evictions conflict
+		obj, _, err := r.podDisruptionBudgetStatus.Update(ctx, pdb)
+		if err != nil {
+			r.refundBudgets(ctx, pod, charged)
+			return err
+		}
+		charged = append(charged, obj.(*api.PodDisruptionBudget))
+	}
+	return nil
+}
+
+// refundBudgets gives back the disruptions taken from the budgets for the pod. A budget
+// which cannot be refunded, because it was updated in the meantime, has its status
+// recomputed by the budget controller once the record of the pod expires.
+func (r *EvictionREST) refundBudgets(ctx api.Context, pod *api.Pod, budgets []*api.PodDisruptionBudget) {
+	for _, pdb := range budgets {
+		pdb.Status.PodDisruptionsAllowed++
+		delete(pdb.Status.DisruptedPods, pod.Name)
+		if _, _, err := r.podDisruptionBudgetStatus.Update(ctx, pdb); err != nil {
+			util.HandleError(fmt.Errorf("unable to refund the disruption budget %s for pod %s: %v", pdb.Name, pod.Name, err))
+		}
+	}
+}
+
 type podLifecycle struct{}
 
 func (h *podLifecycle) AfterUpdate(obj runtime.Object) error {
@@ -158,6 +158,108 @@ func TestDelete(t *testing.T) {
 	test.TestDelete(createFn, gracefulSetFn)
 }
 
+func TestDeleteGraceful(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _, _ := NewStorage(helper)
+	cache := &fakeCache{statusToReturn: &api.PodStatus{}}
+	storage = storage.WithPodStatus(cache)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+
+	createFn := func() runtime.Object {
+		pod := validChangedPod()
+		pod.Spec.Host = "machine"
+		fakeEtcdClient.Data["/registry/pods/default/foo"] = tools.EtcdResponseWithError{
+			R: &etcd.Response{
+				Node: &etcd.Node{
+					Value:         runtime.EncodeOrDie(latest.Codec, pod),
+					ModifiedIndex: 1,
+				},
+			},
+		}
+		return pod
+	}
+	gracefulSetFn := func() bool {
+		if fakeEtcdClient.Data["/registry/pods/default/foo"].R.Node == nil {
+			return false
+		}
+		obj, err := latest.Codec.Decode([]byte(fakeEtcdClient.Data["/registry/pods/default/foo"].R.Node.Value))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pod := obj.(*api.Pod)
+		return pod.DeletionTimestamp != nil && pod.DeletionGracePeriodSeconds != nil &&
+			*pod.DeletionGracePeriodSeconds == api.DefaultTerminationGracePeriodSeconds
+	}
+	test.TestDeleteGraceful(createFn, api.DefaultTerminationGracePeriodSeconds, gracefulSetFn)
+}
+
+func TestDeleteGracefulShortensPendingDeletion(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _, _ := NewStorage(helper)
+	storage = storage.WithPodStatus(&fakeCache{statusToReturn: &api.PodStatus{}})
+	ctx := api.NewDefaultContext()
+	key, _ := storage.Etcd.KeyFunc(ctx, "foo")
+
+	pod := validChangedPod()
+	pod.Spec.Host = "machine"
+	fakeEtcdClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Value:         runtime.EncodeOrDie(latest.Codec, pod),
+				ModifiedIndex: 1,
+			},
+		},
+	}
+
+	getPod := func() *api.Pod {
+		obj, err := storage.Get(ctx, "foo")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return obj.(*api.Pod)
+	}
+
+	if _, err := storage.Delete(ctx, "foo", api.NewDeleteOptions(60)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deleted := getPod()
+	if deleted.DeletionTimestamp == nil || *deleted.DeletionGracePeriodSeconds != 60 {
+		t.Fatalf("expected a pending deletion within 60 seconds, got %#v", deleted.ObjectMeta)
+	}
+	// etcd removes the pod a little after the grace period, if nobody does
+	if ttl := fakeEtcdClient.LastSetTTL; ttl < 59 || ttl > 90 {
+		t.Errorf("expected the pod to expire after its grace period plus some slack, got a TTL of %d", ttl)
+	}
+
+	// a longer grace period is ignored
+	if _, err := storage.Delete(ctx, "foo", api.NewDeleteOptions(120)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pending := getPod(); *pending.DeletionGracePeriodSeconds != 60 {
+		t.Errorf("expected the grace period to be kept, got %d", *pending.DeletionGracePeriodSeconds)
+	}
+
+	// a shorter one replaces it
+	if _, err := storage.Delete(ctx, "foo", api.NewDeleteOptions(10)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	shortened := getPod()
+	if *shortened.DeletionGracePeriodSeconds != 10 || !shortened.DeletionTimestamp.Before(*deleted.DeletionTimestamp) {
+		t.Errorf("expected the deletion to be shortened to 10 seconds, got %#v", shortened.ObjectMeta)
+	}
+	if ttl := fakeEtcdClient.LastSetTTL; ttl < 9 || ttl > 40 {
+		t.Errorf("expected the TTL to be shortened, got %d", ttl)
+	}
+
+	// and a zero grace period removes the pod
+	if _, err := storage.Delete(ctx, "foo", api.NewDeleteOptions(0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := storage.Get(ctx, "foo"); !errors.IsNotFound(err) {
+		t.Errorf("expected the pod to be removed, got %v", err)
+	}
+}
+
 func expectPod(t *testing.T, out runtime.Object) (*api.Pod, bool) {
 	pod, ok := out.(*api.Pod)
 	if !ok || pod == nil {
@@ -1516,3 +1618,143 @@ func TestEtcdWatchPodsNotMatch(t *testing.T) {
 		// expected case
 	}
 }
+
+// fakeBudgets lists and updates the disruption budgets of a list in memory. The first
+// update of the budget named conflict fails with a conflict.
+type fakeBudgets struct {
+	list     api.PodDisruptionBudgetList
+	conflict string
+}
+
+func (f *fakeBudgets) List(ctx api.Context, label labels.Selector, field fields.Selector) (runtime.Object, error) {
+	return api.Scheme.CopyOrDie(&f.list), nil
+}
+
+func (f *fakeBudgets) New() runtime.Object {
+	return &api.PodDisruptionBudget{}
+}
+
+func (f *fakeBudgets) NewList() runtime.Object {
+	return &api.PodDisruptionBudgetList{}
+}
+
+func (f *fakeBudgets) Update(ctx api.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	pdb := obj.(*api.PodDisruptionBudget)
+	if pdb.Name == f.conflict {
+		f.conflict = ""
+		return nil, false, errors.NewConflict("podDisruptionBudget", pdb.Name, fmt.Errorf("the budget was modified"))
+	}
+	for i := range f.list.Items {
+		if f.list.Items[i].Name == pdb.Name {
+			f.list.Items[i] = *pdb
+			return pdb, false, nil
+		}
+	}
+	return nil, false, errors.NewNotFound("podDisruptionBudget", pdb.Name)
+}
+
+func TestEtcdEvictPod(t *testing.T) {
+	fakeClient, helper := newHelper(t)
+	storage, _, _ := NewStorage(helper)
+	storage = storage.WithPodStatus(&fakeCache{statusToReturn: &api.PodStatus{Phase: api.PodRunning}})
+	budgets := &fakeBudgets{list: api.PodDisruptionBudgetList{Items: []api.PodDisruptionBudget{
+		{
+			ObjectMeta: api.ObjectMeta{Name: "budget", Namespace: api.NamespaceDefault},
+			Spec:       api.PodDisruptionBudgetSpec{MinAvailable: 1, Selector: map[string]string{"app": "web"}},
+			Status:     api.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 2, DesiredHealthy: 1, ExpectedPods: 2},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "other", Namespace: api.NamespaceDefault},
+			Spec:       api.PodDisruptionBudgetSpec{MinAvailable: 1, Selector: map[string]string{"app": "db"}},
+		},
+	}}}
+	eviction := NewEvictionREST(storage, budgets, budgets)
+	ctx := api.NewDefaultContext()
+
+	for _, name := range []string{"foo", "bar"} {
+		key, _ := storage.KeyFunc(ctx, name)
+		fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, &api.Pod{
+			ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault, Labels: map[string]string{"app": "web"}},
+			Status:     api.PodStatus{Host: "machine"},
+		}), 0)
+	}
+
+	if _, err := eviction.Create(ctx, &api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pdb := budgets.list.Items[0]
+	if pdb.Status.PodDisruptionsAllowed != 0 {
+		t.Errorf("expected the budget to be charged, got %d disruptions allowed", pdb.Status.PodDisruptionsAllowed)
+	}
+	if _, found := pdb.Status.DisruptedPods["foo"]; !found {
+		t.Errorf("expected foo to be recorded as disrupted, got %v", pdb.Status.DisruptedPods)
+	}
+	if _, err := storage.Get(ctx, "foo"); !errors.IsNotFound(err) {
+		t.Errorf("expected foo to be deleted, got %v", err)
+	}
+
+	_, err := eviction.Create(ctx, &api.Eviction{ObjectMeta: api.ObjectMeta{Name: "bar"}})
+	if !errors.IsTooManyRequests(err) {
+		t.Errorf("expected the eviction of bar to be refused, got %v", err)
+	}
+	if _, err := storage.Get(ctx, "bar"); err != nil {
+		t.Errorf("expected bar to be kept, got %v", err)
+	}
+
+	_, err = eviction.Create(ctx, &api.Eviction{})
+	if !errors.IsInvalid(err) {
+		t.Errorf("expected an invalid error without a name, got %v", err)
+	}
+}
+
+func TestEtcdEvictPodRefundsBudgets(t *testing.T) {
+	fakeClient, helper := newHelper(t)
+	storage, _, _ := NewStorage(helper)
+	storage = storage.WithPodStatus(&fakeCache{statusToReturn: &api.PodStatus{Phase: api.PodRunning}})
+	status := api.PodDisruptionBudgetStatus{PodDisruptionsAllowed: 1, CurrentHealthy: 2, DesiredHealthy: 1, ExpectedPods: 2}
+	budgets := &fakeBudgets{
+		list: api.PodDisruptionBudgetList{Items: []api.PodDisruptionBudget{
+			{
+				ObjectMeta: api.ObjectMeta{Name: "web", Namespace: api.NamespaceDefault},
+				Spec:       api.PodDisruptionBudgetSpec{MinAvailable: 1, Selector: map[string]string{"app": "web"}},
+				Status:     status,
+			},
+			{
+				ObjectMeta: api.ObjectMeta{Name: "frontend", Namespace: api.NamespaceDefault},
+				Spec:       api.PodDisruptionBudgetSpec{MinAvailable: 1, Selector: map[string]string{"tier": "frontend"}},
+				Status:     status,
+			},
+		}},
+		conflict: "frontend",
+	}
+	eviction := NewEvictionREST(storage, budgets, budgets)
+	ctx := api.NewDefaultContext()
+	key, _ := storage.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault, Labels: map[string]string{"app": "web", "tier": "frontend"}},
+		Status:     api.PodStatus{Host: "machine"},
+	}), 0)
+
+	_, err := eviction.Create(ctx, &api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}})
+	if !errors.IsConflict(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+	for _, pdb := range budgets.list.Items {
+		if pdb.Status.PodDisruptionsAllowed != 1 || len(pdb.Status.DisruptedPods) != 0 {
+			t.Errorf("expected the budget %s to be left uncharged, got %#v", pdb.Name, pdb.Status)
+		}
+	}
+	if _, err := storage.Get(ctx, "foo"); err != nil {
+		t.Errorf("expected foo to be kept, got %v", err)
+	}
+
+	// the eviction goes through once retried
+	if _, err := eviction.Create(ctx, &api.Eviction{ObjectMeta: api.ObjectMeta{Name: "foo"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, pdb := range budgets.list.Items {
+		if pdb.Status.PodDisruptionsAllowed != 0 {
+			t.Errorf("expected the budget %s to be charged, got %#v", pdb.Name, pdb.Status)
+		}
+	}
+}
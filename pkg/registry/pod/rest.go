@@ -76,9 +76,29 @@ func (podStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.Validatio
 	return validation.ValidatePodUpdate(obj.(*api.Pod), old.(*api.Pod))
 }
 
-// CheckGracefulDelete allows a pod to be gracefully deleted.
+// CheckGracefulDelete allows a pod to be gracefully deleted. The grace period defaults to
+// the termination grace period of the pod, and is zero for pods which have no containers
+// running yet or anymore.
 func (podStrategy) CheckGracefulDelete(obj runtime.Object, options *api.DeleteOptions) bool {
-	return false
+	if options == nil {
+		return false
+	}
+	pod := obj.(*api.Pod)
+	period := int64(api.DefaultTerminationGracePeriodSeconds)
+	if options.GracePeriodSeconds != nil {
+		period = *options.GracePeriodSeconds
+	} else if pod.Spec.TerminationGracePeriodSeconds != nil {
+		period = *pod.Spec.TerminationGracePeriodSeconds
+	}
+	// a pod which is not bound to a node has no containers to terminate
+	if len(pod.Spec.Host) == 0 {
+		period = 0
+	}
+	if pod.Status.Phase == api.PodFailed || pod.Status.Phase == api.PodSucceeded {
+		period = 0
+	}
+	options.GracePeriodSeconds = &period
+	return true
 }
 
 type podStatusStrategy struct {
@@ -104,6 +124,7 @@ func PodStatusDecorator(cache PodStatusGetter) rest.ObjectFunc {
 	return func(obj runtime.Object) error {
 		pod := obj.(*api.Pod)
 		host := pod.Status.Host
+		nominatedHost := pod.Status.NominatedHost
 		if status, err := cache.GetPodStatus(pod.Namespace, pod.Name); err != nil {
 			pod.Status = api.PodStatus{
 				Phase: api.PodUnknown,
@@ -112,6 +133,7 @@ func PodStatusDecorator(cache PodStatusGetter) rest.ObjectFunc {
 			pod.Status = *status
 		}
 		pod.Status.Host = host
+		pod.Status.NominatedHost = nominatedHost
 		return nil
 	}
 }
@@ -64,6 +64,17 @@ func TestPodStatusDecorator(t *testing.T) {
 	if pod.Status.Phase != api.PodRunning || pod.Status.Host != "foo" {
 		t.Errorf("unexpected pod: %#v", pod)
 	}
+	pod = &api.Pod{
+		Status: api.PodStatus{
+			NominatedHost: "bar",
+		},
+	}
+	if err := PodStatusDecorator(cache)(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Status.Phase != api.PodRunning || pod.Status.NominatedHost != "bar" {
+		t.Errorf("unexpected pod: %#v", pod)
+	}
 }
 
 func TestPodStatusDecoratorError(t *testing.T) {
@@ -76,3 +87,30 @@ func TestPodStatusDecoratorError(t *testing.T) {
 		t.Errorf("unexpected pod: %#v", pod)
 	}
 }
+
+func TestCheckGracefulDelete(t *testing.T) {
+	short, long := int64(5), int64(60)
+	scheduled := api.PodSpec{Host: "machine", TerminationGracePeriodSeconds: &long}
+	testCases := []struct {
+		name     string
+		pod      api.Pod
+		options  api.DeleteOptions
+		expected int64
+	}{
+		{"termination grace period", api.Pod{Spec: scheduled}, api.DeleteOptions{}, long},
+		{"requested grace period", api.Pod{Spec: scheduled}, api.DeleteOptions{GracePeriodSeconds: &short}, short},
+		{"default grace period", api.Pod{Spec: api.PodSpec{Host: "machine"}}, api.DeleteOptions{}, api.DefaultTerminationGracePeriodSeconds},
+		{"unscheduled", api.Pod{Spec: api.PodSpec{TerminationGracePeriodSeconds: &long}}, api.DeleteOptions{}, 0},
+		{"terminated", api.Pod{Spec: scheduled, Status: api.PodStatus{Phase: api.PodSucceeded}}, api.DeleteOptions{}, 0},
+	}
+	for _, tc := range testCases {
+		options := tc.options
+		if !Strategy.CheckGracefulDelete(&tc.pod, &options) {
+			t.Errorf("%s: expected the pod to be gracefully deleted", tc.name)
+			continue
+		}
+		if options.GracePeriodSeconds == nil || *options.GracePeriodSeconds != tc.expected {
+			t.Errorf("%s: expected a grace period of %d, got %v", tc.name, tc.expected, options.GracePeriodSeconds)
+		}
+	}
+}
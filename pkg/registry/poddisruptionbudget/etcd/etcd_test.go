@@ -0,0 +1,203 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/poddisruptionbudget"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *StatusREST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage, statusStorage := NewStorage(h)
+	return storage, statusStorage, fakeEtcdClient, h
+}
+
+func validNewPodDisruptionBudget(name, ns string) *api.PodDisruptionBudget {
+	return &api.PodDisruptionBudget{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: api.PodDisruptionBudgetSpec{
+			MinAvailable: 2,
+			Selector:     map[string]string{"app": name},
+		},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _, _ := newStorage(t)
+	poddisruptionbudget.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	j := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	j.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		j,
+		// invalid
+		&api.PodDisruptionBudget{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestCreateSetsFields(t *testing.T) {
+	_, helper := newHelper(t)
+	storage, _ := NewStorage(helper)
+	j := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	j.Status.PodDisruptionsAllowed = 1
+	j.Status.CurrentHealthy = 3
+	if _, err := storage.Create(api.NewDefaultContext(), j); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual := &api.PodDisruptionBudget{}
+	if err := helper.ExtractObj("/registry/poddisruptionbudgets/default/foo", actual, false); err != nil {
+		t.Fatalf("unexpected extraction error: %v", err)
+	}
+	if actual.Name != j.Name {
+		t.Errorf("unexpected pod disruption budget: %#v", actual)
+	}
+	if len(actual.UID) == 0 {
+		t.Errorf("expected pod disruption budget UID to be set: %#v", actual)
+	}
+	if actual.Status.PodDisruptionsAllowed != 0 || actual.Status.CurrentHealthy != 0 {
+		t.Errorf("expected new pod disruption budget to have an empty status: %#v", actual)
+	}
+}
+
+func TestEtcdListPodDisruptionBudgets(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPodDisruptionBudget("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPodDisruptionBudget("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	pdbObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	podDisruptionBudgets := pdbObj.(*api.PodDisruptionBudgetList)
+	if len(podDisruptionBudgets.Items) != 2 || podDisruptionBudgets.Items[0].Name != "foo" || podDisruptionBudgets.Items[1].Name != "bar" {
+		t.Errorf("Unexpected pod disruption budget list: %#v", podDisruptionBudgets)
+	}
+}
+
+func TestEtcdGetPodDisruptionBudget(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.PodDisruptionBudget)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(j.Spec.Selector, actual.Spec.Selector) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(j, actual))
+	}
+}
+
+func TestEtcdDeletePodDisruptionBudget(t *testing.T) {
+	registry, _, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	j := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, j), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
+
+func TestEtcdUpdateStatus(t *testing.T) {
+	registry, status, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	fakeClient.TestIndex = true
+
+	key, _ := registry.KeyFunc(ctx, "foo")
+	pdbStart := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, pdbStart), 1)
+
+	pdbIn := validNewPodDisruptionBudget("foo", api.NamespaceDefault)
+	pdbIn.ResourceVersion = "1"
+	pdbIn.Spec.Selector = map[string]string{"app": "bar"}
+	pdbIn.Status = api.PodDisruptionBudgetStatus{
+		PodDisruptionsAllowed: 1,
+		CurrentHealthy:        3,
+		DesiredHealthy:        2,
+		ExpectedPods:          3,
+	}
+
+	if _, _, err := status.Update(ctx, pdbIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var pdbOut api.PodDisruptionBudget
+	if err := helper.ExtractObj(key, &pdbOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(pdbOut.Spec.Selector, pdbStart.Spec.Selector) {
+		t.Errorf("expected spec to be unchanged by a status update: %#v", pdbOut.Spec)
+	}
+	if !api.Semantic.DeepEqual(pdbIn.Status, pdbOut.Status) {
+		t.Errorf("unexpected status: %s", util.ObjectDiff(pdbIn.Status, pdbOut.Status))
+	}
+}
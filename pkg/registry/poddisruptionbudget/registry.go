@@ -0,0 +1,87 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptionbudget
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store PodDisruptionBudget objects.
+type Registry interface {
+	// ListPodDisruptionBudgets obtains a list of pod disruption budgets having labels which match selector.
+	ListPodDisruptionBudgets(ctx api.Context, selector labels.Selector) (*api.PodDisruptionBudgetList, error)
+	// Watch for new/changed/deleted pod disruption budgets
+	WatchPodDisruptionBudgets(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific pod disruption budget
+	GetPodDisruptionBudget(ctx api.Context, name string) (*api.PodDisruptionBudget, error)
+	// Create a pod disruption budget based on a specification.
+	CreatePodDisruptionBudget(ctx api.Context, podDisruptionBudget *api.PodDisruptionBudget) error
+	// Update an existing pod disruption budget
+	UpdatePodDisruptionBudget(ctx api.Context, podDisruptionBudget *api.PodDisruptionBudget) error
+	// Delete an existing pod disruption budget
+	DeletePodDisruptionBudget(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListPodDisruptionBudgets(ctx api.Context, label labels.Selector) (*api.PodDisruptionBudgetList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.PodDisruptionBudgetList), nil
+}
+
+func (s *storage) WatchPodDisruptionBudgets(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetPodDisruptionBudget(ctx api.Context, name string) (*api.PodDisruptionBudget, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.PodDisruptionBudget), nil
+}
+
+func (s *storage) CreatePodDisruptionBudget(ctx api.Context, podDisruptionBudget *api.PodDisruptionBudget) error {
+	_, err := s.Create(ctx, podDisruptionBudget)
+	return err
+}
+
+func (s *storage) UpdatePodDisruptionBudget(ctx api.Context, podDisruptionBudget *api.PodDisruptionBudget) error {
+	_, _, err := s.Update(ctx, podDisruptionBudget)
+	return err
+}
+
+func (s *storage) DeletePodDisruptionBudget(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poddisruptionbudget
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// podDisruptionBudgetStrategy implements behavior for PodDisruptionBudget objects
+type podDisruptionBudgetStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PodDisruptionBudget
+// objects via the REST API.
+var Strategy = podDisruptionBudgetStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for pod disruption budgets.
+func (podDisruptionBudgetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate clears the Status field which is not allowed to be set by end users on creation.
+func (podDisruptionBudgetStrategy) ResetBeforeCreate(obj runtime.Object) {
+	podDisruptionBudget := obj.(*api.PodDisruptionBudget)
+	podDisruptionBudget.Status = api.PodDisruptionBudgetStatus{}
+}
+
+// Validate validates a new pod disruption budget.
+func (podDisruptionBudgetStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	podDisruptionBudget := obj.(*api.PodDisruptionBudget)
+	return validation.ValidatePodDisruptionBudget(podDisruptionBudget)
+}
+
+// AllowCreateOnUpdate is false for pod disruption budgets.
+func (podDisruptionBudgetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (podDisruptionBudgetStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePodDisruptionBudgetUpdate(old.(*api.PodDisruptionBudget), obj.(*api.PodDisruptionBudget))
+}
+
+type podDisruptionBudgetStatusStrategy struct {
+	podDisruptionBudgetStrategy
+}
+
+var StatusStrategy = podDisruptionBudgetStatusStrategy{Strategy}
+
+func (podDisruptionBudgetStatusStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePodDisruptionBudgetStatusUpdate(old.(*api.PodDisruptionBudget), obj.(*api.PodDisruptionBudget))
+}
+
+// MatchPodDisruptionBudget returns a generic matcher for a given label and field selector.
+func MatchPodDisruptionBudget(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		pdbObj, ok := obj.(*api.PodDisruptionBudget)
+		if !ok {
+			return false, fmt.Errorf("not a pod disruption budget")
+		}
+		fields := PodDisruptionBudgetToSelectableFields(pdbObj)
+		return label.Matches(labels.Set(pdbObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// PodDisruptionBudgetToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func PodDisruptionBudgetToSelectableFields(podDisruptionBudget *api.PodDisruptionBudget) labels.Set {
+	return labels.Set{
+		"name": podDisruptionBudget.Name,
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/priorityclass"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage := NewStorage(h)
+	return storage, fakeEtcdClient, h
+}
+
+func validNewPriorityClass(name string) *api.PriorityClass {
+	return &api.PriorityClass{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Value: 1000,
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _ := newStorage(t)
+	priorityclass.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError).ClusterScope()
+	priorityClass := validNewPriorityClass("foo")
+	priorityClass.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		priorityClass,
+		// invalid
+		&api.PriorityClass{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestEtcdListPriorityClasses(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPriorityClass("foo")),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewPriorityClass("bar")),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	priorityClassObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	priorityClasses := priorityClassObj.(*api.PriorityClassList)
+	if len(priorityClasses.Items) != 2 || priorityClasses.Items[0].Name != "foo" || priorityClasses.Items[1].Name != "bar" {
+		t.Errorf("Unexpected priority class list: %#v", priorityClasses)
+	}
+}
+
+func TestEtcdGetPriorityClass(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	priorityClass := validNewPriorityClass("foo")
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, priorityClass), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.PriorityClass)
+	if actual.Name != "foo" || actual.Value != priorityClass.Value {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(priorityClass, actual))
+	}
+}
+
+func TestEtcdUpdatePriorityClass(t *testing.T) {
+	registry, fakeClient, helper := newStorage(t)
+	ctx := api.NewContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewPriorityClass("foo")), 1)
+
+	priorityClassIn := validNewPriorityClass("foo")
+	priorityClassIn.ResourceVersion = "1"
+	priorityClassIn.Description = "for the pods serving users"
+	if _, _, err := registry.Update(ctx, priorityClassIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var priorityClassOut api.PriorityClass
+	if err := helper.ExtractObj(key, &priorityClassOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if priorityClassOut.Description != priorityClassIn.Description {
+		t.Errorf("unexpected description: %q", priorityClassOut.Description)
+	}
+}
+
+func TestEtcdDeletePriorityClass(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewPriorityClass("foo")), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
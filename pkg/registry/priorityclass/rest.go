@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorityclass
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// priorityClassStrategy implements behavior for PriorityClass objects
+type priorityClassStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating PriorityClass
+// objects via the REST API.
+var Strategy = priorityClassStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for priority classes.
+func (priorityClassStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate is a no-op, priority classes have no status.
+func (priorityClassStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new priority class.
+func (priorityClassStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePriorityClass(obj.(*api.PriorityClass))
+}
+
+// AllowCreateOnUpdate is false for priority classes.
+func (priorityClassStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (priorityClassStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidatePriorityClassUpdate(obj.(*api.PriorityClass), old.(*api.PriorityClass))
+}
+
+// MatchPriorityClass returns a generic matcher for a given label and field selector.
+func MatchPriorityClass(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		priorityClassObj, ok := obj.(*api.PriorityClass)
+		if !ok {
+			return false, fmt.Errorf("not a priority class")
+		}
+		fields := PriorityClassToSelectableFields(priorityClassObj)
+		return label.Matches(labels.Set(priorityClassObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// PriorityClassToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func PriorityClassToSelectableFields(priorityClass *api.PriorityClass) labels.Set {
+	return labels.Set{
+		"name": priorityClass.Name,
+	}
+}
@@ -104,6 +104,7 @@ func (r *ServiceRegistry) UpdateService(ctx api.Context, svc *api.Service) (*api
 	defer r.mu.Unlock()
 
 	r.UpdatedID = svc.Name
+	r.Service = new(api.Service)
 	*r.Service = *svc
 	return svc, r.Err
 }
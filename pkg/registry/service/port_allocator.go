@@ -0,0 +1,174 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"math/big"
+	math_rand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// PortAllocator hands out the node ports of services.
+type PortAllocator interface {
+	// Allocate allocates a specific port.
+	Allocate(port int) error
+	// AllocateNext allocates and returns a free port.
+	AllocateNext() (int, error)
+	// Release de-allocates a port.
+	Release(port int) error
+}
+
+// portAllocator hands out the node ports of services from a fixed range.  The
+// allocated ports are kept in a bitmap, which can be persisted with Snapshot and
+// Restore.
+type portAllocator struct {
+	lock sync.Mutex // protects 'used' and 'count'
+
+	portRange      util.PortRange
+	used           big.Int // bit i is set when port Base+i is allocated
+	count          int
+	randomAttempts int
+
+	random *math_rand.Rand
+}
+
+// newPortAllocator creates and initializes a new portAllocator object.
+func newPortAllocator(portRange *util.PortRange) *portAllocator {
+	if portRange == nil || portRange.Size == 0 {
+		return nil
+	}
+
+	seed := time.Now().UTC().UnixNano()
+	r := math_rand.New(math_rand.NewSource(seed))
+
+	return &portAllocator{
+		portRange:      *portRange,
+		randomAttempts: 1000,
+		random:         r,
+	}
+}
+
+// Allocate allocates a specific port.  This is useful when recovering saved state.
+func (pa *portAllocator) Allocate(port int) error {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	if !pa.portRange.Contains(port) {
+		return fmt.Errorf("port %d is not in the range %s", port, pa.portRange)
+	}
+
+	if !pa.set(port - pa.portRange.Base) {
+		return fmt.Errorf("port %d is already allocated", port)
+	}
+
+	return nil
+}
+
+// AllocateNext allocates and returns a new port.
+func (pa *portAllocator) AllocateNext() (int, error) {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	if pa.count >= pa.portRange.Size {
+		return 0, fmt.Errorf("can't find a free port in %s", pa.portRange)
+	}
+
+	// Try randomly first
+	for i := 0; i < pa.randomAttempts; i++ {
+		offset := pa.random.Intn(pa.portRange.Size)
+		if pa.set(offset) {
+			return pa.portRange.Base + offset, nil
+		}
+	}
+
+	// If that doesn't work, try a linear search
+	for offset := 0; offset < pa.portRange.Size; offset++ {
+		if pa.set(offset) {
+			return pa.portRange.Base + offset, nil
+		}
+	}
+
+	return 0, fmt.Errorf("can't find a free port in %s", pa.portRange)
+}
+
+// Release de-allocates a port.
+func (pa *portAllocator) Release(port int) error {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	if !pa.portRange.Contains(port) {
+		return fmt.Errorf("port %d is not in the range %s", port, pa.portRange)
+	}
+	offset := port - pa.portRange.Base
+	if pa.used.Bit(offset) == 1 {
+		pa.used.SetBit(&pa.used, offset, 0)
+		pa.count--
+	}
+	return nil
+}
+
+// Has returns true if the port is allocated.
+func (pa *portAllocator) Has(port int) bool {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	return pa.portRange.Contains(port) && pa.used.Bit(port-pa.portRange.Base) == 1
+}
+
+// Snapshot saves the range and the allocated ports into dst.
+func (pa *portAllocator) Snapshot(dst *api.RangeAllocation) {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	dst.Range = pa.portRange.String()
+	dst.Data = pa.used.Bytes()
+}
+
+// Restore replaces the allocated ports with those saved by Snapshot.  The saved
+// range must be the range of the allocator.
+func (pa *portAllocator) Restore(src *api.RangeAllocation) error {
+	pa.lock.Lock()
+	defer pa.lock.Unlock()
+
+	if src.Range != pa.portRange.String() {
+		return fmt.Errorf("the saved node port range %q does not match the range %s", src.Range, pa.portRange)
+	}
+	pa.used.SetBytes(src.Data)
+	pa.count = 0
+	for offset := 0; offset < pa.used.BitLen(); offset++ {
+		if pa.used.Bit(offset) == 1 {
+			pa.count++
+		}
+	}
+	return nil
+}
+
+// set marks the port at offset in the range as allocated.  It returns false if the
+// port was already allocated.
+func (pa *portAllocator) set(offset int) bool {
+	if pa.used.Bit(offset) == 1 {
+		return false
+	}
+	pa.used.SetBit(&pa.used, offset, 1)
+	pa.count++
+	return true
+}
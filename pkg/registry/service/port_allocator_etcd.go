@@ -0,0 +1,118 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// NodePortAllocationKey is the etcd key of the node port allocations of services.
+const NodePortAllocationKey = "/registry/ranges/servicenodeports"
+
+// etcdPortAllocator is a PortAllocator whose allocations are stored in etcd, so
+// that every apiserver sharing the etcd cluster hands out distinct node ports.  Each
+// change is made atomically against the stored bitmap.
+type etcdPortAllocator struct {
+	lock sync.Mutex
+
+	// alloc holds the allocations last read from or written to etcd.
+	alloc  *portAllocator
+	helper tools.EtcdHelper
+	key    string
+	// last is the resource version alloc was last synced with, or empty if alloc
+	// must be restored from etcd before it is used.
+	last string
+}
+
+// NewEtcdPortAllocator returns a PortAllocator handing out ports of portRange,
+// which keeps its allocations in etcd under NodePortAllocationKey.  The allocations
+// must be initialized by a PortAllocatorRepair before ports can be allocated.
+func NewEtcdPortAllocator(portRange *util.PortRange, helper tools.EtcdHelper) (PortAllocator, error) {
+	alloc := newPortAllocator(portRange)
+	if alloc == nil {
+		return nil, fmt.Errorf("invalid node port range %v", portRange)
+	}
+	return &etcdPortAllocator{
+		alloc:  alloc,
+		helper: helper,
+		key:    NodePortAllocationKey,
+	}, nil
+}
+
+// Allocate allocates a specific port.
+func (e *etcdPortAllocator) Allocate(port int) error {
+	return e.tryUpdate(func() error {
+		return e.alloc.Allocate(port)
+	})
+}
+
+// AllocateNext allocates and returns a free port.
+func (e *etcdPortAllocator) AllocateNext() (int, error) {
+	var port int
+	err := e.tryUpdate(func() error {
+		var err error
+		port, err = e.alloc.AllocateNext()
+		return err
+	})
+	return port, err
+}
+
+// Release de-allocates a port.
+func (e *etcdPortAllocator) Release(port int) error {
+	return e.tryUpdate(func() error {
+		return e.alloc.Release(port)
+	})
+}
+
+// tryUpdate applies fn to the allocations stored in etcd and writes them back,
+// retrying if they were changed concurrently.
+func (e *etcdPortAllocator) tryUpdate(fn func() error) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	stored := &api.RangeAllocation{}
+	err := e.helper.AtomicUpdate(e.key, stored, true, func(input runtime.Object) (runtime.Object, uint64, error) {
+		existing := input.(*api.RangeAllocation)
+		if len(existing.ResourceVersion) == 0 {
+			return nil, 0, fmt.Errorf("cannot allocate node ports: the node port allocations have not been initialized yet")
+		}
+		if existing.ResourceVersion != e.last {
+			if err := e.alloc.Restore(existing); err != nil {
+				return nil, 0, err
+			}
+			e.last = existing.ResourceVersion
+		}
+		if err := fn(); err != nil {
+			return nil, 0, err
+		}
+		// alloc is ahead of etcd until the write succeeds.
+		e.last = ""
+		e.alloc.Snapshot(existing)
+		return existing, 0, nil
+	})
+	if err != nil {
+		return err
+	}
+	e.last = stored.ResourceVersion
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/registrytest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func newTestEtcdPortAllocator(t *testing.T, helper tools.EtcdHelper) *etcdPortAllocator {
+	nodePorts, err := NewEtcdPortAllocator(&util.PortRange{Base: 30000, Size: 10}, helper)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e := nodePorts.(*etcdPortAllocator)
+	e.alloc.randomAttempts = 0
+	return e
+}
+
+func newTestPortAllocationHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeClient := tools.NewFakeEtcdClient(t)
+	fakeClient.TestIndex = true
+	fakeClient.ExpectNotFoundGet(NodePortAllocationKey)
+	return fakeClient, tools.NewEtcdHelper(fakeClient, latest.Codec)
+}
+
+func storedPortAllocations(t *testing.T, helper tools.EtcdHelper) *portAllocator {
+	stored := &api.RangeAllocation{}
+	if err := helper.ExtractObj(NodePortAllocationKey, stored, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 10})
+	if err := pa.Restore(stored); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return pa
+}
+
+func TestEtcdPortAllocatorRequiresInitialization(t *testing.T) {
+	_, helper := newTestPortAllocationHelper(t)
+	e := newTestEtcdPortAllocator(t, helper)
+
+	if _, err := e.AllocateNext(); err == nil {
+		t.Errorf("expected failure before the allocations are initialized")
+	}
+}
+
+func TestEtcdPortAllocatorSharedAllocations(t *testing.T) {
+	_, helper := newTestPortAllocationHelper(t)
+	if err := NewPortAllocatorRepair(registrytest.NewServiceRegistry(), &util.PortRange{Base: 30000, Size: 10}, helper).RunOnce(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e1 := newTestEtcdPortAllocator(t, helper)
+	e2 := newTestEtcdPortAllocator(t, helper)
+
+	if port, err := e1.AllocateNext(); err != nil || port != 30000 {
+		t.Errorf("expected port 30000, got %d (%v)", port, err)
+	}
+	// e2 sees the port allocated through e1.
+	if port, err := e2.AllocateNext(); err != nil || port != 30001 {
+		t.Errorf("expected port 30001, got %d (%v)", port, err)
+	}
+	if err := e2.Allocate(30000); err == nil {
+		t.Errorf("expected failure allocating a port allocated through another allocator")
+	}
+	if err := e2.Release(30000); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := e1.Allocate(30000); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	stored := storedPortAllocations(t, helper)
+	if !stored.Has(30000) || !stored.Has(30001) || stored.count != 2 {
+		t.Errorf("unexpected stored allocations: %v", stored.used.String())
+	}
+}
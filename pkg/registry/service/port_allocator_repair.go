@@ -0,0 +1,107 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// PortAllocatorRepair rebuilds the node port allocations stored in etcd from the
+// node ports of the existing services.  It initializes the allocations of a new
+// cluster, releases the ports leaked by failed requests and reports services whose
+// node ports are out of range or in use by another service.
+type PortAllocatorRepair struct {
+	registry  Registry
+	portRange util.PortRange
+	helper    tools.EtcdHelper
+	key       string
+}
+
+// NewPortAllocatorRepair creates a PortAllocatorRepair for the node ports of portRange.
+func NewPortAllocatorRepair(registry Registry, portRange *util.PortRange, helper tools.EtcdHelper) *PortAllocatorRepair {
+	return &PortAllocatorRepair{
+		registry:  registry,
+		portRange: *portRange,
+		helper:    helper,
+		key:       NodePortAllocationKey,
+	}
+}
+
+// Run repairs the allocations at the given period.
+func (c *PortAllocatorRepair) Run(period time.Duration) {
+	go util.Forever(func() {
+		if err := c.RunOnce(); err != nil {
+			util.HandleError(err)
+		}
+	}, period)
+}
+
+// RunOnce rebuilds the allocations once.  The stored allocations are only replaced if
+// they did not change while the services were listed, so ports allocated concurrently
+// are not lost; the next run retries.
+func (c *PortAllocatorRepair) RunOnce() error {
+	latest := &api.RangeAllocation{}
+	if err := c.helper.ExtractObj(c.key, latest, true); err != nil {
+		return fmt.Errorf("unable to read the node port allocations: %v", err)
+	}
+	stored := newPortAllocator(&c.portRange)
+	if len(latest.ResourceVersion) != 0 {
+		if err := stored.Restore(latest); err != nil {
+			glog.Warningf("Discarding the stored node port allocations: %v", err)
+		}
+	}
+
+	services, err := c.registry.ListServices(api.NewContext())
+	if err != nil {
+		return fmt.Errorf("unable to list services to repair the node port allocations: %v", err)
+	}
+
+	rebuilt := newPortAllocator(&c.portRange)
+	for i := range services.Items {
+		service := &services.Items[i]
+		for _, nodePort := range nodePortsOf(service) {
+			switch err := rebuilt.Allocate(nodePort); {
+			case err == nil:
+				if !stored.Has(nodePort) {
+					glog.Warningf("Node port %d of service %s/%s was not allocated; repairing", nodePort, service.Namespace, service.Name)
+				}
+			case !c.portRange.Contains(nodePort):
+				util.HandleError(fmt.Errorf("the node port %d of service %s/%s is not within the range %s; please recreate the service", nodePort, service.Namespace, service.Name, c.portRange))
+			default:
+				util.HandleError(fmt.Errorf("the node port %d of service %s/%s is in use by another service; please recreate the service", nodePort, service.Namespace, service.Name))
+			}
+		}
+	}
+
+	for port := c.portRange.Base; port < c.portRange.Base+c.portRange.Size; port++ {
+		if stored.Has(port) && !rebuilt.Has(port) {
+			glog.V(2).Infof("Releasing leaked node port %d", port)
+		}
+	}
+
+	rebuilt.Snapshot(latest)
+	if err := c.helper.SetObj(c.key, latest, nil, 0); err != nil {
+		return fmt.Errorf("unable to store the repaired node port allocations: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/registrytest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestPortAllocatorRepair(t *testing.T) {
+	_, helper := newTestPortAllocationHelper(t)
+	portRange := &util.PortRange{Base: 30000, Size: 10}
+	registry := registrytest.NewServiceRegistry()
+	registry.List.Items = []api.Service{
+		*makeNodePortService("foo", 30001, 30002),
+		*makeNodePortService("bar", 30002),
+		*makeNodePortService("baz", 40000),
+	}
+	repair := NewPortAllocatorRepair(registry, portRange, helper)
+
+	// The first run initializes the allocations from the services.
+	if err := repair.RunOnce(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored := storedPortAllocations(t, helper)
+	if !stored.Has(30001) || !stored.Has(30002) || stored.count != 2 {
+		t.Errorf("unexpected stored allocations: %v", stored.used.String())
+	}
+
+	// A port leaked by a failed request is released by the next run.
+	e := newTestEtcdPortAllocator(t, helper)
+	if err := e.Allocate(30005); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repair.RunOnce(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored = storedPortAllocations(t, helper)
+	if stored.Has(30005) || stored.count != 2 {
+		t.Errorf("unexpected stored allocations: %v", stored.used.String())
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+func TestNewPortAllocator(t *testing.T) {
+	if newPortAllocator(nil) != nil {
+		t.Errorf("expected nil")
+	}
+	if newPortAllocator(&util.PortRange{}) != nil {
+		t.Errorf("expected nil")
+	}
+	if newPortAllocator(&util.PortRange{Base: 30000, Size: 100}) == nil {
+		t.Errorf("expected non-nil")
+	}
+}
+
+func TestPortAllocatorAllocate(t *testing.T) {
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 100})
+
+	if err := pa.Allocate(29999); err == nil {
+		t.Errorf("expected failure")
+	}
+	if err := pa.Allocate(30100); err == nil {
+		t.Errorf("expected failure")
+	}
+	if err := pa.Allocate(30000); err != nil {
+		t.Errorf("expected success, got %s", err)
+	}
+	if err := pa.Allocate(30099); err != nil {
+		t.Errorf("expected success, got %s", err)
+	}
+	if pa.Allocate(30000) == nil {
+		t.Errorf("expected failure")
+	}
+}
+
+func TestPortAllocatorAllocateNext(t *testing.T) {
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 10})
+	// Turn off random allocation attempts, so we just allocate in sequence
+	pa.randomAttempts = 0
+
+	for i := 0; i < 10; i++ {
+		port, err := pa.AllocateNext()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if port != 30000+i {
+			t.Errorf("expected port %d, got %d", 30000+i, port)
+		}
+	}
+	if _, err := pa.AllocateNext(); err == nil {
+		t.Errorf("expected failure with an exhausted range")
+	}
+}
+
+func TestPortAllocatorAllocateNextRandom(t *testing.T) {
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 100})
+
+	seen := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		port, err := pa.AllocateNext()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if port < 30000 || port >= 30100 {
+			t.Errorf("port %d is out of range", port)
+		}
+		seen[port] = true
+	}
+	if len(seen) != 100 {
+		t.Errorf("expected 100 distinct ports, got %d", len(seen))
+	}
+	if _, err := pa.AllocateNext(); err == nil {
+		t.Errorf("expected failure with an exhausted range")
+	}
+}
+
+func TestPortAllocatorRelease(t *testing.T) {
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 10})
+	pa.randomAttempts = 0
+
+	for i := 0; i < 10; i++ {
+		if _, err := pa.AllocateNext(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := pa.Release(40000); err == nil {
+		t.Errorf("expected failure")
+	}
+	if err := pa.Release(30005); err != nil {
+		t.Errorf("expected success, got %s", err)
+	}
+	port, err := pa.AllocateNext()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if port != 30005 {
+		t.Errorf("expected port 30005, got %d", port)
+	}
+}
+
+func TestPortAllocatorSnapshotRestore(t *testing.T) {
+	pa := newPortAllocator(&util.PortRange{Base: 30000, Size: 100})
+	for _, port := range []int{30000, 30042, 30099} {
+		if err := pa.Allocate(port); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	saved := &api.RangeAllocation{}
+	pa.Snapshot(saved)
+	if saved.Range != "30000-30099" {
+		t.Errorf("unexpected range %q", saved.Range)
+	}
+
+	other := newPortAllocator(&util.PortRange{Base: 30000, Size: 100})
+	if err := other.Restore(saved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, port := range []int{30000, 30042, 30099} {
+		if !other.Has(port) {
+			t.Errorf("expected port %d to be allocated", port)
+		}
+	}
+	if other.Has(30001) {
+		t.Errorf("expected port 30001 to be free")
+	}
+	if other.count != 3 {
+		t.Errorf("expected 3 allocated ports, got %d", other.count)
+	}
+
+	different := newPortAllocator(&util.PortRange{Base: 31000, Size: 100})
+	if err := different.Restore(saved); err == nil {
+		t.Errorf("expected failure restoring a different range")
+	}
+}
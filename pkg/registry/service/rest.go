@@ -23,6 +23,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
@@ -44,12 +45,13 @@ type REST struct {
 	cloud       cloudprovider.Interface
 	machines    minion.Registry
 	portalMgr   *ipAllocator
+	nodePortMgr PortAllocator
 	clusterName string
 }
 
-// NewStorage returns a new REST.
+// NewStorage returns a new REST.  The node ports of services are allocated from nodePorts.
 func NewStorage(registry Registry, cloud cloudprovider.Interface, machines minion.Registry, portalNet *net.IPNet,
-	clusterName string) *REST {
+	nodePorts PortAllocator, clusterName string) *REST {
 	// TODO: Before we can replicate masters, this has to be synced (e.g. lives in etcd)
 	ipa := newIPAllocator(portalNet)
 	if ipa == nil {
@@ -62,6 +64,7 @@ func NewStorage(registry Registry, cloud cloudprovider.Interface, machines minio
 		cloud:       cloud,
 		machines:    machines,
 		portalMgr:   ipa,
+		nodePortMgr: nodePorts,
 		clusterName: clusterName,
 	}
 }
@@ -86,6 +89,88 @@ func reloadIPsFromStorage(ipa *ipAllocator, registry Registry) {
 	}
 }
 
+// usesNodePorts returns true if the service is exposed on a port of every node.
+func usesNodePorts(service *api.Service) bool {
+	return service.Spec.Type == api.ServiceTypeNodePort || service.Spec.Type == api.ServiceTypeLoadBalancer
+}
+
+// allocateNodePorts assigns a node port to every port of service which needs
+// one.  Ports which are set in old, if any, are kept rather than allocated
+// again, and a port left unset is given the node port of the port of old with
+// the same name.  On error, every port allocated by this call is released.
+func (rs *REST) allocateNodePorts(service, old *api.Service) error {
+	oldNodePorts := map[string]int{}
+	inUse := map[int]bool{}
+	if old != nil && usesNodePorts(old) {
+		for i := range old.Spec.Ports {
+			oldNodePorts[old.Spec.Ports[i].Name] = old.Spec.Ports[i].NodePort
+			inUse[old.Spec.Ports[i].NodePort] = true
+		}
+	}
+	allocated := []int{}
+	for i := range service.Spec.Ports {
+		servicePort := &service.Spec.Ports[i]
+		if servicePort.NodePort == 0 {
+			servicePort.NodePort = oldNodePorts[servicePort.Name]
+		}
+		if servicePort.NodePort != 0 && inUse[servicePort.NodePort] {
+			continue
+		}
+		if servicePort.NodePort != 0 {
+			if err := rs.nodePortMgr.Allocate(servicePort.NodePort); err != nil {
+				rs.releaseNodePorts(allocated)
+				el := fielderrors.ValidationErrorList{fielderrors.NewFieldInvalid(fmt.Sprintf("spec.ports[%d].nodePort", i), servicePort.NodePort, err.Error())}
+				return errors.NewInvalid("Service", service.Name, el)
+			}
+		} else {
+			nodePort, err := rs.nodePortMgr.AllocateNext()
+			if err != nil {
+				rs.releaseNodePorts(allocated)
+				return err
+			}
+			servicePort.NodePort = nodePort
+		}
+		allocated = append(allocated, servicePort.NodePort)
+	}
+	return nil
+}
+
+// releaseNodePorts releases the given node ports.
+func (rs *REST) releaseNodePorts(nodePorts []int) {
+	for _, nodePort := range nodePorts {
+		rs.nodePortMgr.Release(nodePort)
+	}
+}
+
+// nodePortsOf returns the node ports held by service.
+func nodePortsOf(service *api.Service) []int {
+	nodePorts := []int{}
+	if !usesNodePorts(service) {
+		return nodePorts
+	}
+	for i := range service.Spec.Ports {
+		if service.Spec.Ports[i].NodePort != 0 {
+			nodePorts = append(nodePorts, service.Spec.Ports[i].NodePort)
+		}
+	}
+	return nodePorts
+}
+
+// unusedNodePorts returns the node ports held by old but not by service.
+func unusedNodePorts(old, service *api.Service) []int {
+	kept := map[int]bool{}
+	for _, nodePort := range nodePortsOf(service) {
+		kept[nodePort] = true
+	}
+	unused := []int{}
+	for _, nodePort := range nodePortsOf(old) {
+		if !kept[nodePort] {
+			unused = append(unused, nodePort)
+		}
+	}
+	return unused
+}
+
 func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, error) {
 	service := obj.(*api.Service)
 
@@ -108,14 +193,24 @@ func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, err
 		}
 	}
 
+	if usesNodePorts(service) {
+		if err := rs.allocateNodePorts(service, nil); err != nil {
+			if api.IsServiceIPSet(service) {
+				rs.portalMgr.Release(net.ParseIP(service.Spec.PortalIP))
+			}
+			return nil, err
+		}
+	}
+
 	// TODO: Move this to post-creation rectification loop, so that we make/remove external load balancers
 	// correctly no matter what http operations happen.
-	if service.Spec.CreateExternalLoadBalancer {
+	if service.Spec.Type == api.ServiceTypeLoadBalancer {
 		err := rs.createExternalLoadBalancer(ctx, service)
 		if err != nil {
 			if api.IsServiceIPSet(service) {
 				rs.portalMgr.Release(net.ParseIP(service.Spec.PortalIP))
 			}
+			rs.releaseNodePorts(nodePortsOf(service))
 			return nil, err
 		}
 	}
@@ -125,6 +220,7 @@ func (rs *REST) Create(ctx api.Context, obj runtime.Object) (runtime.Object, err
 		if api.IsServiceIPSet(service) {
 			rs.portalMgr.Release(net.ParseIP(service.Spec.PortalIP))
 		}
+		rs.releaseNodePorts(nodePortsOf(service))
 		err = rest.CheckGeneratedNameError(rest.Services, err, service)
 	}
 	return out, err
@@ -146,7 +242,8 @@ func (rs *REST) Delete(ctx api.Context, id string) (runtime.Object, error) {
 	if api.IsServiceIPSet(service) {
 		rs.portalMgr.Release(net.ParseIP(service.Spec.PortalIP))
 	}
-	if service.Spec.CreateExternalLoadBalancer {
+	rs.releaseNodePorts(nodePortsOf(service))
+	if service.Spec.Type == api.ServiceTypeLoadBalancer {
 		rs.deleteExternalLoadBalancer(ctx, service)
 	}
 	return &api.Status{Status: api.StatusSuccess}, rs.registry.DeleteService(ctx, id)
@@ -205,23 +302,35 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 	if errs := validation.ValidateServiceUpdate(oldService, service); len(errs) > 0 {
 		return nil, false, errors.NewInvalid("service", service.Name, errs)
 	}
+	if usesNodePorts(service) {
+		if err := rs.allocateNodePorts(service, oldService); err != nil {
+			return nil, false, err
+		}
+	}
 	// Recreate external load balancer if changed.
 	if externalLoadBalancerNeedsUpdate(oldService, service) {
 		// TODO: support updating existing balancers
-		if oldService.Spec.CreateExternalLoadBalancer {
+		if oldService.Spec.Type == api.ServiceTypeLoadBalancer {
 			err = rs.deleteExternalLoadBalancer(ctx, oldService)
 			if err != nil {
+				rs.releaseNodePorts(unusedNodePorts(service, oldService))
 				return nil, false, err
 			}
 		}
-		if service.Spec.CreateExternalLoadBalancer {
+		if service.Spec.Type == api.ServiceTypeLoadBalancer {
 			err = rs.createExternalLoadBalancer(ctx, service)
 			if err != nil {
+				rs.releaseNodePorts(unusedNodePorts(service, oldService))
 				return nil, false, err
 			}
 		}
 	}
 	out, err := rs.registry.UpdateService(ctx, service)
+	if err != nil {
+		rs.releaseNodePorts(unusedNodePorts(service, oldService))
+		return out, false, err
+	}
+	rs.releaseNodePorts(unusedNodePorts(oldService, service))
 	return out, false, err
 }
 
@@ -229,20 +338,39 @@ func (rs *REST) Update(ctx api.Context, obj runtime.Object) (runtime.Object, boo
 var _ = rest.Redirector(&REST{})
 
 // ResourceLocation returns a URL to which one can send traffic for the specified service.
+// The id may be suffixed with ":<port name>" to pick a port of a multi-port service.
 func (rs *REST) ResourceLocation(ctx api.Context, id string) (*url.URL, http.RoundTripper, error) {
-	eps, err := rs.registry.GetEndpoints(ctx, id)
+	// Allow ID as "svcname" or "svcname:port".
+	svcName, portStr := id, ""
+	if parts := strings.SplitN(id, ":", 2); len(parts) == 2 {
+		svcName, portStr = parts[0], parts[1]
+	}
+	eps, err := rs.registry.GetEndpoints(ctx, svcName)
 	if err != nil {
 		return nil, nil, err
 	}
-	if len(eps.Endpoints) == 0 {
-		return nil, nil, fmt.Errorf("no endpoints available for %v", id)
+	if len(eps.Subsets) == 0 {
+		return nil, nil, fmt.Errorf("no endpoints available for %q", svcName)
+	}
+	// Pick a random Subset to start searching from.
+	ssSeed := rand.Intn(len(eps.Subsets))
+	// Find a Subset that has the port.
+	for ssi := 0; ssi < len(eps.Subsets); ssi++ {
+		ss := &eps.Subsets[(ssSeed+ssi)%len(eps.Subsets)]
+		for i := range ss.Ports {
+			if ss.Ports[i].Name == portStr {
+				// Pick a random address.
+				ip := ss.Addresses[rand.Intn(len(ss.Addresses))].IP
+				port := ss.Ports[i].Port
+				// We leave off the scheme ('http://') because we have no idea what sort of server
+				// is listening at this endpoint.
+				return &url.URL{
+					Host: net.JoinHostPort(ip, strconv.Itoa(port)),
+				}, nil, nil
+			}
+		}
 	}
-	// We leave off the scheme ('http://') because we have no idea what sort of server
-	// is listening at this endpoint.
-	ep := &eps.Endpoints[rand.Intn(len(eps.Endpoints))]
-	return &url.URL{
-		Host: net.JoinHostPort(ep.IP, strconv.Itoa(ep.Port)),
-	}, nil, nil
+	return nil, nil, fmt.Errorf("no endpoints available for %q", id)
 }
 
 func (rs *REST) getLoadbalancerName(ctx api.Context, service *api.Service) string {
@@ -253,7 +381,11 @@ func (rs *REST) createExternalLoadBalancer(ctx api.Context, service *api.Service
 	if rs.cloud == nil {
 		return fmt.Errorf("requested an external service, but no cloud provider supplied.")
 	}
-	if service.Spec.Protocol != api.ProtocolTCP {
+	if len(service.Spec.Ports) != 1 {
+		// TODO: Support multi-port external load balancers.
+		return fmt.Errorf("external load balancers for multi-port services are not currently supported.")
+	}
+	if service.Spec.Ports[0].Protocol != api.ProtocolTCP {
 		// TODO: Support UDP here too.
 		return fmt.Errorf("external load balancers for non TCP services are not currently supported.")
 	}
@@ -278,14 +410,14 @@ func (rs *REST) createExternalLoadBalancer(ctx api.Context, service *api.Service
 	var affinityType api.AffinityType = service.Spec.SessionAffinity
 	if len(service.Spec.PublicIPs) > 0 {
 		for _, publicIP := range service.Spec.PublicIPs {
-			_, err = balancer.CreateTCPLoadBalancer(name, zone.Region, net.ParseIP(publicIP), service.Spec.Port, hostsFromMinionList(hosts), affinityType)
+			_, err = balancer.CreateTCPLoadBalancer(name, zone.Region, net.ParseIP(publicIP), service.Spec.Ports[0].Port, hostsFromMinionList(hosts), affinityType)
 			if err != nil {
 				// TODO: have to roll-back any successful calls.
 				return err
 			}
 		}
 	} else {
-		endpoint, err := balancer.CreateTCPLoadBalancer(name, zone.Region, nil, service.Spec.Port, hostsFromMinionList(hosts), affinityType)
+		endpoint, err := balancer.CreateTCPLoadBalancer(name, zone.Region, nil, service.Spec.Ports[0].Port, hostsFromMinionList(hosts), affinityType)
 		if err != nil {
 			return err
 		}
@@ -321,15 +453,21 @@ func (rs *REST) deleteExternalLoadBalancer(ctx api.Context, service *api.Service
 }
 
 func externalLoadBalancerNeedsUpdate(old, new *api.Service) bool {
-	if !old.Spec.CreateExternalLoadBalancer && !new.Spec.CreateExternalLoadBalancer {
+	if old.Spec.Type != api.ServiceTypeLoadBalancer && new.Spec.Type != api.ServiceTypeLoadBalancer {
 		return false
 	}
-	if old.Spec.CreateExternalLoadBalancer != new.Spec.CreateExternalLoadBalancer ||
-		old.Spec.Port != new.Spec.Port ||
-		old.Spec.SessionAffinity != new.Spec.SessionAffinity ||
-		old.Spec.Protocol != new.Spec.Protocol {
+	if old.Spec.Type != new.Spec.Type ||
+		old.Spec.SessionAffinity != new.Spec.SessionAffinity {
 		return true
 	}
+	if len(old.Spec.Ports) != len(new.Spec.Ports) {
+		return true
+	}
+	for i := range old.Spec.Ports {
+		if old.Spec.Ports[i] != new.Spec.Ports[i] {
+			return true
+		}
+	}
 	if len(old.Spec.PublicIPs) != len(new.Spec.PublicIPs) {
 		return true
 	}
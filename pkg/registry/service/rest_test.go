@@ -30,6 +30,7 @@ import (
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/registrytest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
 )
 
 func makeIPNet(t *testing.T) *net.IPNet {
@@ -40,20 +41,28 @@ func makeIPNet(t *testing.T) *net.IPNet {
 	return net
 }
 
+// makeNodePorts returns an in-memory node port allocator which allocates ports in
+// sequence.
+func makeNodePorts() PortAllocator {
+	nodePorts := newPortAllocator(&util.PortRange{Base: 30000, Size: 1000})
+	nodePorts.randomAttempts = 0
+	return nodePorts
+}
+
 func TestServiceRegistryCreate(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	storage.portalMgr.randomAttempts = 0
 
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:            6502,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:        map[string]string{"bar": "baz"},
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx := api.NewDefaultContext()
@@ -85,23 +94,24 @@ func TestServiceRegistryCreate(t *testing.T) {
 
 func TestServiceStorageValidatesCreate(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
-	storage := NewStorage(registry, nil, nil, makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, nil, nil, makeIPNet(t), makeNodePorts(), "kubernetes")
 	failureCases := map[string]api.Service{
 		"empty ID": {
 			ObjectMeta: api.ObjectMeta{Name: ""},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 		"empty port": {
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
+				Ports:           []api.ServicePort{{Protocol: api.ProtocolTCP}},
 				SessionAffinity: api.AffinityTypeNone,
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 	}
@@ -124,18 +134,18 @@ func TestServiceRegistryUpdate(t *testing.T) {
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
-			Port:     6502,
+			Ports:    []api.ServicePort{{Port: 6502}},
 			Selector: map[string]string{"bar": "baz1"},
 		},
 	})
-	storage := NewStorage(registry, nil, nil, makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, nil, nil, makeIPNet(t), makeNodePorts(), "kubernetes")
 	updated_svc, created, err := storage.Update(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:            6502,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			Selector:        map[string]string{"bar": "baz2"},
-			Protocol:        api.ProtocolTCP,
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	})
 	if err != nil {
@@ -162,28 +172,28 @@ func TestServiceStorageValidatesUpdate(t *testing.T) {
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:     6502,
+			Ports:    []api.ServicePort{{Port: 6502}},
 			Selector: map[string]string{"bar": "baz"},
 		},
 	})
-	storage := NewStorage(registry, nil, nil, makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, nil, nil, makeIPNet(t), makeNodePorts(), "kubernetes")
 	failureCases := map[string]api.Service{
 		"empty ID": {
 			ObjectMeta: api.ObjectMeta{Name: ""},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"bar": "baz"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 		"invalid selector": {
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
 			Spec: api.ServiceSpec{
-				Port:            6502,
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 				Selector:        map[string]string{"ThisSelectorFailsValidation": "ok"},
-				Protocol:        api.ProtocolTCP,
 				SessionAffinity: api.AffinityTypeNone,
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 	}
@@ -203,15 +213,14 @@ func TestServiceRegistryExternalService(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
-			Selector:                   map[string]string{"bar": "baz"},
-			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
-			SessionAffinity:            api.AffinityTypeNone,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
+			Selector:        map[string]string{"bar": "baz"},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeLoadBalancer,
 		},
 	}
 	storage.Create(ctx, svc)
@@ -236,15 +245,14 @@ func TestServiceRegistryExternalServiceError(t *testing.T) {
 		Err: fmt.Errorf("test error"),
 	}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
-			Selector:                   map[string]string{"bar": "baz"},
-			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
-			SessionAffinity:            api.AffinityTypeNone,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
+			Selector:        map[string]string{"bar": "baz"},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeLoadBalancer,
 		},
 	}
 	ctx := api.NewDefaultContext()
@@ -262,13 +270,14 @@ func TestServiceRegistryDelete(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	registry.CreateService(ctx, svc)
@@ -286,14 +295,14 @@ func TestServiceRegistryDeleteExternal(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Selector:                   map[string]string{"bar": "baz"},
-			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
-			SessionAffinity:            api.AffinityTypeNone,
+			Selector:        map[string]string{"bar": "baz"},
+			Ports:           []api.ServicePort{{Protocol: api.ProtocolTCP}},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeLoadBalancer,
 		},
 	}
 	registry.CreateService(ctx, svc)
@@ -311,17 +320,16 @@ func TestServiceRegistryUpdateExternalService(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 
 	// Create non-external load balancer.
 	svc1 := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Port:                       6502,
-			Selector:                   map[string]string{"bar": "baz"},
-			CreateExternalLoadBalancer: false,
-			Protocol:                   api.ProtocolTCP,
-			SessionAffinity:            api.AffinityTypeNone,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
+			Selector:        map[string]string{"bar": "baz"},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	storage.Create(ctx, svc1)
@@ -332,7 +340,7 @@ func TestServiceRegistryUpdateExternalService(t *testing.T) {
 	// Modify load balancer to be external.
 	svc2 := new(api.Service)
 	*svc2 = *svc1
-	svc2.Spec.CreateExternalLoadBalancer = true
+	svc2.Spec.Type = api.ServiceTypeLoadBalancer
 	storage.Update(ctx, svc2)
 	if len(fakeCloud.Calls) != 2 || fakeCloud.Calls[0] != "get-zone" || fakeCloud.Calls[1] != "create" {
 		t.Errorf("Unexpected call(s): %#v", fakeCloud.Calls)
@@ -341,7 +349,7 @@ func TestServiceRegistryUpdateExternalService(t *testing.T) {
 	// Change port.
 	svc3 := new(api.Service)
 	*svc3 = *svc2
-	svc3.Spec.Port = 6504
+	svc3.Spec.Ports = []api.ServicePort{{Port: 6504, Protocol: api.ProtocolTCP}}
 	storage.Update(ctx, svc3)
 	if len(fakeCloud.Calls) != 6 || fakeCloud.Calls[0] != "get-zone" || fakeCloud.Calls[1] != "create" ||
 		fakeCloud.Calls[2] != "get-zone" || fakeCloud.Calls[3] != "delete" ||
@@ -355,7 +363,7 @@ func TestServiceRegistryGet(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
@@ -374,10 +382,15 @@ func TestServiceRegistryGet(t *testing.T) {
 func TestServiceRegistryResourceLocation(t *testing.T) {
 	ctx := api.NewDefaultContext()
 	registry := registrytest.NewServiceRegistry()
-	registry.Endpoints = api.Endpoints{Endpoints: []api.Endpoint{{IP: "foo", Port: 80}}}
+	registry.Endpoints = api.Endpoints{
+		Subsets: []api.EndpointSubset{{
+			Addresses: []api.EndpointAddress{{IP: "1.2.3.4"}},
+			Ports:     []api.EndpointPort{{Name: "", Port: 80}, {Name: "p", Port: 93}},
+		}},
+	}
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
@@ -392,13 +405,33 @@ func TestServiceRegistryResourceLocation(t *testing.T) {
 	if location == nil {
 		t.Errorf("Unexpected nil: %v", location)
 	}
-	if e, a := "//foo:80", location.String(); e != a {
+	if e, a := "//1.2.3.4:80", location.String(); e != a {
+		t.Errorf("Expected %v, but got %v", e, a)
+	}
+	if e, a := "foo", registry.GottenID; e != a {
+		t.Errorf("Expected %v, but got %v", e, a)
+	}
+
+	// Test a name + port.
+	location, _, err = redirector.ResourceLocation(ctx, "foo:p")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if location == nil {
+		t.Errorf("Unexpected nil: %v", location)
+	}
+	if e, a := "//1.2.3.4:93", location.String(); e != a {
 		t.Errorf("Expected %v, but got %v", e, a)
 	}
 	if e, a := "foo", registry.GottenID; e != a {
 		t.Errorf("Expected %v, but got %v", e, a)
 	}
 
+	// Test a port that doesn't exist.
+	if _, _, err = redirector.ResourceLocation(ctx, "foo:q"); err == nil {
+		t.Errorf("unexpected nil error")
+	}
+
 	// Test error path
 	registry.Err = fmt.Errorf("fake error")
 	if _, _, err = redirector.ResourceLocation(ctx, "foo"); err == nil {
@@ -411,7 +444,7 @@ func TestServiceRegistryList(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	storage := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	registry.CreateService(ctx, &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
@@ -448,16 +481,16 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest.portalMgr.randomAttempts = 0
 
 	svc1 := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx := api.NewDefaultContext()
@@ -474,9 +507,9 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "bar"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		}}
 	ctx = api.NewDefaultContext()
 	created_svc2, _ := rest.Create(ctx, svc2)
@@ -493,9 +526,9 @@ func TestServiceRegistryIPAllocation(t *testing.T) {
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
 			PortalIP:        "1.2.3.93",
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx = api.NewDefaultContext()
@@ -510,16 +543,16 @@ func TestServiceRegistryIPReallocation(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest.portalMgr.randomAttempts = 0
 
 	svc1 := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx := api.NewDefaultContext()
@@ -538,9 +571,9 @@ func TestServiceRegistryIPReallocation(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "bar"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx = api.NewDefaultContext()
@@ -558,23 +591,23 @@ func TestServiceRegistryIPUpdate(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest.portalMgr.randomAttempts = 0
 
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx := api.NewDefaultContext()
 	created_svc, _ := rest.Create(ctx, svc)
 	created_service := created_svc.(*api.Service)
-	if created_service.Spec.Port != 6502 {
-		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Port)
+	if created_service.Spec.Ports[0].Port != 6502 {
+		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Ports[0].Port)
 	}
 	if created_service.Spec.PortalIP != "1.2.3.1" {
 		t.Errorf("Unexpected PortalIP: %s", created_service.Spec.PortalIP)
@@ -582,16 +615,16 @@ func TestServiceRegistryIPUpdate(t *testing.T) {
 
 	update := new(api.Service)
 	*update = *created_service
-	update.Spec.Port = 6503
+	update.Spec.Ports = []api.ServicePort{{Port: 6503, Protocol: api.ProtocolTCP}}
 
 	updated_svc, _, _ := rest.Update(ctx, update)
 	updated_service := updated_svc.(*api.Service)
-	if updated_service.Spec.Port != 6503 {
-		t.Errorf("Expected port 6503, but got %v", updated_service.Spec.Port)
+	if updated_service.Spec.Ports[0].Port != 6503 {
+		t.Errorf("Expected port 6503, but got %v", updated_service.Spec.Ports[0].Port)
 	}
 
 	*update = *created_service
-	update.Spec.Port = 6503
+	update.Spec.Ports = []api.ServicePort{{Port: 6503, Protocol: api.ProtocolTCP}}
 	update.Spec.PortalIP = "1.2.3.76" // error
 
 	_, _, err := rest.Update(ctx, update)
@@ -604,24 +637,23 @@ func TestServiceRegistryIPExternalLoadBalancer(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest.portalMgr.randomAttempts = 0
 
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo"},
 		Spec: api.ServiceSpec{
-			Selector: map[string]string{"bar": "baz"},
-			Port:     6502,
-			CreateExternalLoadBalancer: true,
-			Protocol:                   api.ProtocolTCP,
-			SessionAffinity:            api.AffinityTypeNone,
+			Selector:        map[string]string{"bar": "baz"},
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeLoadBalancer,
 		},
 	}
 	ctx := api.NewDefaultContext()
 	created_svc, _ := rest.Create(ctx, svc)
 	created_service := created_svc.(*api.Service)
-	if created_service.Spec.Port != 6502 {
-		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Port)
+	if created_service.Spec.Ports[0].Port != 6502 {
+		t.Errorf("Expected port 6502, but got %v", created_service.Spec.Ports[0].Port)
 	}
 	if created_service.Spec.PortalIP != "1.2.3.1" {
 		t.Errorf("Unexpected PortalIP: %s", created_service.Spec.PortalIP)
@@ -643,16 +675,16 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest1 := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest1 := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest1.portalMgr.randomAttempts = 0
 
 	svc := &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	ctx := api.NewDefaultContext()
@@ -661,24 +693,24 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	rest1.Create(ctx, svc)
 
 	// This will reload from storage, finding the previous 2
-	rest2 := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest2 := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest2.portalMgr.randomAttempts = 0
 
 	svc = &api.Service{
 		ObjectMeta: api.ObjectMeta{Name: "foo", Namespace: api.NamespaceDefault},
 		Spec: api.ServiceSpec{
 			Selector:        map[string]string{"bar": "baz"},
-			Port:            6502,
-			Protocol:        api.ProtocolTCP,
+			Ports:           []api.ServicePort{{Port: 6502, Protocol: api.ProtocolTCP}},
 			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeClusterIP,
 		},
 	}
 	created_svc, _ := rest2.Create(ctx, svc)
@@ -688,6 +720,133 @@ func TestServiceRegistryIPReloadFromStorage(t *testing.T) {
 	}
 }
 
+func makeNodePortService(name string, nodePorts ...int) *api.Service {
+	svc := &api.Service{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: api.NamespaceDefault},
+		Spec: api.ServiceSpec{
+			Selector:        map[string]string{"bar": "baz"},
+			SessionAffinity: api.AffinityTypeNone,
+			Type:            api.ServiceTypeNodePort,
+		},
+	}
+	for i, nodePort := range nodePorts {
+		svc.Spec.Ports = append(svc.Spec.Ports, api.ServicePort{
+			Name:     fmt.Sprintf("p%d", i),
+			Port:     6502 + i,
+			Protocol: api.ProtocolTCP,
+			NodePort: nodePort,
+		})
+	}
+	return svc
+}
+
+func TestServiceRegistryNodePortAllocation(t *testing.T) {
+	registry := registrytest.NewServiceRegistry()
+	rest := NewStorage(registry, nil, nil, makeIPNet(t), makeNodePorts(), "kubernetes")
+	ctx := api.NewDefaultContext()
+
+	created_svc, err := rest.Create(ctx, makeNodePortService("foo", 0, 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_service := created_svc.(*api.Service)
+	if created_service.Spec.Ports[0].NodePort != 30000 || created_service.Spec.Ports[1].NodePort != 30001 {
+		t.Errorf("Unexpected NodePorts: %v", created_service.Spec.Ports)
+	}
+
+	created_svc, err = rest.Create(ctx, makeNodePortService("bar", 30500))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_service = created_svc.(*api.Service)
+	if created_service.Spec.Ports[0].NodePort != 30500 {
+		t.Errorf("Unexpected NodePort: %d", created_service.Spec.Ports[0].NodePort)
+	}
+
+	// Already allocated
+	if _, err := rest.Create(ctx, makeNodePortService("baz", 30500)); err == nil || !errors.IsInvalid(err) {
+		t.Errorf("Expected an invalid error, got %v", err)
+	}
+	// Out of range
+	if _, err := rest.Create(ctx, makeNodePortService("baz", 40000)); err == nil || !errors.IsInvalid(err) {
+		t.Errorf("Expected an invalid error, got %v", err)
+	}
+
+	if _, err := rest.Delete(ctx, "bar"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_svc, err = rest.Create(ctx, makeNodePortService("baz", 30500))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_service = created_svc.(*api.Service)
+	if created_service.Spec.Ports[0].NodePort != 30500 { // released by the delete
+		t.Errorf("Unexpected NodePort: %d", created_service.Spec.Ports[0].NodePort)
+	}
+}
+
+func TestServiceRegistryNodePortUpdate(t *testing.T) {
+	registry := registrytest.NewServiceRegistry()
+	rest := NewStorage(registry, nil, nil, makeIPNet(t), makeNodePorts(), "kubernetes")
+	ctx := api.NewDefaultContext()
+
+	created_svc, err := rest.Create(ctx, makeNodePortService("foo", 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_service := created_svc.(*api.Service)
+
+	// Leaving the node port unset keeps the one allocated before.
+	update := makeNodePortService("foo", 0)
+	update.ResourceVersion = created_service.ResourceVersion
+	update.Spec.PortalIP = created_service.Spec.PortalIP
+	updated_svc, _, err := rest.Update(ctx, update)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated_svc.(*api.Service).Spec.Ports[0].NodePort != 30000 {
+		t.Errorf("Unexpected NodePort: %d", updated_svc.(*api.Service).Spec.Ports[0].NodePort)
+	}
+
+	// Switching to a ClusterIP service releases the node port.
+	update = makeNodePortService("foo", 0)
+	update.ResourceVersion = created_service.ResourceVersion
+	update.Spec.PortalIP = created_service.Spec.PortalIP
+	update.Spec.Type = api.ServiceTypeClusterIP
+	if _, _, err := rest.Update(ctx, update); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	created_svc, err = rest.Create(ctx, makeNodePortService("bar", 30000))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created_svc.(*api.Service).Spec.Ports[0].NodePort != 30000 {
+		t.Errorf("Unexpected NodePort: %d", created_svc.(*api.Service).Spec.Ports[0].NodePort)
+	}
+}
+
+func TestServiceRegistryNodePortSharedAllocations(t *testing.T) {
+	registry := registrytest.NewServiceRegistry()
+	nodePorts := makeNodePorts()
+	rest1 := NewStorage(registry, nil, nil, makeIPNet(t), nodePorts, "kubernetes")
+	ctx := api.NewDefaultContext()
+
+	if _, err := rest1.Create(ctx, makeNodePortService("foo", 0, 0)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A second apiserver sees the ports allocated by the first one
+	rest2 := NewStorage(registry, nil, nil, makeIPNet(t), nodePorts, "kubernetes")
+
+	created_svc, err := rest2.Create(ctx, makeNodePortService("bar", 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if created_svc.(*api.Service).Spec.Ports[0].NodePort != 30002 {
+		t.Errorf("Unexpected NodePort: %d", created_svc.(*api.Service).Spec.Ports[0].NodePort)
+	}
+}
+
 // TODO: remove, covered by TestCreate
 func TestCreateServiceWithConflictingNamespace(t *testing.T) {
 	storage := REST{}
@@ -729,7 +888,7 @@ func TestCreate(t *testing.T) {
 	registry := registrytest.NewServiceRegistry()
 	fakeCloud := &cloud.FakeCloud{}
 	machines := []string{"foo", "bar", "baz"}
-	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), "kubernetes")
+	rest := NewStorage(registry, fakeCloud, registrytest.NewMinionRegistry(machines, api.NodeResources{}), makeIPNet(t), makeNodePorts(), "kubernetes")
 	rest.portalMgr.randomAttempts = 0
 
 	test := resttest.New(t, rest, registry.SetError)
@@ -739,9 +898,9 @@ func TestCreate(t *testing.T) {
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"bar": "baz"},
 				PortalIP:        "None",
-				Port:            6502,
-				Protocol:        "TCP",
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: "TCP"}},
 				SessionAffinity: "None",
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 		// invalid
@@ -752,10 +911,10 @@ func TestCreate(t *testing.T) {
 		&api.Service{
 			Spec: api.ServiceSpec{
 				Selector:        map[string]string{"bar": "baz"},
-				Port:            6502,
-				Protocol:        "TCP",
+				Ports:           []api.ServicePort{{Port: 6502, Protocol: "TCP"}},
 				PortalIP:        "invalid",
 				SessionAffinity: "None",
+				Type:            api.ServiceTypeClusterIP,
 			},
 		},
 	)
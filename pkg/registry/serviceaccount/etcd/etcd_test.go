@@ -0,0 +1,161 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/serviceaccount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage := NewStorage(h)
+	return storage, fakeEtcdClient, h
+}
+
+func validNewServiceAccount(name, ns string) *api.ServiceAccount {
+	return &api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Secrets: []api.ObjectReference{{Name: name + "-token-abcde"}},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _ := newStorage(t)
+	serviceaccount.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper)
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	serviceAccount := validNewServiceAccount("foo", api.NamespaceDefault)
+	serviceAccount.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		serviceAccount,
+		// invalid
+		&api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestEtcdListServiceAccounts(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewServiceAccount("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewServiceAccount("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	serviceAccountObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	serviceAccounts := serviceAccountObj.(*api.ServiceAccountList)
+	if len(serviceAccounts.Items) != 2 || serviceAccounts.Items[0].Name != "foo" || serviceAccounts.Items[1].Name != "bar" {
+		t.Errorf("Unexpected service account list: %#v", serviceAccounts)
+	}
+}
+
+func TestEtcdGetServiceAccount(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	serviceAccount := validNewServiceAccount("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, serviceAccount), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.ServiceAccount)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(serviceAccount.Secrets, actual.Secrets) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(serviceAccount, actual))
+	}
+}
+
+func TestEtcdUpdateServiceAccount(t *testing.T) {
+	registry, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewServiceAccount("foo", api.NamespaceDefault)), 1)
+
+	serviceAccountIn := validNewServiceAccount("foo", api.NamespaceDefault)
+	serviceAccountIn.ResourceVersion = "1"
+	serviceAccountIn.Secrets = append(serviceAccountIn.Secrets, api.ObjectReference{Name: "foo-token-fghij"})
+	if _, _, err := registry.Update(ctx, serviceAccountIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var serviceAccountOut api.ServiceAccount
+	if err := helper.ExtractObj(key, &serviceAccountOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(serviceAccountIn.Secrets, serviceAccountOut.Secrets) {
+		t.Errorf("unexpected secrets: %s", util.ObjectDiff(serviceAccountIn.Secrets, serviceAccountOut.Secrets))
+	}
+}
+
+func TestEtcdDeleteServiceAccount(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewServiceAccount("foo", api.NamespaceDefault)), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// serviceAccountStrategy implements behavior for ServiceAccount objects
+type serviceAccountStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ServiceAccount
+// objects via the REST API.
+var Strategy = serviceAccountStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for service accounts.
+func (serviceAccountStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate is a no-op, service accounts have no status.
+func (serviceAccountStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new service account.
+func (serviceAccountStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateServiceAccount(obj.(*api.ServiceAccount))
+}
+
+// AllowCreateOnUpdate is false for service accounts.
+func (serviceAccountStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (serviceAccountStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateServiceAccountUpdate(obj.(*api.ServiceAccount), old.(*api.ServiceAccount))
+}
+
+// MatchServiceAccount returns a generic matcher for a given label and field selector.
+func MatchServiceAccount(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		serviceAccountObj, ok := obj.(*api.ServiceAccount)
+		if !ok {
+			return false, fmt.Errorf("not a service account")
+		}
+		fields := ServiceAccountToSelectableFields(serviceAccountObj)
+		return label.Matches(labels.Set(serviceAccountObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ServiceAccountToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ServiceAccountToSelectableFields(serviceAccount *api.ServiceAccount) labels.Set {
+	return labels.Set{
+		"name": serviceAccount.Name,
+	}
+}
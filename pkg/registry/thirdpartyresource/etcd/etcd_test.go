@@ -0,0 +1,166 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage := NewStorage(h)
+	return storage, fakeEtcdClient, h
+}
+
+func validNewThirdPartyResource(name string) *api.ThirdPartyResource {
+	return &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Versions: []string{"v1"},
+	}
+}
+
+func TestStorage(t *testing.T) {
+	storage, _, _ := newStorage(t)
+	thirdpartyresource.NewRegistry(storage)
+}
+
+func TestCreate(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	if _, err := registry.Create(ctx, validNewThirdPartyResource("database-cluster.example.com")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key, _ := registry.KeyFunc(ctx, "database-cluster.example.com")
+	if _, found := fakeClient.Data[key]; !found {
+		t.Errorf("expected the third party resource to be stored under %s", key)
+	}
+
+	for _, invalid := range []*api.ThirdPartyResource{
+		validNewThirdPartyResource("database-cluster"),
+		{ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"}},
+	} {
+		if _, err := registry.Create(ctx, invalid); !errors.IsInvalid(err) {
+			t.Errorf("expected an invalid error for %#v, got %v", invalid, err)
+		}
+	}
+}
+
+func TestEtcdListThirdPartyResources(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResource("database-cluster.example.com")),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResource("certificate.example.com")),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	thirdPartyResourceObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	thirdPartyResources := thirdPartyResourceObj.(*api.ThirdPartyResourceList)
+	if len(thirdPartyResources.Items) != 2 || thirdPartyResources.Items[0].Name != "database-cluster.example.com" || thirdPartyResources.Items[1].Name != "certificate.example.com" {
+		t.Errorf("Unexpected third party resource list: %#v", thirdPartyResources)
+	}
+}
+
+func TestEtcdGetThirdPartyResource(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	thirdPartyResource := validNewThirdPartyResource("database-cluster.example.com")
+	key, _ := registry.KeyFunc(ctx, "database-cluster.example.com")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, thirdPartyResource), 0)
+
+	obj, err := registry.Get(ctx, "database-cluster.example.com")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.ThirdPartyResource)
+	if actual.Name != thirdPartyResource.Name || !reflect.DeepEqual(actual.Versions, thirdPartyResource.Versions) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(thirdPartyResource, actual))
+	}
+}
+
+func TestEtcdUpdateThirdPartyResource(t *testing.T) {
+	registry, fakeClient, helper := newStorage(t)
+	ctx := api.NewContext()
+	key, _ := registry.KeyFunc(ctx, "database-cluster.example.com")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResource("database-cluster.example.com")), 1)
+
+	thirdPartyResourceIn := validNewThirdPartyResource("database-cluster.example.com")
+	thirdPartyResourceIn.ResourceVersion = "1"
+	thirdPartyResourceIn.Description = "replicated database clusters"
+	if _, _, err := registry.Update(ctx, thirdPartyResourceIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var thirdPartyResourceOut api.ThirdPartyResource
+	if err := helper.ExtractObj(key, &thirdPartyResourceOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if thirdPartyResourceOut.Description != thirdPartyResourceIn.Description {
+		t.Errorf("unexpected description: %q", thirdPartyResourceOut.Description)
+	}
+}
+
+func TestEtcdDeleteThirdPartyResource(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewContext()
+	key, _ := registry.KeyFunc(ctx, "database-cluster.example.com")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResource("database-cluster.example.com")), 0)
+
+	if _, err := registry.Delete(ctx, "database-cluster.example.com", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresource
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Registry is an interface implemented by things that know how to store ThirdPartyResource objects.
+type Registry interface {
+	// ListThirdPartyResources obtains a list of third party resources having labels which match selector.
+	ListThirdPartyResources(ctx api.Context, selector labels.Selector) (*api.ThirdPartyResourceList, error)
+	// Watch for new/changed/deleted third party resources
+	WatchThirdPartyResources(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
+	// Get a specific third party resource
+	GetThirdPartyResource(ctx api.Context, name string) (*api.ThirdPartyResource, error)
+	// Create a third party resource based on a specification.
+	CreateThirdPartyResource(ctx api.Context, thirdPartyResource *api.ThirdPartyResource) error
+	// Update an existing third party resource
+	UpdateThirdPartyResource(ctx api.Context, thirdPartyResource *api.ThirdPartyResource) error
+	// Delete an existing third party resource
+	DeleteThirdPartyResource(ctx api.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListThirdPartyResources(ctx api.Context, label labels.Selector) (*api.ThirdPartyResourceList, error) {
+	obj, err := s.List(ctx, label, fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ThirdPartyResourceList), nil
+}
+
+func (s *storage) WatchThirdPartyResources(ctx api.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return s.Watch(ctx, label, field, resourceVersion)
+}
+
+func (s *storage) GetThirdPartyResource(ctx api.Context, name string) (*api.ThirdPartyResource, error) {
+	obj, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*api.ThirdPartyResource), nil
+}
+
+func (s *storage) CreateThirdPartyResource(ctx api.Context, thirdPartyResource *api.ThirdPartyResource) error {
+	_, err := s.Create(ctx, thirdPartyResource)
+	return err
+}
+
+func (s *storage) UpdateThirdPartyResource(ctx api.Context, thirdPartyResource *api.ThirdPartyResource) error {
+	_, _, err := s.Update(ctx, thirdPartyResource)
+	return err
+}
+
+func (s *storage) DeleteThirdPartyResource(ctx api.Context, name string) error {
+	_, err := s.Delete(ctx, name, nil)
+	return err
+}
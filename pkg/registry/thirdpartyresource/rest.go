@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresource
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// thirdPartyResourceStrategy implements behavior for ThirdPartyResource objects
+type thirdPartyResourceStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ThirdPartyResource
+// objects via the REST API.
+var Strategy = thirdPartyResourceStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is false for third party resources.
+func (thirdPartyResourceStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// ResetBeforeCreate is a no-op, third party resources have no status.
+func (thirdPartyResourceStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new third party resource.
+func (thirdPartyResourceStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateThirdPartyResource(obj.(*api.ThirdPartyResource))
+}
+
+// AllowCreateOnUpdate is false for third party resources.
+func (thirdPartyResourceStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (thirdPartyResourceStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceUpdate(obj.(*api.ThirdPartyResource), old.(*api.ThirdPartyResource))
+}
+
+// MatchThirdPartyResource returns a generic matcher for a given label and field selector.
+func MatchThirdPartyResource(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		thirdPartyResourceObj, ok := obj.(*api.ThirdPartyResource)
+		if !ok {
+			return false, fmt.Errorf("not a third party resource")
+		}
+		fields := ThirdPartyResourceToSelectableFields(thirdPartyResourceObj)
+		return label.Matches(labels.Set(thirdPartyResourceObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ThirdPartyResourceToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ThirdPartyResourceToSelectableFields(thirdPartyResource *api.ThirdPartyResource) labels.Set {
+	return labels.Set{
+		"name": thirdPartyResource.Name,
+	}
+}
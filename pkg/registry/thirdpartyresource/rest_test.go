@@ -0,0 +1,55 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresource
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestThirdPartyResourceStrategy(t *testing.T) {
+	if Strategy.NamespaceScoped() {
+		t.Errorf("ThirdPartyResource should not be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("ThirdPartyResource should not allow create on update")
+	}
+	thirdPartyResource := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com"},
+		Versions:   []string{"v1"},
+	}
+	if errs := Strategy.Validate(thirdPartyResource); len(errs) != 0 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
+
+func TestMatchThirdPartyResource(t *testing.T) {
+	thirdPartyResource := &api.ThirdPartyResource{
+		ObjectMeta: api.ObjectMeta{Name: "database-cluster.example.com", Labels: map[string]string{"team": "a"}},
+	}
+	matcher := MatchThirdPartyResource(labels.Everything(), fields.SelectorFromSet(fields.Set{"name": "database-cluster.example.com"}))
+	if ok, err := matcher.Matches(thirdPartyResource); !ok || err != nil {
+		t.Errorf("expected a match on the name field, got %v %v", ok, err)
+	}
+	matcher = MatchThirdPartyResource(labels.SelectorFromSet(labels.Set{"team": "b"}), fields.Everything())
+	if ok, err := matcher.Matches(thirdPartyResource); ok || err != nil {
+		t.Errorf("expected no match on another label, got %v %v", ok, err)
+	}
+}
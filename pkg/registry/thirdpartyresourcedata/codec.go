@@ -0,0 +1,206 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// thirdPartyResourceDataCodec translates the JSON of the objects of a kind registered by a
+// third party resource, made of their kind, API version, metadata and arbitrary content, to
+// and from the ThirdPartyResourceData they are stored as. The metadata is in the v1beta3
+// format. Other objects, such as statuses and delete options, are left to the delegate.
+type thirdPartyResourceDataCodec struct {
+	delegate   runtime.Codec
+	kind       string
+	apiVersion string
+}
+
+// NewCodec returns a codec for the objects of the given kind in the given API version,
+// which is of the form {group}/{version}.
+func NewCodec(delegate runtime.Codec, kind, apiVersion string) runtime.Codec {
+	return &thirdPartyResourceDataCodec{
+		delegate:   delegate,
+		kind:       kind,
+		apiVersion: apiVersion,
+	}
+}
+
+// unmarshal decodes JSON into a map, keeping numbers as they are written so that large
+// integers survive a round trip.
+func unmarshal(data []byte) (map[string]interface{}, error) {
+	content := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (c *thirdPartyResourceDataCodec) Decode(data []byte) (runtime.Object, error) {
+	typeMeta := struct {
+		Kind string `json:"kind"`
+	}{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, err
+	}
+	var obj runtime.Object
+	switch typeMeta.Kind {
+	case c.kind, "":
+		obj = &api.ThirdPartyResourceData{}
+	case c.kind + "List":
+		obj = &api.ThirdPartyResourceDataList{}
+	default:
+		return c.delegate.Decode(data)
+	}
+	if err := c.DecodeInto(data, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *thirdPartyResourceDataCodec) DecodeInto(data []byte, obj runtime.Object) error {
+	switch obj := obj.(type) {
+	case *api.ThirdPartyResourceData:
+		content, err := unmarshal(data)
+		if err != nil {
+			return err
+		}
+		return c.decodeObject(content, obj)
+	case *api.ThirdPartyResourceDataList:
+		return c.decodeList(data, obj)
+	default:
+		return c.delegate.DecodeInto(data, obj)
+	}
+}
+
+// decodeObject fills a ThirdPartyResourceData from the JSON content of an object.
+func (c *thirdPartyResourceDataCodec) decodeObject(content map[string]interface{}, obj *api.ThirdPartyResourceData) error {
+	if kind, found := content["kind"]; found && kind != c.kind {
+		return fmt.Errorf("expected an object of kind %q, got %v", c.kind, kind)
+	}
+	if apiVersion, found := content["apiVersion"]; found && apiVersion != c.apiVersion {
+		return fmt.Errorf("expected an object of API version %q, got %v", c.apiVersion, apiVersion)
+	}
+	delete(content, "kind")
+	delete(content, "apiVersion")
+
+	if metadata, found := content["metadata"]; found {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+		objectMeta := v1beta3.ObjectMeta{}
+		if err := json.Unmarshal(data, &objectMeta); err != nil {
+			return fmt.Errorf("unable to decode the metadata: %v", err)
+		}
+		if err := api.Scheme.Convert(&objectMeta, &obj.ObjectMeta); err != nil {
+			return err
+		}
+		delete(content, "metadata")
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	obj.Data = data
+	return nil
+}
+
+func (c *thirdPartyResourceDataCodec) decodeList(data []byte, list *api.ThirdPartyResourceDataList) error {
+	versioned := struct {
+		Metadata v1beta3.ListMeta  `json:"metadata"`
+		Items    []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(data, &versioned); err != nil {
+		return err
+	}
+	if err := api.Scheme.Convert(&versioned.Metadata, &list.ListMeta); err != nil {
+		return err
+	}
+	list.Items = make([]api.ThirdPartyResourceData, len(versioned.Items))
+	for i := range versioned.Items {
+		content, err := unmarshal(versioned.Items[i])
+		if err != nil {
+			return err
+		}
+		if err := c.decodeObject(content, &list.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *thirdPartyResourceDataCodec) Encode(obj runtime.Object) ([]byte, error) {
+	switch obj := obj.(type) {
+	case *api.ThirdPartyResourceData:
+		content, err := c.encodeObject(obj)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(content)
+	case *api.ThirdPartyResourceDataList:
+		listMeta := v1beta3.ListMeta{}
+		if err := api.Scheme.Convert(&obj.ListMeta, &listMeta); err != nil {
+			return nil, err
+		}
+		items := []interface{}{}
+		for i := range obj.Items {
+			content, err := c.encodeObject(&obj.Items[i])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, content)
+		}
+		return json.Marshal(map[string]interface{}{
+			"kind":       c.kind + "List",
+			"apiVersion": c.apiVersion,
+			"metadata":   listMeta,
+			"items":      items,
+		})
+	default:
+		return c.delegate.Encode(obj)
+	}
+}
+
+// encodeObject returns the JSON content of a ThirdPartyResourceData, with its kind, API
+// version and current metadata.
+func (c *thirdPartyResourceDataCodec) encodeObject(obj *api.ThirdPartyResourceData) (map[string]interface{}, error) {
+	content := map[string]interface{}{}
+	if len(obj.Data) > 0 {
+		var err error
+		if content, err = unmarshal(obj.Data); err != nil {
+			return nil, fmt.Errorf("unable to decode the data of %s: %v", obj.Name, err)
+		}
+	}
+	objectMeta := v1beta3.ObjectMeta{}
+	if err := api.Scheme.Convert(&obj.ObjectMeta, &objectMeta); err != nil {
+		return nil, err
+	}
+	content["kind"] = c.kind
+	content["apiVersion"] = c.apiVersion
+	content["metadata"] = objectMeta
+	return content, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+)
+
+func TestCodecRoundTrip(t *testing.T) {
+	codec := NewCodec(v1beta3.Codec, "DatabaseCluster", "example.com/v1")
+	data := []byte(`{
+		"kind": "DatabaseCluster",
+		"apiVersion": "example.com/v1",
+		"metadata": {"name": "db", "namespace": "default", "labels": {"app": "db"}},
+		"replicas": 3,
+		"backupSize": 12345678901234567890
+	}`)
+
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rsrcData, ok := obj.(*api.ThirdPartyResourceData)
+	if !ok {
+		t.Fatalf("expected a ThirdPartyResourceData, got %#v", obj)
+	}
+	if rsrcData.Name != "db" || rsrcData.Namespace != "default" || rsrcData.Labels["app"] != "db" {
+		t.Errorf("unexpected metadata: %#v", rsrcData.ObjectMeta)
+	}
+	content := map[string]interface{}{}
+	if err := json.Unmarshal(rsrcData.Data, &content); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, found := content["metadata"]; found || len(content) != 2 {
+		t.Errorf("expected the data to hold the content of the object only, got %s", string(rsrcData.Data))
+	}
+
+	rsrcData.ResourceVersion = "7"
+	encoded, err := codec.Encode(rsrcData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"apiVersion":"example.com/v1","backupSize":12345678901234567890,"kind":"DatabaseCluster",` +
+		`"metadata":{"name":"db","namespace":"default","resourceVersion":"7","creationTimestamp":null,"labels":{"app":"db"}},"replicas":3}`
+	if string(encoded) != expected {
+		t.Errorf("expected %s, got %s", expected, string(encoded))
+	}
+}
+
+func TestCodecRejectsOtherKinds(t *testing.T) {
+	codec := NewCodec(v1beta3.Codec, "DatabaseCluster", "example.com/v1")
+	for _, data := range []string{
+		`{"kind": "Certificate", "apiVersion": "example.com/v1"}`,
+		`{"kind": "DatabaseCluster", "apiVersion": "example.com/v2"}`,
+	} {
+		if err := codec.DecodeInto([]byte(data), &api.ThirdPartyResourceData{}); err == nil {
+			t.Errorf("expected an error decoding %s", data)
+		}
+	}
+}
+
+func TestCodecList(t *testing.T) {
+	codec := NewCodec(v1beta3.Codec, "DatabaseCluster", "example.com/v1")
+	list := &api.ThirdPartyResourceDataList{
+		ListMeta: api.ListMeta{ResourceVersion: "10"},
+		Items: []api.ThirdPartyResourceData{
+			{ObjectMeta: api.ObjectMeta{Name: "a", Namespace: "default"}, Data: []byte(`{"replicas":1}`)},
+			{ObjectMeta: api.ObjectMeta{Name: "b", Namespace: "default"}},
+		},
+	}
+	data, err := codec.Encode(list)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, ok := obj.(*api.ThirdPartyResourceDataList)
+	if !ok {
+		t.Fatalf("expected a ThirdPartyResourceDataList, got %#v", obj)
+	}
+	if decoded.ResourceVersion != "10" || len(decoded.Items) != 2 || decoded.Items[1].Name != "b" {
+		t.Errorf("unexpected list: %#v", decoded)
+	}
+	if string(decoded.Items[0].Data) != `{"replicas":1}` || string(decoded.Items[1].Data) != `{}` {
+		t.Errorf("unexpected data: %s %s", string(decoded.Items[0].Data), string(decoded.Items[1].Data))
+	}
+}
+
+func TestCodecDelegatesOtherObjects(t *testing.T) {
+	codec := NewCodec(v1beta3.Codec, "DatabaseCluster", "example.com/v1")
+	status := &api.Status{Status: api.StatusFailure, Code: 404, Reason: api.StatusReasonNotFound}
+	data, err := codec.Encode(status)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	obj, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(obj, status) {
+		t.Errorf("expected %#v, got %#v", status, obj)
+	}
+}
+
+func TestExtractGroupAndKind(t *testing.T) {
+	tests := []struct {
+		name  string
+		group string
+		kind  string
+		valid bool
+	}{
+		{"database-cluster.example.com", "example.com", "DatabaseCluster", true},
+		{"certificate.security.example.com", "security.example.com", "Certificate", true},
+		{"database-cluster.example", "", "", false},
+		{"database-cluster", "", "", false},
+	}
+	for _, test := range tests {
+		group, kind, err := ExtractGroupAndKind(&api.ThirdPartyResource{ObjectMeta: api.ObjectMeta{Name: test.name}})
+		if test.valid != (err == nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if group != test.group || kind != test.kind {
+			t.Errorf("%s: expected %s %s, got %s %s", test.name, test.group, test.kind, group, kind)
+		}
+	}
+}
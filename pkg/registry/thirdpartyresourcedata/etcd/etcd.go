@@ -0,0 +1,64 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	etcdgeneric "github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic/etcd"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/thirdpartyresourcedata"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+)
+
+// rest implements a RESTStorage for objects of third party resources against etcd
+type REST struct {
+	*etcdgeneric.Etcd
+}
+
+// NewStorage returns a RESTStorage object that will work against the objects of a kind
+// registered by a ThirdPartyResource, given the API group of the kind and its resource name.
+func NewStorage(h tools.EtcdHelper, group, resource string) *REST {
+	prefix := "/registry/thirdparty/" + group + "/" + resource
+	store := &etcdgeneric.Etcd{
+		NewFunc:     func() runtime.Object { return &api.ThirdPartyResourceData{} },
+		NewListFunc: func() runtime.Object { return &api.ThirdPartyResourceDataList{} },
+		KeyRootFunc: func(ctx api.Context) string {
+			return etcdgeneric.NamespaceKeyRootFunc(ctx, prefix)
+		},
+		KeyFunc: func(ctx api.Context, name string) (string, error) {
+			return etcdgeneric.NamespaceKeyFunc(ctx, prefix, name)
+		},
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*api.ThirdPartyResourceData).Name, nil
+		},
+		PredicateFunc: func(label labels.Selector, field fields.Selector) generic.Matcher {
+			return thirdpartyresourcedata.MatchThirdPartyResourceData(label, field)
+		},
+		EndpointName: resource,
+
+		Helper: h,
+	}
+
+	store.CreateStrategy = thirdpartyresourcedata.Strategy
+	store.UpdateStrategy = thirdpartyresourcedata.Strategy
+	store.ReturnDeletedObject = true
+
+	return &REST{store}
+}
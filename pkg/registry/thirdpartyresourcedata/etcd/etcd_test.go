@@ -0,0 +1,166 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/rest/resttest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/tools"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+)
+
+func newHelper(t *testing.T) (*tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient := tools.NewFakeEtcdClient(t)
+	fakeEtcdClient.TestIndex = true
+	helper := tools.NewEtcdHelper(fakeEtcdClient, latest.Codec)
+	return fakeEtcdClient, helper
+}
+
+func newStorage(t *testing.T) (*REST, *tools.FakeEtcdClient, tools.EtcdHelper) {
+	fakeEtcdClient, h := newHelper(t)
+	storage := NewStorage(h, "example.com", "databaseclusters")
+	return storage, fakeEtcdClient, h
+}
+
+func validNewThirdPartyResourceData(name, ns string) *api.ThirdPartyResourceData {
+	return &api.ThirdPartyResourceData{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Data: []byte(`{"replicas":3}`),
+	}
+}
+
+func TestStorageKey(t *testing.T) {
+	storage, _, _ := newStorage(t)
+	key, err := storage.KeyFunc(api.NewDefaultContext(), "foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "/registry/thirdparty/example.com/databaseclusters/default/foo"; key != expected {
+		t.Errorf("expected key %s, got %s", expected, key)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fakeEtcdClient, helper := newHelper(t)
+	storage := NewStorage(helper, "example.com", "databaseclusters")
+	test := resttest.New(t, storage, fakeEtcdClient.SetError)
+	thirdPartyResourceData := validNewThirdPartyResourceData("foo", api.NamespaceDefault)
+	thirdPartyResourceData.ObjectMeta = api.ObjectMeta{}
+	test.TestCreate(
+		// valid
+		thirdPartyResourceData,
+		// invalid
+		&api.ThirdPartyResourceData{
+			ObjectMeta: api.ObjectMeta{Name: "*BadName!"},
+		},
+	)
+}
+
+func TestEtcdListThirdPartyResourceData(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key := registry.KeyRootFunc(ctx)
+	fakeClient.Data[key] = tools.EtcdResponseWithError{
+		R: &etcd.Response{
+			Node: &etcd.Node{
+				Nodes: []*etcd.Node{
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResourceData("foo", api.NamespaceDefault)),
+					},
+					{
+						Value: runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResourceData("bar", api.NamespaceDefault)),
+					},
+				},
+			},
+		},
+		E: nil,
+	}
+
+	thirdPartyResourceDataObj, err := registry.List(ctx, labels.Everything(), fields.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	thirdPartyResourceData := thirdPartyResourceDataObj.(*api.ThirdPartyResourceDataList)
+	if len(thirdPartyResourceData.Items) != 2 || thirdPartyResourceData.Items[0].Name != "foo" || thirdPartyResourceData.Items[1].Name != "bar" {
+		t.Errorf("Unexpected list of objects of a third party resource: %#v", thirdPartyResourceData)
+	}
+}
+
+func TestEtcdGetThirdPartyResourceData(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	thirdPartyResourceData := validNewThirdPartyResourceData("foo", api.NamespaceDefault)
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, thirdPartyResourceData), 0)
+
+	obj, err := registry.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	actual := obj.(*api.ThirdPartyResourceData)
+	if actual.Name != "foo" || !api.Semantic.DeepEqual(thirdPartyResourceData.Data, actual.Data) {
+		t.Errorf("unexpected object: %s", util.ObjectDiff(thirdPartyResourceData, actual))
+	}
+}
+
+func TestEtcdUpdateThirdPartyResourceData(t *testing.T) {
+	registry, fakeClient, helper := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResourceData("foo", api.NamespaceDefault)), 1)
+
+	thirdPartyResourceDataIn := validNewThirdPartyResourceData("foo", api.NamespaceDefault)
+	thirdPartyResourceDataIn.ResourceVersion = "1"
+	thirdPartyResourceDataIn.Data = []byte(`{"replicas":5}`)
+	if _, _, err := registry.Update(ctx, thirdPartyResourceDataIn); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var thirdPartyResourceDataOut api.ThirdPartyResourceData
+	if err := helper.ExtractObj(key, &thirdPartyResourceDataOut, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !api.Semantic.DeepEqual(thirdPartyResourceDataIn.Data, thirdPartyResourceDataOut.Data) {
+		t.Errorf("unexpected data: %s", util.ObjectDiff(thirdPartyResourceDataIn.Data, thirdPartyResourceDataOut.Data))
+	}
+}
+
+func TestEtcdDeleteThirdPartyResourceData(t *testing.T) {
+	registry, fakeClient, _ := newStorage(t)
+	ctx := api.NewDefaultContext()
+	key, _ := registry.KeyFunc(ctx, "foo")
+	fakeClient.Set(key, runtime.EncodeOrDie(latest.Codec, validNewThirdPartyResourceData("foo", api.NamespaceDefault)), 0)
+
+	if _, err := registry.Delete(ctx, "foo", nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fakeClient.DeletedKeys) != 1 {
+		t.Errorf("Expected 1 delete, found %#v", fakeClient.DeletedKeys)
+	} else if fakeClient.DeletedKeys[0] != key {
+		t.Errorf("Unexpected key: %s, expected %s", fakeClient.DeletedKeys[0], key)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/validation"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/registry/generic"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/fielderrors"
+)
+
+// thirdPartyResourceDataStrategy implements behavior for ThirdPartyResourceData objects
+type thirdPartyResourceDataStrategy struct {
+	runtime.ObjectTyper
+	api.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating and updating ThirdPartyResourceData
+// objects via the REST API.
+var Strategy = thirdPartyResourceDataStrategy{api.Scheme, api.SimpleNameGenerator}
+
+// NamespaceScoped is true for objects of third party resources.
+func (thirdPartyResourceDataStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// ResetBeforeCreate is a no-op, objects of third party resources have no status.
+func (thirdPartyResourceDataStrategy) ResetBeforeCreate(obj runtime.Object) {
+}
+
+// Validate validates a new object of a third party resource.
+func (thirdPartyResourceDataStrategy) Validate(obj runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceData(obj.(*api.ThirdPartyResourceData))
+}
+
+// AllowCreateOnUpdate is false for objects of third party resources.
+func (thirdPartyResourceDataStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// ValidateUpdate is the default update validation for an end user.
+func (thirdPartyResourceDataStrategy) ValidateUpdate(obj, old runtime.Object) fielderrors.ValidationErrorList {
+	return validation.ValidateThirdPartyResourceDataUpdate(obj.(*api.ThirdPartyResourceData), old.(*api.ThirdPartyResourceData))
+}
+
+// MatchThirdPartyResourceData returns a generic matcher for a given label and field selector.
+func MatchThirdPartyResourceData(label labels.Selector, field fields.Selector) generic.Matcher {
+	return generic.MatcherFunc(func(obj runtime.Object) (bool, error) {
+		thirdPartyResourceDataObj, ok := obj.(*api.ThirdPartyResourceData)
+		if !ok {
+			return false, fmt.Errorf("not an object of a third party resource")
+		}
+		fields := ThirdPartyResourceDataToSelectableFields(thirdPartyResourceDataObj)
+		return label.Matches(labels.Set(thirdPartyResourceDataObj.Labels)) && field.Matches(fields), nil
+	})
+}
+
+// ThirdPartyResourceDataToSelectableFields returns a label set that represents the object
+// TODO: fields are not labels, and the validation rules for them do not apply.
+func ThirdPartyResourceDataToSelectableFields(thirdPartyResourceData *api.ThirdPartyResourceData) labels.Set {
+	return labels.Set{
+		"name": thirdPartyResourceData.Name,
+	}
+}
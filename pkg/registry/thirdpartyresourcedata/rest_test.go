@@ -0,0 +1,54 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func TestThirdPartyResourceDataStrategy(t *testing.T) {
+	if !Strategy.NamespaceScoped() {
+		t.Errorf("ThirdPartyResourceData should be namespace scoped")
+	}
+	if Strategy.AllowCreateOnUpdate() {
+		t.Errorf("ThirdPartyResourceData should not allow create on update")
+	}
+	thirdPartyResourceData := &api.ThirdPartyResourceData{
+		ObjectMeta: api.ObjectMeta{Name: "db", Namespace: api.NamespaceDefault},
+	}
+	if errs := Strategy.Validate(thirdPartyResourceData); len(errs) != 0 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
+
+func TestMatchThirdPartyResourceData(t *testing.T) {
+	thirdPartyResourceData := &api.ThirdPartyResourceData{
+		ObjectMeta: api.ObjectMeta{Name: "db", Labels: map[string]string{"team": "a"}},
+	}
+	matcher := MatchThirdPartyResourceData(labels.Everything(), fields.SelectorFromSet(fields.Set{"name": "db"}))
+	if ok, err := matcher.Matches(thirdPartyResourceData); !ok || err != nil {
+		t.Errorf("expected a match on the name field, got %v %v", ok, err)
+	}
+	matcher = MatchThirdPartyResourceData(labels.SelectorFromSet(labels.Set{"team": "b"}), fields.Everything())
+	if ok, err := matcher.Matches(thirdPartyResourceData); ok || err != nil {
+		t.Errorf("expected no match on another label, got %v %v", ok, err)
+	}
+}
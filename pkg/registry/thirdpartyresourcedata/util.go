@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thirdpartyresourcedata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/meta"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// ExtractGroupAndKind returns the API group and the kind registered by a third party
+// resource, from its name: "database-cluster.example.com" registers the kind
+// DatabaseCluster in the group example.com.
+func ExtractGroupAndKind(rsrc *api.ThirdPartyResource) (group, kind string, err error) {
+	parts := strings.SplitN(rsrc.Name, ".", 2)
+	if len(parts) != 2 || !strings.Contains(parts[1], ".") {
+		return "", "", fmt.Errorf("the name of third party resource %q is not made of a kind and a domain name", rsrc.Name)
+	}
+	for _, word := range strings.Split(parts[0], "-") {
+		if len(word) > 0 {
+			kind += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return parts[1], kind, nil
+}
+
+// NewMapper returns a RESTMapper which maps the given kind, in the given version of its
+// group, to a namespaced resource encoded with codec.
+func NewMapper(kind, version string, codec runtime.Codec) meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]string{version}, func(string) (*meta.VersionInterfaces, bool) {
+		return &meta.VersionInterfaces{
+			Codec:            codec,
+			ObjectConvertor:  api.Scheme,
+			MetadataAccessor: meta.NewAccessor(),
+		}, true
+	})
+	mapper.Add(meta.RESTScopeNamespace, kind, version, false)
+	return mapper
+}
+
+// typer reports the kind registered by a third party resource as the kind of the
+// ThirdPartyResourceData, and defers to api.Scheme for other objects.
+type typer struct {
+	kind    string
+	version string
+}
+
+// NewObjectTyper returns an ObjectTyper for the objects of the given kind, in the given
+// version of its group.
+func NewObjectTyper(kind, version string) runtime.ObjectTyper {
+	return typer{kind, version}
+}
+
+func (t typer) DataVersionAndKind(data []byte) (string, string, error) {
+	return api.Scheme.DataVersionAndKind(data)
+}
+
+func (t typer) ObjectVersionAndKind(obj runtime.Object) (string, string, error) {
+	switch obj.(type) {
+	case *api.ThirdPartyResourceData:
+		return t.version, t.kind, nil
+	case *api.ThirdPartyResourceDataList:
+		return t.version, t.kind + "List", nil
+	}
+	return api.Scheme.ObjectVersionAndKind(obj)
+}
+
+// creater creates ThirdPartyResourceData for the kind registered by a third party
+// resource, and the other objects in the version they are exchanged in.
+type creater struct {
+	kind            string
+	delegateVersion string
+}
+
+// NewObjectCreater returns an ObjectCreater for the objects of the given kind. The other
+// objects, such as delete options, are created from api.Scheme in the delegate version.
+func NewObjectCreater(kind, delegateVersion string) runtime.ObjectCreater {
+	return creater{kind, delegateVersion}
+}
+
+func (c creater) New(version, kind string) (runtime.Object, error) {
+	switch kind {
+	case c.kind:
+		return &api.ThirdPartyResourceData{}, nil
+	case c.kind + "List":
+		return &api.ThirdPartyResourceDataList{}, nil
+	}
+	return api.Scheme.New(c.delegateVersion, kind)
+}
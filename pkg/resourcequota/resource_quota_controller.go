@@ -177,6 +177,18 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 				val = val + PodCPU(&filteredPods[i]).MilliValue()
 			}
 			value = resource.NewMilliQuantity(int64(val), resource.DecimalSI)
+		case api.ResourceLimitsMemory:
+			val := int64(0)
+			for i := range filteredPods {
+				val = val + PodLimitsMemory(&filteredPods[i]).Value()
+			}
+			value = resource.NewQuantity(int64(val), resource.DecimalSI)
+		case api.ResourceLimitsCPU:
+			val := int64(0)
+			for i := range filteredPods {
+				val = val + PodLimitsCPU(&filteredPods[i]).MilliValue()
+			}
+			value = resource.NewMilliQuantity(int64(val), resource.DecimalSI)
 		case api.ResourceServices:
 			items, err := rm.kubeClient.Services(usage.Namespace).List(labels.Everything())
 			if err != nil {
@@ -214,20 +226,42 @@ func (rm *ResourceQuotaManager) syncResourceQuota(quota api.ResourceQuota) (err
 	return nil
 }
 
-// PodCPU computes total cpu usage of a pod
-func PodCPU(pod *api.Pod) *resource.Quantity {
+// podValue computes the value of a resource for a pod. As the init containers run one at
+// a time before the other containers are started, this is the largest of the values of its
+// init containers and the total of its containers, as in the scheduler.
+func podValue(pod *api.Pod, containerValue func(*api.Container) int64) int64 {
 	val := int64(0)
 	for j := range pod.Spec.Containers {
-		val = val + pod.Spec.Containers[j].Resources.Limits.Cpu().MilliValue()
+		val = val + containerValue(&pod.Spec.Containers[j])
 	}
+	for j := range pod.Spec.InitContainers {
+		if initVal := containerValue(&pod.Spec.InitContainers[j]); initVal > val {
+			val = initVal
+		}
+	}
+	return val
+}
+
+// PodCPU computes total cpu usage of a pod, from the cpu requests of its containers
+func PodCPU(pod *api.Pod) *resource.Quantity {
+	val := podValue(pod, func(c *api.Container) int64 { return c.Resources.Requests.Cpu().MilliValue() })
 	return resource.NewMilliQuantity(int64(val), resource.DecimalSI)
 }
 
-// PodMemory computes the memory usage of a pod
+// PodMemory computes the memory usage of a pod, from the memory requests of its containers
 func PodMemory(pod *api.Pod) *resource.Quantity {
-	val := int64(0)
-	for j := range pod.Spec.Containers {
-		val = val + pod.Spec.Containers[j].Resources.Limits.Memory().Value()
-	}
+	val := podValue(pod, func(c *api.Container) int64 { return c.Resources.Requests.Memory().Value() })
+	return resource.NewQuantity(int64(val), resource.DecimalSI)
+}
+
+// PodLimitsCPU computes the total cpu limit of a pod
+func PodLimitsCPU(pod *api.Pod) *resource.Quantity {
+	val := podValue(pod, func(c *api.Container) int64 { return c.Resources.Limits.Cpu().MilliValue() })
+	return resource.NewMilliQuantity(int64(val), resource.DecimalSI)
+}
+
+// PodLimitsMemory computes the total memory limit of a pod
+func PodLimitsMemory(pod *api.Pod) *resource.Quantity {
+	val := podValue(pod, func(c *api.Container) int64 { return c.Resources.Limits.Memory().Value() })
 	return resource.NewQuantity(int64(val), resource.DecimalSI)
 }
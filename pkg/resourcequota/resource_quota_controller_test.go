@@ -34,6 +34,11 @@ func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
 	if memory != "" {
 		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
 	}
+	// requests default to limits
+	res.Requests = api.ResourceList{}
+	for name, limit := range res.Limits {
+		res.Requests[name] = limit
+	}
 
 	return res
 }
@@ -98,6 +103,9 @@ func TestFilterQuotaPods(t *testing.T) {
 }
 
 func TestSyncResourceQuota(t *testing.T) {
+	// the pods request half of their memory limit
+	resources := getResourceRequirements("100m", "1Gi")
+	resources.Requests[api.ResourceMemory] = resource.MustParse("512Mi")
 	podList := api.PodList{
 		Items: []api.Pod{
 			{
@@ -105,7 +113,7 @@ func TestSyncResourceQuota(t *testing.T) {
 				Status:     api.PodStatus{Phase: api.PodRunning},
 				Spec: api.PodSpec{
 					Volumes:    []api.Volume{{Name: "vol"}},
-					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: resources}},
 				},
 			},
 			{
@@ -113,7 +121,7 @@ func TestSyncResourceQuota(t *testing.T) {
 				Status:     api.PodStatus{Phase: api.PodRunning},
 				Spec: api.PodSpec{
 					Volumes:    []api.Volume{{Name: "vol"}},
-					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: getResourceRequirements("100m", "1Gi")}},
+					Containers: []api.Container{{Name: "ctr", Image: "image", Resources: resources}},
 				},
 			},
 			{
@@ -129,23 +137,26 @@ func TestSyncResourceQuota(t *testing.T) {
 	quota := api.ResourceQuota{
 		Spec: api.ResourceQuotaSpec{
 			Hard: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("3"),
-				api.ResourceMemory: resource.MustParse("100Gi"),
-				api.ResourcePods:   resource.MustParse("5"),
+				api.ResourceCPU:          resource.MustParse("3"),
+				api.ResourceMemory:       resource.MustParse("100Gi"),
+				api.ResourcePods:         resource.MustParse("5"),
+				api.ResourceLimitsMemory: resource.MustParse("100Gi"),
 			},
 		},
 	}
 	expectedUsage := api.ResourceQuota{
 		Status: api.ResourceQuotaStatus{
 			Hard: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("3"),
-				api.ResourceMemory: resource.MustParse("100Gi"),
-				api.ResourcePods:   resource.MustParse("5"),
+				api.ResourceCPU:          resource.MustParse("3"),
+				api.ResourceMemory:       resource.MustParse("100Gi"),
+				api.ResourcePods:         resource.MustParse("5"),
+				api.ResourceLimitsMemory: resource.MustParse("100Gi"),
 			},
 			Used: api.ResourceList{
-				api.ResourceCPU:    resource.MustParse("200m"),
-				api.ResourceMemory: resource.MustParse("2147483648"),
-				api.ResourcePods:   resource.MustParse("2"),
+				api.ResourceCPU:          resource.MustParse("200m"),
+				api.ResourceMemory:       resource.MustParse("1073741824"),
+				api.ResourcePods:         resource.MustParse("2"),
+				api.ResourceLimitsMemory: resource.MustParse("2147483648"),
 			},
 		},
 	}
@@ -17,14 +17,29 @@ limitations under the License.
 package runtime
 
 import (
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/yaml"
 )
 
+// ProtobufContentType is the content type of the protobuf encoding of objects.
+const ProtobufContentType = "application/vnd.kubernetes.protobuf"
+
 // CodecFor returns a Codec that invokes Encode with the provided version.
 func CodecFor(scheme *Scheme, version string) Codec {
 	return &codecWrapper{scheme, version}
 }
 
+// ProtobufCodecFor returns a Codec that encodes objects in protobuf, in the provided
+// version. Like any Codec of the scheme, it decodes both JSON and protobuf.
+func ProtobufCodecFor(scheme *Scheme, version string) Codec {
+	return &protobufCodecWrapper{codecWrapper{scheme, version}}
+}
+
+// IsProtobuf returns true if data is the protobuf encoding of an object.
+func IsProtobuf(data []byte) bool {
+	return conversion.IsProtobuf(data)
+}
+
 // yamlCodec converts YAML passed to the Decoder methods to JSON.
 type yamlCodec struct {
 	// a Codec for JSON
@@ -40,6 +55,9 @@ func YAMLDecoder(codec Codec) Codec {
 }
 
 func (c yamlCodec) Decode(data []byte) (Object, error) {
+	if IsProtobuf(data) {
+		return c.Codec.Decode(data)
+	}
 	out, err := yaml.ToJSON(data)
 	if err != nil {
 		return nil, err
@@ -49,6 +67,9 @@ func (c yamlCodec) Decode(data []byte) (Object, error) {
 }
 
 func (c yamlCodec) DecodeInto(data []byte, obj Object) error {
+	if IsProtobuf(data) {
+		return c.Codec.DecodeInto(data, obj)
+	}
 	out, err := yaml.ToJSON(data)
 	if err != nil {
 		return err
@@ -77,3 +98,14 @@ type codecWrapper struct {
 func (c *codecWrapper) Encode(obj Object) ([]byte, error) {
 	return c.Scheme.EncodeToVersion(obj, c.version)
 }
+
+// protobufCodecWrapper implements encoding to protobuf in an alternative default version
+// for a scheme.
+type protobufCodecWrapper struct {
+	codecWrapper
+}
+
+// Encode implements Codec
+func (c *protobufCodecWrapper) Encode(obj Object) ([]byte, error) {
+	return c.Scheme.EncodeToVersionProtobuf(obj, c.version)
+}
@@ -381,7 +381,14 @@ func (s *Scheme) EncodeToVersion(obj Object, destVersion string) (data []byte, e
 	return s.raw.EncodeToVersion(obj, destVersion)
 }
 
-// Decode converts a YAML or JSON string back into a pointer to an api object.
+// EncodeToVersionProtobuf is like EncodeToVersion, but returns the protobuf encoding of
+// the object. The types of destVersion must implement conversion.ProtobufMarshaler.
+func (s *Scheme) EncodeToVersionProtobuf(obj Object, destVersion string) (data []byte, err error) {
+	return s.raw.EncodeToVersionProtobuf(obj, destVersion)
+}
+
+// Decode converts a YAML or JSON string, or the protobuf encoding of an object, back
+// into a pointer to an api object.
 // Deduces the type based upon the APIVersion and Kind fields, which are set
 // by Encode. Only versioned objects (APIVersion != "") are accepted. The object
 // will be converted into the in-memory unversioned type before being returned.
@@ -43,15 +43,45 @@ func (f *FitError) Error() string {
 	return output
 }
 
+// SchedulingCycle numbers the attempts of a scheduler to find a node for a pod, so that the
+// predicates which need the state of the whole cluster can compute it once per attempt
+// rather than once per node. A nil SchedulingCycle never changes.
+type SchedulingCycle struct {
+	lock    sync.Mutex
+	current uint64
+}
+
+// Start starts a new attempt.
+func (c *SchedulingCycle) Start() {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.current++
+}
+
+// Current returns the number of the current attempt.
+func (c *SchedulingCycle) Current() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.current
+}
+
 type genericScheduler struct {
 	predicates   map[string]FitPredicate
 	prioritizers []PriorityConfig
 	pods         PodLister
+	cycle        *SchedulingCycle
 	random       *rand.Rand
 	randomLock   sync.Mutex
 }
 
 func (g *genericScheduler) Schedule(pod api.Pod, minionLister MinionLister) (string, error) {
+	g.cycle.Start()
 	minions, err := minionLister.List()
 	if err != nil {
 		return "", err
@@ -60,12 +90,17 @@ func (g *genericScheduler) Schedule(pod api.Pod, minionLister MinionLister) (str
 		return "", fmt.Errorf("no minions available to schedule pods")
 	}
 
-	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, g.pods, g.predicates, minions)
+	pods, err := g.podsAround(&pod)
+	if err != nil {
+		return "", err
+	}
+
+	filteredNodes, failedPredicateMap, err := findNodesThatFit(pod, FakePodLister(pods), g.predicates, minions)
 	if err != nil {
 		return "", err
 	}
 
-	priorityList, err := prioritizeNodes(pod, g.pods, g.prioritizers, FakeMinionLister(filteredNodes))
+	priorityList, err := prioritizeNodes(pod, FakePodLister(pods), g.prioritizers, FakeMinionLister(filteredNodes))
 	if err != nil {
 		return "", err
 	}
@@ -194,11 +229,14 @@ func EqualPriority(pod api.Pod, podLister PodLister, minionLister MinionLister)
 	return result, nil
 }
 
-func NewGenericScheduler(predicates map[string]FitPredicate, prioritizers []PriorityConfig, pods PodLister, random *rand.Rand) Scheduler {
+// NewGenericScheduler returns a Scheduler which starts a new cycle each time it looks for a
+// node for a pod. The cycle may be nil if none of the predicates depends on it.
+func NewGenericScheduler(predicates map[string]FitPredicate, prioritizers []PriorityConfig, pods PodLister, cycle *SchedulingCycle, random *rand.Rand) Scheduler {
 	return &genericScheduler{
 		predicates:   predicates,
 		prioritizers: prioritizers,
 		pods:         pods,
+		cycle:        cycle,
 		random:       random,
 	}
 }
@@ -0,0 +1,269 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+// podMatchesAffinityTerm returns true if the pod is one of the pods selected by an affinity
+// term of a pod of the given namespace.
+func podMatchesAffinityTerm(pod *api.Pod, namespace string, term *api.PodAffinityTerm) bool {
+	if pod.Namespace != namespace || len(term.LabelSelector) == 0 {
+		return false
+	}
+	return labels.SelectorFromSet(term.LabelSelector).Matches(labels.Set(pod.Labels))
+}
+
+// inSameTopologyDomain returns true if both nodes have the same value for the topology key.
+// With an empty topology key, each node is its own domain.
+func inSameTopologyDomain(a, b *api.Node, topologyKey string) bool {
+	if len(topologyKey) == 0 {
+		return a.Name == b.Name
+	}
+	valueA, foundA := a.Labels[topologyKey]
+	valueB, foundB := b.Labels[topologyKey]
+	return foundA && foundB && valueA == valueB
+}
+
+// placedPod is an assigned pod along with the node it is assigned to.
+type placedPod struct {
+	pod  *api.Pod
+	node *api.Node
+}
+
+// placedPodIndex holds the assigned pods, and separately the ones with required
+// anti-affinity terms.
+type placedPodIndex struct {
+	pods         []placedPod
+	antiAffinity []placedPod
+}
+
+type PodAffinityChecker struct {
+	podLister PodLister
+	nodeInfo  NodeInfo
+	cycle     *SchedulingCycle
+
+	lock       sync.Mutex
+	index      *placedPodIndex
+	indexCycle uint64
+}
+
+// NewPodAffinityPredicate returns a predicate which lists the assigned pods once per
+// scheduling cycle. With a nil cycle, they are listed for every node.
+func NewPodAffinityPredicate(podLister PodLister, nodeInfo NodeInfo, cycle *SchedulingCycle) FitPredicate {
+	checker := &PodAffinityChecker{
+		podLister: podLister,
+		nodeInfo:  nodeInfo,
+		cycle:     cycle,
+	}
+	return checker.PodFitsPodAffinity
+}
+
+// placedPods returns the index of the assigned pods along with their nodes, built once per
+// scheduling cycle. The pods whose node is not known anymore are left out.
+func (c *PodAffinityChecker) placedPods() (*placedPodIndex, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	current := c.cycle.Current()
+	if c.index != nil && c.cycle != nil && c.indexCycle == current {
+		return c.index, nil
+	}
+
+	pods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]*api.Node{}
+	index := &placedPodIndex{}
+	for i := range pods {
+		host := pods[i].Status.Host
+		if len(host) == 0 {
+			continue
+		}
+		node, found := nodes[host]
+		if !found {
+			if node, err = c.nodeInfo.GetNodeInfo(host); err != nil {
+				node = nil
+			}
+			nodes[host] = node
+		}
+		if node == nil {
+			continue
+		}
+		p := placedPod{pod: &pods[i], node: node}
+		index.pods = append(index.pods, p)
+		if p.pod.Spec.PodAntiAffinity != nil && len(p.pod.Spec.PodAntiAffinity.RequiredDuringScheduling) > 0 {
+			index.antiAffinity = append(index.antiAffinity, p)
+		}
+	}
+	c.index, c.indexCycle = index, current
+	return index, nil
+}
+
+// PodFitsPodAffinity checks that the node is in the same topology domain as a pod matching
+// each of the required pod affinity terms of the pod, and in no topology domain where a
+// pod matches one of its required anti-affinity terms. The pod must also not match the
+// required anti-affinity terms of the pods in the topology domains of the node.
+//
+// A required affinity term which no pod matches yet is satisfied by any node if the pod
+// matches it itself, so that the first of a group of pods with affinity for each other
+// can be scheduled.
+func (c *PodAffinityChecker) PodFitsPodAffinity(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
+	var affinityTerms, antiAffinityTerms []api.PodAffinityTerm
+	if pod.Spec.PodAffinity != nil {
+		affinityTerms = pod.Spec.PodAffinity.RequiredDuringScheduling
+	}
+	if pod.Spec.PodAntiAffinity != nil {
+		antiAffinityTerms = pod.Spec.PodAntiAffinity.RequiredDuringScheduling
+	}
+	placed, err := c.placedPods()
+	if err != nil {
+		return false, err
+	}
+	if len(affinityTerms) == 0 && len(antiAffinityTerms) == 0 && len(placed.antiAffinity) == 0 {
+		return true, nil
+	}
+	minion, err := c.nodeInfo.GetNodeInfo(node)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range affinityTerms {
+		term := &affinityTerms[i]
+		matched, nearby := false, false
+		for _, p := range placed.pods {
+			if !podMatchesAffinityTerm(p.pod, pod.Namespace, term) {
+				continue
+			}
+			matched = true
+			if inSameTopologyDomain(minion, p.node, term.TopologyKey) {
+				nearby = true
+				break
+			}
+		}
+		if nearby {
+			continue
+		}
+		if matched || !podMatchesAffinityTerm(&pod, pod.Namespace, term) {
+			return false, nil
+		}
+	}
+
+	for i := range antiAffinityTerms {
+		term := &antiAffinityTerms[i]
+		for _, p := range placed.pods {
+			if podMatchesAffinityTerm(p.pod, pod.Namespace, term) && inSameTopologyDomain(minion, p.node, term.TopologyKey) {
+				return false, nil
+			}
+		}
+	}
+
+	// the anti-affinity of the pods already placed applies to the pod being scheduled as well
+	for _, p := range placed.antiAffinity {
+		for i := range p.pod.Spec.PodAntiAffinity.RequiredDuringScheduling {
+			term := &p.pod.Spec.PodAntiAffinity.RequiredDuringScheduling[i]
+			if podMatchesAffinityTerm(&pod, p.pod.Namespace, term) && inSameTopologyDomain(minion, p.node, term.TopologyKey) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// PodAffinityPriority is a priority function that favors nodes in the same topology domains
+// as the pods matching the preferred pod affinity terms of the pod, and away from the pods
+// matching its preferred anti-affinity terms. Each matching pod adds (or, for anti-affinity,
+// subtracts) the weight of the term to the nodes in its topology domain, and the totals are
+// scaled to the 0-10 range.
+func PodAffinityPriority(pod api.Pod, podLister PodLister, minionLister MinionLister) (HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	type weightedTerm struct {
+		weight int
+		term   *api.PodAffinityTerm
+	}
+	terms := []weightedTerm{}
+	if pod.Spec.PodAffinity != nil {
+		for i := range pod.Spec.PodAffinity.PreferredDuringScheduling {
+			t := &pod.Spec.PodAffinity.PreferredDuringScheduling[i]
+			terms = append(terms, weightedTerm{weight: t.Weight, term: &t.PodAffinityTerm})
+		}
+	}
+	if pod.Spec.PodAntiAffinity != nil {
+		for i := range pod.Spec.PodAntiAffinity.PreferredDuringScheduling {
+			t := &pod.Spec.PodAntiAffinity.PreferredDuringScheduling[i]
+			terms = append(terms, weightedTerm{weight: -t.Weight, term: &t.PodAffinityTerm})
+		}
+	}
+
+	weights := make([]int, len(minions.Items))
+	if len(terms) > 0 {
+		nodes := map[string]*api.Node{}
+		for i := range minions.Items {
+			nodes[minions.Items[i].Name] = &minions.Items[i]
+		}
+		pods, err := podLister.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for i := range pods {
+			node, found := nodes[pods[i].Status.Host]
+			if !found {
+				continue
+			}
+			for _, t := range terms {
+				if !podMatchesAffinityTerm(&pods[i], pod.Namespace, t.term) {
+					continue
+				}
+				for j := range minions.Items {
+					if inSameTopologyDomain(&minions.Items[j], node, t.term.TopologyKey) {
+						weights[j] += t.weight
+					}
+				}
+			}
+		}
+	}
+
+	minWeight, maxWeight := 0, 0
+	for i, weight := range weights {
+		if i == 0 || weight < minWeight {
+			minWeight = weight
+		}
+		if i == 0 || weight > maxWeight {
+			maxWeight = weight
+		}
+	}
+
+	result := []HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for i, minion := range minions.Items {
+		score := 10
+		if maxWeight > minWeight {
+			score = (10 * (weights[i] - minWeight)) / (maxWeight - minWeight)
+		}
+		result = append(result, HostPriority{host: minion.Name, score: score})
+	}
+	return result, nil
+}
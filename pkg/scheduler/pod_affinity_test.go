@@ -0,0 +1,251 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+var affinityNodes = []api.Node{
+	{ObjectMeta: api.ObjectMeta{Name: "machine1", Labels: map[string]string{"zone": "z1"}}},
+	{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"zone": "z1"}}},
+	{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: map[string]string{"zone": "z2"}}},
+	{ObjectMeta: api.ObjectMeta{Name: "machine4"}},
+}
+
+func placedOn(host string, labels map[string]string) api.Pod {
+	return api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "default", Labels: labels},
+		Status:     api.PodStatus{Host: host},
+	}
+}
+
+func TestPodFitsPodAffinity(t *testing.T) {
+	app := map[string]string{"app": "web"}
+	db := map[string]string{"app": "db"}
+	sameZoneAsApp := &api.PodAffinity{
+		RequiredDuringScheduling: []api.PodAffinityTerm{{LabelSelector: app, TopologyKey: "zone"}},
+	}
+	awayFromDB := &api.PodAntiAffinity{
+		RequiredDuringScheduling: []api.PodAffinityTerm{{LabelSelector: db}},
+	}
+	tests := []struct {
+		pod  api.Pod
+		pods []api.Pod
+		node string
+		fits bool
+		test string
+	}{
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}},
+			pods: []api.Pod{placedOn("machine1", db)},
+			node: "machine1",
+			fits: true,
+			test: "pod without affinity",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}, Spec: api.PodSpec{PodAffinity: sameZoneAsApp}},
+			pods: []api.Pod{placedOn("machine1", app)},
+			node: "machine2",
+			fits: true,
+			test: "node in the zone of a matching pod",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}, Spec: api.PodSpec{PodAffinity: sameZoneAsApp}},
+			pods: []api.Pod{placedOn("machine1", app)},
+			node: "machine3",
+			fits: false,
+			test: "node in another zone than the matching pods",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}, Spec: api.PodSpec{PodAffinity: sameZoneAsApp}},
+			pods: []api.Pod{placedOn("machine1", app)},
+			node: "machine4",
+			fits: false,
+			test: "node without the topology label",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "other"}, Spec: api.PodSpec{PodAffinity: sameZoneAsApp}},
+			pods: []api.Pod{placedOn("machine1", app)},
+			node: "machine1",
+			fits: false,
+			test: "matching pods of other namespaces are ignored",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default", Labels: app}, Spec: api.PodSpec{PodAffinity: sameZoneAsApp}},
+			node: "machine3",
+			fits: true,
+			test: "first pod of a group with affinity for itself",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}, Spec: api.PodSpec{PodAntiAffinity: awayFromDB}},
+			pods: []api.Pod{placedOn("machine1", db)},
+			node: "machine1",
+			fits: false,
+			test: "node running a pod of the anti-affinity",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}, Spec: api.PodSpec{PodAntiAffinity: awayFromDB}},
+			pods: []api.Pod{placedOn("machine1", db)},
+			node: "machine2",
+			fits: true,
+			test: "each node is its own domain without a topology key",
+		},
+		{
+			pod: api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default", Labels: db}},
+			pods: []api.Pod{{
+				ObjectMeta: api.ObjectMeta{Namespace: "default", Labels: db},
+				Spec:       api.PodSpec{PodAntiAffinity: awayFromDB},
+				Status:     api.PodStatus{Host: "machine1"},
+			}},
+			node: "machine1",
+			fits: false,
+			test: "anti-affinity of a placed pod",
+		},
+		{
+			pod:  api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}},
+			pods: []api.Pod{placedOn("machine1", db)},
+			node: "unknown",
+			fits: true,
+			test: "no terms to check",
+		},
+	}
+
+	for _, test := range tests {
+		checker := PodAffinityChecker{podLister: FakePodLister(test.pods), nodeInfo: FakeNodeListInfo(affinityNodes)}
+		fits, err := checker.PodFitsPodAffinity(test.pod, []api.Pod{}, test.node)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
+// countingPodLister counts how many times the pods are listed.
+type countingPodLister struct {
+	FakePodLister
+	lists int
+}
+
+func (l *countingPodLister) List(selector labels.Selector) ([]api.Pod, error) {
+	l.lists++
+	return l.FakePodLister.List(selector)
+}
+
+func TestPodFitsPodAffinityListsPodsOncePerCycle(t *testing.T) {
+	db := map[string]string{"app": "db"}
+	pod := api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "default"},
+		Spec: api.PodSpec{PodAntiAffinity: &api.PodAntiAffinity{
+			RequiredDuringScheduling: []api.PodAffinityTerm{{LabelSelector: db, TopologyKey: "zone"}},
+		}},
+	}
+	podLister := &countingPodLister{FakePodLister: FakePodLister{placedOn("machine1", db)}}
+	cycle := &SchedulingCycle{}
+	predicate := NewPodAffinityPredicate(podLister, FakeNodeListInfo(affinityNodes), cycle)
+
+	for cycles := 1; cycles <= 2; cycles++ {
+		cycle.Start()
+		fits := []bool{}
+		for _, node := range affinityNodes {
+			fit, err := predicate(pod, []api.Pod{}, node.Name)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			fits = append(fits, fit)
+		}
+		if e := []bool{false, false, true, true}; !reflect.DeepEqual(e, fits) {
+			t.Errorf("expected %v, got %v", e, fits)
+		}
+		if podLister.lists != cycles {
+			t.Errorf("expected the pods to be listed %d times after %d cycles, got %d", cycles, cycles, podLister.lists)
+		}
+	}
+}
+
+func TestPodAffinityPriority(t *testing.T) {
+	cache := map[string]string{"app": "cache"}
+	db := map[string]string{"app": "db"}
+	tests := []struct {
+		pod          api.Pod
+		pods         []api.Pod
+		expectedList HostPriorityList
+		test         string
+	}{
+		{
+			pod:          api.Pod{ObjectMeta: api.ObjectMeta{Namespace: "default"}},
+			pods:         []api.Pod{placedOn("machine1", cache)},
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 10}, {"machine4", 10}},
+			test:         "pod without affinity",
+		},
+		{
+			pod: api.Pod{
+				ObjectMeta: api.ObjectMeta{Namespace: "default"},
+				Spec: api.PodSpec{
+					PodAffinity: &api.PodAffinity{
+						PreferredDuringScheduling: []api.WeightedPodAffinityTerm{
+							{Weight: 5, PodAffinityTerm: api.PodAffinityTerm{LabelSelector: cache, TopologyKey: "zone"}},
+						},
+					},
+				},
+			},
+			pods:         []api.Pod{placedOn("machine1", cache)},
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}, {"machine4", 0}},
+			test:         "affinity for the zone of a pod",
+		},
+		{
+			pod: api.Pod{
+				ObjectMeta: api.ObjectMeta{Namespace: "default"},
+				Spec: api.PodSpec{
+					PodAffinity: &api.PodAffinity{
+						PreferredDuringScheduling: []api.WeightedPodAffinityTerm{
+							{Weight: 3, PodAffinityTerm: api.PodAffinityTerm{LabelSelector: cache, TopologyKey: "zone"}},
+						},
+					},
+					PodAntiAffinity: &api.PodAntiAffinity{
+						PreferredDuringScheduling: []api.WeightedPodAffinityTerm{
+							{Weight: 1, PodAffinityTerm: api.PodAffinityTerm{LabelSelector: db}},
+						},
+					},
+				},
+			},
+			pods:         []api.Pod{placedOn("machine1", cache), placedOn("machine1", db), placedOn("machine4", db)},
+			expectedList: []HostPriority{{"machine1", 7}, {"machine2", 10}, {"machine3", 2}, {"machine4", 0}},
+			test:         "affinity and anti-affinity",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := PodAffinityPriority(test.pod, FakePodLister(test.pods), FakeMinionLister(api.NodeList{Items: affinityNodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
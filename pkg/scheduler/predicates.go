@@ -91,12 +91,24 @@ type resourceRequest struct {
 	memory   int64
 }
 
+// getResourceRequest returns the resources requested by a pod. As the init containers
+// run one at a time before the other containers are started, the pod needs the largest
+// of the requests of its init containers and the total requests of its containers.
 func getResourceRequest(pod *api.Pod) resourceRequest {
 	result := resourceRequest{}
 	for ix := range pod.Spec.Containers {
-		limits := pod.Spec.Containers[ix].Resources.Limits
-		result.memory += limits.Memory().Value()
-		result.milliCPU += limits.Cpu().MilliValue()
+		requests := pod.Spec.Containers[ix].Resources.Requests
+		result.memory += requests.Memory().Value()
+		result.milliCPU += requests.Cpu().MilliValue()
+	}
+	for ix := range pod.Spec.InitContainers {
+		requests := pod.Spec.InitContainers[ix].Resources.Requests
+		if memory := requests.Memory().Value(); memory > result.memory {
+			result.memory = memory
+		}
+		if milliCPU := requests.Cpu().MilliValue(); milliCPU > result.milliCPU {
+			result.milliCPU = milliCPU
+		}
 	}
 	return result
 }
@@ -159,10 +171,34 @@ func NewSelectorMatchPredicate(info NodeInfo) FitPredicate {
 }
 
 func PodMatchesNodeLabels(pod *api.Pod, node *api.Node) bool {
-	if len(pod.Spec.NodeSelector) == 0 {
+	if len(pod.Spec.NodeSelector) > 0 {
+		selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
+		if !selector.Matches(labels.Set(node.Labels)) {
+			return false
+		}
+	}
+	if pod.Spec.NodeAffinity == nil || len(pod.Spec.NodeAffinity.RequiredDuringScheduling) == 0 {
 		return true
 	}
-	selector := labels.SelectorFromSet(pod.Spec.NodeSelector)
+	// The required terms are ORed: the node must match at least one of them.
+	for _, term := range pod.Spec.NodeAffinity.RequiredDuringScheduling {
+		if nodeMatchesSelectorTerm(node, &term) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeMatchesSelectorTerm returns true if the labels of the node satisfy every
+// requirement of the term. Invalid terms match no node.
+func nodeMatchesSelectorTerm(node *api.Node, term *api.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 {
+		return false
+	}
+	selector, err := api.NodeSelectorRequirementsAsSelector(term.MatchExpressions)
+	if err != nil {
+		return false
+	}
 	return selector.Matches(labels.Set(node.Labels))
 }
 
@@ -178,6 +214,36 @@ func (n *NodeSelector) PodSelectorMatches(pod api.Pod, existingPods []api.Pod, n
 	return PodMatchesNodeLabels(&pod, minion), nil
 }
 
+type TaintToleration struct {
+	info NodeInfo
+}
+
+func NewTaintTolerationPredicate(info NodeInfo) FitPredicate {
+	toleration := &TaintToleration{
+		info: info,
+	}
+	return toleration.PodToleratesNodeTaints
+}
+
+// PodToleratesNodeTaints checks that the pod tolerates every NoSchedule and NoExecute
+// taint of the node. PreferNoSchedule taints are left to TaintTolerationPriority.
+func (t *TaintToleration) PodToleratesNodeTaints(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
+	minion, err := t.info.GetNodeInfo(node)
+	if err != nil {
+		return false, err
+	}
+	for i := range minion.Spec.Taints {
+		taint := &minion.Spec.Taints[i]
+		if taint.Effect == api.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !api.TolerationsTolerateTaint(pod.Spec.Tolerations, taint) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func PodFitsHost(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
 	if len(pod.Spec.Host) == 0 {
 		return true, nil
@@ -57,7 +57,7 @@ func newResourcePod(usage ...resourceRequest) api.Pod {
 	for _, req := range usage {
 		containers = append(containers, api.Container{
 			Resources: api.ResourceRequirements{
-				Limits: api.ResourceList{
+				Requests: api.ResourceList{
 					"cpu":    *resource.NewMilliQuantity(req.milliCPU, resource.DecimalSI),
 					"memory": *resource.NewQuantity(req.memory, resource.BinarySI),
 				},
@@ -71,6 +71,23 @@ func newResourcePod(usage ...resourceRequest) api.Pod {
 	}
 }
 
+// withResourceLimits sets the limits of every container of a pod.
+func withResourceLimits(pod api.Pod, limit resourceRequest) api.Pod {
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Resources.Limits = api.ResourceList{
+			"cpu":    *resource.NewMilliQuantity(limit.milliCPU, resource.DecimalSI),
+			"memory": *resource.NewQuantity(limit.memory, resource.BinarySI),
+		}
+	}
+	return pod
+}
+
+// withInitContainers adds init containers with the given requests to a pod.
+func withInitContainers(pod api.Pod, usage ...resourceRequest) api.Pod {
+	pod.Spec.InitContainers = newResourcePod(usage...).Spec.Containers
+	return pod
+}
+
 func TestPodFitsResources(t *testing.T) {
 	tests := []struct {
 		pod          api.Pod
@@ -118,6 +135,30 @@ func TestPodFitsResources(t *testing.T) {
 			fits: true,
 			test: "equal edge case",
 		},
+		{
+			pod: newResourcePod(resourceRequest{milliCPU: 1, memory: 1}),
+			existingPods: []api.Pod{
+				withResourceLimits(newResourcePod(resourceRequest{milliCPU: 5, memory: 5}), resourceRequest{milliCPU: 10, memory: 20}),
+			},
+			fits: true,
+			test: "limits of existing pods are not reserved",
+		},
+		{
+			pod: withInitContainers(newResourcePod(resourceRequest{milliCPU: 1, memory: 1}), resourceRequest{milliCPU: 6, memory: 1}),
+			existingPods: []api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 5, memory: 5}),
+			},
+			fits: false,
+			test: "init container requests too many resources",
+		},
+		{
+			pod: withInitContainers(newResourcePod(resourceRequest{milliCPU: 3, memory: 1}), resourceRequest{milliCPU: 2, memory: 1}, resourceRequest{milliCPU: 5, memory: 1}),
+			existingPods: []api.Pod{
+				newResourcePod(resourceRequest{milliCPU: 5, memory: 5}),
+			},
+			fits: true,
+			test: "init container requests are not added up",
+		},
 	}
 	for _, test := range tests {
 		node := api.Node{Spec: api.NodeSpec{Capacity: makeResources(10, 20).Capacity}}
@@ -388,6 +429,103 @@ func TestPodFitsSelector(t *testing.T) {
 			fits: false,
 			test: "node labels are subset",
 		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						RequiredDuringScheduling: []api.NodeSelectorTerm{
+							{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "zone", Operator: api.NodeSelectorOpIn, Values: []string{"a", "b"}},
+								{Key: "unhealthy", Operator: api.NodeSelectorOpNotIn, Values: []string{"true"}},
+							}},
+						},
+					},
+				},
+			},
+			labels: map[string]string{
+				"zone": "b",
+			},
+			fits: true,
+			test: "node matches required affinity term",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						RequiredDuringScheduling: []api.NodeSelectorTerm{
+							{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "zone", Operator: api.NodeSelectorOpIn, Values: []string{"a", "b"}},
+								{Key: "unhealthy", Operator: api.NodeSelectorOpNotIn, Values: []string{"true"}},
+							}},
+						},
+					},
+				},
+			},
+			labels: map[string]string{
+				"zone":      "b",
+				"unhealthy": "true",
+			},
+			fits: false,
+			test: "node does not match every requirement of the affinity term",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						RequiredDuringScheduling: []api.NodeSelectorTerm{
+							{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "zone", Operator: api.NodeSelectorOpIn, Values: []string{"a"}},
+							}},
+							{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "ssd", Operator: api.NodeSelectorOpExists},
+							}},
+						},
+					},
+				},
+			},
+			labels: map[string]string{
+				"zone": "c",
+				"ssd":  "",
+			},
+			fits: true,
+			test: "node matches one of the required affinity terms",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeSelector: map[string]string{
+						"foo": "bar",
+					},
+					NodeAffinity: &api.NodeAffinity{
+						RequiredDuringScheduling: []api.NodeSelectorTerm{
+							{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "ssd", Operator: api.NodeSelectorOpExists},
+							}},
+						},
+					},
+				},
+			},
+			labels: map[string]string{
+				"ssd": "true",
+			},
+			fits: false,
+			test: "node matches affinity but not the node selector",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						PreferredDuringScheduling: []api.PreferredSchedulingTerm{
+							{Weight: 1, Preference: api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{
+								{Key: "ssd", Operator: api.NodeSelectorOpExists},
+							}}},
+						},
+					},
+				},
+			},
+			fits: true,
+			test: "preferred affinity terms are not required",
+		},
 	}
 	for _, test := range tests {
 		node := api.Node{ObjectMeta: api.ObjectMeta{Labels: test.labels}}
@@ -403,6 +541,78 @@ func TestPodFitsSelector(t *testing.T) {
 	}
 }
 
+func TestPodToleratesNodeTaints(t *testing.T) {
+	tests := []struct {
+		pod    api.Pod
+		taints []api.Taint
+		fits   bool
+		test   string
+	}{
+		{
+			pod:  api.Pod{},
+			fits: true,
+			test: "no taints",
+		},
+		{
+			pod:    api.Pod{},
+			taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}},
+			fits:   false,
+			test:   "pod without tolerations",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					Tolerations: []api.Toleration{{Key: "dedicated", Value: "gpu"}},
+				},
+			},
+			taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}},
+			fits:   true,
+			test:   "pod tolerates the taint",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					Tolerations: []api.Toleration{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}},
+				},
+			},
+			taints: []api.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoExecute},
+			},
+			fits: false,
+			test: "pod does not tolerate the NoExecute taint",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					Tolerations: []api.Toleration{{Key: "dedicated", Value: "ingress"}},
+				},
+			},
+			taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoExecute}},
+			fits:   false,
+			test:   "pod tolerates another value",
+		},
+		{
+			pod:    api.Pod{},
+			taints: []api.Taint{{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule}},
+			fits:   true,
+			test:   "PreferNoSchedule taints are ignored",
+		},
+	}
+	for _, test := range tests {
+		node := api.Node{Spec: api.NodeSpec{Taints: test.taints}}
+
+		fit := TaintToleration{FakeNodeInfo(node)}
+		fits, err := fit.PodToleratesNodeTaints(test.pod, []api.Pod{}, "machine")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if fits != test.fits {
+			t.Errorf("%s: expected: %v got %v", test.test, test.fits, fits)
+		}
+	}
+}
+
 func TestNodeLabelPresence(t *testing.T) {
 	label := map[string]string{"foo": "bar", "bar": "foo"}
 	tests := []struct {
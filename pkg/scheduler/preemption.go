@@ -0,0 +1,207 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"sort"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+// isNominated returns true if the pod is not bound yet, but was nominated for a node
+// where it preempted other pods.
+func isNominated(pod *api.Pod) bool {
+	return len(pod.Spec.Host) == 0 && len(pod.Status.NominatedHost) > 0
+}
+
+// nominatedPodLister lists the pods of a PodLister, along with the pending pods which
+// are nominated for a node, as if they were already placed there.
+type nominatedPodLister struct {
+	pods    PodLister
+	pending PodLister
+}
+
+// NewNominatedPodLister returns a PodLister which lists the pods of pods, along with the
+// pods of pending which are nominated for a node. The nominated pods are listed with
+// their nominated node as their host, so that the pods of a lower priority are not
+// scheduled into the room preempted for them.
+func NewNominatedPodLister(pods, pending PodLister) PodLister {
+	return &nominatedPodLister{pods: pods, pending: pending}
+}
+
+func (l *nominatedPodLister) List(selector labels.Selector) ([]api.Pod, error) {
+	pods, err := l.pods.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := l.pending.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	// a nominated pod which was just scheduled may be listed by both listers
+	listed := map[string]bool{}
+	for i := range pods {
+		listed[pods[i].Namespace+"/"+pods[i].Name] = true
+	}
+	for _, pod := range pending {
+		if !isNominated(&pod) || listed[pod.Namespace+"/"+pod.Name] {
+			continue
+		}
+		pod.Status.Host = pod.Status.NominatedHost
+		pods = append(pods, pod)
+	}
+	return pods, nil
+}
+
+// byPriority sorts pods from the highest priority to the lowest.
+type byPriority []api.Pod
+
+func (p byPriority) Len() int           { return len(p) }
+func (p byPriority) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p byPriority) Less(i, j int) bool { return api.PodPriority(&p[i]) > api.PodPriority(&p[j]) }
+
+// highestPriority returns the highest priority of a list of pods, or zero if it is empty.
+func highestPriority(pods []api.Pod) int {
+	highest := 0
+	for i := range pods {
+		if priority := api.PodPriority(&pods[i]); i == 0 || priority > highest {
+			highest = priority
+		}
+	}
+	return highest
+}
+
+// podsAround lists the pods a pod is scheduled around. The pod itself, which is listed on
+// its node when it is nominated, and the nominated pods of a lower priority, which it may
+// preempt before they are even scheduled, are left out.
+func (g *genericScheduler) podsAround(pod *api.Pod) ([]api.Pod, error) {
+	pods, err := g.pods.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	priority := api.PodPriority(pod)
+	result := []api.Pod{}
+	for i := range pods {
+		p := &pods[i]
+		if p.Namespace == pod.Namespace && p.Name == pod.Name {
+			continue
+		}
+		if isNominated(p) && api.PodPriority(p) < priority {
+			continue
+		}
+		result = append(result, *p)
+	}
+	return result, nil
+}
+
+// podFitsOnNode returns true if all the predicates fit the pod on the node, given the pods
+// already running there.
+func (g *genericScheduler) podFitsOnNode(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
+	for _, predicate := range g.predicates {
+		fit, err := predicate(pod, existingPods, node)
+		if err != nil || !fit {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// selectVictims returns the fewest pods of a lower priority than the pod to evict from
+// the node for the pod to fit there, preferring to evict the pods of the lowest priority.
+// It returns false if the pod does not fit even once all those pods are evicted.
+func (g *genericScheduler) selectVictims(pod api.Pod, existingPods []api.Pod, node string) ([]api.Pod, bool, error) {
+	priority := api.PodPriority(&pod)
+	remaining := []api.Pod{}
+	candidates := []api.Pod{}
+	for i := range existingPods {
+		p := &existingPods[i]
+		if isNominated(p) || api.PodPriority(p) >= priority {
+			remaining = append(remaining, *p)
+		} else {
+			candidates = append(candidates, *p)
+		}
+	}
+	fits, err := g.podFitsOnNode(pod, remaining, node)
+	if err != nil || !fits {
+		return nil, false, err
+	}
+
+	// reprieve as many candidates as possible, from the highest priority down
+	sort.Stable(byPriority(candidates))
+	victims := []api.Pod{}
+	for _, candidate := range candidates {
+		fits, err := g.podFitsOnNode(pod, append(remaining, candidate), node)
+		if err != nil {
+			return nil, false, err
+		}
+		if fits {
+			remaining = append(remaining, candidate)
+		} else {
+			victims = append(victims, candidate)
+		}
+	}
+	return victims, true, nil
+}
+
+// Preempt finds the node where evicting the fewest pods of a lower priority than the pod
+// makes room for it, and returns that node along with the pods to evict. Among the nodes
+// requiring as many evictions, the one where the highest priority of the evicted pods is
+// the lowest is chosen. The pods which are already terminating are expected to make room
+// as well. The node is empty if there is no room to make anywhere.
+//
+// Only the pods passed to the predicates are evicted: the predicates which list the pods
+// of the cluster by themselves still take the victims into account.
+func (g *genericScheduler) Preempt(pod api.Pod, minionLister MinionLister) (string, []api.Pod, error) {
+	g.cycle.Start()
+	minions, err := minionLister.List()
+	if err != nil {
+		return "", nil, err
+	}
+	pods, err := g.podsAround(&pod)
+	if err != nil {
+		return "", nil, err
+	}
+	machineToPods, err := MapPodsToMachines(FakePodLister(pods))
+	if err != nil {
+		return "", nil, err
+	}
+
+	selected := ""
+	var selectedVictims []api.Pod
+	for _, node := range minions.Items {
+		existingPods := []api.Pod{}
+		for _, p := range machineToPods[node.Name] {
+			if p.DeletionTimestamp == nil {
+				existingPods = append(existingPods, p)
+			}
+		}
+		victims, fits, err := g.selectVictims(pod, existingPods, node.Name)
+		if err != nil {
+			return "", nil, err
+		}
+		if !fits {
+			continue
+		}
+		if len(selected) == 0 || len(victims) < len(selectedVictims) ||
+			(len(victims) == len(selectedVictims) && highestPriority(victims) < highestPriority(selectedVictims)) {
+			selected = node.Name
+			selectedVictims = victims
+		}
+	}
+	return selected, selectedVictims, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+)
+
+// twoPodsPredicate fits up to two pods on each node.
+func twoPodsPredicate(pod api.Pod, existingPods []api.Pod, node string) (bool, error) {
+	return len(existingPods) < 2, nil
+}
+
+func podWithPriority(name string, priority int, host string) api.Pod {
+	return api.Pod{
+		ObjectMeta: api.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       api.PodSpec{Host: host, Priority: &priority},
+		Status:     api.PodStatus{Host: host},
+	}
+}
+
+func nominatedPod(name string, priority int, host string) api.Pod {
+	pod := podWithPriority(name, priority, "")
+	pod.Status.NominatedHost = host
+	return pod
+}
+
+// splitPending splits pods into the scheduled pods and the pending pods, as listed by the
+// scheduler.
+func splitPending(pods []api.Pod) (FakePodLister, FakePodLister) {
+	scheduled, pending := FakePodLister{}, FakePodLister{}
+	for _, pod := range pods {
+		if len(pod.Spec.Host) > 0 {
+			scheduled = append(scheduled, pod)
+		} else {
+			pending = append(pending, pod)
+		}
+	}
+	return scheduled, pending
+}
+
+func podNames(pods []api.Pod) []string {
+	names := []string{}
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestPreempt(t *testing.T) {
+	terminating := podWithPriority("terminating", 0, "machine2")
+	terminating.DeletionTimestamp = &util.Time{}
+	tests := []struct {
+		pod             api.Pod
+		pods            []api.Pod
+		expectedHost    string
+		expectedVictims []string
+		test            string
+	}{
+		{
+			pod: podWithPriority("critical", 100, ""),
+			pods: []api.Pod{
+				podWithPriority("a", 0, "machine1"), podWithPriority("b", 10, "machine1"),
+				podWithPriority("c", 50, "machine2"), podWithPriority("d", 50, "machine2"),
+			},
+			expectedHost:    "machine1",
+			expectedVictims: []string{"a"},
+			test:            "evict the pod of the lowest priority",
+		},
+		{
+			pod: podWithPriority("critical", 100, ""),
+			pods: []api.Pod{
+				podWithPriority("a", 100, "machine1"), podWithPriority("b", 200, "machine1"),
+				podWithPriority("c", 100, "machine2"), podWithPriority("d", 0, "machine2"),
+			},
+			expectedHost:    "machine2",
+			expectedVictims: []string{"d"},
+			test:            "pods of the same priority are not evicted",
+		},
+		{
+			pod: podWithPriority("critical", 100, ""),
+			pods: []api.Pod{
+				podWithPriority("a", 100, "machine1"), podWithPriority("b", 100, "machine1"),
+				podWithPriority("c", 200, "machine2"), podWithPriority("d", 100, "machine2"),
+			},
+			test: "no room to make",
+		},
+		{
+			pod: podWithPriority("critical", 100, ""),
+			pods: []api.Pod{
+				podWithPriority("a", 0, "machine1"), podWithPriority("b", 0, "machine1"),
+				podWithPriority("c", 0, "machine2"), terminating,
+			},
+			expectedHost:    "machine2",
+			expectedVictims: []string{},
+			test:            "terminating pods make room",
+		},
+		{
+			pod: podWithPriority("critical", 100, ""),
+			pods: []api.Pod{
+				podWithPriority("a", 0, "machine1"), podWithPriority("e", 30, "machine1"), nominatedPod("b", 0, "machine1"),
+				podWithPriority("c", 10, "machine2"), nominatedPod("d", 200, "machine2"),
+			},
+			expectedHost:    "machine1",
+			expectedVictims: []string{"a"},
+			test:            "nominated pods of a lower priority are ignored",
+		},
+	}
+
+	for _, test := range tests {
+		pods := NewNominatedPodLister(splitPending(test.pods))
+		scheduler := NewGenericScheduler(map[string]FitPredicate{"two": twoPodsPredicate}, []PriorityConfig{}, pods, nil, rand.New(rand.NewSource(0)))
+		host, victims, err := scheduler.(Preemptor).Preempt(test.pod, FakeMinionLister(makeNodeList([]string{"machine1", "machine2"})))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+			continue
+		}
+		if host != test.expectedHost {
+			t.Errorf("%s: expected host %q, got %q", test.test, test.expectedHost, host)
+		}
+		if len(test.expectedHost) > 0 && !reflect.DeepEqual(test.expectedVictims, podNames(victims)) {
+			t.Errorf("%s: expected victims %v, got %v", test.test, test.expectedVictims, podNames(victims))
+		}
+	}
+}
+
+func TestScheduleAroundNominatedPods(t *testing.T) {
+	pods := []api.Pod{
+		podWithPriority("a", 0, "machine1"), nominatedPod("b", 100, "machine1"),
+		podWithPriority("c", 0, "machine2"), nominatedPod("d", 0, "machine2"),
+	}
+	scheduler := NewGenericScheduler(
+		map[string]FitPredicate{"two": twoPodsPredicate},
+		[]PriorityConfig{{Function: EqualPriority, Weight: 1}},
+		NewNominatedPodLister(splitPending(pods)),
+		nil,
+		rand.New(rand.NewSource(0)))
+
+	// the room made on machine1 is kept for b
+	host, err := scheduler.Schedule(podWithPriority("e", 50, ""), FakeMinionLister(makeNodeList([]string{"machine1", "machine2"})))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if host != "machine2" {
+		t.Errorf("expected machine2, got %q", host)
+	}
+	// b itself fits into its room
+	host, err = scheduler.Schedule(pods[1], FakeMinionLister(makeNodeList([]string{"machine1"})))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if host != "machine1" {
+		t.Errorf("expected machine1, got %q", host)
+	}
+}
+
+func TestNominatedPodLister(t *testing.T) {
+	scheduled := podWithPriority("a", 0, "machine1")
+	nominated := nominatedPod("b", 0, "machine2")
+	justBound := nominatedPod("a", 0, "machine1")
+	pending := podWithPriority("c", 0, "")
+
+	lister := NewNominatedPodLister(FakePodLister{scheduled}, FakePodLister{nominated, justBound, pending})
+	pods, err := lister.List(labels.Everything())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := podNames(pods); !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("unexpected pods: %v", names)
+	}
+	if pods[1].Status.Host != "machine2" {
+		t.Errorf("expected the nominated pod on machine2, got %q", pods[1].Status.Host)
+	}
+}
@@ -40,18 +40,16 @@ func calculateScore(requested, capacity int64, node string) int {
 func calculateOccupancy(pod api.Pod, node api.Node, pods []api.Pod) HostPriority {
 	totalMilliCPU := int64(0)
 	totalMemory := int64(0)
-	for _, existingPod := range pods {
-		for _, container := range existingPod.Spec.Containers {
-			totalMilliCPU += container.Resources.Limits.Cpu().MilliValue()
-			totalMemory += container.Resources.Limits.Memory().Value()
-		}
+	for ix := range pods {
+		existingPodRequest := getResourceRequest(&pods[ix])
+		totalMilliCPU += existingPodRequest.milliCPU
+		totalMemory += existingPodRequest.memory
 	}
 	// Add the resources requested by the current pod being scheduled.
 	// This also helps differentiate between differently sized, but empty, minions.
-	for _, container := range pod.Spec.Containers {
-		totalMilliCPU += container.Resources.Limits.Cpu().MilliValue()
-		totalMemory += container.Resources.Limits.Memory().Value()
-	}
+	podRequest := getResourceRequest(&pod)
+	totalMilliCPU += podRequest.milliCPU
+	totalMemory += podRequest.memory
 
 	capacityMilliCPU := node.Spec.Capacity.Cpu().MilliValue()
 	capacityMemory := node.Spec.Capacity.Memory().Value()
@@ -90,6 +88,91 @@ func LeastRequestedPriority(pod api.Pod, podLister PodLister, minionLister Minio
 	return list, nil
 }
 
+// countIntolerableTaints returns the number of PreferNoSchedule taints of the node which
+// the pod does not tolerate.
+func countIntolerableTaints(pod *api.Pod, node *api.Node) int {
+	count := 0
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != api.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !api.TolerationsTolerateTaint(pod.Spec.Tolerations, taint) {
+			count++
+		}
+	}
+	return count
+}
+
+// TaintTolerationPriority is a priority function that favors nodes with fewer PreferNoSchedule
+// taints which the pod does not tolerate. Nodes without such a taint get the highest score.
+func TaintTolerationPriority(pod api.Pod, podLister PodLister, minionLister MinionLister) (HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	maxCount := 0
+	counts := make([]int, len(minions.Items))
+	for i := range minions.Items {
+		counts[i] = countIntolerableTaints(&pod, &minions.Items[i])
+		if counts[i] > maxCount {
+			maxCount = counts[i]
+		}
+	}
+
+	result := []HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for i, minion := range minions.Items {
+		score := 10
+		if maxCount > 0 {
+			score = (10 * (maxCount - counts[i])) / maxCount
+		}
+		result = append(result, HostPriority{host: minion.Name, score: score})
+	}
+	return result, nil
+}
+
+// NodeAffinityPriority is a priority function that favors nodes matching the preferred
+// node affinity terms of the pod. A node scores the sum of the weights of the terms it
+// matches, scaled so that the best matching node gets the highest score.
+func NodeAffinityPriority(pod api.Pod, podLister PodLister, minionLister MinionLister) (HostPriorityList, error) {
+	minions, err := minionLister.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var preferred []api.PreferredSchedulingTerm
+	if pod.Spec.NodeAffinity != nil {
+		preferred = pod.Spec.NodeAffinity.PreferredDuringScheduling
+	}
+	maxWeight := 0
+	weights := make([]int, len(minions.Items))
+	for i := range minions.Items {
+		for j := range preferred {
+			if preferred[j].Weight > 0 && nodeMatchesSelectorTerm(&minions.Items[i], &preferred[j].Preference) {
+				weights[i] += preferred[j].Weight
+			}
+		}
+		if weights[i] > maxWeight {
+			maxWeight = weights[i]
+		}
+	}
+
+	result := []HostPriority{}
+	//score int - scale of 0-10
+	// 0 being the lowest priority and 10 being the highest
+	for i, minion := range minions.Items {
+		score := 10
+		if maxWeight > 0 {
+			score = (10 * weights[i]) / maxWeight
+		}
+		result = append(result, HostPriority{host: minion.Name, score: score})
+	}
+	return result, nil
+}
+
 type NodeLabelPrioritizer struct {
 	label    string
 	presence bool
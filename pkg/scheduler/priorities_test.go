@@ -59,14 +59,14 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse("1000m"),
 					},
 				},
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu": resource.MustParse("2000m"),
 					},
 				},
@@ -77,7 +77,7 @@ func TestLeastRequested(t *testing.T) {
 		Containers: []api.Container{
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("1000m"),
 						"memory": resource.MustParse("2000"),
 					},
@@ -85,7 +85,7 @@ func TestLeastRequested(t *testing.T) {
 			},
 			{
 				Resources: api.ResourceRequirements{
-					Limits: api.ResourceList{
+					Requests: api.ResourceList{
 						"cpu":    resource.MustParse("2000m"),
 						"memory": resource.MustParse("3000"),
 					},
@@ -364,3 +364,126 @@ func TestNewNodeLabelPriority(t *testing.T) {
 		}
 	}
 }
+
+func TestTaintTolerationPriority(t *testing.T) {
+	preferNoGPU := api.Taint{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectPreferNoSchedule}
+	preferNoIngress := api.Taint{Key: "dedicated", Value: "ingress", Effect: api.TaintEffectPreferNoSchedule}
+	noGPU := api.Taint{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule}
+	tests := []struct {
+		pod          api.Pod
+		nodes        []api.Node
+		expectedList HostPriorityList
+		test         string
+	}{
+		{
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1"}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2"}, Spec: api.NodeSpec{Taints: []api.Taint{noGPU}}},
+			},
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 10}},
+			test:         "no PreferNoSchedule taints",
+		},
+		{
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1"}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2"}, Spec: api.NodeSpec{Taints: []api.Taint{preferNoGPU}}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine3"}, Spec: api.NodeSpec{Taints: []api.Taint{preferNoGPU, preferNoIngress}}},
+			},
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 5}, {"machine3", 0}},
+			test:         "pod without tolerations",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					Tolerations: []api.Toleration{{Key: "dedicated", Value: "gpu"}},
+				},
+			},
+			nodes: []api.Node{
+				{ObjectMeta: api.ObjectMeta{Name: "machine1"}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine2"}, Spec: api.NodeSpec{Taints: []api.Taint{preferNoGPU}}},
+				{ObjectMeta: api.ObjectMeta{Name: "machine3"}, Spec: api.NodeSpec{Taints: []api.Taint{preferNoGPU, preferNoIngress}}},
+			},
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 0}},
+			test:         "pod tolerates some of the taints",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := TaintTolerationPriority(test.pod, FakePodLister([]api.Pod{}), FakeMinionLister(api.NodeList{Items: test.nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
+
+func TestNodeAffinityPriority(t *testing.T) {
+	preferSSD := api.PreferredSchedulingTerm{
+		Weight: 3,
+		Preference: api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{
+			{Key: "ssd", Operator: api.NodeSelectorOpExists},
+		}},
+	}
+	preferZoneA := api.PreferredSchedulingTerm{
+		Weight: 1,
+		Preference: api.NodeSelectorTerm{MatchExpressions: []api.NodeSelectorRequirement{
+			{Key: "zone", Operator: api.NodeSelectorOpIn, Values: []string{"a"}},
+		}},
+	}
+	nodes := []api.Node{
+		{ObjectMeta: api.ObjectMeta{Name: "machine1"}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine2", Labels: map[string]string{"zone": "a"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine3", Labels: map[string]string{"ssd": "true"}}},
+		{ObjectMeta: api.ObjectMeta{Name: "machine4", Labels: map[string]string{"ssd": "true", "zone": "a"}}},
+	}
+	tests := []struct {
+		pod          api.Pod
+		expectedList HostPriorityList
+		test         string
+	}{
+		{
+			expectedList: []HostPriority{{"machine1", 10}, {"machine2", 10}, {"machine3", 10}, {"machine4", 10}},
+			test:         "pod without node affinity",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						PreferredDuringScheduling: []api.PreferredSchedulingTerm{preferSSD, preferZoneA},
+					},
+				},
+			},
+			expectedList: []HostPriority{{"machine1", 0}, {"machine2", 2}, {"machine3", 7}, {"machine4", 10}},
+			test:         "weights of the matched terms are summed",
+		},
+		{
+			pod: api.Pod{
+				Spec: api.PodSpec{
+					NodeAffinity: &api.NodeAffinity{
+						PreferredDuringScheduling: []api.PreferredSchedulingTerm{preferZoneA},
+					},
+				},
+			},
+			expectedList: []HostPriority{{"machine1", 0}, {"machine2", 10}, {"machine3", 0}, {"machine4", 10}},
+			test:         "single preferred term",
+		},
+	}
+
+	for _, test := range tests {
+		list, err := NodeAffinityPriority(test.pod, FakePodLister([]api.Pod{}), FakeMinionLister(api.NodeList{Items: nodes}))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		// sort the two lists to avoid failures on account of different ordering
+		sort.Sort(test.expectedList)
+		sort.Sort(list)
+		if !reflect.DeepEqual(test.expectedList, list) {
+			t.Errorf("%s: expected %#v, got %#v", test.test, test.expectedList, list)
+		}
+	}
+}
@@ -25,3 +25,11 @@ import (
 type Scheduler interface {
 	Schedule(api.Pod, MinionLister) (selectedMachine string, err error)
 }
+
+// Preemptor is implemented by the schedulers which can make room for a pod that fits
+// on no minion, by evicting pods of a lower priority.
+type Preemptor interface {
+	// Preempt returns the minion to make room on for the pod, and the pods to evict
+	// from it. The minion is empty if there is no room to make.
+	Preempt(api.Pod, MinionLister) (selectedMachine string, victims []api.Pod, err error)
+}
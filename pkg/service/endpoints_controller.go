@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/endpoints"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta1"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta2"
@@ -64,22 +65,19 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 			resultErr = err
 			continue
 		}
-		endpoints := []api.Endpoint{}
-
-		for _, pod := range pods.Items {
-			// TODO: Once v1beta1 and v1beta2 are EOL'ed, this can
-			// assume that service.Spec.TargetPort is populated.
-			_ = v1beta1.Dependency
-			_ = v1beta2.Dependency
-			port, err := findPort(&pod, &service)
-			if err != nil {
-				glog.Errorf("Failed to find port for service %s/%s: %v", service.Namespace, service.Name, err)
-				continue
-			}
+		subsets := []api.EndpointSubset{}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+
 			if len(pod.Status.PodIP) == 0 {
 				glog.Errorf("Failed to find an IP for pod %s/%s", pod.Namespace, pod.Name)
 				continue
 			}
+			if pod.DeletionTimestamp != nil {
+				// Stop sending traffic to pods which are shutting down.
+				glog.V(5).Infof("Pod is being deleted: %v/%v", pod.Namespace, pod.Name)
+				continue
+			}
 
 			inService := false
 			for _, c := range pod.Status.Conditions {
@@ -93,9 +91,8 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 				continue
 			}
 
-			endpoints = append(endpoints, api.Endpoint{
-				IP:   pod.Status.PodIP,
-				Port: port,
+			epa := api.EndpointAddress{
+				IP: pod.Status.PodIP,
 				TargetRef: &api.ObjectReference{
 					Kind:            "Pod",
 					Namespace:       pod.ObjectMeta.Namespace,
@@ -103,8 +100,25 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 					UID:             pod.ObjectMeta.UID,
 					ResourceVersion: pod.ObjectMeta.ResourceVersion,
 				},
-			})
+			}
+			for j := range service.Spec.Ports {
+				servicePort := &service.Spec.Ports[j]
+
+				// TODO: Once v1beta1 and v1beta2 are EOL'ed, this can
+				// assume that servicePort.TargetPort is populated.
+				_ = v1beta1.Dependency
+				_ = v1beta2.Dependency
+				portNum, err := findPort(pod, servicePort)
+				if err != nil {
+					glog.Errorf("Failed to find port %q for service %s/%s: %v", servicePort.Name, service.Namespace, service.Name, err)
+					continue
+				}
+				epp := api.EndpointPort{Name: servicePort.Name, Port: portNum, Protocol: servicePort.Protocol}
+				subsets = append(subsets, api.EndpointSubset{Addresses: []api.EndpointAddress{epa}, Ports: []api.EndpointPort{epp}})
+			}
 		}
+		subsets = endpoints.RepackSubsets(subsets)
+
 		currentEndpoints, err := e.client.Endpoints(service.Namespace).Get(service.Name)
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -112,26 +126,25 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 					ObjectMeta: api.ObjectMeta{
 						Name: service.Name,
 					},
-					Protocol: service.Spec.Protocol,
 				}
 			} else {
 				glog.Errorf("Error getting endpoints: %v", err)
 				continue
 			}
 		}
+		if len(currentEndpoints.ResourceVersion) != 0 && api.Semantic.DeepEqual(currentEndpoints.Subsets, subsets) {
+			glog.V(5).Infof("endpoints are equal for %s/%s, skipping update", service.Namespace, service.Name)
+			continue
+		}
 		newEndpoints := &api.Endpoints{}
 		*newEndpoints = *currentEndpoints
-		newEndpoints.Endpoints = endpoints
+		newEndpoints.Subsets = subsets
 
 		if len(currentEndpoints.ResourceVersion) == 0 {
 			// No previous endpoints, create them
 			_, err = e.client.Endpoints(service.Namespace).Create(newEndpoints)
 		} else {
 			// Pre-existing
-			if currentEndpoints.Protocol == service.Spec.Protocol && endpointsListEqual(currentEndpoints, endpoints) {
-				glog.V(5).Infof("protocol and endpoints are equal for %s/%s, skipping update", service.Namespace, service.Name)
-				continue
-			}
 			_, err = e.client.Endpoints(service.Namespace).Update(newEndpoints)
 		}
 		if err != nil {
@@ -142,42 +155,6 @@ func (e *EndpointController) SyncServiceEndpoints() error {
 	return resultErr
 }
 
-func endpointEqual(this, that *api.Endpoint) bool {
-	if this.IP != that.IP || this.Port != that.Port {
-		return false
-	}
-
-	if this.TargetRef == nil || that.TargetRef == nil {
-		return this.TargetRef == that.TargetRef
-	}
-
-	return *this.TargetRef == *that.TargetRef
-}
-
-func containsEndpoint(haystack *api.Endpoints, needle *api.Endpoint) bool {
-	if haystack == nil || needle == nil {
-		return false
-	}
-	for ix := range haystack.Endpoints {
-		if endpointEqual(&haystack.Endpoints[ix], needle) {
-			return true
-		}
-	}
-	return false
-}
-
-func endpointsListEqual(eps *api.Endpoints, endpoints []api.Endpoint) bool {
-	if len(eps.Endpoints) != len(endpoints) {
-		return false
-	}
-	for i := range endpoints {
-		if !containsEndpoint(eps, &endpoints[i]) {
-			return false
-		}
-	}
-	return true
-}
-
 func findDefaultPort(pod *api.Pod, servicePort int) (int, bool) {
 	foundPorts := []int{}
 	for _, container := range pod.Spec.Containers {
@@ -194,13 +171,13 @@ func findDefaultPort(pod *api.Pod, servicePort int) (int, bool) {
 	return 0, false
 }
 
-// findPort locates the container port for the given manifest and portName.
-func findPort(pod *api.Pod, service *api.Service) (int, error) {
-	portName := service.Spec.TargetPort
+// findPort locates the container port for the given pod and service port.
+func findPort(pod *api.Pod, servicePort *api.ServicePort) (int, error) {
+	portName := servicePort.TargetPort
 	switch portName.Kind {
 	case util.IntstrString:
 		if len(portName.StrVal) == 0 {
-			if port, found := findDefaultPort(pod, service.Spec.Port); found {
+			if port, found := findDefaultPort(pod, servicePort.Port); found {
 				return port, nil
 			}
 			break
@@ -215,7 +192,7 @@ func findPort(pod *api.Pod, service *api.Service) (int, error) {
 		}
 	case util.IntstrInt:
 		if portName.IntVal == 0 {
-			if port, found := findDefaultPort(pod, service.Spec.Port); found {
+			if port, found := findDefaultPort(pod, servicePort.Port); found {
 				return port, nil
 			}
 			break
@@ -0,0 +1,186 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+const (
+	// Issuer is the issuer of the tokens signed for service accounts.
+	Issuer = "kubernetes/serviceaccount"
+
+	// ServiceAccountUsernamePrefix prefixes the user names of service accounts.
+	ServiceAccountUsernamePrefix = "system:serviceaccount:"
+)
+
+// Claims are the claims carried by a service account token.
+type Claims struct {
+	Issuer             string `json:"iss"`
+	Subject            string `json:"sub"`
+	Namespace          string `json:"kubernetes.io/serviceaccount/namespace"`
+	SecretName         string `json:"kubernetes.io/serviceaccount/secret.name"`
+	ServiceAccountName string `json:"kubernetes.io/serviceaccount/service-account.name"`
+	ServiceAccountUID  string `json:"kubernetes.io/serviceaccount/service-account.uid"`
+}
+
+// jwtHeader is the only header of the tokens we sign and accept.
+const jwtHeader = `{"alg":"RS256","typ":"JWT"}`
+
+// MakeUsername returns the user name of the service account with the given namespace and name.
+func MakeUsername(namespace, name string) string {
+	return ServiceAccountUsernamePrefix + namespace + ":" + name
+}
+
+// TokenGenerator generates the API tokens of service accounts.
+type TokenGenerator interface {
+	// GenerateToken returns a token for serviceAccount, to be stored in secret.
+	GenerateToken(serviceAccount api.ServiceAccount, secret api.Secret) (string, error)
+}
+
+// JWTTokenGenerator returns a TokenGenerator that signs JSON Web Tokens with key.
+func JWTTokenGenerator(key *rsa.PrivateKey) TokenGenerator {
+	return &jwtTokenGenerator{key}
+}
+
+type jwtTokenGenerator struct {
+	key *rsa.PrivateKey
+}
+
+func (j *jwtTokenGenerator) GenerateToken(serviceAccount api.ServiceAccount, secret api.Secret) (string, error) {
+	claims, err := json.Marshal(Claims{
+		Issuer:             Issuer,
+		Subject:            MakeUsername(serviceAccount.Namespace, serviceAccount.Name),
+		Namespace:          serviceAccount.Namespace,
+		SecretName:         secret.Name,
+		ServiceAccountName: serviceAccount.Name,
+		ServiceAccountUID:  string(serviceAccount.UID),
+	})
+	if err != nil {
+		return "", err
+	}
+	signed := encodeSegment([]byte(jwtHeader)) + "." + encodeSegment(claims)
+	hashed := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, j.key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + encodeSegment(signature), nil
+}
+
+// ParseToken verifies the signature of token with key and returns its claims.
+func ParseToken(key *rsa.PublicKey, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("token is not a JSON Web Token")
+	}
+	header, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	var h struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := json.Unmarshal(header, &h); err != nil {
+		return nil, err
+	}
+	if h.Algorithm != "RS256" {
+		return nil, fmt.Errorf("unsupported signing algorithm %q", h.Algorithm)
+	}
+	signature, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], signature); err != nil {
+		return nil, err
+	}
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	claims := &Claims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, err
+	}
+	if claims.Issuer != Issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	return claims, nil
+}
+
+// ReadPrivateKey reads a PEM encoded RSA private key from file.
+func ReadPrivateKey(file string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", file)
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// ReadPublicKey reads a PEM encoded RSA public key from file. The file may
+// also hold the private key, in which case its public part is returned.
+func ReadPublicKey(file string) (*rsa.PublicKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", file)
+	}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return &privateKey.PublicKey, nil
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s does not hold an RSA key", file)
+	}
+	return rsaKey, nil
+}
+
+func encodeSegment(data []byte) string {
+	return strings.TrimRight(base64.URLEncoding.EncodeToString(data), "=")
+}
+
+func decodeSegment(segment string) ([]byte, error) {
+	if l := len(segment) % 4; l > 0 {
+		segment += strings.Repeat("=", 4-l)
+	}
+	return base64.URLEncoding.DecodeString(segment)
+}
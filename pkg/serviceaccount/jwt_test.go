@@ -0,0 +1,122 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+)
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return key
+}
+
+func TestGenerateAndParseToken(t *testing.T) {
+	key := newKey(t)
+	serviceAccount := api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "ns", UID: "12345"},
+	}
+	secret := api.Secret{ObjectMeta: api.ObjectMeta{Name: "builder-token-abcde", Namespace: "ns"}}
+
+	token, err := JWTTokenGenerator(key).GenerateToken(serviceAccount, secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	claims, err := ParseToken(&key.PublicKey, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Claims{
+		Issuer:             Issuer,
+		Subject:            "system:serviceaccount:ns:builder",
+		Namespace:          "ns",
+		SecretName:         "builder-token-abcde",
+		ServiceAccountName: "builder",
+		ServiceAccountUID:  "12345",
+	}
+	if *claims != expected {
+		t.Errorf("expected claims %#v, got %#v", expected, *claims)
+	}
+
+	if _, err := ParseToken(&newKey(t).PublicKey, token); err == nil {
+		t.Errorf("expected an error for a token signed with another key")
+	}
+	parts := strings.Split(token, ".")
+	forged := parts[0] + "." + encodeSegment([]byte(`{"iss":"kubernetes/serviceaccount","sub":"system:serviceaccount:ns:admin"}`)) + "." + parts[2]
+	if _, err := ParseToken(&key.PublicKey, forged); err == nil {
+		t.Errorf("expected an error for a token with altered claims")
+	}
+	if _, err := ParseToken(&key.PublicKey, "not-a-token"); err == nil {
+		t.Errorf("expected an error for a malformed token")
+	}
+}
+
+func TestReadKeys(t *testing.T) {
+	key := newKey(t)
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	privateFile := writeTempFile(t, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	defer os.Remove(privateFile)
+	publicFile := writeTempFile(t, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+	defer os.Remove(publicFile)
+
+	privateKey, err := ReadPrivateKey(privateFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if privateKey.D.Cmp(key.D) != 0 {
+		t.Errorf("unexpected private key")
+	}
+	for _, file := range []string{privateFile, publicFile} {
+		publicKey, err := ReadPublicKey(file)
+		if err != nil {
+			t.Fatalf("unexpected error reading %s: %v", file, err)
+		}
+		if publicKey.N.Cmp(key.PublicKey.N) != 0 || publicKey.E != key.PublicKey.E {
+			t.Errorf("unexpected public key read from %s", file)
+		}
+	}
+	if _, err := ReadPrivateKey(publicFile); err == nil {
+		t.Errorf("expected an error reading a private key from a public key file")
+	}
+}
+
+func writeTempFile(t *testing.T, data []byte) string {
+	f, err := ioutil.TempFile("", "serviceaccount_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return f.Name()
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// DefaultServiceAccountName is the name of the service account created in
+// every namespace, and used by pods that do not name one.
+const DefaultServiceAccountName = "default"
+
+// ServiceAccountsController creates the default service account of every active namespace.
+type ServiceAccountsController struct {
+	client client.Interface
+}
+
+// NewServiceAccountsController returns a new ServiceAccountsController.
+func NewServiceAccountsController(client client.Interface) *ServiceAccountsController {
+	return &ServiceAccountsController{client: client}
+}
+
+// Run begins syncing the default service accounts at the given period.
+func (e *ServiceAccountsController) Run(period time.Duration) {
+	go util.Forever(func() { e.synchronize() }, period)
+}
+
+func (e *ServiceAccountsController) synchronize() {
+	namespaces, err := e.client.Namespaces().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to list namespaces: %v", err))
+		return
+	}
+	serviceAccounts, err := e.client.ServiceAccounts(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to list service accounts: %v", err))
+		return
+	}
+	existing := util.NewStringSet()
+	for _, sa := range serviceAccounts.Items {
+		if sa.Name == DefaultServiceAccountName {
+			existing.Insert(sa.Namespace)
+		}
+	}
+	for _, namespace := range namespaces.Items {
+		if namespace.Status.Phase != api.NamespaceActive || existing.Has(namespace.Name) {
+			continue
+		}
+		sa := &api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: DefaultServiceAccountName, Namespace: namespace.Name},
+		}
+		glog.V(2).Infof("Creating the default service account of namespace %s", namespace.Name)
+		if _, err := e.client.ServiceAccounts(namespace.Name).Create(sa); err != nil {
+			util.HandleError(fmt.Errorf("unable to create the default service account of namespace %s: %v", namespace.Name, err))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func TestServiceAccountsControllerCreatesDefaults(t *testing.T) {
+	namespace := func(name string, phase api.NamespacePhase) api.Namespace {
+		return api.Namespace{
+			ObjectMeta: api.ObjectMeta{Name: name},
+			Status:     api.NamespaceStatus{Phase: phase},
+		}
+	}
+	kubeClient := &client.Fake{
+		NamespacesList: api.NamespaceList{Items: []api.Namespace{
+			namespace("new", api.NamespaceActive),
+			namespace("existing", api.NamespaceActive),
+			namespace("deleted", api.NamespaceTerminating),
+		}},
+		ServiceAccountsList: api.ServiceAccountList{Items: []api.ServiceAccount{
+			{ObjectMeta: api.ObjectMeta{Name: DefaultServiceAccountName, Namespace: "existing"}},
+			{ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "new"}},
+		}},
+	}
+	NewServiceAccountsController(kubeClient).synchronize()
+
+	created := []*api.ServiceAccount{}
+	for _, action := range kubeClient.Actions {
+		if action.Action == "create-serviceAccount" {
+			created = append(created, action.Value.(*api.ServiceAccount))
+		}
+	}
+	if len(created) != 1 || created[0].Name != DefaultServiceAccountName || created[0].Namespace != "new" {
+		t.Errorf("expected the default service account to be created in namespace new, got %#v", created)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/golang/glog"
+)
+
+// TokensController makes sure every service account has an API token secret,
+// and deletes the token secrets of service accounts that no longer exist.
+type TokensController struct {
+	client    client.Interface
+	generator TokenGenerator
+}
+
+// NewTokensController returns a TokensController which signs tokens with generator.
+func NewTokensController(client client.Interface, generator TokenGenerator) *TokensController {
+	return &TokensController{
+		client:    client,
+		generator: generator,
+	}
+}
+
+// Run begins syncing service account tokens at the given period.
+func (e *TokensController) Run(period time.Duration) {
+	go util.Forever(func() { e.synchronize() }, period)
+}
+
+func (e *TokensController) synchronize() {
+	serviceAccounts, err := e.client.ServiceAccounts(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to list service accounts: %v", err))
+		return
+	}
+	secrets, err := e.client.Secrets(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		util.HandleError(fmt.Errorf("unable to list secrets: %v", err))
+		return
+	}
+
+	accounts := map[string]*api.ServiceAccount{}
+	for i := range serviceAccounts.Items {
+		sa := &serviceAccounts.Items[i]
+		accounts[sa.Namespace+"/"+sa.Name] = sa
+	}
+	// tokens holds the names of the live token secrets of each service account.
+	tokens := map[string][]string{}
+	for _, secret := range secrets.Items {
+		if secret.Type != api.SecretTypeServiceAccountToken {
+			continue
+		}
+		key := secret.Namespace + "/" + secret.Annotations[api.ServiceAccountNameKey]
+		sa, ok := accounts[key]
+		if !ok || secret.Annotations[api.ServiceAccountUIDKey] != string(sa.UID) {
+			glog.V(2).Infof("Deleting token secret %s/%s of a deleted service account", secret.Namespace, secret.Name)
+			if err := e.client.Secrets(secret.Namespace).Delete(secret.Name); err != nil {
+				util.HandleError(fmt.Errorf("unable to delete token secret %s/%s: %v", secret.Namespace, secret.Name, err))
+			}
+			continue
+		}
+		tokens[key] = append(tokens[key], secret.Name)
+	}
+
+	for key, sa := range accounts {
+		if err := e.syncServiceAccount(sa, tokens[key]); err != nil {
+			util.HandleError(fmt.Errorf("unable to sync tokens of service account %s: %v", key, err))
+		}
+	}
+}
+
+// syncServiceAccount makes sure sa references one of its token secrets,
+// creating one if sa has none.
+func (e *TokensController) syncServiceAccount(sa *api.ServiceAccount, tokens []string) error {
+	if len(tokens) == 0 {
+		secret, err := e.createToken(sa)
+		if err != nil {
+			return err
+		}
+		tokens = []string{secret.Name}
+	}
+	for _, ref := range sa.Secrets {
+		for _, name := range tokens {
+			if ref.Name == name {
+				return nil
+			}
+		}
+	}
+	sa.Secrets = append(sa.Secrets, api.ObjectReference{Name: tokens[0]})
+	_, err := e.client.ServiceAccounts(sa.Namespace).Update(sa)
+	return err
+}
+
+func (e *TokensController) createToken(sa *api.ServiceAccount) (*api.Secret, error) {
+	secret := &api.Secret{
+		ObjectMeta: api.ObjectMeta{
+			Name:      api.SimpleNameGenerator.GenerateName(sa.Name + "-token-"),
+			Namespace: sa.Namespace,
+			Annotations: map[string]string{
+				api.ServiceAccountNameKey: sa.Name,
+				api.ServiceAccountUIDKey:  string(sa.UID),
+			},
+		},
+		Type: api.SecretTypeServiceAccountToken,
+		Data: map[string][]byte{},
+	}
+	token, err := e.generator.GenerateToken(*sa, *secret)
+	if err != nil {
+		return nil, err
+	}
+	secret.Data[api.ServiceAccountTokenKey] = []byte(token)
+	glog.V(2).Infof("Creating token secret %s/%s for service account %s", secret.Namespace, secret.Name, sa.Name)
+	if _, err := e.client.Secrets(sa.Namespace).Create(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
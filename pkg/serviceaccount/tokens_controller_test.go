@@ -0,0 +1,148 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+type fakeTokenGenerator struct{}
+
+func (fakeTokenGenerator) GenerateToken(serviceAccount api.ServiceAccount, secret api.Secret) (string, error) {
+	return serviceAccount.Name + "/" + secret.Name, nil
+}
+
+func newServiceAccount(name string, secrets ...string) api.ServiceAccount {
+	sa := api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID("uid-" + name)},
+	}
+	for _, secret := range secrets {
+		sa.Secrets = append(sa.Secrets, api.ObjectReference{Name: secret})
+	}
+	return sa
+}
+
+func newTokenSecret(name, serviceAccount string) api.Secret {
+	return api.Secret{
+		ObjectMeta: api.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Annotations: map[string]string{
+				api.ServiceAccountNameKey: serviceAccount,
+				api.ServiceAccountUIDKey:  "uid-" + serviceAccount,
+			},
+		},
+		Type: api.SecretTypeServiceAccountToken,
+	}
+}
+
+func TestTokensControllerCreatesTokens(t *testing.T) {
+	kubeClient := &client.Fake{
+		ServiceAccountsList: api.ServiceAccountList{Items: []api.ServiceAccount{newServiceAccount("default")}},
+	}
+	NewTokensController(kubeClient, fakeTokenGenerator{}).synchronize()
+
+	var created *api.Secret
+	var updated *api.ServiceAccount
+	for _, action := range kubeClient.Actions {
+		switch action.Action {
+		case "create-secret":
+			created = action.Value.(*api.Secret)
+		case "update-serviceAccount":
+			updated = action.Value.(*api.ServiceAccount)
+		}
+	}
+	if created == nil {
+		t.Fatalf("expected a token secret to be created, got %#v", kubeClient.Actions)
+	}
+	if !strings.HasPrefix(created.Name, "default-token-") || created.Type != api.SecretTypeServiceAccountToken {
+		t.Errorf("unexpected secret: %#v", created)
+	}
+	if created.Annotations[api.ServiceAccountNameKey] != "default" || created.Annotations[api.ServiceAccountUIDKey] != "uid-default" {
+		t.Errorf("unexpected annotations: %#v", created.Annotations)
+	}
+	if e, a := "default/"+created.Name, string(created.Data[api.ServiceAccountTokenKey]); e != a {
+		t.Errorf("expected token %q, got %q", e, a)
+	}
+	if updated == nil || len(updated.Secrets) != 1 || updated.Secrets[0].Name != created.Name {
+		t.Errorf("expected the service account to reference %s, got %#v", created.Name, updated)
+	}
+}
+
+func TestTokensControllerSync(t *testing.T) {
+	tests := map[string]struct {
+		serviceAccounts []api.ServiceAccount
+		secrets         []api.Secret
+		expectedActions []string
+	}{
+		"leaves referenced tokens alone": {
+			serviceAccounts: []api.ServiceAccount{newServiceAccount("default", "default-token-1")},
+			secrets:         []api.Secret{newTokenSecret("default-token-1", "default")},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets"},
+		},
+		"references an existing token": {
+			serviceAccounts: []api.ServiceAccount{newServiceAccount("default")},
+			secrets:         []api.Secret{newTokenSecret("default-token-1", "default")},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets", "update-serviceAccount"},
+		},
+		"replaces a deleted token": {
+			serviceAccounts: []api.ServiceAccount{newServiceAccount("default", "default-token-1")},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets", "create-secret", "update-serviceAccount"},
+		},
+		"deletes tokens of deleted service accounts": {
+			secrets:         []api.Secret{newTokenSecret("builder-token-1", "builder")},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets", "delete-secret"},
+		},
+		"deletes tokens of recreated service accounts": {
+			serviceAccounts: []api.ServiceAccount{newServiceAccount("default", "default-token-1")},
+			secrets: []api.Secret{func() api.Secret {
+				secret := newTokenSecret("default-token-1", "default")
+				secret.Annotations[api.ServiceAccountUIDKey] = "old-uid"
+				return secret
+			}()},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets", "delete-secret", "create-secret", "update-serviceAccount"},
+		},
+		"ignores other secrets": {
+			serviceAccounts: []api.ServiceAccount{newServiceAccount("default", "default-token-1")},
+			secrets: []api.Secret{
+				newTokenSecret("default-token-1", "default"),
+				{ObjectMeta: api.ObjectMeta{Name: "password", Namespace: "ns"}, Type: api.SecretTypeOpaque},
+			},
+			expectedActions: []string{"list-serviceAccounts", "list-secrets"},
+		},
+	}
+
+	for name, test := range tests {
+		kubeClient := &client.Fake{
+			ServiceAccountsList: api.ServiceAccountList{Items: test.serviceAccounts},
+			SecretList:          api.SecretList{Items: test.secrets},
+		}
+		NewTokensController(kubeClient, fakeTokenGenerator{}).synchronize()
+		actions := []string{}
+		for _, action := range kubeClient.Actions {
+			actions = append(actions, action.Action)
+		}
+		if strings.Join(actions, ",") != strings.Join(test.expectedActions, ",") {
+			t.Errorf("%s: expected actions %v, got %v", name, test.expectedActions, actions)
+		}
+	}
+}
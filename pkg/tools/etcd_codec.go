@@ -0,0 +1,77 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+// base64Codec encodes objects in base64 with its codec, since etcd returns values in JSON
+// strings, which cannot hold the arbitrary bytes of protobuf. It decodes both base64 and
+// JSON values, so that the objects stored before in JSON are still read.
+type base64Codec struct {
+	runtime.Codec
+}
+
+// NewBase64Codec returns a codec encoding objects in base64 with codec, to store encodings
+// which are not valid UTF-8, like protobuf, in etcd.
+func NewBase64Codec(codec runtime.Codec) runtime.Codec {
+	return base64Codec{codec}
+}
+
+func (c base64Codec) Encode(obj runtime.Object) ([]byte, error) {
+	data, err := c.Codec.Encode(obj)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(data)))
+	base64.StdEncoding.Encode(out, data)
+	return out, nil
+}
+
+func (c base64Codec) Decode(data []byte) (runtime.Object, error) {
+	data, err := c.decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return c.Codec.Decode(data)
+}
+
+func (c base64Codec) DecodeInto(data []byte, obj runtime.Object) error {
+	data, err := c.decode(data)
+	if err != nil {
+		return err
+	}
+	return c.Codec.DecodeInto(data, obj)
+}
+
+// decode returns the base64 decoding of data, unless data is JSON or protobuf.
+func (c base64Codec) decode(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] == '{' || runtime.IsProtobuf(data) {
+		return data, nil
+	}
+	out := make([]byte, base64.StdEncoding.DecodedLen(len(trimmed)))
+	n, err := base64.StdEncoding.Decode(out, trimmed)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
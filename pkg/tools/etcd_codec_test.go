@@ -0,0 +1,63 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+)
+
+func TestBase64Codec(t *testing.T) {
+	codec := NewBase64Codec(v1beta3.ProtobufCodec)
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "foo", Labels: map[string]string{"app": "web"}},
+		Spec:       api.PodSpec{RestartPolicy: api.RestartPolicyAlways, DNSPolicy: api.DNSClusterFirst},
+	}
+
+	data, err := codec.Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !utf8.Valid(data) || runtime.IsProtobuf(data) {
+		t.Errorf("expected the protobuf encoding in base64, got %q", string(data))
+	}
+	jsonData, err := v1beta3.Codec.Encode(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, data := range [][]byte{data, jsonData} {
+		obj, err := codec.Decode(data)
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %v", string(data), err)
+		}
+		if !api.Semantic.DeepEqual(pod, obj) {
+			t.Errorf("expected %#v, got %#v", pod, obj)
+		}
+		into := &api.Pod{}
+		if err := codec.DecodeInto(data, into); err != nil {
+			t.Fatalf("unexpected error decoding %q: %v", string(data), err)
+		}
+		if !api.Semantic.DeepEqual(pod, into) {
+			t.Errorf("expected %#v, got %#v", pod, into)
+		}
+	}
+}
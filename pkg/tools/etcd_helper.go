@@ -17,6 +17,7 @@ limitations under the License.
 package tools
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,7 +25,10 @@ import (
 	"net/http"
 	"os/exec"
 	"reflect"
+	"sort"
+	"time"
 
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
 	"github.com/coreos/go-etcd/etcd"
@@ -38,6 +42,9 @@ type EtcdHelper struct {
 	Codec  runtime.Codec
 	// optional, no atomic operations can be performed without this interface
 	Versioner EtcdVersioner
+	// optional, serves the watches of the keys it caches, and their lists which accept
+	// any resource version, from memory
+	WatchCache *WatchCache
 }
 
 // NewEtcdHelper creates a helper that works against objects that use the internal
@@ -85,8 +92,8 @@ func etcdErrorIndex(err error) (uint64, bool) {
 	return 0, false
 }
 
-func (h *EtcdHelper) listEtcdNode(key string) ([]*etcd.Node, uint64, error) {
-	result, err := h.Client.Get(key, true, true)
+func (h *EtcdHelper) listEtcdNode(client etcdListWatcher, key string) ([]*etcd.Node, uint64, error) {
+	result, err := client.Get(key, true, true)
 	if err != nil {
 		index, ok := etcdErrorIndex(err)
 		if !ok {
@@ -116,27 +123,370 @@ func (h *EtcdHelper) decodeNodeList(nodes []*etcd.Node, slicePtr interface{}) er
 			}
 			continue
 		}
-		obj := reflect.New(v.Type().Elem())
-		if err := h.Codec.DecodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
+		obj, err := h.decodeNode(node, v.Type().Elem())
+		if err != nil {
 			return err
 		}
-		if h.Versioner != nil {
-			// being unable to set the version does not prevent the object from being extracted
-			_ = h.Versioner.UpdateObject(obj.Interface().(runtime.Object), node)
-		}
 		v.Set(reflect.Append(v, obj.Elem()))
 	}
 	return nil
 }
 
+// decodeNode decodes the object of node into a new value of type t.
+func (h *EtcdHelper) decodeNode(node *etcd.Node, t reflect.Type) (reflect.Value, error) {
+	obj := reflect.New(t)
+	if err := h.Codec.DecodeInto([]byte(node.Value), obj.Interface().(runtime.Object)); err != nil {
+		return reflect.Value{}, err
+	}
+	if h.Versioner != nil {
+		// being unable to set the version does not prevent the object from being extracted
+		_ = h.Versioner.UpdateObject(obj.Interface().(runtime.Object), node)
+	}
+	return obj, nil
+}
+
 // ExtractToList works on a *List api object (an object that satisfies the runtime.IsList
 // definition) and extracts a go object per etcd node into a slice with the resource version.
 func (h *EtcdHelper) ExtractToList(key string, listObj runtime.Object) error {
+	return h.extractToList(h.Client, key, listObj)
+}
+
+// ExtractToListPage works like ExtractToList, but extracts only the objects filter
+// accepts, at most limit of them if limit is not 0, in the order of their keys. The
+// objects start after the page which returned continueToken if it is not empty. It
+// returns the continue token of the next page, or "" if no objects remain.
+//
+// The continue token holds the key of the last object of the page and the etcd index of
+// the first page, so that any apiserver can serve the next page. It reads the keys after
+// the last object a directory at a time, until the page is full, and rewinds the keys
+// changed since the first page from the changes etcd keeps, so that all the pages hold
+// the objects as of the resource version of the first page, from which a watch receives
+// the changes made while the list was paged. Once etcd no longer keeps the changes since
+// the first page, the continue token is refused with an Expired error and the list must
+// be restarted.
+func (h *EtcdHelper) ExtractToListPage(key string, listObj runtime.Object, filter FilterFunc, limit int, continueToken string) (string, error) {
+	return h.extractToListPage(nil, key, listObj, filter, limit, continueToken)
+}
+
+// ExtractCachedToListPage works like ExtractToListPage, but reads the pages from the
+// watch cache if it holds key, and the changes since the first page for the next ones.
+// The objects may then be older than those in etcd.
+func (h *EtcdHelper) ExtractCachedToListPage(key string, listObj runtime.Object, filter FilterFunc, limit int, continueToken string) (string, error) {
+	cache, _ := h.WatchCache.clientFor(key).(*keyCache)
+	return h.extractToListPage(cache, key, listObj, filter, limit, continueToken)
+}
+
+func (h *EtcdHelper) extractToListPage(cache *keyCache, key string, listObj runtime.Object, filter FilterFunc, limit int, continueToken string) (string, error) {
+	listPtr, err := runtime.GetItemsPtr(listObj)
+	if err != nil {
+		return "", err
+	}
+	v, err := conversion.EnforcePtr(listPtr)
+	if err != nil || v.Kind() != reflect.Slice {
+		// This should not happen at runtime.
+		panic("need ptr to slice")
+	}
+	page := listPage{}
+	if len(continueToken) > 0 {
+		if page, err = decodeContinueToken(continueToken); err != nil {
+			return "", err
+		}
+	}
+	var reader listReader = &etcdKeyRange{helper: h, key: key, index: page.ResourceVersion}
+	if cache != nil {
+		if nodes, index, ok := cache.listAt(key, page.ResourceVersion); ok {
+			reader = &nodeRange{nodes: nodes, index: index}
+		}
+	}
+
+	next := ""
+	count := 0
+	startAfter := page.StartAfter
+	for {
+		nodes, end, more, err := reader.read(startAfter, limit-count)
+		if err != nil {
+			return "", err
+		}
+		for i, node := range nodes {
+			obj, err := h.decodeNode(node, v.Type().Elem())
+			if err != nil {
+				return "", err
+			}
+			if filter(obj.Interface().(runtime.Object)) {
+				v.Set(reflect.Append(v, obj.Elem()))
+				count++
+			}
+			if limit > 0 && count == limit && (more || i < len(nodes)-1) {
+				if next, err = encodeContinueToken(listPage{ResourceVersion: reader.resourceVersion(), StartAfter: node.Key}); err != nil {
+					return "", err
+				}
+				more = false
+				break
+			}
+		}
+		if !more {
+			break
+		}
+		startAfter = end
+	}
+	if h.Versioner != nil {
+		if err := h.Versioner.UpdateList(listObj, reader.resourceVersion()); err != nil {
+			return "", err
+		}
+	}
+	return next, nil
+}
+
+// listReader reads the objects under a key in the order of their keys, as of an etcd index.
+type listReader interface {
+	// read returns the nodes of the objects after the key startAfter, at least count of
+	// them if count is not 0 unless fewer remain. If more objects remain, it returns true
+	// and the key to read the next ones after.
+	read(startAfter string, count int) (nodes etcd.Nodes, end string, more bool, err error)
+	// resourceVersion returns the etcd index of the objects, once they are read.
+	resourceVersion() uint64
+}
+
+// nodeRange is a listReader of nodes held in memory, sorted by key.
+type nodeRange struct {
+	nodes etcd.Nodes
+	index uint64
+}
+
+func (r *nodeRange) read(startAfter string, count int) (etcd.Nodes, string, bool, error) {
+	i := sort.Search(len(r.nodes), func(i int) bool { return keyLess(startAfter, r.nodes[i].Key) })
+	return r.nodes[i:], "", false, nil
+}
+
+func (r *nodeRange) resourceVersion() uint64 {
+	return r.index
+}
+
+// listRewindTimeout bounds the wait for a change of etcd when rewinding a list. etcd
+// holds a change at each of its indexes, but the changes of its own keys are not
+// visible to the watches of the keys of the API.
+const listRewindTimeout = 5 * time.Second
+
+// etcdKeyRange is a listReader of the keys of etcd under key. It reads the directories
+// under key one at a time, and rewinds the keys changed since index from the changes
+// etcd keeps.
+type etcdKeyRange struct {
+	helper *EtcdHelper
+	key    string
+	// index is the etcd index of the list, or 0 until the first directory is read,
+	// whose index the list then takes.
+	index uint64
+	// latest is the etcd index of the last directory read.
+	latest uint64
+	// previous holds the nodes as of index of the keys changed since index, and nil for
+	// the keys which did not exist at index. It holds the changes up to rewound.
+	previous map[string]*etcd.Node
+	rewound  uint64
+}
+
+func (r *etcdKeyRange) read(startAfter string, count int) (etcd.Nodes, string, bool, error) {
+	if count == 0 && len(startAfter) == 0 && r.index == 0 {
+		// a whole list is read at once, as of the index of the read
+		nodes, index, err := r.helper.listEtcdNode(r.helper.Client, r.key)
+		if err != nil {
+			return nil, "", false, err
+		}
+		r.readAt(index)
+		leaves := leafNodes(nil, nodes)
+		sort.Sort(byKey(leaves))
+		return leaves, "", false, nil
+	}
+
+	walked := etcd.Nodes{}
+	done, err := r.walk(r.key, startAfter, count, &walked)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if err := r.rewind(); err != nil {
+		return nil, "", false, err
+	}
+	end := ""
+	if !done {
+		end = walked[len(walked)-1].Key
+	}
+
+	nodes := etcd.Nodes{}
+	current := map[string]bool{}
+	for _, node := range walked {
+		current[node.Key] = true
+		if previous, changed := r.previous[node.Key]; changed {
+			if previous != nil {
+				nodes = append(nodes, previous)
+			}
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	// the keys deleted since index are no longer in their directories
+	for key, previous := range r.previous {
+		if previous != nil && !current[key] && keyLess(startAfter, key) && (done || !keyLess(end, key)) {
+			nodes = append(nodes, previous)
+		}
+	}
+	sort.Sort(byKey(nodes))
+	return nodes, end, !done, nil
+}
+
+func (r *etcdKeyRange) resourceVersion() uint64 {
+	return r.index
+}
+
+// walk appends the nodes of the keys under dir after startAfter to nodes, in the order
+// of their keys, until nodes holds count of them if count is not 0. It returns false if
+// keys under dir remain.
+func (r *etcdKeyRange) walk(dir, startAfter string, count int, nodes *etcd.Nodes) (bool, error) {
+	response, err := r.helper.Client.Get(dir, true, false)
+	if err != nil {
+		if !IsEtcdNotFound(err) {
+			return false, err
+		}
+		index, _ := etcdErrorIndex(err)
+		r.readAt(index)
+		return true, nil
+	}
+	r.readAt(response.EtcdIndex)
+	for _, node := range response.Node.Nodes {
+		if !keyLess(startAfter, node.Key) && !(node.Dir && hasKey(node.Key, startAfter)) {
+			continue
+		}
+		if count > 0 && len(*nodes) >= count {
+			return false, nil
+		}
+		if !node.Dir {
+			*nodes = append(*nodes, node)
+			continue
+		}
+		if done, err := r.walk(node.Key, startAfter, count, nodes); err != nil || !done {
+			return done, err
+		}
+	}
+	return true, nil
+}
+
+func (r *etcdKeyRange) readAt(index uint64) {
+	if r.index == 0 {
+		r.index = index
+		r.rewound = index
+	}
+	if index > r.latest {
+		r.latest = index
+	}
+}
+
+// rewind records the nodes as of index of the keys changed up to the etcd index of the
+// last directory read.
+func (r *etcdKeyRange) rewind() error {
+	if r.previous == nil {
+		r.previous = map[string]*etcd.Node{}
+	}
+	for r.rewound < r.latest {
+		stop := make(chan bool)
+		timer := time.AfterFunc(listRewindTimeout, func() { close(stop) })
+		response, err := r.helper.Client.Watch("/", r.rewound+1, true, nil, stop)
+		timer.Stop()
+		switch {
+		case err == etcd.ErrWatchStoppedByUser:
+			return apierrors.NewServerTimeout(r.key, "list")
+		case isEtcdErrorNum(err, EtcdErrorCodeIndexCleared):
+			return apierrors.NewResourceExpired(fmt.Sprintf("The list of resource version %d can no longer be continued; the list must be restarted", r.index))
+		case err != nil:
+			return err
+		}
+		node := response.Node
+		r.rewound = node.ModifiedIndex
+		switch {
+		case !hasKey(r.key, node.Key) && !hasKey(node.Key, r.key):
+		case node.Dir:
+			// the nodes of a deleted directory are not in its change
+			if isDeletion(response.Action) {
+				return apierrors.NewResourceExpired(fmt.Sprintf("The list of resource version %d can no longer be continued; the list must be restarted", r.index))
+			}
+		default:
+			if _, changed := r.previous[node.Key]; !changed {
+				r.previous[node.Key] = response.PrevNode
+			}
+		}
+	}
+	return nil
+}
+
+// isDeletion returns true if action is an etcd action removing its key.
+func isDeletion(action string) bool {
+	return action == "delete" || action == "expire" || action == "compareAndDelete"
+}
+
+// keyLess returns true if the key a is met before the key b by a walk of the directories
+// of etcd, which compares the keys by their path segments.
+func keyLess(a, b string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] == '/' || b[i] == '/' {
+				return a[i] == '/'
+			}
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+// byKey sorts nodes in the order in which a walk of the directories of etcd meets their keys.
+type byKey etcd.Nodes
+
+func (n byKey) Len() int           { return len(n) }
+func (n byKey) Less(i, j int) bool { return keyLess(n[i].Key, n[j].Key) }
+func (n byKey) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+
+// listPage is the content of a continue token, which is opaque to clients.
+type listPage struct {
+	// ResourceVersion is the etcd index of the first page of the list.
+	ResourceVersion uint64 `json:"rv"`
+	// StartAfter is the key of the last object of the previous page.
+	StartAfter string `json:"start"`
+}
+
+func encodeContinueToken(page listPage) (string, error) {
+	data, err := json.Marshal(&page)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinueToken(continueToken string) (listPage, error) {
+	page := listPage{}
+	data, err := base64.URLEncoding.DecodeString(continueToken)
+	if err == nil {
+		err = json.Unmarshal(data, &page)
+	}
+	if err != nil || len(page.StartAfter) == 0 {
+		return listPage{}, apierrors.NewBadRequest(fmt.Sprintf("The continue token (%s) is not valid", continueToken))
+	}
+	return page, nil
+}
+
+// leafNodes appends the nodes of the trees of nodes which are not directories to leaves.
+func leafNodes(leaves []*etcd.Node, nodes []*etcd.Node) []*etcd.Node {
+	for _, node := range nodes {
+		if node.Dir {
+			leaves = leafNodes(leaves, node.Nodes)
+			continue
+		}
+		leaves = append(leaves, node)
+	}
+	return leaves
+}
+
+func (h *EtcdHelper) extractToList(client etcdListWatcher, key string, listObj runtime.Object) error {
 	listPtr, err := runtime.GetItemsPtr(listObj)
 	if err != nil {
 		return err
 	}
-	nodes, index, err := h.listEtcdNode(key)
+	nodes, index, err := h.listEtcdNode(client, key)
 	if err != nil {
 		return err
 	}
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/testapi"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion"
 	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
@@ -290,6 +291,116 @@ func TestExtractToListExcludesDirectories(t *testing.T) {
 	}
 }
 
+// historyEtcdClient answers the watches of single changes of / from a history of the
+// changes of etcd after the index cleared, like etcd.
+type historyEtcdClient struct {
+	*FakeEtcdClient
+	history []*etcd.Response
+	cleared uint64
+}
+
+func (c *historyEtcdClient) Watch(prefix string, waitIndex uint64, recursive bool, receiver chan *etcd.Response, stop chan bool) (*etcd.Response, error) {
+	if waitIndex <= c.cleared {
+		return nil, EtcdErrorIndexCleared
+	}
+	for _, response := range c.history {
+		if response.Node.ModifiedIndex >= waitIndex {
+			return response, nil
+		}
+	}
+	return nil, etcd.ErrWatchStoppedByUser
+}
+
+func TestExtractToListPage(t *testing.T) {
+	fakeClient := NewFakeEtcdClient(t)
+	client := &historyEtcdClient{FakeEtcdClient: fakeClient}
+	node := func(key string, index uint64) *etcd.Node {
+		return &etcd.Node{
+			Key:           "/some/key/" + key,
+			Value:         fmt.Sprintf(`{"id":%q,"kind":"Pod","apiVersion":"v1beta1"}`, key[len(key)-3:]),
+			ModifiedIndex: index,
+		}
+	}
+	ns1 := &etcd.Node{Key: "/some/key/ns1", Dir: true, Nodes: []*etcd.Node{node("ns1/foo", 1), node("ns1/bar", 2)}}
+	ns2 := &etcd.Node{Key: "/some/key/ns2", Dir: true, Nodes: []*etcd.Node{node("ns2/baz", 3)}}
+	setIndex := func(index uint64) {
+		fakeClient.Data["/some/key"] = EtcdResponseWithError{R: &etcd.Response{EtcdIndex: index, Node: &etcd.Node{Key: "/some/key", Dir: true, Nodes: []*etcd.Node{ns2, ns1}}}}
+		fakeClient.Data["/some/key/ns1"] = EtcdResponseWithError{R: &etcd.Response{EtcdIndex: index, Node: ns1}}
+		fakeClient.Data["/some/key/ns2"] = EtcdResponseWithError{R: &etcd.Response{EtcdIndex: index, Node: ns2}}
+	}
+	setIndex(10)
+	helper := NewEtcdHelper(client, testapi.Codec())
+	names := func(list *api.PodList) []string {
+		names := []string{}
+		for _, pod := range list.Items {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+
+	var got api.PodList
+	next, err := helper.ExtractToListPage("/some/key", &got, Everything, 2, "")
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"bar", "foo"}, names(&got); !reflect.DeepEqual(e, a) || got.ResourceVersion != "10" || len(next) == 0 {
+		t.Errorf("Expected %v at 10 and a continue token, got %v at %s and %q", e, a, got.ResourceVersion, next)
+	}
+
+	// the next pages hold the objects as of the resource version of the first page
+	client.history = []*etcd.Response{
+		{Action: "delete", Node: &etcd.Node{Key: "/some/key/ns2/baz", ModifiedIndex: 11}, PrevNode: node("ns2/baz", 3)},
+		{Action: "create", Node: node("ns2/qux", 12)},
+		{Action: "set", Node: &etcd.Node{Key: "/other/key", ModifiedIndex: 13}},
+	}
+	ns2.Nodes = []*etcd.Node{node("ns2/qux", 12)}
+	setIndex(13)
+	got = api.PodList{}
+	last, err := helper.ExtractToListPage("/some/key", &got, Everything, 2, next)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"baz"}, names(&got); !reflect.DeepEqual(e, a) || got.ResourceVersion != "10" || len(last) != 0 {
+		t.Errorf("Expected %v at 10 and no continue token, got %v at %s and %q", e, a, got.ResourceVersion, last)
+	}
+
+	// the continue tokens expire with the changes etcd keeps
+	client.cleared = 10
+	if _, err := helper.ExtractToListPage("/some/key", &got, Everything, 2, next); !apierrors.IsResourceExpired(err) {
+		t.Errorf("Expected an expired error for an expired token, got %v", err)
+	}
+
+	// the pages are filled with the objects filter accepts
+	got = api.PodList{}
+	filter := func(obj runtime.Object) bool { return obj.(*api.Pod).Name != "foo" }
+	if next, err = helper.ExtractToListPage("/some/key", &got, filter, 2, ""); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := []string{"bar", "qux"}, names(&got); !reflect.DeepEqual(e, a) || got.ResourceVersion != "13" || len(next) != 0 {
+		t.Errorf("Expected %v at 13 and no continue token, got %v at %s and %q", e, a, got.ResourceVersion, next)
+	}
+
+	got = api.PodList{}
+	if next, err = helper.ExtractToListPage("/some/key", &got, Everything, 0, ""); err != nil || len(got.Items) != 3 || got.ResourceVersion != "13" || len(next) != 0 {
+		t.Errorf("Expected the whole list without a limit, got %#v %q %v", got, next, err)
+	}
+	if _, err := helper.ExtractToListPage("/some/key", &got, Everything, 2, "invalid"); !apierrors.IsBadRequest(err) {
+		t.Errorf("Expected a bad request error for an invalid token, got %v", err)
+	}
+}
+
+func TestKeyLess(t *testing.T) {
+	// the keys of a directory come before those of its siblings
+	keys := []string{"/a", "/a/b", "/a/b/c", "/a/c", "/a-b", "/a-b/c", "/ab"}
+	for i := range keys {
+		for j := range keys {
+			if e, a := i < j, keyLess(keys[i], keys[j]); e != a {
+				t.Errorf("Expected keyLess(%q, %q) to be %t", keys[i], keys[j], e)
+			}
+		}
+	}
+}
+
 func TestExtractObj(t *testing.T) {
 	fakeClient := NewFakeEtcdClient(t)
 	expect := api.Pod{
@@ -462,7 +573,7 @@ func TestSetObjWithVersion(t *testing.T) {
 func TestSetObjWithoutResourceVersioner(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
-	helper := EtcdHelper{fakeClient, testapi.Codec(), nil}
+	helper := EtcdHelper{fakeClient, testapi.Codec(), nil, nil}
 	returnedObj := &api.Pod{}
 	err := helper.SetObj("/some/key", obj, returnedObj, 3)
 	if err != nil {
@@ -488,7 +599,7 @@ func TestSetObjWithoutResourceVersioner(t *testing.T) {
 func TestSetObjNilOutParam(t *testing.T) {
 	obj := &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}
 	fakeClient := NewFakeEtcdClient(t)
-	helper := EtcdHelper{fakeClient, testapi.Codec(), nil}
+	helper := EtcdHelper{fakeClient, testapi.Codec(), nil, nil}
 	err := helper.SetObj("/some/key", obj, nil, 3)
 	if err != nil {
 		t.Errorf("Unexpected error %#v", err)
@@ -60,10 +60,15 @@ func ParseWatchResourceVersion(resourceVersion, kind string) (uint64, error) {
 // WatchList begins watching the specified key's items. Items are decoded into
 // API objects, and any items passing 'filter' are sent down the returned
 // watch.Interface. resourceVersion may be used to specify what version to begin
-// watching (e.g., for reconnecting without missing any updates).
+// watching (e.g., for reconnecting without missing any updates). The watch is
+// served by the watch cache if it holds key.
 func (h *EtcdHelper) WatchList(key string, resourceVersion uint64, filter FilterFunc) (watch.Interface, error) {
 	w := newEtcdWatcher(true, exceptKey(key), filter, h.Codec, h.Versioner, nil)
-	go w.etcdWatch(h.Client, key, resourceVersion)
+	var client etcdListWatcher = h.Client
+	if cache := h.WatchCache.clientFor(key); cache != nil {
+		client = cache
+	}
+	go w.etcdWatch(client, key, resourceVersion)
 	return w, nil
 }
 
@@ -159,7 +164,7 @@ func newEtcdWatcher(list bool, include includeFunc, filter FilterFunc, encoding
 
 // etcdWatch calls etcd's Watch function, and handles any errors. Meant to be called
 // as a goroutine.
-func (w *etcdWatcher) etcdWatch(client EtcdGetSet, key string, resourceVersion uint64) {
+func (w *etcdWatcher) etcdWatch(client etcdListWatcher, key string, resourceVersion uint64) {
 	defer util.HandleCrash()
 	defer close(w.etcdError)
 	if resourceVersion == 0 {
@@ -177,7 +182,7 @@ func (w *etcdWatcher) etcdWatch(client EtcdGetSet, key string, resourceVersion u
 }
 
 // etcdGetInitialWatchState turns an etcd Get request into a watch equivalent
-func etcdGetInitialWatchState(client EtcdGetSet, key string, recursive bool, incoming chan<- *etcd.Response) (resourceVersion uint64, err error) {
+func etcdGetInitialWatchState(client etcdListWatcher, key string, recursive bool, incoming chan<- *etcd.Response) (resourceVersion uint64, err error) {
 	resp, err := client.Get(key, false, recursive)
 	if err != nil {
 		if !IsEtcdNotFound(err) {
@@ -381,7 +386,7 @@ func (w *etcdWatcher) sendResult(res *etcd.Response) {
 		w.sendAdd(res)
 	case "set", "compareAndSwap":
 		w.sendModify(res)
-	case "delete":
+	case "delete", "expire":
 		w.sendDelete(res)
 	default:
 		glog.Errorf("unknown action: %v", res.Action)
@@ -26,6 +26,7 @@ const (
 	EtcdErrorCodeTestFailed    = 101
 	EtcdErrorCodeNodeExist     = 105
 	EtcdErrorCodeValueRequired = 200
+	EtcdErrorCodeIndexCleared  = 401
 )
 
 var (
@@ -33,6 +34,7 @@ var (
 	EtcdErrorTestFailed    = &etcd.EtcdError{ErrorCode: EtcdErrorCodeTestFailed}
 	EtcdErrorNodeExist     = &etcd.EtcdError{ErrorCode: EtcdErrorCodeNodeExist}
 	EtcdErrorValueRequired = &etcd.EtcdError{ErrorCode: EtcdErrorCodeValueRequired}
+	EtcdErrorIndexCleared  = &etcd.EtcdError{ErrorCode: EtcdErrorCodeIndexCleared}
 )
 
 // EtcdClient is an injectable interface for testing.
@@ -0,0 +1,406 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+
+	"github.com/coreos/go-etcd/etcd"
+	"github.com/golang/glog"
+)
+
+// cacheWatchBufferSize is the number of events buffered for each watch served by a
+// WatchCache. The watches which fall further behind are closed, for their clients
+// to watch again from the last version they received.
+const cacheWatchBufferSize = 100
+
+// WatchCache serves the watches and lists of some keys of etcd from memory. For each
+// key, it keeps the nodes stored under the key and a window of their most recent
+// changes, kept up to date by a single etcd watch. The watches from 0 or from a
+// resource version in the window, and the lists which accept any resource version,
+// are served from memory; the watches from older versions are passed to etcd.
+type WatchCache struct {
+	caches []*keyCache
+}
+
+// NewWatchCache returns a cache of the given keys of client, which keeps the last
+// capacity changes of each key. Run must be called to fill it.
+func NewWatchCache(client EtcdGetSet, capacity int, keys ...string) *WatchCache {
+	c := &WatchCache{}
+	for _, key := range keys {
+		c.caches = append(c.caches, &keyCache{
+			client:   client,
+			key:      strings.TrimSuffix(key, "/"),
+			events:   make([]*etcd.Response, capacity),
+			watchers: map[*cacheWatcher]bool{},
+		})
+	}
+	return c
+}
+
+// Run starts to list and watch the keys of the cache, and never stops.
+func (c *WatchCache) Run() {
+	c.RunUntil(make(chan struct{}))
+}
+
+// RunUntil starts to list and watch the keys of the cache, until stopCh is closed.
+func (c *WatchCache) RunUntil(stopCh <-chan struct{}) {
+	for _, cache := range c.caches {
+		cache := cache
+		go util.Until(func() { cache.listAndWatch(stopCh) }, time.Second, stopCh)
+	}
+}
+
+// clientFor returns the cache holding key, or nil if key is not cached.
+func (c *WatchCache) clientFor(key string) etcdListWatcher {
+	if c == nil {
+		return nil
+	}
+	key = strings.TrimSuffix(key, "/")
+	for _, cache := range c.caches {
+		if key == cache.key || strings.HasPrefix(key, cache.key+"/") {
+			return cache
+		}
+	}
+	return nil
+}
+
+// keyCache caches the nodes under a key of etcd. It answers the recursive gets and
+// watches of the key, or of the keys under it, like etcd.
+type keyCache struct {
+	client EtcdGetSet
+	key    string
+
+	lock sync.Mutex
+	// ready is true when nodes holds the nodes of the key as of index.
+	ready bool
+	// nodes holds the nodes under key by key.
+	nodes map[string]*etcd.Node
+	// index is the etcd index of the last change, or of the list of the nodes.
+	index uint64
+	// events is a cyclic buffer of the most recent changes, of which the oldest one
+	// is events[start]. All the changes after the index oldest are in the buffer.
+	events []*etcd.Response
+	start  int
+	count  int
+	oldest uint64
+	// watchers are the watches served by the cache.
+	watchers map[*cacheWatcher]bool
+}
+
+// cacheWatcher is a watch of the keys under prefix served by a keyCache.
+type cacheWatcher struct {
+	prefix string
+	input  chan *etcd.Response
+}
+
+// etcdListWatcher is the part of an etcd client used by lists and watches, which
+// keyCache implements.
+type etcdListWatcher interface {
+	Get(key string, sort, recursive bool) (*etcd.Response, error)
+	Watch(prefix string, waitIndex uint64, recursive bool, receiver chan *etcd.Response, stop chan bool) (*etcd.Response, error)
+}
+
+// listAndWatch lists the nodes under the key, and updates the cache with the changes
+// of the key until the etcd watch ends or stopCh is closed.
+func (c *keyCache) listAndWatch(stopCh <-chan struct{}) {
+	defer c.reset()
+	response, err := c.client.Get(c.key, false, true)
+	nodes := map[string]*etcd.Node{}
+	index := uint64(0)
+	switch {
+	case err == nil:
+		index = response.EtcdIndex
+		addNodes(nodes, response.Node)
+	case IsEtcdNotFound(err):
+		index, _ = etcdErrorIndex(err)
+	default:
+		glog.Errorf("Unable to list %s for the watch cache: %v", c.key, err)
+		return
+	}
+	c.replace(nodes, index)
+
+	receiver := make(chan *etcd.Response)
+	stop := make(chan bool)
+	done := make(chan error, 1)
+	go func() {
+		defer util.HandleCrash()
+		_, err := c.client.Watch(c.key, index+1, true, receiver, stop)
+		done <- err
+	}()
+	for {
+		select {
+		case response, ok := <-receiver:
+			if !ok {
+				// wait for the error ending the watch
+				receiver = nil
+				continue
+			}
+			c.add(response)
+		case err := <-done:
+			if err != nil && err != etcd.ErrWatchStoppedByUser {
+				glog.Errorf("The watch of %s for the watch cache ended: %v", c.key, err)
+			}
+			return
+		case <-stopCh:
+			close(stop)
+			stopCh = nil
+		}
+	}
+}
+
+// addNodes adds the leaves of the tree of node to nodes.
+func addNodes(nodes map[string]*etcd.Node, node *etcd.Node) {
+	if node == nil {
+		return
+	}
+	if !node.Dir {
+		nodes[node.Key] = node
+		return
+	}
+	for _, child := range node.Nodes {
+		addNodes(nodes, child)
+	}
+}
+
+// replace sets the nodes under the key as of index, and closes the watches, which may
+// have missed changes.
+func (c *keyCache) replace(nodes map[string]*etcd.Node, index uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ready = true
+	c.nodes = nodes
+	c.index = index
+	c.start, c.count, c.oldest = 0, 0, index
+	c.closeWatchers()
+}
+
+// reset empties the cache, whose requests go to etcd until it is filled again.
+func (c *keyCache) reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ready = false
+	c.nodes = nil
+	c.start, c.count = 0, 0
+	c.closeWatchers()
+}
+
+// closeWatchers closes the watches served by the cache. It must be called with the lock held.
+func (c *keyCache) closeWatchers() {
+	for watcher := range c.watchers {
+		close(watcher.input)
+		delete(c.watchers, watcher)
+	}
+}
+
+// add updates the cache with a change of the key, and sends it to the watches of its key.
+func (c *keyCache) add(response *etcd.Response) {
+	if response.Node == nil {
+		glog.Errorf("Unexpected nil node in the watch of %s: %#v", c.key, response)
+		return
+	}
+	key := response.Node.Key
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	switch response.Action {
+	case "delete", "expire", "compareAndDelete":
+		for nodeKey := range c.nodes {
+			if nodeKey == key || strings.HasPrefix(nodeKey, key+"/") {
+				delete(c.nodes, nodeKey)
+			}
+		}
+	default:
+		if !response.Node.Dir {
+			c.nodes[key] = response.Node
+		}
+	}
+	c.index = response.Node.ModifiedIndex
+
+	if len(c.events) > 0 {
+		if c.count == len(c.events) {
+			c.oldest = c.events[c.start].Node.ModifiedIndex
+			c.start = (c.start + 1) % len(c.events)
+			c.count--
+		}
+		c.events[(c.start+c.count)%len(c.events)] = response
+		c.count++
+	} else {
+		c.oldest = c.index
+	}
+
+	for watcher := range c.watchers {
+		if !hasKey(watcher.prefix, key) {
+			continue
+		}
+		select {
+		case watcher.input <- response:
+		default:
+			glog.V(2).Infof("Closing a watch of %s which fell behind the watch cache", watcher.prefix)
+			close(watcher.input)
+			delete(c.watchers, watcher)
+		}
+	}
+}
+
+// hasKey returns true if key is prefix, or a key under prefix.
+func hasKey(prefix, key string) bool {
+	return key == prefix || strings.HasPrefix(key, prefix+"/")
+}
+
+// Get returns the nodes under key, like a recursive get of etcd, or a not found error if
+// no node is under key. The get goes to etcd if it is not recursive, or if the cache is
+// not filled.
+func (c *keyCache) Get(key string, sorted, recursive bool) (*etcd.Response, error) {
+	c.lock.Lock()
+	if !c.ready || !recursive {
+		c.lock.Unlock()
+		return c.client.Get(key, sorted, recursive)
+	}
+	defer c.lock.Unlock()
+	key = strings.TrimSuffix(key, "/")
+	nodes := etcd.Nodes{}
+	for nodeKey, node := range c.nodes {
+		if hasKey(key, nodeKey) {
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, &etcd.EtcdError{ErrorCode: EtcdErrorCodeNotFound, Message: "Key not found", Cause: key, Index: c.index}
+	}
+	sort.Sort(nodes)
+	return &etcd.Response{
+		Action:    "get",
+		Node:      &etcd.Node{Key: key, Dir: true, Nodes: nodes},
+		EtcdIndex: c.index,
+	}, nil
+}
+
+// listAt returns the nodes under key as of index, or as of the last change if index is
+// 0, sorted by key, and their index. It returns false if the cache is not filled, or does
+// not hold the changes since index.
+func (c *keyCache) listAt(key string, index uint64) (etcd.Nodes, uint64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if index == 0 {
+		index = c.index
+	}
+	if !c.ready || index < c.oldest || index > c.index {
+		return nil, 0, false
+	}
+	key = strings.TrimSuffix(key, "/")
+	nodes := map[string]*etcd.Node{}
+	for nodeKey, node := range c.nodes {
+		if hasKey(key, nodeKey) {
+			nodes[nodeKey] = node
+		}
+	}
+	// undo the changes since index, from the last one
+	for i := c.count - 1; i >= 0; i-- {
+		response := c.events[(c.start+i)%len(c.events)]
+		node := response.Node
+		if node.ModifiedIndex <= index {
+			break
+		}
+		switch {
+		case !hasKey(key, node.Key) && !hasKey(node.Key, key):
+		case node.Dir:
+			// the nodes of a deleted directory are not in its change
+			if isDeletion(response.Action) {
+				return nil, 0, false
+			}
+		case response.PrevNode != nil:
+			nodes[node.Key] = response.PrevNode
+		default:
+			delete(nodes, node.Key)
+		}
+	}
+	sorted := etcd.Nodes{}
+	for _, node := range nodes {
+		sorted = append(sorted, node)
+	}
+	sort.Sort(byKey(sorted))
+	return sorted, index, true
+}
+
+// Watch sends the changes of the keys under prefix since waitIndex to receiver,
+// until stop is closed or receives a value, like a recursive watch of etcd. The watch
+// goes to etcd if it is not recursive, if the cache is not filled, or if the changes
+// since waitIndex are no longer in the cache. It ends without error if the watch falls behind the cache.
+func (c *keyCache) Watch(prefix string, waitIndex uint64, recursive bool, receiver chan *etcd.Response, stop chan bool) (*etcd.Response, error) {
+	c.lock.Lock()
+	if !c.ready || !recursive || waitIndex != 0 && waitIndex <= c.oldest {
+		c.lock.Unlock()
+		return c.client.Watch(prefix, waitIndex, recursive, receiver, stop)
+	}
+	if waitIndex == 0 {
+		waitIndex = c.index + 1
+	}
+	prefix = strings.TrimSuffix(prefix, "/")
+	backlog := []*etcd.Response{}
+	for i := 0; i < c.count; i++ {
+		response := c.events[(c.start+i)%len(c.events)]
+		if response.Node.ModifiedIndex >= waitIndex && hasKey(prefix, response.Node.Key) {
+			backlog = append(backlog, response)
+		}
+	}
+	watcher := &cacheWatcher{prefix: prefix, input: make(chan *etcd.Response, cacheWatchBufferSize)}
+	c.watchers[watcher] = true
+	c.lock.Unlock()
+
+	defer close(receiver)
+	for _, response := range backlog {
+		select {
+		case receiver <- response:
+		case <-stop:
+			c.forget(watcher)
+			return nil, etcd.ErrWatchStoppedByUser
+		}
+	}
+	for {
+		select {
+		case response, ok := <-watcher.input:
+			if !ok {
+				return nil, nil
+			}
+			select {
+			case receiver <- response:
+			case <-stop:
+				c.forget(watcher)
+				return nil, etcd.ErrWatchStoppedByUser
+			}
+		case <-stop:
+			c.forget(watcher)
+			return nil, etcd.ErrWatchStoppedByUser
+		}
+	}
+}
+
+// forget stops sending changes to watcher.
+func (c *keyCache) forget(watcher *cacheWatcher) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.watchers[watcher] {
+		close(watcher.input)
+		delete(c.watchers, watcher)
+	}
+}
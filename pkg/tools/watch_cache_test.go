@@ -0,0 +1,227 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tools
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/latest"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/wait"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+	"github.com/coreos/go-etcd/etcd"
+)
+
+// newTestWatchCache returns a cache of /pods holding a pod in each of the namespaces
+// ns1 and ns2 as of index 10, which keeps the last 3 changes.
+func newTestWatchCache(t *testing.T) (*FakeEtcdClient, *WatchCache, EtcdHelper, chan struct{}) {
+	fakeClient := NewFakeEtcdClient(t)
+	fakeClient.Data["/pods"] = EtcdResponseWithError{
+		R: &etcd.Response{
+			EtcdIndex: 10,
+			Node: &etcd.Node{
+				Key: "/pods",
+				Dir: true,
+				Nodes: []*etcd.Node{
+					{Key: "/pods/ns1", Dir: true, Nodes: []*etcd.Node{testPodNode(t, "/pods/ns1/foo", 5)}},
+					{Key: "/pods/ns2", Dir: true, Nodes: []*etcd.Node{testPodNode(t, "/pods/ns2/bar", 7)}},
+				},
+			},
+		},
+	}
+	cache := NewWatchCache(fakeClient, 3, "/pods")
+	stopCh := make(chan struct{})
+	cache.RunUntil(stopCh)
+	fakeClient.WaitForWatchCompletion()
+	if fakeClient.WatchIndex != 11 {
+		t.Fatalf("expected the cache to watch from 11, got %d", fakeClient.WatchIndex)
+	}
+	return fakeClient, cache, EtcdHelper{fakeClient, latest.Codec, versioner, cache}, stopCh
+}
+
+func testPodNode(t *testing.T, key string, index uint64) *etcd.Node {
+	name := key[len(key)-3:]
+	data, err := latest.Codec.Encode(&api.Pod{ObjectMeta: api.ObjectMeta{Name: name}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &etcd.Node{Key: key, Value: string(data), ModifiedIndex: index, CreatedIndex: index}
+}
+
+// sendChange sends a change of the pod of key to the etcd watch of the cache, and waits
+// for the cache to hold it.
+func sendChange(t *testing.T, fakeClient *FakeEtcdClient, cache *WatchCache, action, key string, index uint64) {
+	response := &etcd.Response{Action: action, Node: testPodNode(t, key, index)}
+	if action == "delete" {
+		response.PrevNode, response.Node = response.Node, &etcd.Node{Key: key, ModifiedIndex: index}
+	}
+	fakeClient.WatchResponse <- response
+	c := cache.clientFor(key).(*keyCache)
+	err := wait.Poll(time.Millisecond, time.Second, func() (bool, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return c.index == index, nil
+	})
+	if err != nil {
+		t.Fatalf("the cache did not receive the change %d of %s", index, key)
+	}
+}
+
+func podNames(t *testing.T, list *api.PodList) []string {
+	names := []string{}
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestWatchCacheList(t *testing.T) {
+	fakeClient, cache, helper, stopCh := newTestWatchCache(t)
+	defer close(stopCh)
+
+	list := api.PodList{}
+	next, err := helper.ExtractCachedToListPage("/pods", &list, Everything, 1, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := podNames(t, &list); len(names) != 1 || names[0] != "foo" || list.ResourceVersion != "10" || len(next) == 0 {
+		t.Errorf("unexpected first page: %v %s %q", names, list.ResourceVersion, next)
+	}
+
+	// the next pages hold the pods as of the first page
+	sendChange(t, fakeClient, cache, "create", "/pods/ns1/baz", 11)
+	sendChange(t, fakeClient, cache, "delete", "/pods/ns2/bar", 12)
+	list = api.PodList{}
+	if next, err = helper.ExtractCachedToListPage("/pods", &list, Everything, 1, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := podNames(t, &list); len(names) != 1 || names[0] != "bar" || list.ResourceVersion != "10" || len(next) != 0 {
+		t.Errorf("unexpected last page: %v %s %q", names, list.ResourceVersion, next)
+	}
+
+	sendChange(t, fakeClient, cache, "delete", "/pods/ns1/foo", 13)
+	// etcd would answer the lists of /pods/ns1 with an error
+	fakeClient.Data["/pods/ns1"] = EtcdResponseWithError{R: &etcd.Response{}, E: fakeClient.NewError(EtcdErrorCodeNotFound)}
+	list = api.PodList{}
+	if _, err := helper.ExtractCachedToListPage("/pods/ns1", &list, Everything, 1, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := podNames(t, &list); len(names) != 1 || names[0] != "baz" || list.ResourceVersion != "13" {
+		t.Errorf("unexpected list: %v %s", names, list.ResourceVersion)
+	}
+	list = api.PodList{}
+	if err := helper.ExtractToList("/pods/ns1", &list); err != nil || len(list.Items) != 0 {
+		t.Errorf("expected lists which are not cached to go to etcd, got %#v %v", list, err)
+	}
+}
+
+func TestWatchCacheGetNotFound(t *testing.T) {
+	_, cache, _, stopCh := newTestWatchCache(t)
+	defer close(stopCh)
+
+	if _, err := cache.clientFor("/pods/ns1/bar").Get("/pods/ns1/bar", false, true); !IsEtcdNotFound(err) {
+		t.Errorf("expected a not found error for a key without nodes, got %v", err)
+	}
+	if response, err := cache.clientFor("/pods/ns1/foo").Get("/pods/ns1/foo", false, true); err != nil || len(response.Node.Nodes) != 1 {
+		t.Errorf("expected the node of the key, got %#v %v", response, err)
+	}
+}
+
+func TestWatchCacheWatchNotReady(t *testing.T) {
+	fakeClient := NewFakeEtcdClient(t)
+	// the cache was reset after changes up to 20
+	cache := &keyCache{client: fakeClient, key: "/pods", index: 20, watchers: map[*cacheWatcher]bool{}}
+	stop := make(chan bool)
+	go cache.Watch("/pods", 0, true, make(chan *etcd.Response), stop)
+	fakeClient.WaitForWatchCompletion()
+	if fakeClient.WatchIndex != 0 {
+		t.Errorf("expected the watch to go to etcd from now, got %d", fakeClient.WatchIndex)
+	}
+	close(stop)
+}
+
+func TestWatchCacheWatch(t *testing.T) {
+	fakeClient, cache, helper, stopCh := newTestWatchCache(t)
+	defer close(stopCh)
+
+	for i, name := range []string{"aaa", "bbb", "ccc", "ddd"} {
+		sendChange(t, fakeClient, cache, "create", "/pods/ns1/"+name, uint64(11+i))
+	}
+	watching, err := helper.WatchList("/pods", 13, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watching.Stop()
+	sendChange(t, fakeClient, cache, "delete", "/pods/ns1/aaa", 15)
+	for _, expected := range []struct {
+		eventType watch.EventType
+		name      string
+		version   string
+	}{
+		{watch.Added, "ccc", "13"},
+		{watch.Added, "ddd", "14"},
+		{watch.Deleted, "aaa", "15"},
+	} {
+		event := <-watching.ResultChan()
+		pod := event.Object.(*api.Pod)
+		if event.Type != expected.eventType || pod.Name != expected.name || pod.ResourceVersion != expected.version {
+			t.Errorf("expected %v, got %s %s %s", expected, event.Type, pod.Name, pod.ResourceVersion)
+		}
+	}
+
+	watching, err = helper.WatchList("/pods/ns2", 0, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer watching.Stop()
+	event := <-watching.ResultChan()
+	if pod := event.Object.(*api.Pod); event.Type != watch.Added || pod.Name != "bar" {
+		t.Errorf("unexpected event: %#v", event)
+	}
+
+	// the changes since 12 are no longer in the cache
+	if _, err := helper.WatchList("/pods", 12, Everything); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fakeClient.WaitForWatchCompletion()
+	if fakeClient.WatchIndex != 12 {
+		t.Errorf("expected the watch to go to etcd from 12, got %d", fakeClient.WatchIndex)
+	}
+}
+
+func TestWatchCacheSlowWatcher(t *testing.T) {
+	fakeClient, cache, helper, stopCh := newTestWatchCache(t)
+	defer close(stopCh)
+
+	watching, err := helper.WatchList("/pods/ns1", 11, Everything)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changes := 2*cacheWatchBufferSize + 1
+	for i := 0; i < changes; i++ {
+		sendChange(t, fakeClient, cache, "create", "/pods/ns1/"+strconv.Itoa(100+i), uint64(11+i))
+	}
+	received := 0
+	for range watching.ResultChan() {
+		received++
+	}
+	if received == 0 || received >= changes {
+		t.Errorf("expected the watch to be closed after some changes, got %d changes", received)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package strategicpatch applies strategic merge patches to the JSON of API objects.
+//
+// A strategic merge patch is a JSON merge patch (RFC 7386), except for the lists
+// whose field in the Go type of the object is tagged with patchStrategy:"merge":
+// instead of being replaced, these lists are merged with the list of the patch.
+// Lists of maps are merged by the key named by the patchMergeKey tag of the field,
+// for example:
+//
+//	Containers []Container `json:"containers" patchStrategy:"merge" patchMergeKey:"name"`
+//
+// The items of the patch which have the same key as an item of the original list are
+// merged into it, the others are appended. Lists of primitives are merged as sets.
+//
+// The patch may hold the following directives, in a "$patch" key:
+//   - {"$patch": "delete", "name": "foo"} in a merged list deletes the item of key foo.
+//   - {"$patch": "replace"} in a list replaces the original list with the other items
+//     of the patch.
+//   - {"$patch": "replace", ...} in a map replaces the original map with the patch.
+//   - {"$patch": "delete"} as the value of a field deletes the field.
+package strategicpatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	directiveMarker  = "$patch"
+	deleteDirective  = "delete"
+	replaceDirective = "replace"
+
+	mergeStrategy = "merge"
+)
+
+// StrategicMergePatch applies patch to original, which are both the JSON of an object
+// of the type of dataStruct, and returns the JSON of the patched object.
+func StrategicMergePatch(original, patch []byte, dataStruct interface{}) ([]byte, error) {
+	originalMap := map[string]interface{}{}
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &originalMap); err != nil {
+			return nil, fmt.Errorf("unable to decode the original object: %v", err)
+		}
+	}
+	patchMap := map[string]interface{}{}
+	if err := json.Unmarshal(patch, &patchMap); err != nil {
+		return nil, fmt.Errorf("unable to decode the patch: %v", err)
+	}
+
+	t := reflect.TypeOf(dataStruct)
+	result, deleted, err := mergeMap(originalMap, patchMap, t)
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		return nil, fmt.Errorf("the patch may not delete the whole object")
+	}
+	return json.Marshal(result)
+}
+
+// mergeMap merges patch into original, the JSON of a value of type t, and returns
+// true if the patch deletes the map.
+func mergeMap(original, patch map[string]interface{}, t reflect.Type) (map[string]interface{}, bool, error) {
+	if directive, found := patch[directiveMarker]; found {
+		switch directive {
+		case replaceDirective:
+			delete(patch, directiveMarker)
+			return mergeMap(map[string]interface{}{}, patch, t)
+		case deleteDirective:
+			return nil, true, nil
+		default:
+			return nil, false, fmt.Errorf("unknown patch directive %v in a map", directive)
+		}
+	}
+
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(original, key)
+			continue
+		}
+		fieldType, strategy, mergeKey, err := lookupField(t, key)
+		if err != nil {
+			return nil, false, err
+		}
+		originalValue := original[key]
+
+		switch patchValue := patchValue.(type) {
+		case map[string]interface{}:
+			originalMap, ok := originalValue.(map[string]interface{})
+			if !ok {
+				originalMap = map[string]interface{}{}
+			}
+			merged, deleted, err := mergeMap(originalMap, patchValue, fieldType)
+			if err != nil {
+				return nil, false, err
+			}
+			if deleted {
+				delete(original, key)
+			} else {
+				original[key] = merged
+			}
+		case []interface{}:
+			if strategy != mergeStrategy {
+				original[key] = patchValue
+				continue
+			}
+			originalList, ok := originalValue.([]interface{})
+			if !ok {
+				originalList = []interface{}{}
+			}
+			merged, err := mergeList(originalList, patchValue, fieldType, mergeKey)
+			if err != nil {
+				return nil, false, err
+			}
+			original[key] = merged
+		default:
+			original[key] = patchValue
+		}
+	}
+	return original, false, nil
+}
+
+// mergeList merges patch into original, the JSON of a list of type t, whose maps are
+// merged by mergeKey.
+func mergeList(original, patch []interface{}, t reflect.Type, mergeKey string) ([]interface{}, error) {
+	elemType := elem(t)
+	items := []interface{}{}
+	for _, item := range patch {
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			if directive, found := itemMap[directiveMarker]; found && directive == replaceDirective && len(itemMap) == 1 {
+				original = []interface{}{}
+				continue
+			}
+		}
+		items = append(items, item)
+	}
+
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			// a list of primitives is merged as a set
+			found := false
+			for _, originalItem := range original {
+				if reflect.DeepEqual(originalItem, item) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				original = append(original, item)
+			}
+			continue
+		}
+
+		if len(mergeKey) == 0 {
+			merged, _, err := mergeMap(map[string]interface{}{}, itemMap, elemType)
+			if err != nil {
+				return nil, err
+			}
+			original = append(original, merged)
+			continue
+		}
+		keyValue, found := itemMap[mergeKey]
+		if !found {
+			return nil, fmt.Errorf("map in list does not contain the merge key %q: %v", mergeKey, itemMap)
+		}
+		index := -1
+		for i, originalItem := range original {
+			if originalMap, ok := originalItem.(map[string]interface{}); ok && reflect.DeepEqual(originalMap[mergeKey], keyValue) {
+				index = i
+				break
+			}
+		}
+
+		if directive, found := itemMap[directiveMarker]; found && directive == deleteDirective {
+			if index >= 0 {
+				original = append(original[:index], original[index+1:]...)
+			}
+			continue
+		}
+		originalMap := map[string]interface{}{}
+		if index >= 0 {
+			originalMap = original[index].(map[string]interface{})
+		}
+		merged, deleted, err := mergeMap(originalMap, itemMap, elemType)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case deleted:
+			if index >= 0 {
+				original = append(original[:index], original[index+1:]...)
+			}
+		case index >= 0:
+			original[index] = merged
+		default:
+			original = append(original, merged)
+		}
+	}
+	return original, nil
+}
+
+// lookupField returns the type of the field of t whose JSON name is name, along with
+// its patch strategy and merge key. The type is nil if t is not known.
+func lookupField(t reflect.Type, name string) (reflect.Type, string, string, error) {
+	t = elem(t)
+	if t == nil {
+		return nil, "", "", nil
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem(), "", "", nil
+	case reflect.Struct:
+	default:
+		return nil, "", "", nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+		if len(jsonName) == 0 && field.Anonymous {
+			fieldType, strategy, mergeKey, err := lookupField(field.Type, name)
+			if err != nil || fieldType != nil {
+				return fieldType, strategy, mergeKey, err
+			}
+			continue
+		}
+		if len(jsonName) == 0 {
+			jsonName = field.Name
+		}
+		if jsonName != name {
+			continue
+		}
+		strategy := field.Tag.Get("patchStrategy")
+		mergeKey := field.Tag.Get("patchMergeKey")
+		if strategy == mergeStrategy && len(mergeKey) == 0 && elem(field.Type).Kind() == reflect.Struct {
+			return nil, "", "", fmt.Errorf("field %s of %s is merged without a merge key", field.Name, t)
+		}
+		return field.Type, strategy, mergeKey, nil
+	}
+	return nil, "", "", nil
+}
+
+// elem returns the type pointed to by t, or the type of the items of t for lists.
+func elem(t reflect.Type) reflect.Type {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	return t
+}
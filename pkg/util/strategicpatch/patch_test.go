@@ -0,0 +1,167 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package strategicpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type testMeta struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type testPort struct {
+	ContainerPort int    `json:"containerPort"`
+	Protocol      string `json:"protocol,omitempty"`
+}
+
+type testContainer struct {
+	Name    string     `json:"name"`
+	Image   string     `json:"image,omitempty"`
+	Command []string   `json:"command,omitempty"`
+	Ports   []testPort `json:"ports,omitempty" patchStrategy:"merge" patchMergeKey:"containerPort"`
+}
+
+type testObject struct {
+	testMeta   `json:",inline"`
+	Containers []testContainer `json:"containers,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+	Finalizers []string        `json:"finalizers,omitempty" patchStrategy:"merge"`
+	Args       []string        `json:"args,omitempty"`
+}
+
+func TestStrategicMergePatch(t *testing.T) {
+	original := `{
+		"name": "pod",
+		"labels": {"app": "web", "tier": "frontend"},
+		"containers": [
+			{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+			{"name": "log", "image": "fluentd"}
+		],
+		"finalizers": ["a"],
+		"args": ["-v", "-x"]
+	}`
+
+	tests := []struct {
+		patch    string
+		expected string
+		test     string
+	}{
+		{
+			patch: `{"containers": [{"name": "web", "image": "nginx:1.9"}]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "web", "image": "nginx:1.9", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+				{"name": "log", "image": "fluentd"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "merge an item of a list by key",
+		},
+		{
+			patch: `{"containers": [{"name": "proxy", "image": "haproxy"}]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+				{"name": "log", "image": "fluentd"},
+				{"name": "proxy", "image": "haproxy"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "append a new item to a list",
+		},
+		{
+			patch: `{"containers": [{"name": "log", "$patch": "delete"}]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "delete an item of a list",
+		},
+		{
+			patch: `{"containers": [{"name": "web", "ports": [{"containerPort": 443, "$patch": "delete"}, {"containerPort": 80, "protocol": "TCP"}], "command": ["httpd"]}]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["httpd"], "ports": [{"containerPort": 80, "protocol": "TCP"}]},
+				{"name": "log", "image": "fluentd"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "merge nested lists, and replace the lists without a patch strategy",
+		},
+		{
+			patch: `{"containers": [{"$patch": "replace"}, {"name": "proxy", "image": "haproxy"}]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "proxy", "image": "haproxy"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "replace a list",
+		},
+		{
+			patch: `{"finalizers": ["b", "a"], "args": ["-q"]}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "tier": "frontend"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+				{"name": "log", "image": "fluentd"}
+			], "finalizers": ["a", "b"], "args": ["-q"]}`,
+			test: "merge a list of primitives as a set",
+		},
+		{
+			patch: `{"labels": {"tier": null, "track": "stable"}}`,
+			expected: `{"name": "pod", "labels": {"app": "web", "track": "stable"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+				{"name": "log", "image": "fluentd"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "merge a map, deleting a null key",
+		},
+		{
+			patch: `{"labels": {"$patch": "replace", "app": "api"}}`,
+			expected: `{"name": "pod", "labels": {"app": "api"}, "containers": [
+				{"name": "web", "image": "nginx:1.7", "command": ["nginx"], "ports": [{"containerPort": 80}, {"containerPort": 443}]},
+				{"name": "log", "image": "fluentd"}
+			], "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test: "replace a map",
+		},
+		{
+			patch:    `{"labels": {"$patch": "delete"}, "containers": null}`,
+			expected: `{"name": "pod", "finalizers": ["a"], "args": ["-v", "-x"]}`,
+			test:     "delete fields",
+		},
+	}
+
+	for _, test := range tests {
+		result, err := StrategicMergePatch([]byte(original), []byte(test.patch), testObject{})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.test, err)
+			continue
+		}
+		var actual, expected interface{}
+		if err := json.Unmarshal(result, &actual); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.test, err)
+		}
+		if err := json.Unmarshal([]byte(test.expected), &expected); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.test, err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %s, got %s", test.test, test.expected, string(result))
+		}
+	}
+}
+
+func TestStrategicMergePatchErrors(t *testing.T) {
+	original := `{"name": "pod", "containers": [{"name": "web"}]}`
+	for _, patch := range []string{
+		`{"containers": [{"image": "nginx"}]}`,
+		`{"labels": {"$patch": "merge"}}`,
+		`{"$patch": "delete"}`,
+		`[{"op": "add", "path": "/name", "value": "other"}]`,
+	} {
+		if _, err := StrategicMergePatch([]byte(original), []byte(patch), &testObject{}); err == nil {
+			t.Errorf("expected an error applying %s", patch)
+		}
+	}
+}
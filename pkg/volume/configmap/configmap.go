@@ -0,0 +1,158 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugins is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&configMapPlugin{}}
+}
+
+const (
+	configMapPluginName = "kubernetes.io/configmap"
+)
+
+// configMapPlugin implements the VolumePlugin interface.
+type configMapPlugin struct {
+	host volume.VolumeHost
+}
+
+func (plugin *configMapPlugin) Init(host volume.VolumeHost) {
+	plugin.host = host
+}
+
+func (plugin *configMapPlugin) Name() string {
+	return configMapPluginName
+}
+
+func (plugin *configMapPlugin) CanSupport(spec *api.Volume) bool {
+	return spec.ConfigMap != nil
+}
+
+func (plugin *configMapPlugin) NewBuilder(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
+	return &configMapVolume{spec.Name, pod, plugin, spec.ConfigMap.Name, spec.ConfigMap.Items}, nil
+}
+
+func (plugin *configMapPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	return &configMapVolume{volName, &api.Pod{ObjectMeta: api.ObjectMeta{UID: podUID}}, plugin, "", nil}, nil
+}
+
+// configMapVolume handles retrieving config maps from the API server
+// and placing their data into the volume on the host.
+type configMapVolume struct {
+	volName       string
+	pod           *api.Pod
+	plugin        *configMapPlugin
+	configMapName string
+	items         []api.KeyToPath
+}
+
+func (cv *configMapVolume) SetUp() error {
+	return cv.SetUpAt(cv.GetPath())
+}
+
+// This is the spec for the volume that this plugin wraps.
+var wrappedVolumeSpec = &api.Volume{
+	Name:         "not-used",
+	VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory}},
+}
+
+// SetUpAt is called on every pod sync, so the files are rewritten whenever
+// the config map changes and removed when their key goes away.
+func (cv *configMapVolume) SetUpAt(dir string) error {
+	glog.V(3).Infof("Setting up volume %v for pod %v at %v", cv.volName, cv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the setup.
+	wrapped, err := cv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, cv.pod)
+	if err != nil {
+		return err
+	}
+	if err := wrapped.SetUpAt(dir); err != nil {
+		return err
+	}
+
+	kubeClient := cv.plugin.host.GetKubeClient()
+	if kubeClient == nil {
+		return fmt.Errorf("Cannot setup config map volume %v because kube client is not configured", cv.volName)
+	}
+
+	configMap, err := kubeClient.ConfigMaps(cv.pod.Namespace).Get(cv.configMapName)
+	if err != nil {
+		glog.Errorf("Couldn't get config map %v/%v", cv.pod.Namespace, cv.configMapName)
+		return err
+	}
+
+	files, err := projectConfigMap(configMap, cv.items)
+	if err != nil {
+		return err
+	}
+	if err := volume.WriteFiles(dir, files); err != nil {
+		glog.Errorf("Error writing config map data to host path: %v, %v", dir, err)
+		return err
+	}
+	return nil
+}
+
+// projectConfigMap returns the contents of every file in the volume, keyed by
+// the path relative to the volume root. Without items every key is projected
+// to a file of the same name.
+func projectConfigMap(configMap *api.ConfigMap, items []api.KeyToPath) (map[string]string, error) {
+	files := map[string]string{}
+	if len(items) == 0 {
+		for key, value := range configMap.Data {
+			files[key] = value
+		}
+		return files, nil
+	}
+	for _, item := range items {
+		value, ok := configMap.Data[item.Key]
+		if !ok {
+			return nil, fmt.Errorf("config map %v/%v has no key %q", configMap.Namespace, configMap.Name, item.Key)
+		}
+		files[path.Clean(item.Path)] = value
+	}
+	return files, nil
+}
+
+func (cv *configMapVolume) GetPath() string {
+	return cv.plugin.host.GetPodVolumeDir(cv.pod.UID, util.EscapeQualifiedNameForDisk(configMapPluginName), cv.volName)
+}
+
+func (cv *configMapVolume) TearDown() error {
+	return cv.TearDownAt(cv.GetPath())
+}
+
+func (cv *configMapVolume) TearDownAt(dir string) error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", cv.volName, cv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the teardown.
+	wrapped, err := cv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, cv.pod.UID)
+	if err != nil {
+		return err
+	}
+	return wrapped.TearDownAt(dir)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configmap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume/empty_dir"
+)
+
+func newTestHost(t *testing.T, client client.Interface) volume.VolumeHost {
+	tempDir, err := ioutil.TempDir("/tmp", "configmap_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return volume.NewFakeVolumeHost(tempDir, client, empty_dir.ProbeVolumePluginsWithMounter(&mount.FakeMounter{}))
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t, nil))
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != configMapPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{ConfigMap: &api.ConfigMapVolumeSource{Name: ""}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func expectFiles(t *testing.T, dir string, expected map[string]string) {
+	for name, value := range expected {
+		data, err := ioutil.ReadFile(path.Join(dir, name))
+		if err != nil {
+			t.Errorf("Couldn't read config map data from %v: %v", name, err)
+			continue
+		}
+		if string(data) != value {
+			t.Errorf("Unexpected value of %v; expected %q, got %q", name, value, string(data))
+		}
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = "test_pod_uid"
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_configmap_namespace"
+		testName       = "test_configmap_name"
+	)
+
+	volumeSpec := &api.Volume{
+		Name: testVolumeName,
+		VolumeSource: api.VolumeSource{
+			ConfigMap: &api.ConfigMapVolumeSource{
+				Name: testName,
+			},
+		},
+	}
+
+	client := &client.Fake{
+		ConfigMap: api.ConfigMap{
+			ObjectMeta: api.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testName,
+			},
+			Data: map[string]string{
+				"data-1": "value-1",
+				"data-2": "value-2",
+			},
+		},
+	}
+
+	pluginMgr := volume.VolumePluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t, client))
+
+	plugin, err := pluginMgr.FindPluginByName(configMapPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	builder, err := plugin.NewBuilder(volumeSpec, &api.Pod{ObjectMeta: api.ObjectMeta{UID: types.UID(testPodUID), Namespace: testNamespace}})
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid/volumes/kubernetes.io~configmap/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	expectFiles(t, volumePath, client.ConfigMap.Data)
+
+	// A changed config map is picked up on the next setup.
+	client.ConfigMap.Data = map[string]string{"data-1": "updated"}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	expectFiles(t, volumePath, client.ConfigMap.Data)
+	if _, err := os.Stat(path.Join(volumePath, "data-2")); !os.IsNotExist(err) {
+		t.Errorf("Expected the file of a removed key to be deleted, got %v", err)
+	}
+
+	cleaner, err := plugin.NewCleaner(testVolumeName, types.UID(testPodUID))
+	if err != nil {
+		t.Fatalf("Failed to make a new Cleaner: %v", err)
+	}
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", volumePath)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+}
+
+func TestProjectConfigMap(t *testing.T) {
+	configMap := &api.ConfigMap{
+		ObjectMeta: api.ObjectMeta{Namespace: "ns", Name: "config"},
+		Data:       map[string]string{"a": "1", "b": "2"},
+	}
+
+	files, err := projectConfigMap(configMap, []api.KeyToPath{{Key: "a", Path: "dir/a.conf"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(files) != 1 || files["dir/a.conf"] != "1" {
+		t.Errorf("Unexpected files: %#v", files)
+	}
+
+	if _, err := projectConfigMap(configMap, []api.KeyToPath{{Key: "missing", Path: "c"}}); err == nil {
+		t.Errorf("Expected an error for a missing key")
+	}
+}
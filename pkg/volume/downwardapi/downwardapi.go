@@ -0,0 +1,129 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downwardapi
+
+import (
+	"path"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/kubelet/envvars"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/golang/glog"
+)
+
+// ProbeVolumePlugins is the entry point for plugin detection in a package.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	return []volume.VolumePlugin{&downwardAPIPlugin{}}
+}
+
+const (
+	downwardAPIPluginName = "kubernetes.io/downward-api"
+)
+
+// downwardAPIPlugin implements the VolumePlugin interface.
+type downwardAPIPlugin struct {
+	host volume.VolumeHost
+}
+
+func (plugin *downwardAPIPlugin) Init(host volume.VolumeHost) {
+	plugin.host = host
+}
+
+func (plugin *downwardAPIPlugin) Name() string {
+	return downwardAPIPluginName
+}
+
+func (plugin *downwardAPIPlugin) CanSupport(spec *api.Volume) bool {
+	return spec.DownwardAPI != nil
+}
+
+func (plugin *downwardAPIPlugin) NewBuilder(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
+	return &downwardAPIVolume{spec.Name, pod, plugin, spec.DownwardAPI.Items}, nil
+}
+
+func (plugin *downwardAPIPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
+	return &downwardAPIVolume{volName, &api.Pod{ObjectMeta: api.ObjectMeta{UID: podUID}}, plugin, nil}, nil
+}
+
+// downwardAPIVolume writes the selected fields of the pod it is built for into
+// the volume on the host.
+type downwardAPIVolume struct {
+	volName string
+	pod     *api.Pod
+	plugin  *downwardAPIPlugin
+	items   []api.DownwardAPIVolumeFile
+}
+
+func (dv *downwardAPIVolume) SetUp() error {
+	return dv.SetUpAt(dv.GetPath())
+}
+
+// This is the spec for the volume that this plugin wraps.
+var wrappedVolumeSpec = &api.Volume{
+	Name:         "not-used",
+	VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageTypeMemory}},
+}
+
+// SetUpAt is called on every pod sync with the kubelet's current copy of the pod,
+// so the files are rewritten whenever the labels or annotations of the pod change.
+func (dv *downwardAPIVolume) SetUpAt(dir string) error {
+	glog.V(3).Infof("Setting up volume %v for pod %v at %v", dv.volName, dv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the setup.
+	wrapped, err := dv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, dv.pod)
+	if err != nil {
+		return err
+	}
+	if err := wrapped.SetUpAt(dir); err != nil {
+		return err
+	}
+
+	files := map[string]string{}
+	for _, item := range dv.items {
+		value, err := envvars.PodFieldValue(dv.pod, item.FieldRef.FieldPath)
+		if err != nil {
+			return err
+		}
+		files[path.Clean(item.Path)] = value
+	}
+	if err := volume.WriteFiles(dir, files); err != nil {
+		glog.Errorf("Error writing downward API data to host path: %v, %v", dir, err)
+		return err
+	}
+	return nil
+}
+
+func (dv *downwardAPIVolume) GetPath() string {
+	return dv.plugin.host.GetPodVolumeDir(dv.pod.UID, util.EscapeQualifiedNameForDisk(downwardAPIPluginName), dv.volName)
+}
+
+func (dv *downwardAPIVolume) TearDown() error {
+	return dv.TearDownAt(dv.GetPath())
+}
+
+func (dv *downwardAPIVolume) TearDownAt(dir string) error {
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", dv.volName, dv.pod.UID, dir)
+
+	// Wrap EmptyDir, let it do the teardown.
+	wrapped, err := dv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, dv.pod.UID)
+	if err != nil {
+		return err
+	}
+	return wrapped.TearDownAt(dir)
+}
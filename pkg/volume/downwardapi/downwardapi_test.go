@@ -0,0 +1,149 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package downwardapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util/mount"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/volume/empty_dir"
+)
+
+func newTestHost(t *testing.T) volume.VolumeHost {
+	tempDir, err := ioutil.TempDir("/tmp", "downwardapi_volume_test.")
+	if err != nil {
+		t.Fatalf("can't make a temp rootdir: %v", err)
+	}
+
+	return volume.NewFakeVolumeHost(tempDir, nil, empty_dir.ProbeVolumePluginsWithMounter(&mount.FakeMounter{}))
+}
+
+func TestCanSupport(t *testing.T) {
+	pluginMgr := volume.VolumePluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t))
+
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+	if plugin.Name() != downwardAPIPluginName {
+		t.Errorf("Wrong name: %s", plugin.Name())
+	}
+	if !plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{DownwardAPI: &api.DownwardAPIVolumeSource{}}}) {
+		t.Errorf("Expected true")
+	}
+	if plugin.CanSupport(&api.Volume{VolumeSource: api.VolumeSource{}}) {
+		t.Errorf("Expected false")
+	}
+}
+
+func expectFile(t *testing.T, filePath, expected string) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Errorf("Couldn't read %v: %v", filePath, err)
+		return
+	}
+	if string(data) != expected {
+		t.Errorf("Unexpected content of %v; expected %q, got %q", filePath, expected, string(data))
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	var (
+		testPodUID     = "test_pod_uid"
+		testVolumeName = "test_volume_name"
+		testNamespace  = "test_namespace"
+		testName       = "test_pod_name"
+	)
+
+	volumeSpec := &api.Volume{
+		Name: testVolumeName,
+		VolumeSource: api.VolumeSource{
+			DownwardAPI: &api.DownwardAPIVolumeSource{
+				Items: []api.DownwardAPIVolumeFile{
+					{Path: "labels", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.labels"}},
+					{Path: "meta/name", FieldRef: api.ObjectFieldSelector{FieldPath: "metadata.name"}},
+				},
+			},
+		},
+	}
+
+	pod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{
+			UID:       types.UID(testPodUID),
+			Name:      testName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app": "web"},
+		},
+	}
+
+	pluginMgr := volume.VolumePluginMgr{}
+	pluginMgr.InitPlugins(ProbeVolumePlugins(), newTestHost(t))
+
+	plugin, err := pluginMgr.FindPluginByName(downwardAPIPluginName)
+	if err != nil {
+		t.Errorf("Can't find the plugin by name")
+	}
+
+	builder, err := plugin.NewBuilder(volumeSpec, pod)
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+
+	volumePath := builder.GetPath()
+	if !strings.HasSuffix(volumePath, fmt.Sprintf("pods/test_pod_uid/volumes/kubernetes.io~downward-api/test_volume_name")) {
+		t.Errorf("Got unexpected path: %s", volumePath)
+	}
+
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	expectFile(t, path.Join(volumePath, "labels"), "app=\"web\"\n")
+	expectFile(t, path.Join(volumePath, "meta/name"), testName)
+
+	// Changed labels are picked up on the next setup.
+	pod.Labels = map[string]string{"app": "web", "track": "canary"}
+	builder, err = plugin.NewBuilder(volumeSpec, pod)
+	if err != nil {
+		t.Fatalf("Failed to make a new Builder: %v", err)
+	}
+	if err := builder.SetUp(); err != nil {
+		t.Fatalf("Failed to setup volume: %v", err)
+	}
+	expectFile(t, path.Join(volumePath, "labels"), "app=\"web\"\ntrack=\"canary\"\n")
+
+	cleaner, err := plugin.NewCleaner(testVolumeName, types.UID(testPodUID))
+	if err != nil {
+		t.Fatalf("Failed to make a new Cleaner: %v", err)
+	}
+	if err := cleaner.TearDown(); err != nil {
+		t.Errorf("Expected success, got: %v", err)
+	}
+	if _, err := os.Stat(volumePath); err == nil {
+		t.Errorf("TearDown() failed, volume path still exists: %s", volumePath)
+	} else if !os.IsNotExist(err) {
+		t.Errorf("TearDown() failed: %v", err)
+	}
+}
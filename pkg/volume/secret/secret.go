@@ -58,12 +58,12 @@ func (plugin *secretPlugin) CanSupport(spec *api.Volume) bool {
 	return false
 }
 
-func (plugin *secretPlugin) NewBuilder(spec *api.Volume, podRef *api.ObjectReference) (volume.Builder, error) {
-	return plugin.newBuilderInternal(spec, podRef)
+func (plugin *secretPlugin) NewBuilder(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
+	return plugin.newBuilderInternal(spec, pod)
 }
 
-func (plugin *secretPlugin) newBuilderInternal(spec *api.Volume, podRef *api.ObjectReference) (volume.Builder, error) {
-	return &secretVolume{spec.Name, *podRef, plugin, spec.Secret.SecretName}, nil
+func (plugin *secretPlugin) newBuilderInternal(spec *api.Volume, pod *api.Pod) (volume.Builder, error) {
+	return &secretVolume{spec.Name, pod, plugin, spec.Secret.SecretName}, nil
 }
 
 func (plugin *secretPlugin) NewCleaner(volName string, podUID types.UID) (volume.Cleaner, error) {
@@ -71,14 +71,14 @@ func (plugin *secretPlugin) NewCleaner(volName string, podUID types.UID) (volume
 }
 
 func (plugin *secretPlugin) newCleanerInternal(volName string, podUID types.UID) (volume.Cleaner, error) {
-	return &secretVolume{volName, api.ObjectReference{UID: podUID}, plugin, ""}, nil
+	return &secretVolume{volName, &api.Pod{ObjectMeta: api.ObjectMeta{UID: podUID}}, plugin, ""}, nil
 }
 
 // secretVolume handles retrieving secrets from the API server
 // and placing them into the volume on the host.
 type secretVolume struct {
 	volName    string
-	podRef     api.ObjectReference
+	pod        *api.Pod
 	plugin     *secretPlugin
 	secretName string
 }
@@ -94,10 +94,10 @@ var wrappedVolumeSpec = &api.Volume{
 }
 
 func (sv *secretVolume) SetUpAt(dir string) error {
-	glog.V(3).Infof("Setting up volume %v for pod %v at %v", sv.volName, sv.podRef.UID, dir)
+	glog.V(3).Infof("Setting up volume %v for pod %v at %v", sv.volName, sv.pod.UID, dir)
 
 	// Wrap EmptyDir, let it do the setup.
-	wrapped, err := sv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, &sv.podRef)
+	wrapped, err := sv.plugin.host.NewWrapperBuilder(wrappedVolumeSpec, sv.pod)
 	if err != nil {
 		return err
 	}
@@ -110,9 +110,9 @@ func (sv *secretVolume) SetUpAt(dir string) error {
 		return fmt.Errorf("Cannot setup secret volume %v because kube client is not configured", sv)
 	}
 
-	secret, err := kubeClient.Secrets(sv.podRef.Namespace).Get(sv.secretName)
+	secret, err := kubeClient.Secrets(sv.pod.Namespace).Get(sv.secretName)
 	if err != nil {
-		glog.Errorf("Couldn't get secret %v/%v", sv.podRef.Namespace, sv.secretName)
+		glog.Errorf("Couldn't get secret %v/%v", sv.pod.Namespace, sv.secretName)
 		return err
 	}
 
@@ -129,7 +129,7 @@ func (sv *secretVolume) SetUpAt(dir string) error {
 }
 
 func (sv *secretVolume) GetPath() string {
-	return sv.plugin.host.GetPodVolumeDir(sv.podRef.UID, util.EscapeQualifiedNameForDisk(secretPluginName), sv.volName)
+	return sv.plugin.host.GetPodVolumeDir(sv.pod.UID, util.EscapeQualifiedNameForDisk(secretPluginName), sv.volName)
 }
 
 func (sv *secretVolume) TearDown() error {
@@ -137,10 +137,10 @@ func (sv *secretVolume) TearDown() error {
 }
 
 func (sv *secretVolume) TearDownAt(dir string) error {
-	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", sv.volName, sv.podRef.UID, dir)
+	glog.V(3).Infof("Tearing down volume %v for pod %v at %v", sv.volName, sv.pod.UID, dir)
 
 	// Wrap EmptyDir, let it do the teardown.
-	wrapped, err := sv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, sv.podRef.UID)
+	wrapped, err := sv.plugin.host.NewWrapperCleaner(wrappedVolumeSpec, sv.pod.UID)
 	if err != nil {
 		return err
 	}
@@ -17,9 +17,11 @@ limitations under the License.
 package volume
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 )
 
 // Volume represents a directory used by pods or hosts on a node.
@@ -65,3 +67,63 @@ func RenameDirectory(oldPath, newName string) (string, error) {
 	}
 	return newPath, nil
 }
+
+// WriteFiles makes the regular files under dir match files, which maps paths
+// relative to dir to their contents. Files whose content changed are replaced
+// atomically, and files not in the map are removed. It is meant for volumes
+// which project API objects and are refreshed on every pod sync.
+func WriteFiles(dir string, files map[string]string) error {
+	for name, data := range files {
+		if err := writeFileIfChanged(path.Join(dir, name), []byte(data)); err != nil {
+			return err
+		}
+	}
+	return removeStaleFiles(dir, files)
+}
+
+// writeFileIfChanged replaces the file at filePath with data unless it already
+// holds it. The data is written to a temporary file first and renamed into
+// place, so readers never observe a partial file.
+func writeFileIfChanged(filePath string, data []byte) error {
+	if current, err := ioutil.ReadFile(filePath); err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+	if err := os.MkdirAll(path.Dir(filePath), 0777); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(path.Dir(filePath), ".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filePath)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// removeStaleFiles deletes the regular files under dir which are not in files.
+func removeStaleFiles(dir string, files map[string]string) error {
+	return filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[rel]; ok {
+			return nil
+		}
+		return os.Remove(filePath)
+	})
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeclaimbinder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/util"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+
+	"github.com/golang/glog"
+)
+
+// PersistentVolumeClaimBinder is a controller that binds pending PersistentVolumeClaims
+// to available PersistentVolumes and releases volumes whose claims have been deleted.
+type PersistentVolumeClaimBinder struct {
+	kubeClient  client.Interface
+	volumeIndex *persistentVolumeOrderedIndex
+	claimStore  cache.Store
+	// storesSynced returns true once the volume index and the claim store have been
+	// populated by their first list. Nothing is synced before then, or a bound volume
+	// would appear released and a claim could find no volume.
+	storesSynced func() bool
+}
+
+// NewPersistentVolumeClaimBinder creates a new PersistentVolumeClaimBinder
+func NewPersistentVolumeClaimBinder(kubeClient client.Interface) *PersistentVolumeClaimBinder {
+	volumeIndex := newPersistentVolumeOrderedIndex()
+	volumeReflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.PersistentVolumes().List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.PersistentVolumes().Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.PersistentVolume{},
+		volumeIndex,
+		0,
+	)
+	volumeReflector.Run()
+
+	claimStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	claimReflector := cache.NewReflector(
+		&cache.ListWatch{
+			ListFunc: func() (runtime.Object, error) {
+				return kubeClient.PersistentVolumeClaims(api.NamespaceAll).List(labels.Everything(), fields.Everything())
+			},
+			WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+				return kubeClient.PersistentVolumeClaims(api.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+			},
+		},
+		&api.PersistentVolumeClaim{},
+		claimStore,
+		0,
+	)
+	claimReflector.Run()
+
+	return &PersistentVolumeClaimBinder{
+		kubeClient:  kubeClient,
+		volumeIndex: volumeIndex,
+		claimStore:  claimStore,
+		storesSynced: func() bool {
+			return volumeReflector.LastSyncResourceVersion() != "" && claimReflector.LastSyncResourceVersion() != ""
+		},
+	}
+}
+
+// Run begins syncing at the specified period interval
+func (binder *PersistentVolumeClaimBinder) Run(period time.Duration) {
+	go util.Forever(func() { binder.synchronize() }, period)
+}
+
+// synchronize updates the phase of every volume and then attempts to bind every pending claim.
+func (binder *PersistentVolumeClaimBinder) synchronize() {
+	if !binder.storesSynced() {
+		glog.V(4).Infof("Waiting for the volume index and the claim store to sync before synchronizing volumes")
+		return
+	}
+	for _, obj := range binder.volumeIndex.List() {
+		volume := api.Scheme.CopyOrDie(obj.(*api.PersistentVolume)).(*api.PersistentVolume)
+		if err := binder.syncVolume(volume); err != nil {
+			glog.Errorf("Error synchronizing volume %s: %v", volume.Name, err)
+		}
+	}
+	for _, obj := range binder.claimStore.List() {
+		claim := api.Scheme.CopyOrDie(obj.(*api.PersistentVolumeClaim)).(*api.PersistentVolumeClaim)
+		if err := binder.syncClaim(claim); err != nil {
+			glog.Errorf("Error synchronizing claim %s/%s: %v", claim.Namespace, claim.Name, err)
+		}
+	}
+}
+
+// syncVolume sets the phase of a volume from the state of its binding.  A volume whose
+// claim no longer exists is released and is not offered to new claims.
+func (binder *PersistentVolumeClaimBinder) syncVolume(volume *api.PersistentVolume) error {
+	phase := api.VolumeAvailable
+	if volume.Spec.ClaimRef != nil {
+		phase = api.VolumeBound
+		key := volume.Spec.ClaimRef.Namespace + "/" + volume.Spec.ClaimRef.Name
+		obj, exists, err := binder.claimStore.GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if !exists || obj.(*api.PersistentVolumeClaim).UID != volume.Spec.ClaimRef.UID {
+			phase = api.VolumeReleased
+		}
+	}
+	if volume.Status.Phase == phase {
+		return nil
+	}
+
+	glog.V(4).Infof("Volume %s changing phase from %q to %q", volume.Name, volume.Status.Phase, phase)
+	volume.Status.Phase = phase
+	_, err := binder.kubeClient.PersistentVolumes().UpdateStatus(volume)
+	return err
+}
+
+// syncClaim binds a pending claim to the smallest available volume that satisfies it.
+// The volume's ClaimRef is written before the claim's status, so a claim whose status
+// update failed is completed against the volume that already refers to it.
+func (binder *PersistentVolumeClaimBinder) syncClaim(claim *api.PersistentVolumeClaim) error {
+	if claim.Status.VolumeRef != nil {
+		return nil
+	}
+
+	volume := binder.volumeIndex.findVolumeBoundToClaim(claim)
+	if volume == nil {
+		match, err := binder.volumeIndex.findBestMatchForClaim(claim)
+		if err != nil {
+			return err
+		}
+		if match == nil {
+			glog.V(5).Infof("No volume available for claim %s/%s", claim.Namespace, claim.Name)
+			return nil
+		}
+		volume = api.Scheme.CopyOrDie(match).(*api.PersistentVolume)
+
+		claimRef, err := api.GetReference(claim)
+		if err != nil {
+			return fmt.Errorf("unexpected error getting claim reference: %v", err)
+		}
+		volume.Spec.ClaimRef = claimRef
+		if _, err := binder.kubeClient.PersistentVolumes().Update(volume); err != nil {
+			return err
+		}
+		// keep the local index current so the volume is not offered to another claim
+		// before the watch delivers the update.
+		binder.volumeIndex.Update(volume)
+	}
+
+	glog.V(4).Infof("Bound claim %s/%s to volume %s", claim.Namespace, claim.Name, volume.Name)
+	volumeRef, err := api.GetReference(volume)
+	if err != nil {
+		return fmt.Errorf("unexpected error getting volume reference: %v", err)
+	}
+	claim.Status.Phase = api.ClaimBound
+	claim.Status.VolumeRef = volumeRef
+	claim.Status.AccessModes = volume.Spec.AccessModes
+	claim.Status.Capacity = volume.Spec.Capacity
+	_, err = binder.kubeClient.PersistentVolumeClaims(claim.Namespace).UpdateStatus(claim)
+	return err
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeclaimbinder
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/resource"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/types"
+)
+
+func init() {
+	api.ForTesting_ReferencesAllowBlankSelfLinks = true
+}
+
+func newVolume(name, size string, modes ...api.AccessModeType) *api.PersistentVolume {
+	return &api.PersistentVolume{
+		ObjectMeta: api.ObjectMeta{Name: name},
+		Spec: api.PersistentVolumeSpec{
+			Capacity: api.ResourceList{
+				api.ResourceStorage: resource.MustParse(size),
+			},
+			AccessModes: modes,
+			PersistentVolumeSource: api.PersistentVolumeSource{
+				HostPath: &api.HostPathVolumeSource{Path: "/tmp/" + name},
+			},
+		},
+	}
+}
+
+func newClaim(name, size string, modes ...api.AccessModeType) *api.PersistentVolumeClaim {
+	return &api.PersistentVolumeClaim{
+		ObjectMeta: api.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: modes,
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{
+					api.ResourceStorage: resource.MustParse(size),
+				},
+			},
+		},
+		Status: api.PersistentVolumeClaimStatus{
+			Phase: api.ClaimPending,
+		},
+	}
+}
+
+func TestFindBestMatchForClaim(t *testing.T) {
+	index := newPersistentVolumeOrderedIndex()
+	index.Add(newVolume("small", "1G", api.ReadWriteOnce))
+	index.Add(newVolume("medium", "5G", api.ReadWriteOnce, api.ReadOnlyMany))
+	index.Add(newVolume("large", "10G", api.ReadWriteOnce, api.ReadOnlyMany))
+	bound := newVolume("bound", "5G", api.ReadWriteOnce, api.ReadOnlyMany)
+	bound.Spec.ClaimRef = &api.ObjectReference{Name: "other", Namespace: "default"}
+	index.Add(bound)
+
+	limitOnly := newClaim("h", "2G", api.ReadWriteOnce)
+	limitOnly.Spec.Resources = api.ResourceRequirements{Limits: limitOnly.Spec.Resources.Requests}
+	requestBelowLimit := newClaim("i", "1G", api.ReadWriteOnce)
+	requestBelowLimit.Spec.Resources.Limits = api.ResourceList{api.ResourceStorage: resource.MustParse("8G")}
+
+	tests := map[string]struct {
+		claim    *api.PersistentVolumeClaim
+		expected string
+	}{
+		"exact size":            {newClaim("a", "1G", api.ReadWriteOnce), "small"},
+		"smallest that fits":    {newClaim("b", "3G", api.ReadWriteOnce), "medium"},
+		"access modes":          {newClaim("c", "1G", api.ReadOnlyMany), "medium"},
+		"too large":             {newClaim("d", "20G", api.ReadWriteOnce), ""},
+		"unsupported mode":      {newClaim("e", "1G", api.ReadWriteMany), ""},
+		"largest that fits":     {newClaim("f", "6G", api.ReadWriteOnce, api.ReadOnlyMany), "large"},
+		"skips bound volumes":   {newClaim("g", "4G", api.ReadOnlyMany), "medium"},
+		"limit without request": {limitOnly, "medium"},
+		"request below limit":   {requestBelowLimit, "small"},
+	}
+
+	for name, test := range tests {
+		volume, err := index.findBestMatchForClaim(test.claim)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if test.expected == "" {
+			if volume != nil {
+				t.Errorf("%s: expected no match, got %s", name, volume.Name)
+			}
+			continue
+		}
+		if volume == nil || volume.Name != test.expected {
+			t.Errorf("%s: expected %s, got %#v", name, test.expected, volume)
+		}
+	}
+}
+
+func TestSyncClaimBindsVolume(t *testing.T) {
+	mockClient := &client.Fake{}
+	binder := &PersistentVolumeClaimBinder{
+		kubeClient:  mockClient,
+		volumeIndex: newPersistentVolumeOrderedIndex(),
+		claimStore:  cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	binder.volumeIndex.Add(newVolume("foo", "10G", api.ReadWriteOnce))
+	claim := newClaim("bar", "5G", api.ReadWriteOnce)
+
+	if err := binder.syncClaim(claim); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedActions := []string{"update-persistentVolume", "update-status-persistentVolumeClaim"}
+	if len(mockClient.Actions) != len(expectedActions) {
+		t.Fatalf("expected actions %v, got %#v", expectedActions, mockClient.Actions)
+	}
+	for i, action := range expectedActions {
+		if mockClient.Actions[i].Action != action {
+			t.Errorf("expected action %s, got %s", action, mockClient.Actions[i].Action)
+		}
+	}
+	if claim.Status.Phase != api.ClaimBound || claim.Status.VolumeRef == nil || claim.Status.VolumeRef.Name != "foo" {
+		t.Errorf("expected claim to be bound to foo: %#v", claim.Status)
+	}
+
+	obj, exists, _ := binder.volumeIndex.GetByKey("foo")
+	if !exists || obj.(*api.PersistentVolume).Spec.ClaimRef == nil {
+		t.Errorf("expected local volume index to record the binding")
+	}
+
+	// a second claim must not be bound to the same volume
+	mockClient.Actions = nil
+	if err := binder.syncClaim(newClaim("baz", "5G", api.ReadWriteOnce)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mockClient.Actions) != 0 {
+		t.Errorf("expected no actions, got %#v", mockClient.Actions)
+	}
+}
+
+func TestSyncVolumePhase(t *testing.T) {
+	claim := newClaim("bar", "5G", api.ReadWriteOnce)
+	claimRef := &api.ObjectReference{Name: claim.Name, Namespace: claim.Namespace, UID: claim.UID}
+
+	available := newVolume("available", "10G", api.ReadWriteOnce)
+	bound := newVolume("bound", "10G", api.ReadWriteOnce)
+	bound.Spec.ClaimRef = claimRef
+	released := newVolume("released", "10G", api.ReadWriteOnce)
+	released.Spec.ClaimRef = &api.ObjectReference{Name: "deleted", Namespace: "default", UID: "uid-deleted"}
+	current := newVolume("current", "10G", api.ReadWriteOnce)
+	current.Spec.ClaimRef = claimRef
+	current.Status.Phase = api.VolumeBound
+
+	tests := map[string]struct {
+		volume        *api.PersistentVolume
+		expectedPhase api.PersistentVolumePhase
+		expectUpdate  bool
+	}{
+		"available":      {available, api.VolumeAvailable, true},
+		"bound":          {bound, api.VolumeBound, true},
+		"released":       {released, api.VolumeReleased, true},
+		"already synced": {current, api.VolumeBound, false},
+	}
+
+	for name, test := range tests {
+		mockClient := &client.Fake{}
+		binder := &PersistentVolumeClaimBinder{
+			kubeClient:  mockClient,
+			volumeIndex: newPersistentVolumeOrderedIndex(),
+			claimStore:  cache.NewStore(cache.MetaNamespaceKeyFunc),
+		}
+		binder.claimStore.Add(claim)
+
+		if err := binder.syncVolume(test.volume); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if test.volume.Status.Phase != test.expectedPhase {
+			t.Errorf("%s: expected phase %s, got %s", name, test.expectedPhase, test.volume.Status.Phase)
+		}
+		if updated := len(mockClient.Actions) == 1 && mockClient.Actions[0].Action == "update-status-persistentVolume"; updated != test.expectUpdate {
+			t.Errorf("%s: unexpected actions %#v", name, mockClient.Actions)
+		}
+	}
+}
+
+func TestSyncClaimCompletesPartialBinding(t *testing.T) {
+	mockClient := &client.Fake{}
+	binder := &PersistentVolumeClaimBinder{
+		kubeClient:  mockClient,
+		volumeIndex: newPersistentVolumeOrderedIndex(),
+		claimStore:  cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	claim := newClaim("bar", "5G", api.ReadWriteOnce)
+	// a previous sync wrote the volume's ClaimRef but failed to update the claim status
+	partial := newVolume("partial", "10G", api.ReadWriteOnce)
+	partial.Spec.ClaimRef = &api.ObjectReference{Name: claim.Name, Namespace: claim.Namespace, UID: claim.UID}
+	binder.volumeIndex.Add(partial)
+	binder.volumeIndex.Add(newVolume("smaller", "5G", api.ReadWriteOnce))
+
+	if err := binder.syncClaim(claim); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mockClient.Actions) != 1 || mockClient.Actions[0].Action != "update-status-persistentVolumeClaim" {
+		t.Errorf("expected only a claim status update, got %#v", mockClient.Actions)
+	}
+	if claim.Status.VolumeRef == nil || claim.Status.VolumeRef.Name != "partial" {
+		t.Errorf("expected claim to be bound to partial: %#v", claim.Status)
+	}
+}
+
+func TestSynchronizeWaitsForClaimSync(t *testing.T) {
+	mockClient := &client.Fake{}
+	synced := false
+	binder := &PersistentVolumeClaimBinder{
+		kubeClient:   mockClient,
+		volumeIndex:  newPersistentVolumeOrderedIndex(),
+		claimStore:   cache.NewStore(cache.MetaNamespaceKeyFunc),
+		storesSynced: func() bool { return synced },
+	}
+	bound := newVolume("bound", "10G", api.ReadWriteOnce)
+	bound.Spec.ClaimRef = &api.ObjectReference{Name: "bar", Namespace: "default", UID: "uid-bar"}
+	bound.Status.Phase = api.VolumeBound
+	binder.volumeIndex.Add(bound)
+
+	binder.synchronize()
+	if len(mockClient.Actions) != 0 {
+		t.Errorf("expected no actions before the claim store synced, got %#v", mockClient.Actions)
+	}
+
+	synced = true
+	binder.synchronize()
+	if len(mockClient.Actions) != 1 || mockClient.Actions[0].Action != "update-status-persistentVolume" {
+		t.Errorf("expected the volume to be released once synced, got %#v", mockClient.Actions)
+	}
+}
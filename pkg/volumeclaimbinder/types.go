@@ -0,0 +1,114 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeclaimbinder
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client/cache"
+)
+
+// persistentVolumeOrderedIndex is a cache.Store of PersistentVolumes that can
+// answer which available volume best satisfies a claim.
+type persistentVolumeOrderedIndex struct {
+	cache.Store
+}
+
+func newPersistentVolumeOrderedIndex() *persistentVolumeOrderedIndex {
+	return &persistentVolumeOrderedIndex{cache.NewStore(cache.MetaNamespaceKeyFunc)}
+}
+
+// byCapacity is used to order volumes by ascending storage size
+type byCapacity []*api.PersistentVolume
+
+func (c byCapacity) Len() int      { return len(c) }
+func (c byCapacity) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c byCapacity) Less(i, j int) bool {
+	return storageSize(c[i].Spec.Capacity) < storageSize(c[j].Spec.Capacity)
+}
+
+// storageSize returns the storage quantity of the list in bytes, or zero if it is not set.
+func storageSize(list api.ResourceList) int64 {
+	if qty, ok := list[api.ResourceStorage]; ok {
+		return qty.Value()
+	}
+	return 0
+}
+
+// containsAccessModes returns true if every requested mode is in modes.
+func containsAccessModes(modes, requested []api.AccessModeType) bool {
+	for _, r := range requested {
+		found := false
+		for _, m := range modes {
+			if m == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// findVolumeBoundToClaim returns the volume whose ClaimRef already refers to the claim,
+// or nil if there is none.
+func (pvIndex *persistentVolumeOrderedIndex) findVolumeBoundToClaim(claim *api.PersistentVolumeClaim) *api.PersistentVolume {
+	for _, obj := range pvIndex.List() {
+		volume := obj.(*api.PersistentVolume)
+		if volume.Spec.ClaimRef != nil && volume.Spec.ClaimRef.UID == claim.UID {
+			return volume
+		}
+	}
+	return nil
+}
+
+// findBestMatchForClaim returns the smallest unbound volume that supports all of the
+// claim's access modes and has at least the requested storage, or nil if there is none.
+// The storage limit of the claim is used if it requests none.
+func (pvIndex *persistentVolumeOrderedIndex) findBestMatchForClaim(claim *api.PersistentVolumeClaim) (*api.PersistentVolume, error) {
+	requested, ok := claim.Spec.Resources.Requests[api.ResourceStorage]
+	if !ok {
+		// the claims made before requests were separated from limits only have a limit
+		if requested, ok = claim.Spec.Resources.Limits[api.ResourceStorage]; !ok {
+			return nil, fmt.Errorf("claim %s/%s does not request %s", claim.Namespace, claim.Name, api.ResourceStorage)
+		}
+	}
+
+	candidates := byCapacity{}
+	for _, obj := range pvIndex.List() {
+		volume := obj.(*api.PersistentVolume)
+		if volume.Spec.ClaimRef != nil {
+			continue
+		}
+		if !containsAccessModes(volume.Spec.AccessModes, claim.Spec.AccessModes) {
+			continue
+		}
+		if storageSize(volume.Spec.Capacity) < requested.Value() {
+			continue
+		}
+		candidates = append(candidates, volume)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sort.Sort(candidates)
+	return candidates[0], nil
+}
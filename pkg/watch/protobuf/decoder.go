@@ -0,0 +1,82 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Decoder implements the watch.Decoder interface for io.ReadClosers that
+// have contents which consist of a series of watchEvent objects encoded in protobuf.
+// It will decode any object registered in the supplied codec.
+type Decoder struct {
+	r      io.ReadCloser
+	reader *bufio.Reader
+	codec  runtime.Codec
+}
+
+// NewDecoder creates an Decoder for the given reader and codec.
+func NewDecoder(r io.ReadCloser, codec runtime.Codec) *Decoder {
+	return &Decoder{
+		r:      r,
+		reader: bufio.NewReader(r),
+		codec:  codec,
+	}
+}
+
+// Decode blocks until it can return the next object in the reader. Returns an error
+// if the reader is closed or an object can't be decoded.
+func (d *Decoder) Decode() (watch.EventType, runtime.Object, error) {
+	length, err := binary.ReadUvarint(d.reader)
+	if err != nil {
+		return "", nil, err
+	}
+	if length > maxEventSize {
+		return "", nil, fmt.Errorf("watch event of %d bytes is larger than the maximum of %d bytes", length, maxEventSize)
+	}
+	message := make([]byte, length)
+	if _, err := io.ReadFull(d.reader, message); err != nil {
+		return "", nil, err
+	}
+	var got watchEvent
+	if err := got.UnmarshalProtobuf(message); err != nil {
+		return "", nil, err
+	}
+	eventType := watch.EventType(got.Type)
+	switch eventType {
+	case watch.Added, watch.Modified, watch.Deleted, watch.Error:
+	default:
+		return "", nil, fmt.Errorf("got invalid watch event type: %v", got.Type)
+	}
+
+	obj, err := d.codec.Decode(got.Object)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to decode watch event: %v", err)
+	}
+	return eventType, obj, nil
+}
+
+// Close closes the underlying r.
+func (d *Decoder) Close() {
+	d.r.Close()
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/runtime"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+// Encoder writes watch events to an io.Writer in protobuf. It will encode any object
+// registered in the supplied codec, which should encode objects in protobuf.
+type Encoder struct {
+	w     io.Writer
+	codec runtime.Codec
+}
+
+// NewEncoder creates an Encoder for the given writer and codec.
+func NewEncoder(w io.Writer, codec runtime.Codec) *Encoder {
+	return &Encoder{
+		w:     w,
+		codec: codec,
+	}
+}
+
+// Encode writes an event to the writer. Returns an error
+// if the writer is closed or an object can't be encoded.
+func (e *Encoder) Encode(event *watch.Event) error {
+	obj, ok := event.Object.(runtime.Object)
+	if !ok {
+		return fmt.Errorf("the event object cannot be safely converted to protobuf: %v", reflect.TypeOf(event.Object).Name())
+	}
+	data, err := e.codec.Encode(obj)
+	if err != nil {
+		return err
+	}
+	message := (&watchEvent{Type: string(event.Type), Object: data}).AppendProtobuf(nil)
+	length := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(length, uint64(len(message)))
+	if _, err := e.w.Write(append(length[:n], message...)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api/v1beta3"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/watch"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	events := []watch.Event{
+		{Type: watch.Added, Object: &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo"}}},
+		{Type: watch.Modified, Object: &api.Pod{ObjectMeta: api.ObjectMeta{Name: "foo", Labels: map[string]string{"app": "web"}}}},
+		{Type: watch.Deleted, Object: &api.Service{ObjectMeta: api.ObjectMeta{Name: "bar"}}},
+		{Type: watch.Error, Object: &api.Status{Status: api.StatusFailure, Message: "gone"}},
+	}
+	buf := &bytes.Buffer{}
+	encoder := NewEncoder(buf, v1beta3.ProtobufCodec)
+	for i := range events {
+		if err := encoder.Encode(&events[i]); err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+	}
+
+	decoder := NewDecoder(ioutil.NopCloser(buf), v1beta3.ProtobufCodec)
+	for i, expected := range events {
+		eventType, obj, err := decoder.Decode()
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %v", i, err)
+		}
+		if eventType != expected.Type {
+			t.Errorf("%d: unexpected type: %v", i, eventType)
+		}
+		if !api.Semantic.DeepDerivative(expected.Object, obj) {
+			t.Errorf("%d: expected %#v, got %#v", i, expected.Object, obj)
+		}
+	}
+	if _, _, err := decoder.Decode(); err != io.EOF {
+		t.Errorf("expected the end of the stream, got %v", err)
+	}
+}
+
+func TestDecodeInvalidEvent(t *testing.T) {
+	buf := &bytes.Buffer{}
+	encoder := NewEncoder(buf, v1beta3.ProtobufCodec)
+	if err := encoder.Encode(&watch.Event{Type: "UNKNOWN", Object: &api.Pod{}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := NewDecoder(ioutil.NopCloser(buf), v1beta3.ProtobufCodec).Decode(); err == nil {
+		t.Errorf("expected an error for an invalid event type")
+	}
+}
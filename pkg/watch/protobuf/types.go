@@ -0,0 +1,65 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"fmt"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/conversion/protobuf"
+)
+
+// watchEvent objects are streamed from the api server in response to a watch request
+// from a client which accepts protobuf.
+type watchEvent struct {
+	// The type of the watch event; added, modified, or deleted.
+	Type string
+
+	// The protobuf encoding of the object of the event, by the codec of the stream.
+	Object []byte
+}
+
+// AppendProtobuf appends the protobuf encoding of the event to buf: Type is field 1
+// and Object field 2.
+func (e *watchEvent) AppendProtobuf(buf []byte) []byte {
+	buf = protobuf.AppendString(buf, 1, e.Type)
+	return protobuf.AppendBytes(buf, 2, e.Object)
+}
+
+// UnmarshalProtobuf decodes the protobuf encoding of an event. Object is not copied
+// from data.
+func (e *watchEvent) UnmarshalProtobuf(data []byte) error {
+	for len(data) > 0 {
+		number, value, rest, err := protobuf.ReadField(data)
+		if err != nil {
+			return err
+		}
+		data = rest
+		switch number {
+		case 1:
+			err = value.DecodeString(&e.Type)
+		case 2:
+			e.Object, err = value.Message()
+		}
+		if err != nil {
+			return fmt.Errorf("unable to decode field %d of the watch event: %v", number, err)
+		}
+	}
+	return nil
+}
+
+// maxEventSize bounds the size of the events read by a Decoder.
+const maxEventSize = 64 << 20
@@ -114,41 +114,74 @@ func Max(a int64, b int64) int64 {
 	return b
 }
 
-// PodLimitFunc enforces that a pod spec does not exceed any limits specified on the supplied limit range
+// PodLimitFunc enforces that a pod spec does not exceed any limits specified on the supplied limit range.
+// The minimums apply to the resources requested by the containers, and the maximums to their limits.
+// Init containers are held to the container limits too.
 func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.Object) error {
 	if resourceName != "pods" {
 		return nil
 	}
 
-	pod := obj.(*api.Pod)
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		// subresources of pods, like evictions, are posted as objects of other kinds
+		return nil
+	}
 
-	podCPU := int64(0)
-	podMem := int64(0)
+	podCPURequest := int64(0)
+	podMemRequest := int64(0)
+	podCPULimit := int64(0)
+	podMemLimit := int64(0)
 
-	minContainerCPU := int64(0)
-	minContainerMem := int64(0)
-	maxContainerCPU := int64(0)
-	maxContainerMem := int64(0)
+	minContainerCPURequest := int64(0)
+	minContainerMemRequest := int64(0)
+	maxContainerCPULimit := int64(0)
+	maxContainerMemLimit := int64(0)
 
 	for i := range pod.Spec.Containers {
 		container := pod.Spec.Containers[i]
-		containerCPU := container.Resources.Limits.Cpu().MilliValue()
-		containerMem := container.Resources.Limits.Memory().Value()
+		containerCPURequest := container.Resources.Requests.Cpu().MilliValue()
+		containerMemRequest := container.Resources.Requests.Memory().Value()
+		containerCPULimit := container.Resources.Limits.Cpu().MilliValue()
+		containerMemLimit := container.Resources.Limits.Memory().Value()
 
 		if i == 0 {
-			minContainerCPU = containerCPU
-			minContainerMem = containerMem
-			maxContainerCPU = containerCPU
-			maxContainerMem = containerMem
+			minContainerCPURequest = containerCPURequest
+			minContainerMemRequest = containerMemRequest
+			maxContainerCPULimit = containerCPULimit
+			maxContainerMemLimit = containerMemLimit
 		}
 
-		podCPU = podCPU + container.Resources.Limits.Cpu().MilliValue()
-		podMem = podMem + container.Resources.Limits.Memory().Value()
+		podCPURequest = podCPURequest + containerCPURequest
+		podMemRequest = podMemRequest + containerMemRequest
+		podCPULimit = podCPULimit + containerCPULimit
+		podMemLimit = podMemLimit + containerMemLimit
+
+		minContainerCPURequest = Min(containerCPURequest, minContainerCPURequest)
+		minContainerMemRequest = Min(containerMemRequest, minContainerMemRequest)
+		maxContainerCPULimit = Max(containerCPULimit, maxContainerCPULimit)
+		maxContainerMemLimit = Max(containerMemLimit, maxContainerMemLimit)
+	}
 
-		minContainerCPU = Min(containerCPU, minContainerCPU)
-		minContainerMem = Min(containerMem, minContainerMem)
-		maxContainerCPU = Max(containerCPU, maxContainerCPU)
-		maxContainerMem = Max(containerMem, maxContainerMem)
+	// The init containers run one at a time before the other containers are started, so
+	// the pod needs the largest of the resources of its init containers and the total of
+	// its containers, as in the scheduler.
+	for i := range pod.Spec.InitContainers {
+		container := pod.Spec.InitContainers[i]
+		containerCPURequest := container.Resources.Requests.Cpu().MilliValue()
+		containerMemRequest := container.Resources.Requests.Memory().Value()
+		containerCPULimit := container.Resources.Limits.Cpu().MilliValue()
+		containerMemLimit := container.Resources.Limits.Memory().Value()
+
+		podCPURequest = Max(containerCPURequest, podCPURequest)
+		podMemRequest = Max(containerMemRequest, podMemRequest)
+		podCPULimit = Max(containerCPULimit, podCPULimit)
+		podMemLimit = Max(containerMemLimit, podMemLimit)
+
+		minContainerCPURequest = Min(containerCPURequest, minContainerCPURequest)
+		minContainerMemRequest = Min(containerMemRequest, minContainerMemRequest)
+		maxContainerCPULimit = Max(containerCPULimit, maxContainerCPULimit)
+		maxContainerMemLimit = Max(containerMemLimit, maxContainerMemLimit)
 	}
 
 	for i := range limitRange.Spec.Limits {
@@ -170,20 +203,32 @@ func PodLimitFunc(limitRange *api.LimitRange, resourceName string, obj runtime.O
 					enforced = v.Value()
 					switch limit.Type {
 					case api.LimitTypePod:
-						observed = podMem
+						observed = podMemLimit
+						if minOrMax == "Min" {
+							observed = podMemRequest
+						}
 						err = fmt.Errorf("%simum memory usage per pod is %s", minOrMax, v.String())
 					case api.LimitTypeContainer:
-						observed = maxContainerMem
+						observed = maxContainerMemLimit
+						if minOrMax == "Min" {
+							observed = minContainerMemRequest
+						}
 						err = fmt.Errorf("%simum memory usage per container is %s", minOrMax, v.String())
 					}
 				case api.ResourceCPU:
 					enforced = v.MilliValue()
 					switch limit.Type {
 					case api.LimitTypePod:
-						observed = podCPU
+						observed = podCPULimit
+						if minOrMax == "Min" {
+							observed = podCPURequest
+						}
 						err = fmt.Errorf("%simum CPU usage per pod is %s, but requested %s", minOrMax, v.String(), resource.NewMilliQuantity(observed, resource.DecimalSI))
 					case api.LimitTypeContainer:
-						observed = maxContainerCPU
+						observed = maxContainerCPULimit
+						if minOrMax == "Min" {
+							observed = minContainerCPURequest
+						}
 						err = fmt.Errorf("%simum CPU usage per container is %s", minOrMax, v.String())
 					}
 				}
@@ -32,7 +32,18 @@ func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
 	if memory != "" {
 		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
 	}
+	// requests default to limits
+	res.Requests = api.ResourceList{}
+	for name, limit := range res.Limits {
+		res.Requests[name] = limit
+	}
+
+	return res
+}
 
+// withRequests overrides the requests of resource requirements.
+func withRequests(res api.ResourceRequirements, cpu, memory string) api.ResourceRequirements {
+	res.Requests = getResourceRequirements(cpu, memory).Limits
 	return res
 }
 
@@ -84,9 +95,109 @@ func TestPodLimitFunc(t *testing.T) {
 				},
 			},
 		},
+		{
+			// the init container is not added to the total of the containers
+			ObjectMeta: api.ObjectMeta{Name: "init-within-pod-max"},
+			Spec: api.PodSpec{
+				InitContainers: []api.Container{
+					{
+						Image:     "init:V1",
+						Resources: getResourceRequirements("100m", "2Gi"),
+					},
+				},
+				Containers: []api.Container{
+					{
+						Image:     "foo:V1",
+						Resources: getResourceRequirements("100m", "2Gi"),
+					},
+					{
+						Image:     "boo:V1",
+						Resources: getResourceRequirements("100m", "2Gi"),
+					},
+				},
+			},
+		},
+		{
+			// the init container needs more than the containers of the pod
+			ObjectMeta: api.ObjectMeta{Name: "init-above-pod-min"},
+			Spec: api.PodSpec{
+				InitContainers: []api.Container{
+					{
+						Image:     "init:V1",
+						Resources: getResourceRequirements("60m", "2Gi"),
+					},
+				},
+				Containers: []api.Container{
+					{
+						Image:     "boo:V1",
+						Resources: getResourceRequirements("40m", "2Gi"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: api.ObjectMeta{Name: "burstable"},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					{
+						Image:     "boo:V1",
+						Resources: withRequests(getResourceRequirements("100m", "2Gi"), "50m", "2Mi"),
+					},
+				},
+			},
+		},
 	}
 
 	errorCases := map[string]api.Pod{
+		"max-init-container-cpu": {
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec: api.PodSpec{
+				InitContainers: []api.Container{
+					{
+						Image:     "init:V1",
+						Resources: getResourceRequirements("110m", "1Gi"),
+					},
+				},
+				Containers: []api.Container{
+					{
+						Image:     "boo:V1",
+						Resources: getResourceRequirements("100m", "1Gi"),
+					},
+				},
+			},
+		},
+		"min-init-container-mem": {
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec: api.PodSpec{
+				InitContainers: []api.Container{
+					{
+						Image:     "init:V1",
+						Resources: getResourceRequirements("30m", "0"),
+					},
+				},
+				Containers: []api.Container{
+					{
+						Image:     "boo:V1",
+						Resources: getResourceRequirements("100m", "1Gi"),
+					},
+				},
+			},
+		},
+		"min-container-cpu-request": {
+			ObjectMeta: api.ObjectMeta{Name: "foo"},
+			Spec: api.PodSpec{
+				Containers: []api.Container{
+					{
+						Image:     "boo:V1",
+						Resources: withRequests(getResourceRequirements("100m", "2Gi"), "10m", "2Gi"),
+					},
+					{
+						Image:     "boo:V2",
+						Resources: getResourceRequirements("100m", "2Gi"),
+					},
+				},
+			},
+		},
 		"min-container-cpu": {
 			ObjectMeta: api.ObjectMeta{Name: "foo"},
 			Spec: api.PodSpec{
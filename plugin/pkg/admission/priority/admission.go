@@ -0,0 +1,99 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/fields"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/labels"
+)
+
+func init() {
+	admission.RegisterPlugin("Priority", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewPriority(client), nil
+	})
+}
+
+// priority is an implementation of admission.Interface.
+// It sets the priority of new pods from their PriorityClass.
+type priority struct {
+	client client.Interface
+}
+
+func (p *priority) Admit(a admission.Attributes) (err error) {
+	if a.GetOperation() != "CREATE" || a.GetResource() != "pods" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return nil
+	}
+
+	// the priority is never taken from the user, even without a class
+	value := 0
+	if len(pod.Spec.PriorityClassName) > 0 {
+		pc, err := p.client.PriorityClasses().Get(pod.Spec.PriorityClassName)
+		if apierrors.IsNotFound(err) {
+			return apierrors.NewForbidden(a.GetResource(), pod.Name, fmt.Errorf("priority class %s was not found", pod.Spec.PriorityClassName))
+		}
+		if err != nil {
+			return err
+		}
+		value = pc.Value
+	} else {
+		pc, err := p.findGlobalDefault()
+		if err != nil {
+			return err
+		}
+		if pc != nil {
+			pod.Spec.PriorityClassName = pc.Name
+			value = pc.Value
+		}
+	}
+	pod.Spec.Priority = &value
+	return nil
+}
+
+// findGlobalDefault returns the global default priority class, or nil if there
+// is none. If several classes are marked as the global default, the one with the
+// lowest value is used.
+func (p *priority) findGlobalDefault() (*api.PriorityClass, error) {
+	list, err := p.client.PriorityClasses().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var result *api.PriorityClass
+	for i := range list.Items {
+		pc := &list.Items[i]
+		if pc.GlobalDefault && (result == nil || pc.Value < result.Value) {
+			result = pc
+		}
+	}
+	return result, nil
+}
+
+// NewPriority returns an admission.Interface which looks up priority classes
+// through client.
+func NewPriority(client client.Interface) admission.Interface {
+	return &priority{client: client}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func newPod(priorityClassName string) *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: "ns"},
+		Spec: api.PodSpec{
+			Containers:        []api.Container{{Name: "ctr", Image: "image"}},
+			PriorityClassName: priorityClassName,
+		},
+	}
+}
+
+func TestAdmitResolvesPriority(t *testing.T) {
+	userValue := 1000000
+	kubeClient := &client.Fake{
+		PriorityClass: api.PriorityClass{ObjectMeta: api.ObjectMeta{Name: "high-priority"}, Value: 1000},
+		PriorityClassesList: api.PriorityClassList{
+			Items: []api.PriorityClass{
+				{ObjectMeta: api.ObjectMeta{Name: "high-priority"}, Value: 1000},
+				{ObjectMeta: api.ObjectMeta{Name: "batch"}, Value: 10, GlobalDefault: true},
+			},
+		},
+	}
+	handler := NewPriority(kubeClient)
+
+	named := newPod("high-priority")
+	named.Spec.Priority = &userValue
+	if err := handler.Admit(admission.NewAttributesRecord(named, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if named.Spec.Priority == nil || *named.Spec.Priority != 1000 {
+		t.Errorf("expected the priority of the class, got %v", named.Spec.Priority)
+	}
+	if e, a := "get-priorityClass", kubeClient.Actions[0]; a.Action != e || a.Value != "high-priority" {
+		t.Errorf("expected the class of the pod to be looked up, got %#v", a)
+	}
+
+	unnamed := newPod("")
+	if err := handler.Admit(admission.NewAttributesRecord(unnamed, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if unnamed.Spec.PriorityClassName != "batch" || unnamed.Spec.Priority == nil || *unnamed.Spec.Priority != 10 {
+		t.Errorf("expected the global default class, got %q %v", unnamed.Spec.PriorityClassName, unnamed.Spec.Priority)
+	}
+}
+
+func TestAdmitWithoutGlobalDefault(t *testing.T) {
+	userValue := 1000000
+	pod := newPod("")
+	pod.Spec.Priority = &userValue
+	if err := NewPriority(&client.Fake{}).Admit(admission.NewAttributesRecord(pod, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pod.Spec.PriorityClassName) != 0 || pod.Spec.Priority == nil || *pod.Spec.Priority != 0 {
+		t.Errorf("expected a zero priority, got %q %v", pod.Spec.PriorityClassName, pod.Spec.Priority)
+	}
+}
+
+func TestAdmitRejectsMissingClass(t *testing.T) {
+	kubeClient := &client.Fake{Err: apierrors.NewNotFound("priorityClasses", "high-priority")}
+	err := NewPriority(kubeClient).Admit(admission.NewAttributesRecord(newPod("high-priority"), "ns", "pods", "CREATE"))
+	if !apierrors.IsForbidden(err) {
+		t.Errorf("expected a forbidden error, got %v", err)
+	}
+}
+
+func TestIgnoreAdmission(t *testing.T) {
+	kubeClient := &client.Fake{}
+	pod := newPod("high-priority")
+	if err := NewPriority(kubeClient).Admit(admission.NewAttributesRecord(pod, "ns", "pods", "UPDATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.Priority != nil || len(kubeClient.Actions) != 0 {
+		t.Errorf("expected updates to be ignored, got %v %#v", pod.Spec.Priority, kubeClient.Actions)
+	}
+}
@@ -53,21 +53,43 @@ func (resourceDefaults) Admit(a admission.Attributes) (err error) {
 
 	// get the pod, so we can validate each of the containers within have default mem / cpu constraints
 	obj := a.GetObject()
-	pod := obj.(*api.Pod)
+	pod, ok := obj.(*api.Pod)
+	if !ok {
+		// subresources of pods, like evictions, are posted as objects of other kinds
+		return nil
+	}
+	for index := range pod.Spec.InitContainers {
+		defaultResources(&pod.Spec.InitContainers[index])
+	}
 	for index := range pod.Spec.Containers {
-		if pod.Spec.Containers[index].Resources.Limits == nil {
-			pod.Spec.Containers[index].Resources.Limits = api.ResourceList{}
-		}
-		if pod.Spec.Containers[index].Resources.Limits.Memory().Value() == 0 {
-			pod.Spec.Containers[index].Resources.Limits[api.ResourceMemory] = resource.MustParse(defaultMemory)
-		}
-		if pod.Spec.Containers[index].Resources.Limits.Cpu().Value() == 0 {
-			pod.Spec.Containers[index].Resources.Limits[api.ResourceCPU] = resource.MustParse(defaultCPU)
-		}
+		defaultResources(&pod.Spec.Containers[index])
 	}
 	return nil
 }
 
+// defaultResources sets the default memory and cpu limits of container, and defaults
+// its requests to its limits.
+func defaultResources(container *api.Container) {
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = api.ResourceList{}
+	}
+	if container.Resources.Limits.Memory().Value() == 0 {
+		container.Resources.Limits[api.ResourceMemory] = resource.MustParse(defaultMemory)
+	}
+	if container.Resources.Limits.Cpu().Value() == 0 {
+		container.Resources.Limits[api.ResourceCPU] = resource.MustParse(defaultCPU)
+	}
+	// requests default to limits
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = api.ResourceList{}
+	}
+	for _, name := range []api.ResourceName{api.ResourceMemory, api.ResourceCPU} {
+		if _, found := container.Resources.Requests[name]; !found {
+			container.Resources.Requests[name] = container.Resources.Limits[name]
+		}
+	}
+}
+
 func NewResourceDefaults() admission.Interface {
 	return new(resourceDefaults)
 }
@@ -31,8 +31,9 @@ func TestAdmission(t *testing.T) {
 	pod := api.Pod{
 		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: "ns"},
 		Spec: api.PodSpec{
-			Volumes:    []api.Volume{{Name: "vol"}},
-			Containers: []api.Container{{Name: "ctr", Image: "image"}},
+			Volumes:        []api.Volume{{Name: "vol"}},
+			InitContainers: []api.Container{{Name: "init", Image: "image"}},
+			Containers:     []api.Container{{Name: "ctr", Image: "image"}},
 		},
 	}
 
@@ -41,15 +42,23 @@ func TestAdmission(t *testing.T) {
 		t.Errorf("Unexpected error returned from admission handler")
 	}
 
-	for i := range pod.Spec.Containers {
-		memory := pod.Spec.Containers[i].Resources.Limits.Memory().String()
-		cpu := pod.Spec.Containers[i].Resources.Limits.Cpu().String()
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		memory := container.Resources.Limits.Memory().String()
+		cpu := container.Resources.Limits.Cpu().String()
 		if memory != "512Mi" {
 			t.Errorf("Unexpected memory value %s", memory)
 		}
 		if cpu != "1" {
 			t.Errorf("Unexpected cpu value %s", cpu)
 		}
+		memoryRequest := container.Resources.Requests.Memory().String()
+		cpuRequest := container.Resources.Requests.Cpu().String()
+		if memoryRequest != "512Mi" {
+			t.Errorf("Unexpected memory request %s", memoryRequest)
+		}
+		if cpuRequest != "1" {
+			t.Errorf("Unexpected cpu request %s", cpuRequest)
+		}
 	}
 }
 
@@ -132,11 +132,28 @@ func (q *quota) Admit(a admission.Attributes) (err error) {
 	return nil
 }
 
+// computeResources are the compute resources of pods tracked by quota, along
+// with the functions computing the usage of a pod.
+var computeResources = []struct {
+	name        api.ResourceName
+	description string
+	podUsage    func(*api.Pod) *resource.Quantity
+}{
+	{api.ResourceMemory, "memory", resourcequota.PodMemory},
+	{api.ResourceCPU, "CPU", resourcequota.PodCPU},
+	{api.ResourceLimitsMemory, "memory limits", resourcequota.PodLimitsMemory},
+	{api.ResourceLimitsCPU, "CPU limits", resourcequota.PodLimitsCPU},
+}
+
 // IncrementUsage updates the supplied ResourceQuotaStatus object based on the incoming operation
 // Return true if the usage must be recorded prior to admitting the new resource
 // Return an error if the operation should not pass admission control
 func IncrementUsage(a admission.Attributes, status *api.ResourceQuotaStatus, client client.Interface) (bool, error) {
 	obj := a.GetObject()
+	// subresources of pods, like evictions, are posted as objects of other kinds
+	if _, isPod := obj.(*api.Pod); a.GetResource() == "pods" && !isPod {
+		return false, nil
+	}
 	resourceName := a.GetResource()
 	name := "Unknown"
 	if obj != nil {
@@ -164,48 +181,36 @@ func IncrementUsage(a admission.Attributes, status *api.ResourceQuotaStatus, cli
 			}
 		}
 	}
-	// handle memory/cpu constraints, and any diff of usage based on memory/cpu on updates
-	if a.GetResource() == "pods" && (set[api.ResourceMemory] || set[api.ResourceCPU]) {
+	// handle compute resource constraints, and any diff of usage based on compute resources on updates
+	if a.GetResource() == "pods" {
 		pod := obj.(*api.Pod)
-		deltaCPU := resourcequota.PodCPU(pod)
-		deltaMemory := resourcequota.PodMemory(pod)
-		// if this is an update, we need to find the delta cpu/memory usage from previous state
-		if a.GetOperation() == "UPDATE" {
-			oldPod, err := client.Pods(a.GetNamespace()).Get(pod.Name)
-			if err != nil {
-				return false, apierrors.NewForbidden(resourceName, name, err)
+		var oldPod *api.Pod
+		for _, computeResource := range computeResources {
+			hard, hardFound := status.Hard[computeResource.name]
+			if !hardFound {
+				continue
 			}
-			oldCPU := resourcequota.PodCPU(oldPod)
-			oldMemory := resourcequota.PodMemory(oldPod)
-			deltaCPU = resource.NewMilliQuantity(deltaCPU.MilliValue()-oldCPU.MilliValue(), resource.DecimalSI)
-			deltaMemory = resource.NewQuantity(deltaMemory.Value()-oldMemory.Value(), resource.DecimalSI)
-		}
-
-		hardMem, hardMemFound := status.Hard[api.ResourceMemory]
-		if hardMemFound {
-			used, usedFound := status.Used[api.ResourceMemory]
+			used, usedFound := status.Used[computeResource.name]
 			if !usedFound {
 				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed."))
 			}
-			if used.Value()+deltaMemory.Value() > hardMem.Value() {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s memory", hardMem.String()))
-			} else {
-				status.Used[api.ResourceMemory] = *resource.NewQuantity(used.Value()+deltaMemory.Value(), resource.DecimalSI)
-				dirty = true
-			}
-		}
-		hardCPU, hardCPUFound := status.Hard[api.ResourceCPU]
-		if hardCPUFound {
-			used, usedFound := status.Used[api.ResourceCPU]
-			if !usedFound {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Quota usage stats are not yet known, unable to admit resource until an accurate count is completed."))
+			delta := computeResource.podUsage(pod).MilliValue()
+			// if this is an update, we need to find the delta usage from previous state
+			if a.GetOperation() == "UPDATE" {
+				if oldPod == nil {
+					var err error
+					oldPod, err = client.Pods(a.GetNamespace()).Get(pod.Name)
+					if err != nil {
+						return false, apierrors.NewForbidden(resourceName, name, err)
+					}
+				}
+				delta = delta - computeResource.podUsage(oldPod).MilliValue()
 			}
-			if used.MilliValue()+deltaCPU.MilliValue() > hardCPU.MilliValue() {
-				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s CPU", hardCPU.String()))
-			} else {
-				status.Used[api.ResourceCPU] = *resource.NewMilliQuantity(used.MilliValue()+deltaCPU.MilliValue(), resource.DecimalSI)
-				dirty = true
+			if used.MilliValue()+delta > hard.MilliValue() {
+				return false, apierrors.NewForbidden(resourceName, name, fmt.Errorf("Limited to %s %s", hard.String(), computeResource.description))
 			}
+			status.Used[computeResource.name] = *resource.NewMilliQuantity(used.MilliValue()+delta, resource.DecimalSI)
+			dirty = true
 		}
 	}
 	return dirty, nil
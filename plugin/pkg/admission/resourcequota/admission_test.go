@@ -34,6 +34,11 @@ func getResourceRequirements(cpu, memory string) api.ResourceRequirements {
 	if memory != "" {
 		res.Limits[api.ResourceMemory] = resource.MustParse(memory)
 	}
+	// requests default to limits
+	res.Requests = api.ResourceList{}
+	for name, limit := range res.Limits {
+		res.Requests[name] = limit
+	}
 
 	return res
 }
@@ -203,6 +208,84 @@ func TestIncrementUsageCPU(t *testing.T) {
 	}
 }
 
+func TestIncrementUsageCPURequestsAndLimits(t *testing.T) {
+	namespace := "default"
+	client := &client.Fake{}
+	status := &api.ResourceQuotaStatus{
+		Hard: api.ResourceList{},
+		Used: api.ResourceList{},
+	}
+	status.Hard[api.ResourceCPU] = resource.MustParse("200m")
+	status.Used[api.ResourceCPU] = resource.MustParse("100m")
+	status.Hard[api.ResourceLimitsCPU] = resource.MustParse("1")
+	status.Used[api.ResourceLimitsCPU] = resource.MustParse("400m")
+
+	resources := getResourceRequirements("500m", "1Gi")
+	resources.Requests[api.ResourceCPU] = resource.MustParse("100m")
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			Volumes:    []api.Volume{{Name: "vol"}},
+			Containers: []api.Container{{Name: "ctr", Image: "image", Resources: resources}},
+		}}
+	dirty, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
+	if err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if !dirty {
+		t.Errorf("Expected the status to get incremented, therefore should have been dirty")
+	}
+	for name, expected := range map[api.ResourceName]string{api.ResourceCPU: "200m", api.ResourceLimitsCPU: "900m"} {
+		expectedVal := resource.MustParse(expected)
+		quantity := status.Used[name]
+		if quantity.MilliValue() != expectedVal.MilliValue() {
+			t.Errorf("Expected %s usage %v was %v", name, expectedVal.MilliValue(), quantity.MilliValue())
+		}
+	}
+
+	// the requests of the next pod fit in the quota, but not its limits
+	status.Used[api.ResourceCPU] = resource.MustParse("100m")
+	_, err = IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client)
+	if err == nil {
+		t.Errorf("Expected CPU limits usage exceeded error")
+	}
+}
+
+func TestIncrementUsageInitContainers(t *testing.T) {
+	namespace := "default"
+	client := &client.Fake{}
+	status := &api.ResourceQuotaStatus{
+		Hard: api.ResourceList{},
+		Used: api.ResourceList{},
+	}
+	status.Hard[api.ResourceCPU] = resource.MustParse("2")
+	status.Used[api.ResourceCPU] = resource.MustParse("100m")
+	status.Hard[api.ResourceMemory] = resource.MustParse("2Gi")
+	status.Used[api.ResourceMemory] = resource.MustParse("0")
+
+	// the init container runs before the containers, so the pod uses the largest of its
+	// resources and the total of the containers
+	newPod := &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: namespace},
+		Spec: api.PodSpec{
+			InitContainers: []api.Container{{Name: "init", Image: "image", Resources: getResourceRequirements("500m", "100Mi")}},
+			Containers: []api.Container{
+				{Name: "ctr1", Image: "image", Resources: getResourceRequirements("100m", "1Gi")},
+				{Name: "ctr2", Image: "image", Resources: getResourceRequirements("100m", "1Gi")},
+			},
+		}}
+	if _, err := IncrementUsage(admission.NewAttributesRecord(newPod, namespace, "pods", "CREATE"), status, client); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	for name, expected := range map[api.ResourceName]string{api.ResourceCPU: "600m", api.ResourceMemory: "2Gi"} {
+		expectedVal := resource.MustParse(expected)
+		quantity := status.Used[name]
+		if quantity.MilliValue() != expectedVal.MilliValue() {
+			t.Errorf("Expected %s usage %v was %v", name, expectedVal.MilliValue(), quantity.MilliValue())
+		}
+	}
+}
+
 func TestExceedUsageCPU(t *testing.T) {
 	namespace := "default"
 	client := &client.Fake{
@@ -0,0 +1,147 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+)
+
+func init() {
+	admission.RegisterPlugin("ServiceAccount", func(client client.Interface, config io.Reader) (admission.Interface, error) {
+		return NewServiceAccount(client), nil
+	})
+}
+
+// DefaultAPITokenMountPath is the path the API token of the service account
+// of a pod is mounted at in each of its containers.
+const DefaultAPITokenMountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// mirrorPodAnnotationKey marks the mirror pods the kubelet creates for static
+// pods, which are not run from the API and cannot use a service account.
+const mirrorPodAnnotationKey = "kubernetes.io/config.mirror"
+
+// serviceAccount is an implementation of admission.Interface.
+// It sets the service account of new pods and mounts its API token.
+type serviceAccount struct {
+	client client.Interface
+}
+
+func (s *serviceAccount) Admit(a admission.Attributes) (err error) {
+	if a.GetOperation() != "CREATE" || a.GetResource() != "pods" {
+		return nil
+	}
+	pod, ok := a.GetObject().(*api.Pod)
+	if !ok {
+		return nil
+	}
+	if _, isMirror := pod.Annotations[mirrorPodAnnotationKey]; isMirror {
+		return nil
+	}
+
+	if len(pod.Spec.ServiceAccount) == 0 {
+		pod.Spec.ServiceAccount = serviceaccount.DefaultServiceAccountName
+	}
+	sa, err := s.client.ServiceAccounts(a.GetNamespace()).Get(pod.Spec.ServiceAccount)
+	if apierrors.IsNotFound(err) {
+		return apierrors.NewForbidden(a.GetResource(), pod.Name, fmt.Errorf("service account %s/%s was not found", a.GetNamespace(), pod.Spec.ServiceAccount))
+	}
+	if err != nil {
+		return err
+	}
+	token, err := s.findToken(sa)
+	if err != nil {
+		return err
+	}
+	if len(token) == 0 {
+		return apierrors.NewForbidden(a.GetResource(), pod.Name, fmt.Errorf("no API token found for service account %s/%s, retry after the token is automatically created", a.GetNamespace(), sa.Name))
+	}
+	mountToken(pod, token)
+	return nil
+}
+
+// findToken returns the name of a token secret referenced by sa, or an empty
+// string if sa has none yet.
+func (s *serviceAccount) findToken(sa *api.ServiceAccount) (string, error) {
+	for _, ref := range sa.Secrets {
+		secret, err := s.client.Secrets(sa.Namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if secret.Type == api.SecretTypeServiceAccountToken && secret.Annotations[api.ServiceAccountNameKey] == sa.Name {
+			return ref.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// mountToken adds a volume for the token secret to pod, and mounts it in every
+// init container and container that does not already use DefaultAPITokenMountPath.
+func mountToken(pod *api.Pod, token string) {
+	volumeName := ""
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == token {
+			volumeName = volume.Name
+			break
+		}
+	}
+	if len(volumeName) == 0 {
+		volumeName = token
+		pod.Spec.Volumes = append(pod.Spec.Volumes, api.Volume{
+			Name: volumeName,
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{SecretName: token},
+			},
+		})
+	}
+	for i := range pod.Spec.InitContainers {
+		mountTokenVolume(&pod.Spec.InitContainers[i], volumeName)
+	}
+	for i := range pod.Spec.Containers {
+		mountTokenVolume(&pod.Spec.Containers[i], volumeName)
+	}
+}
+
+// mountTokenVolume mounts the named volume at DefaultAPITokenMountPath in container,
+// unless something is already mounted there.
+func mountTokenVolume(container *api.Container, volumeName string) {
+	for _, mount := range container.VolumeMounts {
+		if mount.MountPath == DefaultAPITokenMountPath {
+			return
+		}
+	}
+	container.VolumeMounts = append(container.VolumeMounts, api.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: DefaultAPITokenMountPath,
+	})
+}
+
+// NewServiceAccount returns an admission.Interface which looks up service
+// accounts and their tokens through client.
+func NewServiceAccount(client client.Interface) admission.Interface {
+	return &serviceAccount{client: client}
+}
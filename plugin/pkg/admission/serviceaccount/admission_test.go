@@ -0,0 +1,132 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/admission"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+)
+
+func newFakeClient(tokenSecret string) *client.Fake {
+	return &client.Fake{
+		ServiceAccount: api.ServiceAccount{
+			ObjectMeta: api.ObjectMeta{Name: "default", Namespace: "ns"},
+			Secrets:    []api.ObjectReference{{Name: tokenSecret}},
+		},
+		Secret: api.Secret{
+			ObjectMeta: api.ObjectMeta{
+				Name:        tokenSecret,
+				Namespace:   "ns",
+				Annotations: map[string]string{api.ServiceAccountNameKey: "default"},
+			},
+			Type: api.SecretTypeServiceAccountToken,
+		},
+	}
+}
+
+func newPod() *api.Pod {
+	return &api.Pod{
+		ObjectMeta: api.ObjectMeta{Name: "123", Namespace: "ns"},
+		Spec: api.PodSpec{
+			InitContainers: []api.Container{{Name: "init", Image: "image"}},
+			Containers:     []api.Container{{Name: "ctr1", Image: "image"}, {Name: "ctr2", Image: "image"}},
+		},
+	}
+}
+
+func TestAdmitMountsToken(t *testing.T) {
+	handler := NewServiceAccount(newFakeClient("default-token-abcde"))
+	pod := newPod()
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.ServiceAccount != "default" {
+		t.Errorf("expected the default service account, got %q", pod.Spec.ServiceAccount)
+	}
+	if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Secret == nil || pod.Spec.Volumes[0].Secret.SecretName != "default-token-abcde" {
+		t.Fatalf("expected a volume for the token secret, got %#v", pod.Spec.Volumes)
+	}
+	for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+		expected := api.VolumeMount{Name: pod.Spec.Volumes[0].Name, ReadOnly: true, MountPath: DefaultAPITokenMountPath}
+		if len(container.VolumeMounts) != 1 || container.VolumeMounts[0] != expected {
+			t.Errorf("expected container %s to mount the token, got %#v", container.Name, container.VolumeMounts)
+		}
+	}
+
+	// Admitting the pod again does not mount the token twice.
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pod.Spec.Volumes) != 1 || len(pod.Spec.Containers[0].VolumeMounts) != 1 {
+		t.Errorf("expected the token to be mounted once, got %#v", pod.Spec)
+	}
+}
+
+func TestAdmitKeepsExplicitServiceAccount(t *testing.T) {
+	kubeClient := newFakeClient("builder-token-abcde")
+	kubeClient.ServiceAccount.Name = "builder"
+	kubeClient.Secret.Annotations[api.ServiceAccountNameKey] = "builder"
+	pod := newPod()
+	pod.Spec.ServiceAccount = "builder"
+	if err := NewServiceAccount(kubeClient).Admit(admission.NewAttributesRecord(pod, "ns", "pods", "CREATE")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pod.Spec.ServiceAccount != "builder" {
+		t.Errorf("expected the builder service account, got %q", pod.Spec.ServiceAccount)
+	}
+	if e, a := "get-serviceAccount", kubeClient.Actions[0]; a.Action != e || a.Value != "builder" {
+		t.Errorf("expected the builder service account to be looked up, got %#v", a)
+	}
+}
+
+func TestAdmitRejectsPods(t *testing.T) {
+	missing := newFakeClient("default-token-abcde")
+	missing.Err = apierrors.NewNotFound("serviceAccounts", "default")
+	noToken := newFakeClient("password")
+	noToken.Secret.Type = api.SecretTypeOpaque
+
+	for name, kubeClient := range map[string]*client.Fake{
+		"missing service account":       missing,
+		"service account with no token": noToken,
+	} {
+		err := NewServiceAccount(kubeClient).Admit(admission.NewAttributesRecord(newPod(), "ns", "pods", "CREATE"))
+		if !apierrors.IsForbidden(err) {
+			t.Errorf("%s: expected a forbidden error, got %v", name, err)
+		}
+	}
+}
+
+func TestIgnoreAdmission(t *testing.T) {
+	kubeClient := newFakeClient("default-token-abcde")
+	handler := NewServiceAccount(kubeClient)
+	pod := newPod()
+	if err := handler.Admit(admission.NewAttributesRecord(pod, "ns", "pods", "UPDATE")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	mirror := newPod()
+	mirror.Annotations = map[string]string{mirrorPodAnnotationKey: "mirror"}
+	if err := handler.Admit(admission.NewAttributesRecord(mirror, "ns", "pods", "CREATE")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(kubeClient.Actions) != 0 || len(pod.Spec.Volumes) != 0 || len(mirror.Spec.Volumes) != 0 {
+		t.Errorf("expected pod updates and mirror pods to be ignored, got %#v", kubeClient.Actions)
+	}
+}
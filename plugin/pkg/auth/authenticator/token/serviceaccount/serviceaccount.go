@@ -0,0 +1,90 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package serviceaccount authenticates the API tokens of service accounts.
+package serviceaccount
+
+import (
+	"crypto/rsa"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	apierrors "github.com/GoogleCloudPlatform/kubernetes/pkg/api/errors"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+	"github.com/golang/glog"
+)
+
+// TokenAuthenticator authenticates the tokens signed by the service account
+// tokens controller as the service account they were issued to.
+type TokenAuthenticator struct {
+	key *rsa.PublicKey
+	// client is used to check that the token secret and its service account
+	// still exist. No lookup is done when nil.
+	client client.Interface
+}
+
+// NewJWT returns a TokenAuthenticator which verifies tokens with key. If client
+// is not nil, tokens are only accepted while their secret and service account exist.
+func NewJWT(key *rsa.PublicKey, client client.Interface) *TokenAuthenticator {
+	return &TokenAuthenticator{
+		key:    key,
+		client: client,
+	}
+}
+
+func (a *TokenAuthenticator) AuthenticateToken(value string) (user.Info, bool, error) {
+	claims, err := serviceaccount.ParseToken(a.key, value)
+	if err != nil {
+		return nil, false, err
+	}
+	if a.client != nil {
+		ok, err := a.lookup(claims, value)
+		if !ok || err != nil {
+			return nil, false, err
+		}
+	}
+	return &user.DefaultInfo{
+		Name: serviceaccount.MakeUsername(claims.Namespace, claims.ServiceAccountName),
+		UID:  claims.ServiceAccountUID,
+	}, true, nil
+}
+
+// lookup returns true if the secret holding token and the service account it
+// was issued to still exist.
+func (a *TokenAuthenticator) lookup(claims *serviceaccount.Claims, token string) (bool, error) {
+	secret, err := a.client.Secrets(claims.Namespace).Get(claims.SecretName)
+	if apierrors.IsNotFound(err) {
+		glog.V(4).Infof("Token secret %s/%s no longer exists", claims.Namespace, claims.SecretName)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if string(secret.Data[api.ServiceAccountTokenKey]) != token {
+		glog.V(4).Infof("Token secret %s/%s holds another token", claims.Namespace, claims.SecretName)
+		return false, nil
+	}
+	serviceAccount, err := a.client.ServiceAccounts(claims.Namespace).Get(claims.ServiceAccountName)
+	if apierrors.IsNotFound(err) {
+		glog.V(4).Infof("Service account %s/%s no longer exists", claims.Namespace, claims.ServiceAccountName)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(serviceAccount.UID) == claims.ServiceAccountUID, nil
+}
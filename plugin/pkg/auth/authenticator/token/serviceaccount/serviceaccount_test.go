@@ -0,0 +1,120 @@
+/*
+Copyright 2015 Google Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccount
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/api"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/auth/user"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/client"
+	"github.com/GoogleCloudPlatform/kubernetes/pkg/serviceaccount"
+)
+
+func TestTokenAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	serviceAccount := api.ServiceAccount{
+		ObjectMeta: api.ObjectMeta{Name: "builder", Namespace: "ns", UID: "12345"},
+	}
+	secret := api.Secret{
+		ObjectMeta: api.ObjectMeta{Name: "builder-token-abcde", Namespace: "ns"},
+		Type:       api.SecretTypeServiceAccountToken,
+	}
+	token, err := serviceaccount.JWTTokenGenerator(key).GenerateToken(serviceAccount, secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	otherToken, err := serviceaccount.JWTTokenGenerator(otherKey).GenerateToken(serviceAccount, secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	liveSecret := secret
+	liveSecret.Data = map[string][]byte{api.ServiceAccountTokenKey: []byte(token)}
+	recreatedServiceAccount := serviceAccount
+	recreatedServiceAccount.UID = "67890"
+
+	testCases := map[string]struct {
+		Token  string
+		Client client.Interface
+		User   *user.DefaultInfo
+		Ok     bool
+		Err    bool
+	}{
+		"valid token": {
+			Token: token,
+			User:  &user.DefaultInfo{Name: "system:serviceaccount:ns:builder", UID: "12345"},
+			Ok:    true,
+		},
+		"token signed with another key": {
+			Token: otherToken,
+			Err:   true,
+		},
+		"malformed token": {
+			Token: "token1",
+			Err:   true,
+		},
+		"valid token with lookup": {
+			Token:  token,
+			Client: &client.Fake{Secret: liveSecret, ServiceAccount: serviceAccount},
+			User:   &user.DefaultInfo{Name: "system:serviceaccount:ns:builder", UID: "12345"},
+			Ok:     true,
+		},
+		"token replaced in its secret": {
+			Token:  token,
+			Client: &client.Fake{Secret: secret, ServiceAccount: serviceAccount},
+		},
+		"service account recreated": {
+			Token:  token,
+			Client: &client.Fake{Secret: liveSecret, ServiceAccount: recreatedServiceAccount},
+		},
+	}
+	for k, testCase := range testCases {
+		var auth *TokenAuthenticator
+		if testCase.Client == nil {
+			auth = NewJWT(&key.PublicKey, nil)
+		} else {
+			auth = NewJWT(&key.PublicKey, testCase.Client)
+		}
+		user, ok, err := auth.AuthenticateToken(testCase.Token)
+		if testCase.User == nil {
+			if user != nil {
+				t.Errorf("%s: unexpected non-nil user %#v", k, user)
+			}
+		} else if !reflect.DeepEqual(testCase.User, user) {
+			t.Errorf("%s: expected user %#v, got %#v", k, testCase.User, user)
+		}
+		if testCase.Ok != ok {
+			t.Errorf("%s: expected auth %v, got %v", k, testCase.Ok, ok)
+		}
+		switch {
+		case err == nil && testCase.Err:
+			t.Errorf("%s: unexpected nil error", k)
+		case err != nil && !testCase.Err:
+			t.Errorf("%s: unexpected error: %v", k, err)
+		}
+	}
+}
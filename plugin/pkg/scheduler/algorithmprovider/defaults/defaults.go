@@ -49,6 +49,20 @@ func defaultPredicates() util.StringSet {
 		),
 		// Fit is determined by the presence of the Host parameter and a string match
 		factory.RegisterFitPredicate("HostName", algorithm.PodFitsHost),
+		// Fit is determined by the pod tolerating the taints of the node.
+		factory.RegisterFitPredicateFactory(
+			"PodToleratesNodeTaints",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return algorithm.NewTaintTolerationPredicate(args.NodeInfo)
+			},
+		),
+		// Fit is determined by the pod affinity and anti-affinity of the pod, and of the pods already scheduled.
+		factory.RegisterFitPredicateFactory(
+			"MatchInterPodAffinity",
+			func(args factory.PluginFactoryArgs) algorithm.FitPredicate {
+				return algorithm.NewPodAffinityPredicate(args.PodLister, args.NodeInfo, args.Cycle)
+			},
+		),
 	)
 }
 
@@ -66,6 +80,12 @@ func defaultPriorities() util.StringSet {
 				}
 			},
 		),
+		// Prioritize nodes by the number of PreferNoSchedule taints the pod does not tolerate.
+		factory.RegisterPriorityFunction("TaintTolerationPriority", algorithm.TaintTolerationPriority, 1),
+		// Prioritize nodes matching the preferred node affinity terms of the pod.
+		factory.RegisterPriorityFunction("NodeAffinityPriority", algorithm.NodeAffinityPriority, 1),
+		// Prioritize nodes near the pods of the preferred pod affinity terms, and away from the pods of the preferred anti-affinity terms.
+		factory.RegisterPriorityFunction("InterPodAffinityPriority", algorithm.PodAffinityPriority, 1),
 		// EqualPriority is a prioritizer function that gives an equal weight of one to all minions
 		factory.RegisterPriorityFunction("EqualPriority", algorithm.EqualPriority, 0),
 	)
@@ -19,6 +19,8 @@ package algorithmprovider
 import (
 	"testing"
 
+	schedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api"
+	latestschedulerapi "github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/api/latest"
 	"github.com/GoogleCloudPlatform/kubernetes/plugin/pkg/scheduler/factory"
 )
 
@@ -63,3 +65,32 @@ func TestAlgorithmProviders(t *testing.T) {
 		}
 	}
 }
+
+func TestAffinityPolicy(t *testing.T) {
+	var policy schedulerapi.Policy
+	configData := []byte(`{
+		"kind" : "Policy",
+		"apiVersion" : "v1",
+		"predicates" : [
+			{"name" : "MatchNodeSelector"},
+			{"name" : "MatchInterPodAffinity"}
+		],
+		"priorities" : [
+			{"name" : "NodeAffinityPriority", "weight" : 2},
+			{"name" : "InterPodAffinityPriority", "weight" : 2}
+		]
+	}`)
+	if err := latestschedulerapi.Codec.DecodeInto(configData, &policy); err != nil {
+		t.Fatalf("Invalid configuration: %v", err)
+	}
+	for _, predicate := range policy.Predicates {
+		if !factory.IsFitPredicateRegistered(predicate.Name) {
+			t.Errorf("fit predicate %s is not registered", predicate.Name)
+		}
+	}
+	for _, priority := range policy.Priorities {
+		if !factory.IsPriorityFunctionRegistered(priority.Name) {
+			t.Errorf("priority function %s is not registered", priority.Name)
+		}
+	}
+}
@@ -39,11 +39,15 @@ import (
 // ConfigFactory knows how to fill out a scheduler config with its support functions.
 type ConfigFactory struct {
 	Client *client.Client
-	// queue for pods that need scheduling
-	PodQueue *cache.FIFO
+	// queue for pods that need scheduling, popped in priority order
+	PodQueue *cache.PriorityFIFO
 	// a means to list all known scheduled pods.
 	ScheduledPodLister *cache.StoreToPodLister
-	// a means to list all known scheduled pods and pods assumed to have been scheduled.
+	// a means to list all known pods that need scheduling, including the ones
+	// popped from the queue.
+	PendingPodLister *cache.StoreToPodLister
+	// a means to list all known scheduled pods, pods assumed to have been scheduled,
+	// and pods nominated for the room preempted for them.
 	PodLister algorithm.PodLister
 	// a means to list all minions
 	NodeLister *cache.StoreToNodeLister
@@ -57,17 +61,23 @@ type ConfigFactory struct {
 func NewConfigFactory(client *client.Client) *ConfigFactory {
 	c := &ConfigFactory{
 		Client:             client,
-		PodQueue:           cache.NewFIFO(cache.MetaNamespaceKeyFunc),
+		PodQueue:           cache.NewPriorityFIFO(cache.MetaNamespaceKeyFunc, podPriority),
 		ScheduledPodLister: &cache.StoreToPodLister{cache.NewStore(cache.MetaNamespaceKeyFunc)},
+		PendingPodLister:   &cache.StoreToPodLister{Store: cache.NewStore(cache.MetaNamespaceKeyFunc)},
 		NodeLister:         &cache.StoreToNodeLister{cache.NewStore(cache.MetaNamespaceKeyFunc)},
 		ServiceLister:      &cache.StoreToServiceLister{cache.NewStore(cache.MetaNamespaceKeyFunc)},
 	}
 	modeler := scheduler.NewSimpleModeler(&cache.StoreToPodLister{c.PodQueue}, c.ScheduledPodLister)
 	c.modeler = modeler
-	c.PodLister = modeler.PodLister()
+	c.PodLister = algorithm.NewNominatedPodLister(modeler.PodLister(), c.PendingPodLister)
 	return c
 }
 
+// podPriority orders the pods of the queue.
+func podPriority(obj interface{}) int {
+	return api.PodPriority(obj.(*api.Pod))
+}
+
 // Create creates a scheduler with the default algorithm provider.
 func (f *ConfigFactory) Create() (*scheduler.Config, error) {
 	return f.CreateFromProvider(DefaultProvider)
@@ -106,11 +116,13 @@ func (f *ConfigFactory) CreateFromConfig(policy schedulerapi.Policy) (*scheduler
 // Creates a scheduler from a set of registered fit predicate keys and priority keys.
 func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSet) (*scheduler.Config, error) {
 	glog.V(2).Infof("creating scheduler with fit predicates '%v' and priority functions '%v", predicateKeys, priorityKeys)
+	cycle := &algorithm.SchedulingCycle{}
 	pluginArgs := PluginFactoryArgs{
 		PodLister:     f.PodLister,
 		ServiceLister: f.ServiceLister,
 		NodeLister:    f.NodeLister,
 		NodeInfo:      f.NodeLister,
+		Cycle:         cycle,
 	}
 	predicateFuncs, err := getFitPredicateFunctions(predicateKeys, pluginArgs)
 	if err != nil {
@@ -125,6 +137,10 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 	// Watch and queue pods that need scheduling.
 	cache.NewReflector(f.createUnassignedPodLW(), &api.Pod{}, f.PodQueue, 0).Run()
 
+	// Watch and cache all pods that need scheduling, whether queued or not, so
+	// that the room preempted for the nominated ones is kept for them.
+	cache.NewReflector(f.createUnassignedPodLW(), &api.Pod{}, f.PendingPodLister.Store, 0).Run()
+
 	// Watch and cache all running pods. Scheduler needs to find all pods
 	// so it knows where it's safe to place a pod. Cache this locally.
 	cache.NewReflector(f.createAssignedPodLW(), &api.Pod{}, f.ScheduledPodLister.Store, 0).Run()
@@ -146,7 +162,7 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	algo := algorithm.NewGenericScheduler(predicateFuncs, priorityConfigs, f.PodLister, r)
+	algo := algorithm.NewGenericScheduler(predicateFuncs, priorityConfigs, f.PodLister, cycle, r)
 
 	podBackoff := podBackoff{
 		perPodBackoff: map[string]*backoffEntry{},
@@ -161,6 +177,7 @@ func (f *ConfigFactory) CreateFromKeys(predicateKeys, priorityKeys util.StringSe
 		MinionLister: f.NodeLister,
 		Algorithm:    algo,
 		Binder:       &binder{f.Client},
+		PodPreemptor: &podPreemptor{f.Client},
 		NextPod: func() *api.Pod {
 			pod := f.PodQueue.Pop().(*api.Pod)
 			glog.V(2).Infof("About to try and schedule pod %v", pod.Name)
@@ -242,7 +259,7 @@ func (factory *ConfigFactory) createServiceLW() *cache.ListWatch {
 	return cache.NewListWatchFromClient(factory.Client, "services", api.NamespaceAll, parseSelectorOrDie(""))
 }
 
-func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue *cache.FIFO) func(pod *api.Pod, err error) {
+func (factory *ConfigFactory) makeDefaultErrorFunc(backoff *podBackoff, podQueue *cache.PriorityFIFO) func(pod *api.Pod, err error) {
 	return func(pod *api.Pod, err error) {
 		glog.Errorf("Error scheduling %v %v: %v; retrying", pod.Namespace, pod.Name, err)
 		backoff.gc()
@@ -307,6 +324,26 @@ func (b *binder) Bind(binding *api.Binding) error {
 	// return b.Pods(binding.Namespace).Bind(binding)
 }
 
+type podPreemptor struct {
+	*client.Client
+}
+
+// DeletePod deletes a preempted pod gracefully.
+func (p *podPreemptor) DeletePod(pod *api.Pod) error {
+	return p.Pods(pod.Namespace).Delete(pod.Name, &api.DeleteOptions{})
+}
+
+// SetNominatedHost records the node a pod is nominated for in its status.
+func (p *podPreemptor) SetNominatedHost(pod *api.Pod, host string) error {
+	current, err := p.Pods(pod.Namespace).Get(pod.Name)
+	if err != nil {
+		return err
+	}
+	current.Status.NominatedHost = host
+	_, err = p.Pods(pod.Namespace).UpdateStatus(current.Name, &current.Status)
+	return err
+}
+
 type clock interface {
 	Now() time.Time
 }
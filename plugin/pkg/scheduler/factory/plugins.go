@@ -34,6 +34,8 @@ type PluginFactoryArgs struct {
 	algorithm.ServiceLister
 	NodeLister algorithm.MinionLister
 	NodeInfo   algorithm.NodeInfo
+	// Cycle is started by the scheduler each time it looks for a node for a pod.
+	Cycle *algorithm.SchedulingCycle
 }
 
 // A FitPredicateFactory produces a FitPredicate from the given args.
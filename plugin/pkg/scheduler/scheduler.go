@@ -46,6 +46,13 @@ type SystemModeler interface {
 	AssumePod(pod *api.Pod)
 }
 
+// PodPreemptor evicts the pods preempted by a pod of a higher priority, and
+// nominates that pod for the node where room is made for it.
+type PodPreemptor interface {
+	DeletePod(pod *api.Pod) error
+	SetNominatedHost(pod *api.Pod, host string) error
+}
+
 // Scheduler watches for new unscheduled pods. It attempts to find
 // minions that they fit on and writes bindings back to the api server.
 type Scheduler struct {
@@ -59,6 +66,9 @@ type Config struct {
 	MinionLister scheduler.MinionLister
 	Algorithm    scheduler.Scheduler
 	Binder       Binder
+	// PodPreemptor is used to make room for the pods which fit nowhere, if
+	// the Algorithm is a scheduler.Preemptor. Preemption is disabled if nil.
+	PodPreemptor PodPreemptor
 
 	// NextPod should be a function that blocks until the next pod
 	// is available. We don't use a channel for this, because scheduling
@@ -94,6 +104,9 @@ func (s *Scheduler) scheduleOne() {
 	if err != nil {
 		glog.V(1).Infof("Failed to schedule: %v", pod)
 		s.config.Recorder.Eventf(pod, "failedScheduling", "Error scheduling: %v", err)
+		if _, ok := err.(*scheduler.FitError); ok {
+			s.preempt(pod)
+		}
 		s.config.Error(pod, err)
 		return
 	}
@@ -117,3 +130,34 @@ func (s *Scheduler) scheduleOne() {
 	assumed.Status.Host = dest
 	s.config.Modeler.AssumePod(&assumed)
 }
+
+// preempt evicts the pods of a lower priority which keep a pod from fitting on
+// a minion, and nominates the pod for that minion. The pod is scheduled there
+// once it is retried and the evicted pods are gone.
+func (s *Scheduler) preempt(pod *api.Pod) {
+	preemptor, ok := s.config.Algorithm.(scheduler.Preemptor)
+	if !ok || s.config.PodPreemptor == nil {
+		return
+	}
+	node, victims, err := preemptor.Preempt(*pod, s.config.MinionLister)
+	if err != nil {
+		glog.Errorf("Error preempting pods for %v/%v: %v", pod.Namespace, pod.Name, err)
+		return
+	}
+	if len(node) == 0 {
+		return
+	}
+	for i := range victims {
+		victim := &victims[i]
+		if err := s.config.PodPreemptor.DeletePod(victim); err != nil {
+			glog.Errorf("Error preempting pod %v/%v: %v", victim.Namespace, victim.Name, err)
+			return
+		}
+		s.config.Recorder.Eventf(victim, "preempted", "Preempted by %v/%v on node %v", pod.Namespace, pod.Name, node)
+	}
+	if pod.Status.NominatedHost != node {
+		if err := s.config.PodPreemptor.SetNominatedHost(pod, node); err != nil {
+			glog.Errorf("Error nominating %v/%v for node %v: %v", pod.Namespace, pod.Name, node, err)
+		}
+	}
+}
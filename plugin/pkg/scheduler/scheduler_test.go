@@ -146,3 +146,84 @@ func TestScheduler(t *testing.T) {
 		events.Stop()
 	}
 }
+
+type mockPreemptor struct {
+	mockScheduler
+	machine string
+	victims []api.Pod
+}
+
+func (mp mockPreemptor) Preempt(pod api.Pod, ml scheduler.MinionLister) (string, []api.Pod, error) {
+	return mp.machine, mp.victims, nil
+}
+
+type fakePodPreemptor struct {
+	deleted   []string
+	nominated map[string]string
+}
+
+func (fp *fakePodPreemptor) DeletePod(pod *api.Pod) error {
+	fp.deleted = append(fp.deleted, pod.Name)
+	return nil
+}
+
+func (fp *fakePodPreemptor) SetNominatedHost(pod *api.Pod, host string) error {
+	fp.nominated[pod.Name] = host
+	return nil
+}
+
+func TestSchedulerPreemption(t *testing.T) {
+	defer record.StartLogging(t.Logf).Stop()
+	errFit := &scheduler.FitError{Pod: *podWithID("foo", "")}
+	errS := errors.New("scheduler")
+
+	table := []struct {
+		algo            scheduler.Scheduler
+		expectDeleted   []string
+		expectNominated map[string]string
+	}{
+		{
+			algo:            mockPreemptor{mockScheduler{"", errFit}, "machine1", []api.Pod{*podWithID("bar", "machine1")}},
+			expectDeleted:   []string{"bar"},
+			expectNominated: map[string]string{"foo": "machine1"},
+		}, {
+			algo:            mockPreemptor{mockScheduler{"", errFit}, "", nil},
+			expectNominated: map[string]string{},
+		}, {
+			algo:            mockPreemptor{mockScheduler{"", errS}, "machine1", []api.Pod{*podWithID("bar", "machine1")}},
+			expectNominated: map[string]string{},
+		}, {
+			algo:            mockScheduler{"", errFit},
+			expectNominated: map[string]string{},
+		},
+	}
+
+	for i, item := range table {
+		var gotError error
+		preemptor := &fakePodPreemptor{nominated: map[string]string{}}
+		c := &Config{
+			MinionLister: scheduler.FakeMinionLister(
+				api.NodeList{Items: []api.Node{{ObjectMeta: api.ObjectMeta{Name: "machine1"}}}},
+			),
+			Algorithm:    item.algo,
+			PodPreemptor: preemptor,
+			Error: func(p *api.Pod, err error) {
+				gotError = err
+			},
+			NextPod: func() *api.Pod {
+				return podWithID("foo", "")
+			},
+			Recorder: record.FromSource(api.EventSource{Component: "scheduler"}),
+		}
+		New(c).scheduleOne()
+		if gotError == nil {
+			t.Errorf("%v: expected an error", i)
+		}
+		if e, a := item.expectDeleted, preemptor.deleted; !reflect.DeepEqual(e, a) {
+			t.Errorf("%v: deleted pods: wanted %v, got %v", i, e, a)
+		}
+		if e, a := item.expectNominated, preemptor.nominated; !reflect.DeepEqual(e, a) {
+			t.Errorf("%v: nominated hosts: wanted %v, got %v", i, e, a)
+		}
+	}
+}
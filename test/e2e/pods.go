@@ -43,7 +43,7 @@ func runLivenessTest(c *client.Client, podDescr *api.Pod) {
 	// At the end of the test, clean up by removing the pod.
 	defer func() {
 		By("deleting the pod")
-		c.Pods(ns).Delete(podDescr.Name)
+		c.Pods(ns).Delete(podDescr.Name, nil)
 	}()
 
 	// Wait until the pod is not pending. (Here we need to check for something other than
@@ -140,7 +140,7 @@ var _ = Describe("Pods", func() {
 		// We call defer here in case there is a problem with
 		// the test so we can ensure that we clean up after
 		// ourselves
-		defer podClient.Delete(pod.Name)
+		defer podClient.Delete(pod.Name, nil)
 		_, err = podClient.Create(pod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create pod: %v", err))
@@ -164,7 +164,7 @@ var _ = Describe("Pods", func() {
 		}
 
 		By("deleting the pod")
-		podClient.Delete(pod.Name)
+		podClient.Delete(pod.Name, nil)
 		pods, err = podClient.List(labels.SelectorFromSet(labels.Set(map[string]string{"time": value})))
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to delete pod: %v", err))
@@ -227,7 +227,7 @@ var _ = Describe("Pods", func() {
 		By("submitting the pod to kubernetes")
 		defer func() {
 			By("deleting the pod")
-			podClient.Delete(pod.Name)
+			podClient.Delete(pod.Name, nil)
 		}()
 		_, err := podClient.Create(pod)
 		if err != nil {
@@ -284,7 +284,7 @@ var _ = Describe("Pods", func() {
 				},
 			},
 		}
-		defer c.Pods(api.NamespaceDefault).Delete(serverPod.Name)
+		defer c.Pods(api.NamespaceDefault).Delete(serverPod.Name, nil)
 		_, err := c.Pods(api.NamespaceDefault).Create(serverPod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create serverPod: %v", err))
@@ -307,8 +307,11 @@ var _ = Describe("Pods", func() {
 				},
 			},
 			Spec: api.ServiceSpec{
-				Port:       8765,
-				TargetPort: util.NewIntOrStringFromInt(8080),
+				Ports: []api.ServicePort{{
+					Protocol:   "TCP",
+					Port:       8765,
+					TargetPort: util.NewIntOrStringFromInt(8080),
+				}},
 				Selector: map[string]string{
 					"name": serverName,
 				},
@@ -340,7 +343,7 @@ var _ = Describe("Pods", func() {
 				RestartPolicy: api.RestartPolicyNever,
 			},
 		}
-		defer c.Pods(api.NamespaceDefault).Delete(clientPod.Name)
+		defer c.Pods(api.NamespaceDefault).Delete(clientPod.Name, nil)
 		_, err = c.Pods(api.NamespaceDefault).Create(clientPod)
 		if err != nil {
 			Fail(fmt.Sprintf("Failed to create pod: %v", err))
@@ -492,7 +495,7 @@ var _ = Describe("Pods", func() {
 				// We call defer here in case there is a problem with
 				// the test so we can ensure that we clean up after
 				// ourselves
-				podClient.Delete(pod.Name)
+				podClient.Delete(pod.Name, nil)
 			}()
 
 			By("waiting for the pod to start running")
@@ -565,7 +568,7 @@ var _ = Describe("Pods", func() {
 				// We call defer here in case there is a problem with
 				// the test so we can ensure that we clean up after
 				// ourselves
-				podClient.Delete(pod.Name)
+				podClient.Delete(pod.Name, nil)
 			}()
 
 			By("waiting for the pod to start running")
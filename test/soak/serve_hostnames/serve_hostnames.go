@@ -95,8 +95,11 @@ func main() {
 			},
 		},
 		Spec: api.ServiceSpec{
-			Port:       9376,
-			TargetPort: util.NewIntOrStringFromInt(9376),
+			Ports: []api.ServicePort{{
+				Protocol:   "TCP",
+				Port:       9376,
+				TargetPort: util.NewIntOrStringFromInt(9376),
+			}},
 			Selector: map[string]string{
 				"name": "serve-hostname",
 			},
@@ -149,7 +152,7 @@ func main() {
 	defer func() {
 		glog.Info("Cleaning up pods")
 		for _, podName := range podNames {
-			if err := c.Pods(ns).Delete(podName); err != nil {
+			if err := c.Pods(ns).Delete(podName, nil); err != nil {
 				glog.Warningf("Failed to delete pod %s: %v", podName, err)
 			}
 		}